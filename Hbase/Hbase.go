@@ -40,7 +40,7 @@ type IHbase interface {
 	 * Parameters:
 	 *  - TableName: name of the table to check
 	 */
-	IsTableEnabled(tableName Bytes) (retval113 bool, io *IOError, err error)
+	IsTableEnabled(tableName Bytes) (retval164 bool, io *IOError, err error)
 	/**
 	 * Parameters:
 	 *  - TableNameOrRegionName
@@ -56,7 +56,7 @@ type IHbase interface {
 	 * 
 	 * @return returns a list of names
 	 */
-	GetTableNames() (retval116 []Text, io *IOError, err error)
+	GetTableNames() (retval167 []Text, io *IOError, err error)
 	/**
 	 * List all the column families assoicated with a table.
 	 * 
@@ -65,7 +65,7 @@ type IHbase interface {
 	 * Parameters:
 	 *  - TableName: table name
 	 */
-	GetColumnDescriptors(tableName Text) (retval117 map[string]*ColumnDescriptor, io *IOError, err error)
+	GetColumnDescriptors(tableName Text) (retval168 map[string]*ColumnDescriptor, io *IOError, err error)
 	/**
 	 * List the regions associated with a table.
 	 * 
@@ -74,7 +74,7 @@ type IHbase interface {
 	 * Parameters:
 	 *  - TableName: table name
 	 */
-	GetTableRegions(tableName Text) (retval118 []*TRegionInfo, io *IOError, err error)
+	GetTableRegions(tableName Text) (retval169 []*TRegionInfo, io *IOError, err error)
 	/**
 	 * Create a table with the specified column families.  The name
 	 * field for each ColumnDescriptor must be set and must end in a
@@ -112,7 +112,7 @@ type IHbase interface {
 	 *  - Column: column name
 	 *  - Attributes: Get attributes
 	 */
-	Get(tableName Text, row Text, column Text, attributes map[string]Text) (retval121 []*TCell, io *IOError, err error)
+	Get(tableName Text, row Text, column Text, attributes map[string]Text) (retval172 []*TCell, io *IOError, err error)
 	/**
 	 * Get the specified number of versions for the specified table,
 	 * row, and column.
@@ -126,7 +126,7 @@ type IHbase interface {
 	 *  - NumVersions: number of versions to retrieve
 	 *  - Attributes: Get attributes
 	 */
-	GetVer(tableName Text, row Text, column Text, numVersions int32, attributes map[string]Text) (retval122 []*TCell, io *IOError, err error)
+	GetVer(tableName Text, row Text, column Text, numVersions int32, attributes map[string]Text) (retval173 []*TCell, io *IOError, err error)
 	/**
 	 * Get the specified number of versions for the specified table,
 	 * row, and column.  Only versions less than or equal to the specified
@@ -142,7 +142,7 @@ type IHbase interface {
 	 *  - NumVersions: number of versions to retrieve
 	 *  - Attributes: Get attributes
 	 */
-	GetVerTs(tableName Text, row Text, column Text, timestamp int64, numVersions int32, attributes map[string]Text) (retval123 []*TCell, io *IOError, err error)
+	GetVerTs(tableName Text, row Text, column Text, timestamp int64, numVersions int32, attributes map[string]Text) (retval174 []*TCell, io *IOError, err error)
 	/**
 	 * Get all the data for the specified table and row at the latest
 	 * timestamp. Returns an empty list if the row does not exist.
@@ -154,7 +154,7 @@ type IHbase interface {
 	 *  - Row: row key
 	 *  - Attributes: Get attributes
 	 */
-	GetRow(tableName Text, row Text, attributes map[string]Text) (retval124 []*TRowResult, io *IOError, err error)
+	GetRow(tableName Text, row Text, attributes map[string]Text) (retval175 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get the specified columns for the specified table and row at the latest
 	 * timestamp. Returns an empty list if the row does not exist.
@@ -167,7 +167,7 @@ type IHbase interface {
 	 *  - Columns: List of columns to return, null for all columns
 	 *  - Attributes: Get attributes
 	 */
-	GetRowWithColumns(tableName Text, row Text, columns []Text, attributes map[string]Text) (retval125 []*TRowResult, io *IOError, err error)
+	GetRowWithColumns(tableName Text, row Text, columns []Text, attributes map[string]Text) (retval176 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get all the data for the specified table and row at the specified
 	 * timestamp. Returns an empty list if the row does not exist.
@@ -180,7 +180,7 @@ type IHbase interface {
 	 *  - Timestamp: timestamp
 	 *  - Attributes: Get attributes
 	 */
-	GetRowTs(tableName Text, row Text, timestamp int64, attributes map[string]Text) (retval126 []*TRowResult, io *IOError, err error)
+	GetRowTs(tableName Text, row Text, timestamp int64, attributes map[string]Text) (retval177 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get the specified columns for the specified table and row at the specified
 	 * timestamp. Returns an empty list if the row does not exist.
@@ -194,7 +194,7 @@ type IHbase interface {
 	 *  - Timestamp
 	 *  - Attributes: Get attributes
 	 */
-	GetRowWithColumnsTs(tableName Text, row Text, columns []Text, timestamp int64, attributes map[string]Text) (retval127 []*TRowResult, io *IOError, err error)
+	GetRowWithColumnsTs(tableName Text, row Text, columns []Text, timestamp int64, attributes map[string]Text) (retval178 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get all the data for the specified table and rows at the latest
 	 * timestamp. Returns an empty list if no rows exist.
@@ -206,7 +206,7 @@ type IHbase interface {
 	 *  - Rows: row keys
 	 *  - Attributes: Get attributes
 	 */
-	GetRows(tableName Text, rows []Text, attributes map[string]Text) (retval128 []*TRowResult, io *IOError, err error)
+	GetRows(tableName Text, rows []Text, attributes map[string]Text) (retval179 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get the specified columns for the specified table and rows at the latest
 	 * timestamp. Returns an empty list if no rows exist.
@@ -219,7 +219,7 @@ type IHbase interface {
 	 *  - Columns: List of columns to return, null for all columns
 	 *  - Attributes: Get attributes
 	 */
-	GetRowsWithColumns(tableName Text, rows []Text, columns []Text, attributes map[string]Text) (retval129 []*TRowResult, io *IOError, err error)
+	GetRowsWithColumns(tableName Text, rows []Text, columns []Text, attributes map[string]Text) (retval180 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get all the data for the specified table and rows at the specified
 	 * timestamp. Returns an empty list if no rows exist.
@@ -232,7 +232,7 @@ type IHbase interface {
 	 *  - Timestamp: timestamp
 	 *  - Attributes: Get attributes
 	 */
-	GetRowsTs(tableName Text, rows []Text, timestamp int64, attributes map[string]Text) (retval130 []*TRowResult, io *IOError, err error)
+	GetRowsTs(tableName Text, rows []Text, timestamp int64, attributes map[string]Text) (retval181 []*TRowResult, io *IOError, err error)
 	/**
 	 * Get the specified columns for the specified table and rows at the specified
 	 * timestamp. Returns an empty list if no rows exist.
@@ -246,7 +246,7 @@ type IHbase interface {
 	 *  - Timestamp
 	 *  - Attributes: Get attributes
 	 */
-	GetRowsWithColumnsTs(tableName Text, rows []Text, columns []Text, timestamp int64, attributes map[string]Text) (retval131 []*TRowResult, io *IOError, err error)
+	GetRowsWithColumnsTs(tableName Text, rows []Text, columns []Text, timestamp int64, attributes map[string]Text) (retval182 []*TRowResult, io *IOError, err error)
 	/**
 	 * Apply a series of mutations (updates/deletes) to a row in a
 	 * single transaction.  If an exception is thrown, then the
@@ -308,7 +308,7 @@ type IHbase interface {
 	 *  - Column: name of column
 	 *  - Value: amount to increment by
 	 */
-	AtomicIncrement(tableName Text, row Text, column Text, value int64) (retval136 int64, io *IOError, ia *IllegalArgument, err error)
+	AtomicIncrement(tableName Text, row Text, column Text, value int64) (retval187 int64, io *IOError, ia *IllegalArgument, err error)
 	/**
 	 * Delete all cells that match the passed row and column.
 	 * 
@@ -375,7 +375,7 @@ type IHbase interface {
 	 *  - Scan: Scan instance
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpenWithScan(tableName Text, scan *TScan, attributes map[string]Text) (retval143 ScannerID, io *IOError, err error)
+	ScannerOpenWithScan(tableName Text, scan *TScan, attributes map[string]Text) (retval194 ScannerID, io *IOError, err error)
 	/**
 	 * Get a scanner on the current table starting at the specified row and
 	 * ending at the last row in the table.  Return the specified columns.
@@ -391,7 +391,7 @@ type IHbase interface {
 	 * to pass a regex in the column qualifier.
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpen(tableName Text, startRow Text, columns []Text, attributes map[string]Text) (retval144 ScannerID, io *IOError, err error)
+	ScannerOpen(tableName Text, startRow Text, columns []Text, attributes map[string]Text) (retval195 ScannerID, io *IOError, err error)
 	/**
 	 * Get a scanner on the current table starting and stopping at the
 	 * specified rows.  ending at the last row in the table.  Return the
@@ -410,7 +410,7 @@ type IHbase interface {
 	 * to pass a regex in the column qualifier.
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpenWithStop(tableName Text, startRow Text, stopRow Text, columns []Text, attributes map[string]Text) (retval145 ScannerID, io *IOError, err error)
+	ScannerOpenWithStop(tableName Text, startRow Text, stopRow Text, columns []Text, attributes map[string]Text) (retval196 ScannerID, io *IOError, err error)
 	/**
 	 * Open a scanner for a given prefix.  That is all rows will have the specified
 	 * prefix. No other rows will be returned.
@@ -423,7 +423,7 @@ type IHbase interface {
 	 *  - Columns: the columns you want returned
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpenWithPrefix(tableName Text, startAndPrefix Text, columns []Text, attributes map[string]Text) (retval146 ScannerID, io *IOError, err error)
+	ScannerOpenWithPrefix(tableName Text, startAndPrefix Text, columns []Text, attributes map[string]Text) (retval197 ScannerID, io *IOError, err error)
 	/**
 	 * Get a scanner on the current table starting at the specified row and
 	 * ending at the last row in the table.  Return the specified columns.
@@ -441,7 +441,7 @@ type IHbase interface {
 	 *  - Timestamp: timestamp
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpenTs(tableName Text, startRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval147 ScannerID, io *IOError, err error)
+	ScannerOpenTs(tableName Text, startRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval198 ScannerID, io *IOError, err error)
 	/**
 	 * Get a scanner on the current table starting and stopping at the
 	 * specified rows.  ending at the last row in the table.  Return the
@@ -462,7 +462,7 @@ type IHbase interface {
 	 *  - Timestamp: timestamp
 	 *  - Attributes: Scan attributes
 	 */
-	ScannerOpenWithStopTs(tableName Text, startRow Text, stopRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval148 ScannerID, io *IOError, err error)
+	ScannerOpenWithStopTs(tableName Text, startRow Text, stopRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval199 ScannerID, io *IOError, err error)
 	/**
 	 * Returns the scanner's current row value and advances to the next
 	 * row in the table.  When there are no more rows in the table, or a key
@@ -478,7 +478,7 @@ type IHbase interface {
 	 * Parameters:
 	 *  - Id: id of a scanner returned by scannerOpen
 	 */
-	ScannerGet(id ScannerID) (retval149 []*TRowResult, io *IOError, ia *IllegalArgument, err error)
+	ScannerGet(id ScannerID) (retval200 []*TRowResult, io *IOError, ia *IllegalArgument, err error)
 	/**
 	 * Returns, starting at the scanner's current row value nbRows worth of
 	 * rows and advances to the next row in the table.  When there are no more
@@ -495,7 +495,7 @@ type IHbase interface {
 	 *  - Id: id of a scanner returned by scannerOpen
 	 *  - NbRows: number of results to return
 	 */
-	ScannerGetList(id ScannerID, nbRows int32) (retval150 []*TRowResult, io *IOError, ia *IllegalArgument, err error)
+	ScannerGetList(id ScannerID, nbRows int32) (retval201 []*TRowResult, io *IOError, ia *IllegalArgument, err error)
 	/**
 	 * Closes the server-state associated with an open scanner.
 	 * 
@@ -515,7 +515,7 @@ type IHbase interface {
 	 *  - Row: row key
 	 *  - Family: column name
 	 */
-	GetRowOrBefore(tableName Text, row Text, family Text) (retval152 []*TCell, io *IOError, err error)
+	GetRowOrBefore(tableName Text, row Text, family Text) (retval203 []*TCell, io *IOError, err error)
 	/**
 	 * Get the regininfo for the specified row. It scans
 	 * the metatable to find region's start and end keys.
@@ -525,7 +525,33 @@ type IHbase interface {
 	 * Parameters:
 	 *  - Row: row key
 	 */
-	GetRegionInfo(row Text) (retval153 *TRegionInfo, io *IOError, err error)
+	GetRegionInfo(row Text) (retval204 *TRegionInfo, io *IOError, err error)
+	/**
+	 * Appends values to one or more columns within a single row.
+	 * 
+	 * @return values of columns after the append operation.
+	 * 
+	 * Parameters:
+	 *  - Append: The single append operation to apply
+	 */
+	Append(append *TAppend) (retval205 []*TCell, io *IOError, err error)
+	/**
+	 * Atomically checks if a row/family/qualifier value matches the expected
+	 * value. If it does, it adds the corresponding mutation operation for put.
+	 * 
+	 * @return true if the new put was executed, false otherwise
+	 * 
+	 * Parameters:
+	 *  - TableName: name of table
+	 *  - Row: row key
+	 *  - Column: column name
+	 *  - Value: the expected value for the column parameter, if not
+	 * provided the check is for the non-existence of the
+	 * column in question
+	 *  - Mput: mutation for the put
+	 *  - Attributes: Mutation attributes
+	 */
+	CheckAndPut(tableName Text, row Text, column Text, value Text, mput *Mutation, attributes map[string]Text) (retval206 bool, io *IOError, ia *IllegalArgument, err error)
 }
 
 type HbaseClient struct {
@@ -576,9 +602,9 @@ func (p *HbaseClient) SendEnableTable(tableName Bytes) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("enableTable", thrift.CALL, p.SeqId)
-	args155 := NewEnableTableArgs()
-	args155.TableName = tableName
-	err = args155.Write(oprot)
+	args208 := NewEnableTableArgs()
+	args208.TableName = tableName
+	err = args208.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -595,27 +621,27 @@ func (p *HbaseClient) RecvEnableTable() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error157 := thrift.NewTApplicationExceptionDefault()
-		var error158 error
-		error158, err = error157.Read(iprot)
+		error210 := thrift.NewTApplicationExceptionDefault()
+		var error211 error
+		error211, err = error210.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error158
+		err = error211
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result156 := NewEnableTableResult()
-	err = result156.Read(iprot)
+	result209 := NewEnableTableResult()
+	err = result209.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result156.Io != nil {
-		io = result156.Io
+	if result209.Io != nil {
+		io = result209.Io
 	}
 	return
 }
@@ -643,9 +669,9 @@ func (p *HbaseClient) SendDisableTable(tableName Bytes) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("disableTable", thrift.CALL, p.SeqId)
-	args160 := NewDisableTableArgs()
-	args160.TableName = tableName
-	err = args160.Write(oprot)
+	args213 := NewDisableTableArgs()
+	args213.TableName = tableName
+	err = args213.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -662,27 +688,27 @@ func (p *HbaseClient) RecvDisableTable() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error162 := thrift.NewTApplicationExceptionDefault()
-		var error163 error
-		error163, err = error162.Read(iprot)
+		error215 := thrift.NewTApplicationExceptionDefault()
+		var error216 error
+		error216, err = error215.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error163
+		err = error216
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result161 := NewDisableTableResult()
-	err = result161.Read(iprot)
+	result214 := NewDisableTableResult()
+	err = result214.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result161.Io != nil {
-		io = result161.Io
+	if result214.Io != nil {
+		io = result214.Io
 	}
 	return
 }
@@ -693,7 +719,7 @@ func (p *HbaseClient) RecvDisableTable() (io *IOError, err error) {
  * Parameters:
  *  - TableName: name of the table to check
  */
-func (p *HbaseClient) IsTableEnabled(tableName Bytes) (retval164 bool, io *IOError, err error) {
+func (p *HbaseClient) IsTableEnabled(tableName Bytes) (retval217 bool, io *IOError, err error) {
 	err = p.SendIsTableEnabled(tableName)
 	if err != nil {
 		return
@@ -709,9 +735,9 @@ func (p *HbaseClient) SendIsTableEnabled(tableName Bytes) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("isTableEnabled", thrift.CALL, p.SeqId)
-	args165 := NewIsTableEnabledArgs()
-	args165.TableName = tableName
-	err = args165.Write(oprot)
+	args218 := NewIsTableEnabledArgs()
+	args218.TableName = tableName
+	err = args218.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -728,28 +754,28 @@ func (p *HbaseClient) RecvIsTableEnabled() (value bool, io *IOError, err error)
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error167 := thrift.NewTApplicationExceptionDefault()
-		var error168 error
-		error168, err = error167.Read(iprot)
+		error220 := thrift.NewTApplicationExceptionDefault()
+		var error221 error
+		error221, err = error220.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error168
+		err = error221
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result166 := NewIsTableEnabledResult()
-	err = result166.Read(iprot)
+	result219 := NewIsTableEnabledResult()
+	err = result219.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result166.Success
-	if result166.Io != nil {
-		io = result166.Io
+	value = result219.Success
+	if result219.Io != nil {
+		io = result219.Io
 	}
 	return
 }
@@ -774,9 +800,9 @@ func (p *HbaseClient) SendCompact(tableNameOrRegionName Bytes) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("compact", thrift.CALL, p.SeqId)
-	args170 := NewCompactArgs()
-	args170.TableNameOrRegionName = tableNameOrRegionName
-	err = args170.Write(oprot)
+	args223 := NewCompactArgs()
+	args223.TableNameOrRegionName = tableNameOrRegionName
+	err = args223.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -793,27 +819,27 @@ func (p *HbaseClient) RecvCompact() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error172 := thrift.NewTApplicationExceptionDefault()
-		var error173 error
-		error173, err = error172.Read(iprot)
+		error225 := thrift.NewTApplicationExceptionDefault()
+		var error226 error
+		error226, err = error225.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error173
+		err = error226
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result171 := NewCompactResult()
-	err = result171.Read(iprot)
+	result224 := NewCompactResult()
+	err = result224.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result171.Io != nil {
-		io = result171.Io
+	if result224.Io != nil {
+		io = result224.Io
 	}
 	return
 }
@@ -838,9 +864,9 @@ func (p *HbaseClient) SendMajorCompact(tableNameOrRegionName Bytes) (err error)
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("majorCompact", thrift.CALL, p.SeqId)
-	args175 := NewMajorCompactArgs()
-	args175.TableNameOrRegionName = tableNameOrRegionName
-	err = args175.Write(oprot)
+	args228 := NewMajorCompactArgs()
+	args228.TableNameOrRegionName = tableNameOrRegionName
+	err = args228.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -857,27 +883,27 @@ func (p *HbaseClient) RecvMajorCompact() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error177 := thrift.NewTApplicationExceptionDefault()
-		var error178 error
-		error178, err = error177.Read(iprot)
+		error230 := thrift.NewTApplicationExceptionDefault()
+		var error231 error
+		error231, err = error230.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error178
+		err = error231
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result176 := NewMajorCompactResult()
-	err = result176.Read(iprot)
+	result229 := NewMajorCompactResult()
+	err = result229.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result176.Io != nil {
-		io = result176.Io
+	if result229.Io != nil {
+		io = result229.Io
 	}
 	return
 }
@@ -887,7 +913,7 @@ func (p *HbaseClient) RecvMajorCompact() (io *IOError, err error) {
  * 
  * @return returns a list of names
  */
-func (p *HbaseClient) GetTableNames() (retval179 []Text, io *IOError, err error) {
+func (p *HbaseClient) GetTableNames() (retval232 []Text, io *IOError, err error) {
 	err = p.SendGetTableNames()
 	if err != nil {
 		return
@@ -903,8 +929,8 @@ func (p *HbaseClient) SendGetTableNames() (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getTableNames", thrift.CALL, p.SeqId)
-	args180 := NewGetTableNamesArgs()
-	err = args180.Write(oprot)
+	args233 := NewGetTableNamesArgs()
+	err = args233.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -921,28 +947,28 @@ func (p *HbaseClient) RecvGetTableNames() (value []Text, io *IOError, err error)
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error182 := thrift.NewTApplicationExceptionDefault()
-		var error183 error
-		error183, err = error182.Read(iprot)
+		error235 := thrift.NewTApplicationExceptionDefault()
+		var error236 error
+		error236, err = error235.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error183
+		err = error236
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result181 := NewGetTableNamesResult()
-	err = result181.Read(iprot)
+	result234 := NewGetTableNamesResult()
+	err = result234.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result181.Success
-	if result181.Io != nil {
-		io = result181.Io
+	value = result234.Success
+	if result234.Io != nil {
+		io = result234.Io
 	}
 	return
 }
@@ -955,7 +981,7 @@ func (p *HbaseClient) RecvGetTableNames() (value []Text, io *IOError, err error)
  * Parameters:
  *  - TableName: table name
  */
-func (p *HbaseClient) GetColumnDescriptors(tableName Text) (retval184 map[string]*ColumnDescriptor, io *IOError, err error) {
+func (p *HbaseClient) GetColumnDescriptors(tableName Text) (retval237 map[string]*ColumnDescriptor, io *IOError, err error) {
 	err = p.SendGetColumnDescriptors(tableName)
 	if err != nil {
 		return
@@ -971,9 +997,9 @@ func (p *HbaseClient) SendGetColumnDescriptors(tableName Text) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getColumnDescriptors", thrift.CALL, p.SeqId)
-	args185 := NewGetColumnDescriptorsArgs()
-	args185.TableName = tableName
-	err = args185.Write(oprot)
+	args238 := NewGetColumnDescriptorsArgs()
+	args238.TableName = tableName
+	err = args238.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -990,28 +1016,28 @@ func (p *HbaseClient) RecvGetColumnDescriptors() (value map[string]*ColumnDescri
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error187 := thrift.NewTApplicationExceptionDefault()
-		var error188 error
-		error188, err = error187.Read(iprot)
+		error240 := thrift.NewTApplicationExceptionDefault()
+		var error241 error
+		error241, err = error240.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error188
+		err = error241
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result186 := NewGetColumnDescriptorsResult()
-	err = result186.Read(iprot)
+	result239 := NewGetColumnDescriptorsResult()
+	err = result239.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result186.Success
-	if result186.Io != nil {
-		io = result186.Io
+	value = result239.Success
+	if result239.Io != nil {
+		io = result239.Io
 	}
 	return
 }
@@ -1024,7 +1050,7 @@ func (p *HbaseClient) RecvGetColumnDescriptors() (value map[string]*ColumnDescri
  * Parameters:
  *  - TableName: table name
  */
-func (p *HbaseClient) GetTableRegions(tableName Text) (retval189 []*TRegionInfo, io *IOError, err error) {
+func (p *HbaseClient) GetTableRegions(tableName Text) (retval242 []*TRegionInfo, io *IOError, err error) {
 	err = p.SendGetTableRegions(tableName)
 	if err != nil {
 		return
@@ -1040,9 +1066,9 @@ func (p *HbaseClient) SendGetTableRegions(tableName Text) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getTableRegions", thrift.CALL, p.SeqId)
-	args190 := NewGetTableRegionsArgs()
-	args190.TableName = tableName
-	err = args190.Write(oprot)
+	args243 := NewGetTableRegionsArgs()
+	args243.TableName = tableName
+	err = args243.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1059,28 +1085,28 @@ func (p *HbaseClient) RecvGetTableRegions() (value []*TRegionInfo, io *IOError,
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error192 := thrift.NewTApplicationExceptionDefault()
-		var error193 error
-		error193, err = error192.Read(iprot)
+		error245 := thrift.NewTApplicationExceptionDefault()
+		var error246 error
+		error246, err = error245.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error193
+		err = error246
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result191 := NewGetTableRegionsResult()
-	err = result191.Read(iprot)
+	result244 := NewGetTableRegionsResult()
+	err = result244.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result191.Success
-	if result191.Io != nil {
-		io = result191.Io
+	value = result244.Success
+	if result244.Io != nil {
+		io = result244.Io
 	}
 	return
 }
@@ -1115,10 +1141,10 @@ func (p *HbaseClient) SendCreateTable(tableName Text, columnFamilies []*ColumnDe
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("createTable", thrift.CALL, p.SeqId)
-	args195 := NewCreateTableArgs()
-	args195.TableName = tableName
-	args195.ColumnFamilies = columnFamilies
-	err = args195.Write(oprot)
+	args248 := NewCreateTableArgs()
+	args248.TableName = tableName
+	args248.ColumnFamilies = columnFamilies
+	err = args248.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1135,33 +1161,33 @@ func (p *HbaseClient) RecvCreateTable() (io *IOError, ia *IllegalArgument, exist
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error197 := thrift.NewTApplicationExceptionDefault()
-		var error198 error
-		error198, err = error197.Read(iprot)
+		error250 := thrift.NewTApplicationExceptionDefault()
+		var error251 error
+		error251, err = error250.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error198
+		err = error251
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result196 := NewCreateTableResult()
-	err = result196.Read(iprot)
+	result249 := NewCreateTableResult()
+	err = result249.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result196.Io != nil {
-		io = result196.Io
+	if result249.Io != nil {
+		io = result249.Io
 	}
-	if result196.Ia != nil {
-		ia = result196.Ia
+	if result249.Ia != nil {
+		ia = result249.Ia
 	}
-	if result196.Exist != nil {
-		exist = result196.Exist
+	if result249.Exist != nil {
+		exist = result249.Exist
 	}
 	return
 }
@@ -1191,9 +1217,9 @@ func (p *HbaseClient) SendDeleteTable(tableName Text) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("deleteTable", thrift.CALL, p.SeqId)
-	args200 := NewDeleteTableArgs()
-	args200.TableName = tableName
-	err = args200.Write(oprot)
+	args253 := NewDeleteTableArgs()
+	args253.TableName = tableName
+	err = args253.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1210,27 +1236,27 @@ func (p *HbaseClient) RecvDeleteTable() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error202 := thrift.NewTApplicationExceptionDefault()
-		var error203 error
-		error203, err = error202.Read(iprot)
+		error255 := thrift.NewTApplicationExceptionDefault()
+		var error256 error
+		error256, err = error255.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error203
+		err = error256
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result201 := NewDeleteTableResult()
-	err = result201.Read(iprot)
+	result254 := NewDeleteTableResult()
+	err = result254.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result201.Io != nil {
-		io = result201.Io
+	if result254.Io != nil {
+		io = result254.Io
 	}
 	return
 }
@@ -1247,7 +1273,7 @@ func (p *HbaseClient) RecvDeleteTable() (io *IOError, err error) {
  *  - Column: column name
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) Get(tableName Text, row Text, column Text, attributes map[string]Text) (retval204 []*TCell, io *IOError, err error) {
+func (p *HbaseClient) Get(tableName Text, row Text, column Text, attributes map[string]Text) (retval257 []*TCell, io *IOError, err error) {
 	err = p.SendGet(tableName, row, column, attributes)
 	if err != nil {
 		return
@@ -1263,12 +1289,12 @@ func (p *HbaseClient) SendGet(tableName Text, row Text, column Text, attributes
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("get", thrift.CALL, p.SeqId)
-	args205 := NewGetArgs()
-	args205.TableName = tableName
-	args205.Row = row
-	args205.Column = column
-	args205.Attributes = attributes
-	err = args205.Write(oprot)
+	args258 := NewGetArgs()
+	args258.TableName = tableName
+	args258.Row = row
+	args258.Column = column
+	args258.Attributes = attributes
+	err = args258.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1285,28 +1311,28 @@ func (p *HbaseClient) RecvGet() (value []*TCell, io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error207 := thrift.NewTApplicationExceptionDefault()
-		var error208 error
-		error208, err = error207.Read(iprot)
+		error260 := thrift.NewTApplicationExceptionDefault()
+		var error261 error
+		error261, err = error260.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error208
+		err = error261
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result206 := NewGetResult()
-	err = result206.Read(iprot)
+	result259 := NewGetResult()
+	err = result259.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result206.Success
-	if result206.Io != nil {
-		io = result206.Io
+	value = result259.Success
+	if result259.Io != nil {
+		io = result259.Io
 	}
 	return
 }
@@ -1324,7 +1350,7 @@ func (p *HbaseClient) RecvGet() (value []*TCell, io *IOError, err error) {
  *  - NumVersions: number of versions to retrieve
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetVer(tableName Text, row Text, column Text, numVersions int32, attributes map[string]Text) (retval209 []*TCell, io *IOError, err error) {
+func (p *HbaseClient) GetVer(tableName Text, row Text, column Text, numVersions int32, attributes map[string]Text) (retval262 []*TCell, io *IOError, err error) {
 	err = p.SendGetVer(tableName, row, column, numVersions, attributes)
 	if err != nil {
 		return
@@ -1340,13 +1366,13 @@ func (p *HbaseClient) SendGetVer(tableName Text, row Text, column Text, numVersi
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getVer", thrift.CALL, p.SeqId)
-	args210 := NewGetVerArgs()
-	args210.TableName = tableName
-	args210.Row = row
-	args210.Column = column
-	args210.NumVersions = numVersions
-	args210.Attributes = attributes
-	err = args210.Write(oprot)
+	args263 := NewGetVerArgs()
+	args263.TableName = tableName
+	args263.Row = row
+	args263.Column = column
+	args263.NumVersions = numVersions
+	args263.Attributes = attributes
+	err = args263.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1363,28 +1389,28 @@ func (p *HbaseClient) RecvGetVer() (value []*TCell, io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error212 := thrift.NewTApplicationExceptionDefault()
-		var error213 error
-		error213, err = error212.Read(iprot)
+		error265 := thrift.NewTApplicationExceptionDefault()
+		var error266 error
+		error266, err = error265.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error213
+		err = error266
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result211 := NewGetVerResult()
-	err = result211.Read(iprot)
+	result264 := NewGetVerResult()
+	err = result264.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result211.Success
-	if result211.Io != nil {
-		io = result211.Io
+	value = result264.Success
+	if result264.Io != nil {
+		io = result264.Io
 	}
 	return
 }
@@ -1404,7 +1430,7 @@ func (p *HbaseClient) RecvGetVer() (value []*TCell, io *IOError, err error) {
  *  - NumVersions: number of versions to retrieve
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetVerTs(tableName Text, row Text, column Text, timestamp int64, numVersions int32, attributes map[string]Text) (retval214 []*TCell, io *IOError, err error) {
+func (p *HbaseClient) GetVerTs(tableName Text, row Text, column Text, timestamp int64, numVersions int32, attributes map[string]Text) (retval267 []*TCell, io *IOError, err error) {
 	err = p.SendGetVerTs(tableName, row, column, timestamp, numVersions, attributes)
 	if err != nil {
 		return
@@ -1420,14 +1446,14 @@ func (p *HbaseClient) SendGetVerTs(tableName Text, row Text, column Text, timest
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getVerTs", thrift.CALL, p.SeqId)
-	args215 := NewGetVerTsArgs()
-	args215.TableName = tableName
-	args215.Row = row
-	args215.Column = column
-	args215.Timestamp = timestamp
-	args215.NumVersions = numVersions
-	args215.Attributes = attributes
-	err = args215.Write(oprot)
+	args268 := NewGetVerTsArgs()
+	args268.TableName = tableName
+	args268.Row = row
+	args268.Column = column
+	args268.Timestamp = timestamp
+	args268.NumVersions = numVersions
+	args268.Attributes = attributes
+	err = args268.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1444,28 +1470,28 @@ func (p *HbaseClient) RecvGetVerTs() (value []*TCell, io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error217 := thrift.NewTApplicationExceptionDefault()
-		var error218 error
-		error218, err = error217.Read(iprot)
+		error270 := thrift.NewTApplicationExceptionDefault()
+		var error271 error
+		error271, err = error270.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error218
+		err = error271
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result216 := NewGetVerTsResult()
-	err = result216.Read(iprot)
+	result269 := NewGetVerTsResult()
+	err = result269.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result216.Success
-	if result216.Io != nil {
-		io = result216.Io
+	value = result269.Success
+	if result269.Io != nil {
+		io = result269.Io
 	}
 	return
 }
@@ -1481,7 +1507,7 @@ func (p *HbaseClient) RecvGetVerTs() (value []*TCell, io *IOError, err error) {
  *  - Row: row key
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRow(tableName Text, row Text, attributes map[string]Text) (retval219 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRow(tableName Text, row Text, attributes map[string]Text) (retval272 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRow(tableName, row, attributes)
 	if err != nil {
 		return
@@ -1497,11 +1523,11 @@ func (p *HbaseClient) SendGetRow(tableName Text, row Text, attributes map[string
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRow", thrift.CALL, p.SeqId)
-	args220 := NewGetRowArgs()
-	args220.TableName = tableName
-	args220.Row = row
-	args220.Attributes = attributes
-	err = args220.Write(oprot)
+	args273 := NewGetRowArgs()
+	args273.TableName = tableName
+	args273.Row = row
+	args273.Attributes = attributes
+	err = args273.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1518,28 +1544,28 @@ func (p *HbaseClient) RecvGetRow() (value []*TRowResult, io *IOError, err error)
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error222 := thrift.NewTApplicationExceptionDefault()
-		var error223 error
-		error223, err = error222.Read(iprot)
+		error275 := thrift.NewTApplicationExceptionDefault()
+		var error276 error
+		error276, err = error275.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error223
+		err = error276
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result221 := NewGetRowResult()
-	err = result221.Read(iprot)
+	result274 := NewGetRowResult()
+	err = result274.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result221.Success
-	if result221.Io != nil {
-		io = result221.Io
+	value = result274.Success
+	if result274.Io != nil {
+		io = result274.Io
 	}
 	return
 }
@@ -1556,7 +1582,7 @@ func (p *HbaseClient) RecvGetRow() (value []*TRowResult, io *IOError, err error)
  *  - Columns: List of columns to return, null for all columns
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowWithColumns(tableName Text, row Text, columns []Text, attributes map[string]Text) (retval224 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowWithColumns(tableName Text, row Text, columns []Text, attributes map[string]Text) (retval277 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowWithColumns(tableName, row, columns, attributes)
 	if err != nil {
 		return
@@ -1572,12 +1598,12 @@ func (p *HbaseClient) SendGetRowWithColumns(tableName Text, row Text, columns []
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowWithColumns", thrift.CALL, p.SeqId)
-	args225 := NewGetRowWithColumnsArgs()
-	args225.TableName = tableName
-	args225.Row = row
-	args225.Columns = columns
-	args225.Attributes = attributes
-	err = args225.Write(oprot)
+	args278 := NewGetRowWithColumnsArgs()
+	args278.TableName = tableName
+	args278.Row = row
+	args278.Columns = columns
+	args278.Attributes = attributes
+	err = args278.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1594,28 +1620,28 @@ func (p *HbaseClient) RecvGetRowWithColumns() (value []*TRowResult, io *IOError,
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error227 := thrift.NewTApplicationExceptionDefault()
-		var error228 error
-		error228, err = error227.Read(iprot)
+		error280 := thrift.NewTApplicationExceptionDefault()
+		var error281 error
+		error281, err = error280.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error228
+		err = error281
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result226 := NewGetRowWithColumnsResult()
-	err = result226.Read(iprot)
+	result279 := NewGetRowWithColumnsResult()
+	err = result279.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result226.Success
-	if result226.Io != nil {
-		io = result226.Io
+	value = result279.Success
+	if result279.Io != nil {
+		io = result279.Io
 	}
 	return
 }
@@ -1632,7 +1658,7 @@ func (p *HbaseClient) RecvGetRowWithColumns() (value []*TRowResult, io *IOError,
  *  - Timestamp: timestamp
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowTs(tableName Text, row Text, timestamp int64, attributes map[string]Text) (retval229 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowTs(tableName Text, row Text, timestamp int64, attributes map[string]Text) (retval282 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowTs(tableName, row, timestamp, attributes)
 	if err != nil {
 		return
@@ -1648,12 +1674,12 @@ func (p *HbaseClient) SendGetRowTs(tableName Text, row Text, timestamp int64, at
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowTs", thrift.CALL, p.SeqId)
-	args230 := NewGetRowTsArgs()
-	args230.TableName = tableName
-	args230.Row = row
-	args230.Timestamp = timestamp
-	args230.Attributes = attributes
-	err = args230.Write(oprot)
+	args283 := NewGetRowTsArgs()
+	args283.TableName = tableName
+	args283.Row = row
+	args283.Timestamp = timestamp
+	args283.Attributes = attributes
+	err = args283.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1670,28 +1696,28 @@ func (p *HbaseClient) RecvGetRowTs() (value []*TRowResult, io *IOError, err erro
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error232 := thrift.NewTApplicationExceptionDefault()
-		var error233 error
-		error233, err = error232.Read(iprot)
+		error285 := thrift.NewTApplicationExceptionDefault()
+		var error286 error
+		error286, err = error285.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error233
+		err = error286
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result231 := NewGetRowTsResult()
-	err = result231.Read(iprot)
+	result284 := NewGetRowTsResult()
+	err = result284.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result231.Success
-	if result231.Io != nil {
-		io = result231.Io
+	value = result284.Success
+	if result284.Io != nil {
+		io = result284.Io
 	}
 	return
 }
@@ -1709,7 +1735,7 @@ func (p *HbaseClient) RecvGetRowTs() (value []*TRowResult, io *IOError, err erro
  *  - Timestamp
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowWithColumnsTs(tableName Text, row Text, columns []Text, timestamp int64, attributes map[string]Text) (retval234 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowWithColumnsTs(tableName Text, row Text, columns []Text, timestamp int64, attributes map[string]Text) (retval287 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowWithColumnsTs(tableName, row, columns, timestamp, attributes)
 	if err != nil {
 		return
@@ -1725,13 +1751,13 @@ func (p *HbaseClient) SendGetRowWithColumnsTs(tableName Text, row Text, columns
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowWithColumnsTs", thrift.CALL, p.SeqId)
-	args235 := NewGetRowWithColumnsTsArgs()
-	args235.TableName = tableName
-	args235.Row = row
-	args235.Columns = columns
-	args235.Timestamp = timestamp
-	args235.Attributes = attributes
-	err = args235.Write(oprot)
+	args288 := NewGetRowWithColumnsTsArgs()
+	args288.TableName = tableName
+	args288.Row = row
+	args288.Columns = columns
+	args288.Timestamp = timestamp
+	args288.Attributes = attributes
+	err = args288.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1748,28 +1774,28 @@ func (p *HbaseClient) RecvGetRowWithColumnsTs() (value []*TRowResult, io *IOErro
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error237 := thrift.NewTApplicationExceptionDefault()
-		var error238 error
-		error238, err = error237.Read(iprot)
+		error290 := thrift.NewTApplicationExceptionDefault()
+		var error291 error
+		error291, err = error290.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error238
+		err = error291
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result236 := NewGetRowWithColumnsTsResult()
-	err = result236.Read(iprot)
+	result289 := NewGetRowWithColumnsTsResult()
+	err = result289.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result236.Success
-	if result236.Io != nil {
-		io = result236.Io
+	value = result289.Success
+	if result289.Io != nil {
+		io = result289.Io
 	}
 	return
 }
@@ -1785,7 +1811,7 @@ func (p *HbaseClient) RecvGetRowWithColumnsTs() (value []*TRowResult, io *IOErro
  *  - Rows: row keys
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRows(tableName Text, rows []Text, attributes map[string]Text) (retval239 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRows(tableName Text, rows []Text, attributes map[string]Text) (retval292 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRows(tableName, rows, attributes)
 	if err != nil {
 		return
@@ -1801,11 +1827,11 @@ func (p *HbaseClient) SendGetRows(tableName Text, rows []Text, attributes map[st
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRows", thrift.CALL, p.SeqId)
-	args240 := NewGetRowsArgs()
-	args240.TableName = tableName
-	args240.Rows = rows
-	args240.Attributes = attributes
-	err = args240.Write(oprot)
+	args293 := NewGetRowsArgs()
+	args293.TableName = tableName
+	args293.Rows = rows
+	args293.Attributes = attributes
+	err = args293.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1822,28 +1848,28 @@ func (p *HbaseClient) RecvGetRows() (value []*TRowResult, io *IOError, err error
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error242 := thrift.NewTApplicationExceptionDefault()
-		var error243 error
-		error243, err = error242.Read(iprot)
+		error295 := thrift.NewTApplicationExceptionDefault()
+		var error296 error
+		error296, err = error295.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error243
+		err = error296
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result241 := NewGetRowsResult()
-	err = result241.Read(iprot)
+	result294 := NewGetRowsResult()
+	err = result294.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result241.Success
-	if result241.Io != nil {
-		io = result241.Io
+	value = result294.Success
+	if result294.Io != nil {
+		io = result294.Io
 	}
 	return
 }
@@ -1860,7 +1886,7 @@ func (p *HbaseClient) RecvGetRows() (value []*TRowResult, io *IOError, err error
  *  - Columns: List of columns to return, null for all columns
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowsWithColumns(tableName Text, rows []Text, columns []Text, attributes map[string]Text) (retval244 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowsWithColumns(tableName Text, rows []Text, columns []Text, attributes map[string]Text) (retval297 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowsWithColumns(tableName, rows, columns, attributes)
 	if err != nil {
 		return
@@ -1876,12 +1902,12 @@ func (p *HbaseClient) SendGetRowsWithColumns(tableName Text, rows []Text, column
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowsWithColumns", thrift.CALL, p.SeqId)
-	args245 := NewGetRowsWithColumnsArgs()
-	args245.TableName = tableName
-	args245.Rows = rows
-	args245.Columns = columns
-	args245.Attributes = attributes
-	err = args245.Write(oprot)
+	args298 := NewGetRowsWithColumnsArgs()
+	args298.TableName = tableName
+	args298.Rows = rows
+	args298.Columns = columns
+	args298.Attributes = attributes
+	err = args298.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1898,28 +1924,28 @@ func (p *HbaseClient) RecvGetRowsWithColumns() (value []*TRowResult, io *IOError
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error247 := thrift.NewTApplicationExceptionDefault()
-		var error248 error
-		error248, err = error247.Read(iprot)
+		error300 := thrift.NewTApplicationExceptionDefault()
+		var error301 error
+		error301, err = error300.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error248
+		err = error301
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result246 := NewGetRowsWithColumnsResult()
-	err = result246.Read(iprot)
+	result299 := NewGetRowsWithColumnsResult()
+	err = result299.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result246.Success
-	if result246.Io != nil {
-		io = result246.Io
+	value = result299.Success
+	if result299.Io != nil {
+		io = result299.Io
 	}
 	return
 }
@@ -1936,7 +1962,7 @@ func (p *HbaseClient) RecvGetRowsWithColumns() (value []*TRowResult, io *IOError
  *  - Timestamp: timestamp
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowsTs(tableName Text, rows []Text, timestamp int64, attributes map[string]Text) (retval249 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowsTs(tableName Text, rows []Text, timestamp int64, attributes map[string]Text) (retval302 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowsTs(tableName, rows, timestamp, attributes)
 	if err != nil {
 		return
@@ -1952,12 +1978,12 @@ func (p *HbaseClient) SendGetRowsTs(tableName Text, rows []Text, timestamp int64
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowsTs", thrift.CALL, p.SeqId)
-	args250 := NewGetRowsTsArgs()
-	args250.TableName = tableName
-	args250.Rows = rows
-	args250.Timestamp = timestamp
-	args250.Attributes = attributes
-	err = args250.Write(oprot)
+	args303 := NewGetRowsTsArgs()
+	args303.TableName = tableName
+	args303.Rows = rows
+	args303.Timestamp = timestamp
+	args303.Attributes = attributes
+	err = args303.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -1974,28 +2000,28 @@ func (p *HbaseClient) RecvGetRowsTs() (value []*TRowResult, io *IOError, err err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error252 := thrift.NewTApplicationExceptionDefault()
-		var error253 error
-		error253, err = error252.Read(iprot)
+		error305 := thrift.NewTApplicationExceptionDefault()
+		var error306 error
+		error306, err = error305.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error253
+		err = error306
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result251 := NewGetRowsTsResult()
-	err = result251.Read(iprot)
+	result304 := NewGetRowsTsResult()
+	err = result304.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result251.Success
-	if result251.Io != nil {
-		io = result251.Io
+	value = result304.Success
+	if result304.Io != nil {
+		io = result304.Io
 	}
 	return
 }
@@ -2013,7 +2039,7 @@ func (p *HbaseClient) RecvGetRowsTs() (value []*TRowResult, io *IOError, err err
  *  - Timestamp
  *  - Attributes: Get attributes
  */
-func (p *HbaseClient) GetRowsWithColumnsTs(tableName Text, rows []Text, columns []Text, timestamp int64, attributes map[string]Text) (retval254 []*TRowResult, io *IOError, err error) {
+func (p *HbaseClient) GetRowsWithColumnsTs(tableName Text, rows []Text, columns []Text, timestamp int64, attributes map[string]Text) (retval307 []*TRowResult, io *IOError, err error) {
 	err = p.SendGetRowsWithColumnsTs(tableName, rows, columns, timestamp, attributes)
 	if err != nil {
 		return
@@ -2029,13 +2055,13 @@ func (p *HbaseClient) SendGetRowsWithColumnsTs(tableName Text, rows []Text, colu
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowsWithColumnsTs", thrift.CALL, p.SeqId)
-	args255 := NewGetRowsWithColumnsTsArgs()
-	args255.TableName = tableName
-	args255.Rows = rows
-	args255.Columns = columns
-	args255.Timestamp = timestamp
-	args255.Attributes = attributes
-	err = args255.Write(oprot)
+	args308 := NewGetRowsWithColumnsTsArgs()
+	args308.TableName = tableName
+	args308.Rows = rows
+	args308.Columns = columns
+	args308.Timestamp = timestamp
+	args308.Attributes = attributes
+	err = args308.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2052,28 +2078,28 @@ func (p *HbaseClient) RecvGetRowsWithColumnsTs() (value []*TRowResult, io *IOErr
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error257 := thrift.NewTApplicationExceptionDefault()
-		var error258 error
-		error258, err = error257.Read(iprot)
+		error310 := thrift.NewTApplicationExceptionDefault()
+		var error311 error
+		error311, err = error310.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error258
+		err = error311
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result256 := NewGetRowsWithColumnsTsResult()
-	err = result256.Read(iprot)
+	result309 := NewGetRowsWithColumnsTsResult()
+	err = result309.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result256.Success
-	if result256.Io != nil {
-		io = result256.Io
+	value = result309.Success
+	if result309.Io != nil {
+		io = result309.Io
 	}
 	return
 }
@@ -2106,12 +2132,12 @@ func (p *HbaseClient) SendMutateRow(tableName Text, row Text, mutations []*Mutat
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("mutateRow", thrift.CALL, p.SeqId)
-	args260 := NewMutateRowArgs()
-	args260.TableName = tableName
-	args260.Row = row
-	args260.Mutations = mutations
-	args260.Attributes = attributes
-	err = args260.Write(oprot)
+	args313 := NewMutateRowArgs()
+	args313.TableName = tableName
+	args313.Row = row
+	args313.Mutations = mutations
+	args313.Attributes = attributes
+	err = args313.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2128,30 +2154,30 @@ func (p *HbaseClient) RecvMutateRow() (io *IOError, ia *IllegalArgument, err err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error262 := thrift.NewTApplicationExceptionDefault()
-		var error263 error
-		error263, err = error262.Read(iprot)
+		error315 := thrift.NewTApplicationExceptionDefault()
+		var error316 error
+		error316, err = error315.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error263
+		err = error316
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result261 := NewMutateRowResult()
-	err = result261.Read(iprot)
+	result314 := NewMutateRowResult()
+	err = result314.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result261.Io != nil {
-		io = result261.Io
+	if result314.Io != nil {
+		io = result314.Io
 	}
-	if result261.Ia != nil {
-		ia = result261.Ia
+	if result314.Ia != nil {
+		ia = result314.Ia
 	}
 	return
 }
@@ -2185,13 +2211,13 @@ func (p *HbaseClient) SendMutateRowTs(tableName Text, row Text, mutations []*Mut
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("mutateRowTs", thrift.CALL, p.SeqId)
-	args265 := NewMutateRowTsArgs()
-	args265.TableName = tableName
-	args265.Row = row
-	args265.Mutations = mutations
-	args265.Timestamp = timestamp
-	args265.Attributes = attributes
-	err = args265.Write(oprot)
+	args318 := NewMutateRowTsArgs()
+	args318.TableName = tableName
+	args318.Row = row
+	args318.Mutations = mutations
+	args318.Timestamp = timestamp
+	args318.Attributes = attributes
+	err = args318.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2208,30 +2234,30 @@ func (p *HbaseClient) RecvMutateRowTs() (io *IOError, ia *IllegalArgument, err e
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error267 := thrift.NewTApplicationExceptionDefault()
-		var error268 error
-		error268, err = error267.Read(iprot)
+		error320 := thrift.NewTApplicationExceptionDefault()
+		var error321 error
+		error321, err = error320.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error268
+		err = error321
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result266 := NewMutateRowTsResult()
-	err = result266.Read(iprot)
+	result319 := NewMutateRowTsResult()
+	err = result319.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result266.Io != nil {
-		io = result266.Io
+	if result319.Io != nil {
+		io = result319.Io
 	}
-	if result266.Ia != nil {
-		ia = result266.Ia
+	if result319.Ia != nil {
+		ia = result319.Ia
 	}
 	return
 }
@@ -2263,11 +2289,11 @@ func (p *HbaseClient) SendMutateRows(tableName Text, rowBatches []*BatchMutation
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("mutateRows", thrift.CALL, p.SeqId)
-	args270 := NewMutateRowsArgs()
-	args270.TableName = tableName
-	args270.RowBatches = rowBatches
-	args270.Attributes = attributes
-	err = args270.Write(oprot)
+	args323 := NewMutateRowsArgs()
+	args323.TableName = tableName
+	args323.RowBatches = rowBatches
+	args323.Attributes = attributes
+	err = args323.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2284,30 +2310,30 @@ func (p *HbaseClient) RecvMutateRows() (io *IOError, ia *IllegalArgument, err er
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error272 := thrift.NewTApplicationExceptionDefault()
-		var error273 error
-		error273, err = error272.Read(iprot)
+		error325 := thrift.NewTApplicationExceptionDefault()
+		var error326 error
+		error326, err = error325.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error273
+		err = error326
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result271 := NewMutateRowsResult()
-	err = result271.Read(iprot)
+	result324 := NewMutateRowsResult()
+	err = result324.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result271.Io != nil {
-		io = result271.Io
+	if result324.Io != nil {
+		io = result324.Io
 	}
-	if result271.Ia != nil {
-		ia = result271.Ia
+	if result324.Ia != nil {
+		ia = result324.Ia
 	}
 	return
 }
@@ -2340,12 +2366,12 @@ func (p *HbaseClient) SendMutateRowsTs(tableName Text, rowBatches []*BatchMutati
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("mutateRowsTs", thrift.CALL, p.SeqId)
-	args275 := NewMutateRowsTsArgs()
-	args275.TableName = tableName
-	args275.RowBatches = rowBatches
-	args275.Timestamp = timestamp
-	args275.Attributes = attributes
-	err = args275.Write(oprot)
+	args328 := NewMutateRowsTsArgs()
+	args328.TableName = tableName
+	args328.RowBatches = rowBatches
+	args328.Timestamp = timestamp
+	args328.Attributes = attributes
+	err = args328.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2362,30 +2388,30 @@ func (p *HbaseClient) RecvMutateRowsTs() (io *IOError, ia *IllegalArgument, err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error277 := thrift.NewTApplicationExceptionDefault()
-		var error278 error
-		error278, err = error277.Read(iprot)
+		error330 := thrift.NewTApplicationExceptionDefault()
+		var error331 error
+		error331, err = error330.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error278
+		err = error331
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result276 := NewMutateRowsTsResult()
-	err = result276.Read(iprot)
+	result329 := NewMutateRowsTsResult()
+	err = result329.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result276.Io != nil {
-		io = result276.Io
+	if result329.Io != nil {
+		io = result329.Io
 	}
-	if result276.Ia != nil {
-		ia = result276.Ia
+	if result329.Ia != nil {
+		ia = result329.Ia
 	}
 	return
 }
@@ -2399,7 +2425,7 @@ func (p *HbaseClient) RecvMutateRowsTs() (io *IOError, ia *IllegalArgument, err
  *  - Column: name of column
  *  - Value: amount to increment by
  */
-func (p *HbaseClient) AtomicIncrement(tableName Text, row Text, column Text, value int64) (retval279 int64, io *IOError, ia *IllegalArgument, err error) {
+func (p *HbaseClient) AtomicIncrement(tableName Text, row Text, column Text, value int64) (retval332 int64, io *IOError, ia *IllegalArgument, err error) {
 	err = p.SendAtomicIncrement(tableName, row, column, value)
 	if err != nil {
 		return
@@ -2415,12 +2441,12 @@ func (p *HbaseClient) SendAtomicIncrement(tableName Text, row Text, column Text,
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("atomicIncrement", thrift.CALL, p.SeqId)
-	args280 := NewAtomicIncrementArgs()
-	args280.TableName = tableName
-	args280.Row = row
-	args280.Column = column
-	args280.Value = value
-	err = args280.Write(oprot)
+	args333 := NewAtomicIncrementArgs()
+	args333.TableName = tableName
+	args333.Row = row
+	args333.Column = column
+	args333.Value = value
+	err = args333.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2437,31 +2463,31 @@ func (p *HbaseClient) RecvAtomicIncrement() (value int64, io *IOError, ia *Illeg
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error282 := thrift.NewTApplicationExceptionDefault()
-		var error283 error
-		error283, err = error282.Read(iprot)
+		error335 := thrift.NewTApplicationExceptionDefault()
+		var error336 error
+		error336, err = error335.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error283
+		err = error336
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result281 := NewAtomicIncrementResult()
-	err = result281.Read(iprot)
+	result334 := NewAtomicIncrementResult()
+	err = result334.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result281.Success
-	if result281.Io != nil {
-		io = result281.Io
+	value = result334.Success
+	if result334.Io != nil {
+		io = result334.Io
 	}
-	if result281.Ia != nil {
-		ia = result281.Ia
+	if result334.Ia != nil {
+		ia = result334.Ia
 	}
 	return
 }
@@ -2491,12 +2517,12 @@ func (p *HbaseClient) SendDeleteAll(tableName Text, row Text, column Text, attri
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("deleteAll", thrift.CALL, p.SeqId)
-	args285 := NewDeleteAllArgs()
-	args285.TableName = tableName
-	args285.Row = row
-	args285.Column = column
-	args285.Attributes = attributes
-	err = args285.Write(oprot)
+	args338 := NewDeleteAllArgs()
+	args338.TableName = tableName
+	args338.Row = row
+	args338.Column = column
+	args338.Attributes = attributes
+	err = args338.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2513,27 +2539,27 @@ func (p *HbaseClient) RecvDeleteAll() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error287 := thrift.NewTApplicationExceptionDefault()
-		var error288 error
-		error288, err = error287.Read(iprot)
+		error340 := thrift.NewTApplicationExceptionDefault()
+		var error341 error
+		error341, err = error340.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error288
+		err = error341
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result286 := NewDeleteAllResult()
-	err = result286.Read(iprot)
+	result339 := NewDeleteAllResult()
+	err = result339.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result286.Io != nil {
-		io = result286.Io
+	if result339.Io != nil {
+		io = result339.Io
 	}
 	return
 }
@@ -2565,13 +2591,13 @@ func (p *HbaseClient) SendDeleteAllTs(tableName Text, row Text, column Text, tim
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("deleteAllTs", thrift.CALL, p.SeqId)
-	args290 := NewDeleteAllTsArgs()
-	args290.TableName = tableName
-	args290.Row = row
-	args290.Column = column
-	args290.Timestamp = timestamp
-	args290.Attributes = attributes
-	err = args290.Write(oprot)
+	args343 := NewDeleteAllTsArgs()
+	args343.TableName = tableName
+	args343.Row = row
+	args343.Column = column
+	args343.Timestamp = timestamp
+	args343.Attributes = attributes
+	err = args343.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2588,27 +2614,27 @@ func (p *HbaseClient) RecvDeleteAllTs() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error292 := thrift.NewTApplicationExceptionDefault()
-		var error293 error
-		error293, err = error292.Read(iprot)
+		error345 := thrift.NewTApplicationExceptionDefault()
+		var error346 error
+		error346, err = error345.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error293
+		err = error346
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result291 := NewDeleteAllTsResult()
-	err = result291.Read(iprot)
+	result344 := NewDeleteAllTsResult()
+	err = result344.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result291.Io != nil {
-		io = result291.Io
+	if result344.Io != nil {
+		io = result344.Io
 	}
 	return
 }
@@ -2637,11 +2663,11 @@ func (p *HbaseClient) SendDeleteAllRow(tableName Text, row Text, attributes map[
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("deleteAllRow", thrift.CALL, p.SeqId)
-	args295 := NewDeleteAllRowArgs()
-	args295.TableName = tableName
-	args295.Row = row
-	args295.Attributes = attributes
-	err = args295.Write(oprot)
+	args348 := NewDeleteAllRowArgs()
+	args348.TableName = tableName
+	args348.Row = row
+	args348.Attributes = attributes
+	err = args348.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2658,27 +2684,27 @@ func (p *HbaseClient) RecvDeleteAllRow() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error297 := thrift.NewTApplicationExceptionDefault()
-		var error298 error
-		error298, err = error297.Read(iprot)
+		error350 := thrift.NewTApplicationExceptionDefault()
+		var error351 error
+		error351, err = error350.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error298
+		err = error351
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result296 := NewDeleteAllRowResult()
-	err = result296.Read(iprot)
+	result349 := NewDeleteAllRowResult()
+	err = result349.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result296.Io != nil {
-		io = result296.Io
+	if result349.Io != nil {
+		io = result349.Io
 	}
 	return
 }
@@ -2708,9 +2734,9 @@ func (p *HbaseClient) SendIncrement(increment *TIncrement) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("increment", thrift.CALL, p.SeqId)
-	args300 := NewIncrementArgs()
-	args300.Increment = increment
-	err = args300.Write(oprot)
+	args353 := NewIncrementArgs()
+	args353.Increment = increment
+	err = args353.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2727,27 +2753,27 @@ func (p *HbaseClient) RecvIncrement() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error302 := thrift.NewTApplicationExceptionDefault()
-		var error303 error
-		error303, err = error302.Read(iprot)
+		error355 := thrift.NewTApplicationExceptionDefault()
+		var error356 error
+		error356, err = error355.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error303
+		err = error356
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result301 := NewIncrementResult()
-	err = result301.Read(iprot)
+	result354 := NewIncrementResult()
+	err = result354.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result301.Io != nil {
-		io = result301.Io
+	if result354.Io != nil {
+		io = result354.Io
 	}
 	return
 }
@@ -2772,9 +2798,9 @@ func (p *HbaseClient) SendIncrementRows(increments []*TIncrement) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("incrementRows", thrift.CALL, p.SeqId)
-	args305 := NewIncrementRowsArgs()
-	args305.Increments = increments
-	err = args305.Write(oprot)
+	args358 := NewIncrementRowsArgs()
+	args358.Increments = increments
+	err = args358.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2791,27 +2817,27 @@ func (p *HbaseClient) RecvIncrementRows() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error307 := thrift.NewTApplicationExceptionDefault()
-		var error308 error
-		error308, err = error307.Read(iprot)
+		error360 := thrift.NewTApplicationExceptionDefault()
+		var error361 error
+		error361, err = error360.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error308
+		err = error361
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result306 := NewIncrementRowsResult()
-	err = result306.Read(iprot)
+	result359 := NewIncrementRowsResult()
+	err = result359.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result306.Io != nil {
-		io = result306.Io
+	if result359.Io != nil {
+		io = result359.Io
 	}
 	return
 }
@@ -2842,12 +2868,12 @@ func (p *HbaseClient) SendDeleteAllRowTs(tableName Text, row Text, timestamp int
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("deleteAllRowTs", thrift.CALL, p.SeqId)
-	args310 := NewDeleteAllRowTsArgs()
-	args310.TableName = tableName
-	args310.Row = row
-	args310.Timestamp = timestamp
-	args310.Attributes = attributes
-	err = args310.Write(oprot)
+	args363 := NewDeleteAllRowTsArgs()
+	args363.TableName = tableName
+	args363.Row = row
+	args363.Timestamp = timestamp
+	args363.Attributes = attributes
+	err = args363.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2864,27 +2890,27 @@ func (p *HbaseClient) RecvDeleteAllRowTs() (io *IOError, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error312 := thrift.NewTApplicationExceptionDefault()
-		var error313 error
-		error313, err = error312.Read(iprot)
+		error365 := thrift.NewTApplicationExceptionDefault()
+		var error366 error
+		error366, err = error365.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error313
+		err = error366
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result311 := NewDeleteAllRowTsResult()
-	err = result311.Read(iprot)
+	result364 := NewDeleteAllRowTsResult()
+	err = result364.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result311.Io != nil {
-		io = result311.Io
+	if result364.Io != nil {
+		io = result364.Io
 	}
 	return
 }
@@ -2898,7 +2924,7 @@ func (p *HbaseClient) RecvDeleteAllRowTs() (io *IOError, err error) {
  *  - Scan: Scan instance
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpenWithScan(tableName Text, scan *TScan, attributes map[string]Text) (retval314 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpenWithScan(tableName Text, scan *TScan, attributes map[string]Text) (retval367 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpenWithScan(tableName, scan, attributes)
 	if err != nil {
 		return
@@ -2914,11 +2940,11 @@ func (p *HbaseClient) SendScannerOpenWithScan(tableName Text, scan *TScan, attri
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpenWithScan", thrift.CALL, p.SeqId)
-	args315 := NewScannerOpenWithScanArgs()
-	args315.TableName = tableName
-	args315.Scan = scan
-	args315.Attributes = attributes
-	err = args315.Write(oprot)
+	args368 := NewScannerOpenWithScanArgs()
+	args368.TableName = tableName
+	args368.Scan = scan
+	args368.Attributes = attributes
+	err = args368.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -2935,28 +2961,28 @@ func (p *HbaseClient) RecvScannerOpenWithScan() (value ScannerID, io *IOError, e
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error317 := thrift.NewTApplicationExceptionDefault()
-		var error318 error
-		error318, err = error317.Read(iprot)
+		error370 := thrift.NewTApplicationExceptionDefault()
+		var error371 error
+		error371, err = error370.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error318
+		err = error371
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result316 := NewScannerOpenWithScanResult()
-	err = result316.Read(iprot)
+	result369 := NewScannerOpenWithScanResult()
+	err = result369.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result316.Success
-	if result316.Io != nil {
-		io = result316.Io
+	value = result369.Success
+	if result369.Io != nil {
+		io = result369.Io
 	}
 	return
 }
@@ -2976,7 +3002,7 @@ func (p *HbaseClient) RecvScannerOpenWithScan() (value ScannerID, io *IOError, e
  * to pass a regex in the column qualifier.
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpen(tableName Text, startRow Text, columns []Text, attributes map[string]Text) (retval319 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpen(tableName Text, startRow Text, columns []Text, attributes map[string]Text) (retval372 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpen(tableName, startRow, columns, attributes)
 	if err != nil {
 		return
@@ -2992,12 +3018,12 @@ func (p *HbaseClient) SendScannerOpen(tableName Text, startRow Text, columns []T
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpen", thrift.CALL, p.SeqId)
-	args320 := NewScannerOpenArgs()
-	args320.TableName = tableName
-	args320.StartRow = startRow
-	args320.Columns = columns
-	args320.Attributes = attributes
-	err = args320.Write(oprot)
+	args373 := NewScannerOpenArgs()
+	args373.TableName = tableName
+	args373.StartRow = startRow
+	args373.Columns = columns
+	args373.Attributes = attributes
+	err = args373.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3014,28 +3040,28 @@ func (p *HbaseClient) RecvScannerOpen() (value ScannerID, io *IOError, err error
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error322 := thrift.NewTApplicationExceptionDefault()
-		var error323 error
-		error323, err = error322.Read(iprot)
+		error375 := thrift.NewTApplicationExceptionDefault()
+		var error376 error
+		error376, err = error375.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error323
+		err = error376
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result321 := NewScannerOpenResult()
-	err = result321.Read(iprot)
+	result374 := NewScannerOpenResult()
+	err = result374.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result321.Success
-	if result321.Io != nil {
-		io = result321.Io
+	value = result374.Success
+	if result374.Io != nil {
+		io = result374.Io
 	}
 	return
 }
@@ -3058,7 +3084,7 @@ func (p *HbaseClient) RecvScannerOpen() (value ScannerID, io *IOError, err error
  * to pass a regex in the column qualifier.
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpenWithStop(tableName Text, startRow Text, stopRow Text, columns []Text, attributes map[string]Text) (retval324 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpenWithStop(tableName Text, startRow Text, stopRow Text, columns []Text, attributes map[string]Text) (retval377 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpenWithStop(tableName, startRow, stopRow, columns, attributes)
 	if err != nil {
 		return
@@ -3074,13 +3100,13 @@ func (p *HbaseClient) SendScannerOpenWithStop(tableName Text, startRow Text, sto
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpenWithStop", thrift.CALL, p.SeqId)
-	args325 := NewScannerOpenWithStopArgs()
-	args325.TableName = tableName
-	args325.StartRow = startRow
-	args325.StopRow = stopRow
-	args325.Columns = columns
-	args325.Attributes = attributes
-	err = args325.Write(oprot)
+	args378 := NewScannerOpenWithStopArgs()
+	args378.TableName = tableName
+	args378.StartRow = startRow
+	args378.StopRow = stopRow
+	args378.Columns = columns
+	args378.Attributes = attributes
+	err = args378.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3097,28 +3123,28 @@ func (p *HbaseClient) RecvScannerOpenWithStop() (value ScannerID, io *IOError, e
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error327 := thrift.NewTApplicationExceptionDefault()
-		var error328 error
-		error328, err = error327.Read(iprot)
+		error380 := thrift.NewTApplicationExceptionDefault()
+		var error381 error
+		error381, err = error380.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error328
+		err = error381
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result326 := NewScannerOpenWithStopResult()
-	err = result326.Read(iprot)
+	result379 := NewScannerOpenWithStopResult()
+	err = result379.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result326.Success
-	if result326.Io != nil {
-		io = result326.Io
+	value = result379.Success
+	if result379.Io != nil {
+		io = result379.Io
 	}
 	return
 }
@@ -3135,7 +3161,7 @@ func (p *HbaseClient) RecvScannerOpenWithStop() (value ScannerID, io *IOError, e
  *  - Columns: the columns you want returned
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpenWithPrefix(tableName Text, startAndPrefix Text, columns []Text, attributes map[string]Text) (retval329 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpenWithPrefix(tableName Text, startAndPrefix Text, columns []Text, attributes map[string]Text) (retval382 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpenWithPrefix(tableName, startAndPrefix, columns, attributes)
 	if err != nil {
 		return
@@ -3151,12 +3177,12 @@ func (p *HbaseClient) SendScannerOpenWithPrefix(tableName Text, startAndPrefix T
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpenWithPrefix", thrift.CALL, p.SeqId)
-	args330 := NewScannerOpenWithPrefixArgs()
-	args330.TableName = tableName
-	args330.StartAndPrefix = startAndPrefix
-	args330.Columns = columns
-	args330.Attributes = attributes
-	err = args330.Write(oprot)
+	args383 := NewScannerOpenWithPrefixArgs()
+	args383.TableName = tableName
+	args383.StartAndPrefix = startAndPrefix
+	args383.Columns = columns
+	args383.Attributes = attributes
+	err = args383.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3173,28 +3199,28 @@ func (p *HbaseClient) RecvScannerOpenWithPrefix() (value ScannerID, io *IOError,
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error332 := thrift.NewTApplicationExceptionDefault()
-		var error333 error
-		error333, err = error332.Read(iprot)
+		error385 := thrift.NewTApplicationExceptionDefault()
+		var error386 error
+		error386, err = error385.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error333
+		err = error386
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result331 := NewScannerOpenWithPrefixResult()
-	err = result331.Read(iprot)
+	result384 := NewScannerOpenWithPrefixResult()
+	err = result384.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result331.Success
-	if result331.Io != nil {
-		io = result331.Io
+	value = result384.Success
+	if result384.Io != nil {
+		io = result384.Io
 	}
 	return
 }
@@ -3216,7 +3242,7 @@ func (p *HbaseClient) RecvScannerOpenWithPrefix() (value ScannerID, io *IOError,
  *  - Timestamp: timestamp
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpenTs(tableName Text, startRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval334 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpenTs(tableName Text, startRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval387 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpenTs(tableName, startRow, columns, timestamp, attributes)
 	if err != nil {
 		return
@@ -3232,13 +3258,13 @@ func (p *HbaseClient) SendScannerOpenTs(tableName Text, startRow Text, columns [
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpenTs", thrift.CALL, p.SeqId)
-	args335 := NewScannerOpenTsArgs()
-	args335.TableName = tableName
-	args335.StartRow = startRow
-	args335.Columns = columns
-	args335.Timestamp = timestamp
-	args335.Attributes = attributes
-	err = args335.Write(oprot)
+	args388 := NewScannerOpenTsArgs()
+	args388.TableName = tableName
+	args388.StartRow = startRow
+	args388.Columns = columns
+	args388.Timestamp = timestamp
+	args388.Attributes = attributes
+	err = args388.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3255,28 +3281,28 @@ func (p *HbaseClient) RecvScannerOpenTs() (value ScannerID, io *IOError, err err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error337 := thrift.NewTApplicationExceptionDefault()
-		var error338 error
-		error338, err = error337.Read(iprot)
+		error390 := thrift.NewTApplicationExceptionDefault()
+		var error391 error
+		error391, err = error390.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error338
+		err = error391
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result336 := NewScannerOpenTsResult()
-	err = result336.Read(iprot)
+	result389 := NewScannerOpenTsResult()
+	err = result389.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result336.Success
-	if result336.Io != nil {
-		io = result336.Io
+	value = result389.Success
+	if result389.Io != nil {
+		io = result389.Io
 	}
 	return
 }
@@ -3301,7 +3327,7 @@ func (p *HbaseClient) RecvScannerOpenTs() (value ScannerID, io *IOError, err err
  *  - Timestamp: timestamp
  *  - Attributes: Scan attributes
  */
-func (p *HbaseClient) ScannerOpenWithStopTs(tableName Text, startRow Text, stopRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval339 ScannerID, io *IOError, err error) {
+func (p *HbaseClient) ScannerOpenWithStopTs(tableName Text, startRow Text, stopRow Text, columns []Text, timestamp int64, attributes map[string]Text) (retval392 ScannerID, io *IOError, err error) {
 	err = p.SendScannerOpenWithStopTs(tableName, startRow, stopRow, columns, timestamp, attributes)
 	if err != nil {
 		return
@@ -3317,14 +3343,14 @@ func (p *HbaseClient) SendScannerOpenWithStopTs(tableName Text, startRow Text, s
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerOpenWithStopTs", thrift.CALL, p.SeqId)
-	args340 := NewScannerOpenWithStopTsArgs()
-	args340.TableName = tableName
-	args340.StartRow = startRow
-	args340.StopRow = stopRow
-	args340.Columns = columns
-	args340.Timestamp = timestamp
-	args340.Attributes = attributes
-	err = args340.Write(oprot)
+	args393 := NewScannerOpenWithStopTsArgs()
+	args393.TableName = tableName
+	args393.StartRow = startRow
+	args393.StopRow = stopRow
+	args393.Columns = columns
+	args393.Timestamp = timestamp
+	args393.Attributes = attributes
+	err = args393.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3341,28 +3367,28 @@ func (p *HbaseClient) RecvScannerOpenWithStopTs() (value ScannerID, io *IOError,
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error342 := thrift.NewTApplicationExceptionDefault()
-		var error343 error
-		error343, err = error342.Read(iprot)
+		error395 := thrift.NewTApplicationExceptionDefault()
+		var error396 error
+		error396, err = error395.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error343
+		err = error396
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result341 := NewScannerOpenWithStopTsResult()
-	err = result341.Read(iprot)
+	result394 := NewScannerOpenWithStopTsResult()
+	err = result394.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result341.Success
-	if result341.Io != nil {
-		io = result341.Io
+	value = result394.Success
+	if result394.Io != nil {
+		io = result394.Io
 	}
 	return
 }
@@ -3382,7 +3408,7 @@ func (p *HbaseClient) RecvScannerOpenWithStopTs() (value ScannerID, io *IOError,
  * Parameters:
  *  - Id: id of a scanner returned by scannerOpen
  */
-func (p *HbaseClient) ScannerGet(id ScannerID) (retval344 []*TRowResult, io *IOError, ia *IllegalArgument, err error) {
+func (p *HbaseClient) ScannerGet(id ScannerID) (retval397 []*TRowResult, io *IOError, ia *IllegalArgument, err error) {
 	err = p.SendScannerGet(id)
 	if err != nil {
 		return
@@ -3398,9 +3424,9 @@ func (p *HbaseClient) SendScannerGet(id ScannerID) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerGet", thrift.CALL, p.SeqId)
-	args345 := NewScannerGetArgs()
-	args345.Id = id
-	err = args345.Write(oprot)
+	args398 := NewScannerGetArgs()
+	args398.Id = id
+	err = args398.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3417,31 +3443,31 @@ func (p *HbaseClient) RecvScannerGet() (value []*TRowResult, io *IOError, ia *Il
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error347 := thrift.NewTApplicationExceptionDefault()
-		var error348 error
-		error348, err = error347.Read(iprot)
+		error400 := thrift.NewTApplicationExceptionDefault()
+		var error401 error
+		error401, err = error400.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error348
+		err = error401
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result346 := NewScannerGetResult()
-	err = result346.Read(iprot)
+	result399 := NewScannerGetResult()
+	err = result399.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result346.Success
-	if result346.Io != nil {
-		io = result346.Io
+	value = result399.Success
+	if result399.Io != nil {
+		io = result399.Io
 	}
-	if result346.Ia != nil {
-		ia = result346.Ia
+	if result399.Ia != nil {
+		ia = result399.Ia
 	}
 	return
 }
@@ -3462,7 +3488,7 @@ func (p *HbaseClient) RecvScannerGet() (value []*TRowResult, io *IOError, ia *Il
  *  - Id: id of a scanner returned by scannerOpen
  *  - NbRows: number of results to return
  */
-func (p *HbaseClient) ScannerGetList(id ScannerID, nbRows int32) (retval349 []*TRowResult, io *IOError, ia *IllegalArgument, err error) {
+func (p *HbaseClient) ScannerGetList(id ScannerID, nbRows int32) (retval402 []*TRowResult, io *IOError, ia *IllegalArgument, err error) {
 	err = p.SendScannerGetList(id, nbRows)
 	if err != nil {
 		return
@@ -3478,10 +3504,10 @@ func (p *HbaseClient) SendScannerGetList(id ScannerID, nbRows int32) (err error)
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerGetList", thrift.CALL, p.SeqId)
-	args350 := NewScannerGetListArgs()
-	args350.Id = id
-	args350.NbRows = nbRows
-	err = args350.Write(oprot)
+	args403 := NewScannerGetListArgs()
+	args403.Id = id
+	args403.NbRows = nbRows
+	err = args403.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3498,31 +3524,31 @@ func (p *HbaseClient) RecvScannerGetList() (value []*TRowResult, io *IOError, ia
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error352 := thrift.NewTApplicationExceptionDefault()
-		var error353 error
-		error353, err = error352.Read(iprot)
+		error405 := thrift.NewTApplicationExceptionDefault()
+		var error406 error
+		error406, err = error405.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error353
+		err = error406
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result351 := NewScannerGetListResult()
-	err = result351.Read(iprot)
+	result404 := NewScannerGetListResult()
+	err = result404.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result351.Success
-	if result351.Io != nil {
-		io = result351.Io
+	value = result404.Success
+	if result404.Io != nil {
+		io = result404.Io
 	}
-	if result351.Ia != nil {
-		ia = result351.Ia
+	if result404.Ia != nil {
+		ia = result404.Ia
 	}
 	return
 }
@@ -3551,9 +3577,9 @@ func (p *HbaseClient) SendScannerClose(id ScannerID) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("scannerClose", thrift.CALL, p.SeqId)
-	args355 := NewScannerCloseArgs()
-	args355.Id = id
-	err = args355.Write(oprot)
+	args408 := NewScannerCloseArgs()
+	args408.Id = id
+	err = args408.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3570,30 +3596,30 @@ func (p *HbaseClient) RecvScannerClose() (io *IOError, ia *IllegalArgument, err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error357 := thrift.NewTApplicationExceptionDefault()
-		var error358 error
-		error358, err = error357.Read(iprot)
+		error410 := thrift.NewTApplicationExceptionDefault()
+		var error411 error
+		error411, err = error410.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error358
+		err = error411
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result356 := NewScannerCloseResult()
-	err = result356.Read(iprot)
+	result409 := NewScannerCloseResult()
+	err = result409.Read(iprot)
 	iprot.ReadMessageEnd()
-	if result356.Io != nil {
-		io = result356.Io
+	if result409.Io != nil {
+		io = result409.Io
 	}
-	if result356.Ia != nil {
-		ia = result356.Ia
+	if result409.Ia != nil {
+		ia = result409.Ia
 	}
 	return
 }
@@ -3608,7 +3634,7 @@ func (p *HbaseClient) RecvScannerClose() (io *IOError, ia *IllegalArgument, err
  *  - Row: row key
  *  - Family: column name
  */
-func (p *HbaseClient) GetRowOrBefore(tableName Text, row Text, family Text) (retval359 []*TCell, io *IOError, err error) {
+func (p *HbaseClient) GetRowOrBefore(tableName Text, row Text, family Text) (retval412 []*TCell, io *IOError, err error) {
 	err = p.SendGetRowOrBefore(tableName, row, family)
 	if err != nil {
 		return
@@ -3624,11 +3650,11 @@ func (p *HbaseClient) SendGetRowOrBefore(tableName Text, row Text, family Text)
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRowOrBefore", thrift.CALL, p.SeqId)
-	args360 := NewGetRowOrBeforeArgs()
-	args360.TableName = tableName
-	args360.Row = row
-	args360.Family = family
-	err = args360.Write(oprot)
+	args413 := NewGetRowOrBeforeArgs()
+	args413.TableName = tableName
+	args413.Row = row
+	args413.Family = family
+	err = args413.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3645,28 +3671,28 @@ func (p *HbaseClient) RecvGetRowOrBefore() (value []*TCell, io *IOError, err err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error362 := thrift.NewTApplicationExceptionDefault()
-		var error363 error
-		error363, err = error362.Read(iprot)
+		error415 := thrift.NewTApplicationExceptionDefault()
+		var error416 error
+		error416, err = error415.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error363
+		err = error416
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result361 := NewGetRowOrBeforeResult()
-	err = result361.Read(iprot)
+	result414 := NewGetRowOrBeforeResult()
+	err = result414.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result361.Success
-	if result361.Io != nil {
-		io = result361.Io
+	value = result414.Success
+	if result414.Io != nil {
+		io = result414.Io
 	}
 	return
 }
@@ -3680,7 +3706,7 @@ func (p *HbaseClient) RecvGetRowOrBefore() (value []*TCell, io *IOError, err err
  * Parameters:
  *  - Row: row key
  */
-func (p *HbaseClient) GetRegionInfo(row Text) (retval364 *TRegionInfo, io *IOError, err error) {
+func (p *HbaseClient) GetRegionInfo(row Text) (retval417 *TRegionInfo, io *IOError, err error) {
 	err = p.SendGetRegionInfo(row)
 	if err != nil {
 		return
@@ -3696,9 +3722,9 @@ func (p *HbaseClient) SendGetRegionInfo(row Text) (err error) {
 	}
 	p.SeqId++
 	oprot.WriteMessageBegin("getRegionInfo", thrift.CALL, p.SeqId)
-	args365 := NewGetRegionInfoArgs()
-	args365.Row = row
-	err = args365.Write(oprot)
+	args418 := NewGetRegionInfoArgs()
+	args418.Row = row
+	err = args418.Write(oprot)
 	oprot.WriteMessageEnd()
 	oprot.Transport().Flush()
 	return
@@ -3715,28 +3741,182 @@ func (p *HbaseClient) RecvGetRegionInfo() (value *TRegionInfo, io *IOError, err
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error367 := thrift.NewTApplicationExceptionDefault()
-		var error368 error
-		error368, err = error367.Read(iprot)
+		error420 := thrift.NewTApplicationExceptionDefault()
+		var error421 error
+		error421, err = error420.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error421
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result419 := NewGetRegionInfoResult()
+	err = result419.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result419.Success
+	if result419.Io != nil {
+		io = result419.Io
+	}
+	return
+}
+
+/**
+ * Appends values to one or more columns within a single row.
+ * 
+ * @return values of columns after the append operation.
+ * 
+ * Parameters:
+ *  - Append: The single append operation to apply
+ */
+func (p *HbaseClient) Append(append *TAppend) (retval422 []*TCell, io *IOError, err error) {
+	err = p.SendAppend(append)
+	if err != nil {
+		return
+	}
+	return p.RecvAppend()
+}
+
+func (p *HbaseClient) SendAppend(append *TAppend) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("append", thrift.CALL, p.SeqId)
+	args423 := NewAppendArgs()
+	args423.Append = append
+	err = args423.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *HbaseClient) RecvAppend() (value []*TCell, io *IOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error425 := thrift.NewTApplicationExceptionDefault()
+		var error426 error
+		error426, err = error425.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error426
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result424 := NewAppendResult()
+	err = result424.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result424.Success
+	if result424.Io != nil {
+		io = result424.Io
+	}
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the corresponding mutation operation for put.
+ * 
+ * @return true if the new put was executed, false otherwise
+ * 
+ * Parameters:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Column: column name
+ *  - Value: the expected value for the column parameter, if not
+ * provided the check is for the non-existence of the
+ * column in question
+ *  - Mput: mutation for the put
+ *  - Attributes: Mutation attributes
+ */
+func (p *HbaseClient) CheckAndPut(tableName Text, row Text, column Text, value Text, mput *Mutation, attributes map[string]Text) (retval427 bool, io *IOError, ia *IllegalArgument, err error) {
+	err = p.SendCheckAndPut(tableName, row, column, value, mput, attributes)
+	if err != nil {
+		return
+	}
+	return p.RecvCheckAndPut()
+}
+
+func (p *HbaseClient) SendCheckAndPut(tableName Text, row Text, column Text, value Text, mput *Mutation, attributes map[string]Text) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("checkAndPut", thrift.CALL, p.SeqId)
+	args428 := NewCheckAndPutArgs()
+	args428.TableName = tableName
+	args428.Row = row
+	args428.Column = column
+	args428.Value = value
+	args428.Mput = mput
+	args428.Attributes = attributes
+	err = args428.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *HbaseClient) RecvCheckAndPut() (value bool, io *IOError, ia *IllegalArgument, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error430 := thrift.NewTApplicationExceptionDefault()
+		var error431 error
+		error431, err = error430.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error368
+		err = error431
 		return
 	}
 	if p.SeqId != seqId {
 		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
 		return
 	}
-	result366 := NewGetRegionInfoResult()
-	err = result366.Read(iprot)
+	result429 := NewCheckAndPutResult()
+	err = result429.Read(iprot)
 	iprot.ReadMessageEnd()
-	value = result366.Success
-	if result366.Io != nil {
-		io = result366.Io
+	value = result429.Success
+	if result429.Io != nil {
+		io = result429.Io
+	}
+	if result429.Ia != nil {
+		ia = result429.Ia
 	}
 	return
 }
@@ -3765,51 +3945,53 @@ func (p *HbaseProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
 
 func NewHbaseProcessor(handler IHbase) *HbaseProcessor {
 
-	self369 := &HbaseProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self369.processorMap["enableTable"] = &hbaseProcessorEnableTable{handler: handler}
-	self369.processorMap["disableTable"] = &hbaseProcessorDisableTable{handler: handler}
-	self369.processorMap["isTableEnabled"] = &hbaseProcessorIsTableEnabled{handler: handler}
-	self369.processorMap["compact"] = &hbaseProcessorCompact{handler: handler}
-	self369.processorMap["majorCompact"] = &hbaseProcessorMajorCompact{handler: handler}
-	self369.processorMap["getTableNames"] = &hbaseProcessorGetTableNames{handler: handler}
-	self369.processorMap["getColumnDescriptors"] = &hbaseProcessorGetColumnDescriptors{handler: handler}
-	self369.processorMap["getTableRegions"] = &hbaseProcessorGetTableRegions{handler: handler}
-	self369.processorMap["createTable"] = &hbaseProcessorCreateTable{handler: handler}
-	self369.processorMap["deleteTable"] = &hbaseProcessorDeleteTable{handler: handler}
-	self369.processorMap["get"] = &hbaseProcessorGet{handler: handler}
-	self369.processorMap["getVer"] = &hbaseProcessorGetVer{handler: handler}
-	self369.processorMap["getVerTs"] = &hbaseProcessorGetVerTs{handler: handler}
-	self369.processorMap["getRow"] = &hbaseProcessorGetRow{handler: handler}
-	self369.processorMap["getRowWithColumns"] = &hbaseProcessorGetRowWithColumns{handler: handler}
-	self369.processorMap["getRowTs"] = &hbaseProcessorGetRowTs{handler: handler}
-	self369.processorMap["getRowWithColumnsTs"] = &hbaseProcessorGetRowWithColumnsTs{handler: handler}
-	self369.processorMap["getRows"] = &hbaseProcessorGetRows{handler: handler}
-	self369.processorMap["getRowsWithColumns"] = &hbaseProcessorGetRowsWithColumns{handler: handler}
-	self369.processorMap["getRowsTs"] = &hbaseProcessorGetRowsTs{handler: handler}
-	self369.processorMap["getRowsWithColumnsTs"] = &hbaseProcessorGetRowsWithColumnsTs{handler: handler}
-	self369.processorMap["mutateRow"] = &hbaseProcessorMutateRow{handler: handler}
-	self369.processorMap["mutateRowTs"] = &hbaseProcessorMutateRowTs{handler: handler}
-	self369.processorMap["mutateRows"] = &hbaseProcessorMutateRows{handler: handler}
-	self369.processorMap["mutateRowsTs"] = &hbaseProcessorMutateRowsTs{handler: handler}
-	self369.processorMap["atomicIncrement"] = &hbaseProcessorAtomicIncrement{handler: handler}
-	self369.processorMap["deleteAll"] = &hbaseProcessorDeleteAll{handler: handler}
-	self369.processorMap["deleteAllTs"] = &hbaseProcessorDeleteAllTs{handler: handler}
-	self369.processorMap["deleteAllRow"] = &hbaseProcessorDeleteAllRow{handler: handler}
-	self369.processorMap["increment"] = &hbaseProcessorIncrement{handler: handler}
-	self369.processorMap["incrementRows"] = &hbaseProcessorIncrementRows{handler: handler}
-	self369.processorMap["deleteAllRowTs"] = &hbaseProcessorDeleteAllRowTs{handler: handler}
-	self369.processorMap["scannerOpenWithScan"] = &hbaseProcessorScannerOpenWithScan{handler: handler}
-	self369.processorMap["scannerOpen"] = &hbaseProcessorScannerOpen{handler: handler}
-	self369.processorMap["scannerOpenWithStop"] = &hbaseProcessorScannerOpenWithStop{handler: handler}
-	self369.processorMap["scannerOpenWithPrefix"] = &hbaseProcessorScannerOpenWithPrefix{handler: handler}
-	self369.processorMap["scannerOpenTs"] = &hbaseProcessorScannerOpenTs{handler: handler}
-	self369.processorMap["scannerOpenWithStopTs"] = &hbaseProcessorScannerOpenWithStopTs{handler: handler}
-	self369.processorMap["scannerGet"] = &hbaseProcessorScannerGet{handler: handler}
-	self369.processorMap["scannerGetList"] = &hbaseProcessorScannerGetList{handler: handler}
-	self369.processorMap["scannerClose"] = &hbaseProcessorScannerClose{handler: handler}
-	self369.processorMap["getRowOrBefore"] = &hbaseProcessorGetRowOrBefore{handler: handler}
-	self369.processorMap["getRegionInfo"] = &hbaseProcessorGetRegionInfo{handler: handler}
-	return self369
+	self432 := &HbaseProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self432.processorMap["enableTable"] = &hbaseProcessorEnableTable{handler: handler}
+	self432.processorMap["disableTable"] = &hbaseProcessorDisableTable{handler: handler}
+	self432.processorMap["isTableEnabled"] = &hbaseProcessorIsTableEnabled{handler: handler}
+	self432.processorMap["compact"] = &hbaseProcessorCompact{handler: handler}
+	self432.processorMap["majorCompact"] = &hbaseProcessorMajorCompact{handler: handler}
+	self432.processorMap["getTableNames"] = &hbaseProcessorGetTableNames{handler: handler}
+	self432.processorMap["getColumnDescriptors"] = &hbaseProcessorGetColumnDescriptors{handler: handler}
+	self432.processorMap["getTableRegions"] = &hbaseProcessorGetTableRegions{handler: handler}
+	self432.processorMap["createTable"] = &hbaseProcessorCreateTable{handler: handler}
+	self432.processorMap["deleteTable"] = &hbaseProcessorDeleteTable{handler: handler}
+	self432.processorMap["get"] = &hbaseProcessorGet{handler: handler}
+	self432.processorMap["getVer"] = &hbaseProcessorGetVer{handler: handler}
+	self432.processorMap["getVerTs"] = &hbaseProcessorGetVerTs{handler: handler}
+	self432.processorMap["getRow"] = &hbaseProcessorGetRow{handler: handler}
+	self432.processorMap["getRowWithColumns"] = &hbaseProcessorGetRowWithColumns{handler: handler}
+	self432.processorMap["getRowTs"] = &hbaseProcessorGetRowTs{handler: handler}
+	self432.processorMap["getRowWithColumnsTs"] = &hbaseProcessorGetRowWithColumnsTs{handler: handler}
+	self432.processorMap["getRows"] = &hbaseProcessorGetRows{handler: handler}
+	self432.processorMap["getRowsWithColumns"] = &hbaseProcessorGetRowsWithColumns{handler: handler}
+	self432.processorMap["getRowsTs"] = &hbaseProcessorGetRowsTs{handler: handler}
+	self432.processorMap["getRowsWithColumnsTs"] = &hbaseProcessorGetRowsWithColumnsTs{handler: handler}
+	self432.processorMap["mutateRow"] = &hbaseProcessorMutateRow{handler: handler}
+	self432.processorMap["mutateRowTs"] = &hbaseProcessorMutateRowTs{handler: handler}
+	self432.processorMap["mutateRows"] = &hbaseProcessorMutateRows{handler: handler}
+	self432.processorMap["mutateRowsTs"] = &hbaseProcessorMutateRowsTs{handler: handler}
+	self432.processorMap["atomicIncrement"] = &hbaseProcessorAtomicIncrement{handler: handler}
+	self432.processorMap["deleteAll"] = &hbaseProcessorDeleteAll{handler: handler}
+	self432.processorMap["deleteAllTs"] = &hbaseProcessorDeleteAllTs{handler: handler}
+	self432.processorMap["deleteAllRow"] = &hbaseProcessorDeleteAllRow{handler: handler}
+	self432.processorMap["increment"] = &hbaseProcessorIncrement{handler: handler}
+	self432.processorMap["incrementRows"] = &hbaseProcessorIncrementRows{handler: handler}
+	self432.processorMap["deleteAllRowTs"] = &hbaseProcessorDeleteAllRowTs{handler: handler}
+	self432.processorMap["scannerOpenWithScan"] = &hbaseProcessorScannerOpenWithScan{handler: handler}
+	self432.processorMap["scannerOpen"] = &hbaseProcessorScannerOpen{handler: handler}
+	self432.processorMap["scannerOpenWithStop"] = &hbaseProcessorScannerOpenWithStop{handler: handler}
+	self432.processorMap["scannerOpenWithPrefix"] = &hbaseProcessorScannerOpenWithPrefix{handler: handler}
+	self432.processorMap["scannerOpenTs"] = &hbaseProcessorScannerOpenTs{handler: handler}
+	self432.processorMap["scannerOpenWithStopTs"] = &hbaseProcessorScannerOpenWithStopTs{handler: handler}
+	self432.processorMap["scannerGet"] = &hbaseProcessorScannerGet{handler: handler}
+	self432.processorMap["scannerGetList"] = &hbaseProcessorScannerGetList{handler: handler}
+	self432.processorMap["scannerClose"] = &hbaseProcessorScannerClose{handler: handler}
+	self432.processorMap["getRowOrBefore"] = &hbaseProcessorGetRowOrBefore{handler: handler}
+	self432.processorMap["getRegionInfo"] = &hbaseProcessorGetRegionInfo{handler: handler}
+	self432.processorMap["append"] = &hbaseProcessorAppend{handler: handler}
+	self432.processorMap["checkAndPut"] = &hbaseProcessorCheckAndPut{handler: handler}
+	return self432
 }
 
 func (p *HbaseProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -3821,12 +4003,12 @@ func (p *HbaseProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, e
 	if !nameFound || process == nil {
 		iprot.Skip(thrift.STRUCT)
 		iprot.ReadMessageEnd()
-		x370 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+		x433 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
 		oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-		x370.Write(oprot)
+		x433.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Transport().Flush()
-		return false, x370
+		return false, x433
 	}
 	return process.Process(seqId, iprot, oprot)
 }
@@ -5680,6 +5862,92 @@ func (p *hbaseProcessorGetRegionInfo) Process(seqId int32, iprot, oprot thrift.T
 	return true, err
 }
 
+type hbaseProcessorAppend struct {
+	handler IHbase
+}
+
+func (p *hbaseProcessorAppend) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewAppendArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("append", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewAppendResult()
+	if result.Success, result.Io, err = p.handler.Append(args.Append); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing append: "+err.Error())
+		oprot.WriteMessageBegin("append", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("append", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type hbaseProcessorCheckAndPut struct {
+	handler IHbase
+}
+
+func (p *hbaseProcessorCheckAndPut) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCheckAndPutArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("checkAndPut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCheckAndPutResult()
+	if result.Success, result.Io, result.Ia, err = p.handler.CheckAndPut(args.TableName, args.Row, args.Column, args.Value, args.Mput, args.Attributes); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkAndPut: "+err.Error())
+		oprot.WriteMessageBegin("checkAndPut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("checkAndPut", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 // HELPER FUNCTIONS AND STRUCTURES
 
 /**
@@ -5751,11 +6019,11 @@ func (p *EnableTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *EnableTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v371, err372 := iprot.ReadBinary()
-	if err372 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err372)
+	v434, err435 := iprot.ReadBinary()
+	if err435 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err435)
 	}
-	p.TableName = Bytes(v371)
+	p.TableName = Bytes(v434)
 	return err
 }
 
@@ -5891,9 +6159,9 @@ func (p *EnableTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *EnableTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err375 := p.Io.Read(iprot)
-	if err375 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err375)
+	err438 := p.Io.Read(iprot)
+	if err438 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err438)
 	}
 	return err
 }
@@ -6031,11 +6299,11 @@ func (p *DisableTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *DisableTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v376, err377 := iprot.ReadBinary()
-	if err377 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err377)
+	v439, err440 := iprot.ReadBinary()
+	if err440 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err440)
 	}
-	p.TableName = Bytes(v376)
+	p.TableName = Bytes(v439)
 	return err
 }
 
@@ -6171,9 +6439,9 @@ func (p *DisableTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *DisableTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err380 := p.Io.Read(iprot)
-	if err380 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err380)
+	err443 := p.Io.Read(iprot)
+	if err443 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err443)
 	}
 	return err
 }
@@ -6311,11 +6579,11 @@ func (p *IsTableEnabledArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 }
 
 func (p *IsTableEnabledArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v381, err382 := iprot.ReadBinary()
-	if err382 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err382)
+	v444, err445 := iprot.ReadBinary()
+	if err445 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err445)
 	}
-	p.TableName = Bytes(v381)
+	p.TableName = Bytes(v444)
 	return err
 }
 
@@ -6465,19 +6733,19 @@ func (p *IsTableEnabledResult) Read(iprot thrift.TProtocol) (err thrift.TProtoco
 }
 
 func (p *IsTableEnabledResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v383, err384 := iprot.ReadBool()
-	if err384 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err384)
+	v446, err447 := iprot.ReadBool()
+	if err447 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err447)
 	}
-	p.Success = v383
+	p.Success = v446
 	return err
 }
 
 func (p *IsTableEnabledResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err387 := p.Io.Read(iprot)
-	if err387 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err387)
+	err450 := p.Io.Read(iprot)
+	if err450 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err450)
 	}
 	return err
 }
@@ -6638,11 +6906,11 @@ func (p *CompactArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExceptio
 }
 
 func (p *CompactArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v388, err389 := iprot.ReadBinary()
-	if err389 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableNameOrRegionName", p.ThriftName(), err389)
+	v451, err452 := iprot.ReadBinary()
+	if err452 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableNameOrRegionName", p.ThriftName(), err452)
 	}
-	p.TableNameOrRegionName = Bytes(v388)
+	p.TableNameOrRegionName = Bytes(v451)
 	return err
 }
 
@@ -6778,9 +7046,9 @@ func (p *CompactResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 
 func (p *CompactResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err392 := p.Io.Read(iprot)
-	if err392 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err392)
+	err455 := p.Io.Read(iprot)
+	if err455 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err455)
 	}
 	return err
 }
@@ -6918,11 +7186,11 @@ func (p *MajorCompactArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *MajorCompactArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v393, err394 := iprot.ReadBinary()
-	if err394 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableNameOrRegionName", p.ThriftName(), err394)
+	v456, err457 := iprot.ReadBinary()
+	if err457 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableNameOrRegionName", p.ThriftName(), err457)
 	}
-	p.TableNameOrRegionName = Bytes(v393)
+	p.TableNameOrRegionName = Bytes(v456)
 	return err
 }
 
@@ -7058,9 +7326,9 @@ func (p *MajorCompactResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *MajorCompactResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err397 := p.Io.Read(iprot)
-	if err397 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err397)
+	err460 := p.Io.Read(iprot)
+	if err460 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err460)
 	}
 	return err
 }
@@ -7298,19 +7566,19 @@ func (p *GetTableNamesResult) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *GetTableNamesResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype403, _size400, err := iprot.ReadListBegin()
+	_etype466, _size463, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype403
-	p.Success = make([]Text, _size400, _size400)
-	for i := 0; i < _size400; i++ {
-		v406, err407 := iprot.ReadBinary()
-		if err407 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem405", "", err407)
+	_ = _etype466
+	p.Success = make([]Text, _size463, _size463)
+	for i := 0; i < _size463; i++ {
+		v469, err470 := iprot.ReadBinary()
+		if err470 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem468", "", err470)
 		}
-		_elem405 := Text(v406)
-		p.Success[i] = _elem405
+		_elem468 := Text(v469)
+		p.Success[i] = _elem468
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -7321,9 +7589,9 @@ func (p *GetTableNamesResult) readField0(iprot thrift.TProtocol) (err thrift.TPr
 
 func (p *GetTableNamesResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err410 := p.Io.Read(iprot)
-	if err410 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err410)
+	err473 := p.Io.Read(iprot)
+	if err473 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err473)
 	}
 	return err
 }
@@ -7364,10 +7632,10 @@ func (p *GetTableNamesResult) writeField0(oprot thrift.TProtocol) (err thrift.TP
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter411 := range p.Success {
-			err = oprot.WriteBinary(Iter411)
+		for _, Iter474 := range p.Success {
+			err = oprot.WriteBinary(Iter474)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter411", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter474", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -7496,11 +7764,11 @@ func (p *GetColumnDescriptorsArgs) Read(iprot thrift.TProtocol) (err thrift.TPro
 }
 
 func (p *GetColumnDescriptorsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v412, err413 := iprot.ReadBinary()
-	if err413 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err413)
+	v475, err476 := iprot.ReadBinary()
+	if err476 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err476)
 	}
-	p.TableName = Text(v412)
+	p.TableName = Text(v475)
 	return err
 }
 
@@ -7652,24 +7920,24 @@ func (p *GetColumnDescriptorsResult) Read(iprot thrift.TProtocol) (err thrift.TP
 }
 
 func (p *GetColumnDescriptorsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype417, _vtype418, _size416, err := iprot.ReadMapBegin()
+	_ktype480, _vtype481, _size479, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_, _ = _ktype417, _vtype418
-	p.Success = make(map[string]*ColumnDescriptor, _size416)
-	for i := 0; i < _size416; i++ {
-		v423, err424 := iprot.ReadString()
-		if err424 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key421", "", err424)
+	_, _ = _ktype480, _vtype481
+	p.Success = make(map[string]*ColumnDescriptor, _size479)
+	for i := 0; i < _size479; i++ {
+		v486, err487 := iprot.ReadString()
+		if err487 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key484", "", err487)
 		}
-		_key421 := v423
-		_val422 := NewColumnDescriptor()
-		err427 := _val422.Read(iprot)
-		if err427 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_val422ColumnDescriptor", err427)
+		_key484 := v486
+		_val485 := NewColumnDescriptor()
+		err490 := _val485.Read(iprot)
+		if err490 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_val485ColumnDescriptor", err490)
 		}
-		p.Success[_key421] = _val422
+		p.Success[_key484] = _val485
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -7680,9 +7948,9 @@ func (p *GetColumnDescriptorsResult) readField0(iprot thrift.TProtocol) (err thr
 
 func (p *GetColumnDescriptorsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err430 := p.Io.Read(iprot)
-	if err430 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err430)
+	err493 := p.Io.Read(iprot)
+	if err493 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err493)
 	}
 	return err
 }
@@ -7723,12 +7991,12 @@ func (p *GetColumnDescriptorsResult) writeField0(oprot thrift.TProtocol) (err th
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter431, Viter432 := range p.Success {
-			err = oprot.WriteString(Kiter431)
+		for Kiter494, Viter495 := range p.Success {
+			err = oprot.WriteString(Kiter494)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter431", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter494", "", err)
 			}
-			err = Viter432.Write(oprot)
+			err = Viter495.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("ColumnDescriptor", err)
 			}
@@ -7859,11 +8127,11 @@ func (p *GetTableRegionsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *GetTableRegionsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v433, err434 := iprot.ReadBinary()
-	if err434 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err434)
+	v496, err497 := iprot.ReadBinary()
+	if err497 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err497)
 	}
-	p.TableName = Text(v433)
+	p.TableName = Text(v496)
 	return err
 }
 
@@ -8013,19 +8281,19 @@ func (p *GetTableRegionsResult) Read(iprot thrift.TProtocol) (err thrift.TProtoc
 }
 
 func (p *GetTableRegionsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype440, _size437, err := iprot.ReadListBegin()
+	_etype503, _size500, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype440
-	p.Success = make([]*TRegionInfo, _size437, _size437)
-	for i := 0; i < _size437; i++ {
-		_elem442 := NewTRegionInfo()
-		err445 := _elem442.Read(iprot)
-		if err445 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem442TRegionInfo", err445)
+	_ = _etype503
+	p.Success = make([]*TRegionInfo, _size500, _size500)
+	for i := 0; i < _size500; i++ {
+		_elem505 := NewTRegionInfo()
+		err508 := _elem505.Read(iprot)
+		if err508 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem505TRegionInfo", err508)
 		}
-		p.Success[i] = _elem442
+		p.Success[i] = _elem505
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -8036,9 +8304,9 @@ func (p *GetTableRegionsResult) readField0(iprot thrift.TProtocol) (err thrift.T
 
 func (p *GetTableRegionsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err448 := p.Io.Read(iprot)
-	if err448 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err448)
+	err511 := p.Io.Read(iprot)
+	if err511 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err511)
 	}
 	return err
 }
@@ -8079,8 +8347,8 @@ func (p *GetTableRegionsResult) writeField0(oprot thrift.TProtocol) (err thrift.
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter449 := range p.Success {
-			err = Iter449.Write(oprot)
+		for _, Iter512 := range p.Success {
+			err = Iter512.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRegionInfo", err)
 			}
@@ -8226,28 +8494,28 @@ func (p *CreateTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *CreateTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v450, err451 := iprot.ReadBinary()
-	if err451 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err451)
+	v513, err514 := iprot.ReadBinary()
+	if err514 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err514)
 	}
-	p.TableName = Text(v450)
+	p.TableName = Text(v513)
 	return err
 }
 
 func (p *CreateTableArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype457, _size454, err := iprot.ReadListBegin()
+	_etype520, _size517, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.ColumnFamilies", "", err)
 	}
-	_ = _etype457
-	p.ColumnFamilies = make([]*ColumnDescriptor, _size454, _size454)
-	for i := 0; i < _size454; i++ {
-		_elem459 := NewColumnDescriptor()
-		err462 := _elem459.Read(iprot)
-		if err462 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem459ColumnDescriptor", err462)
+	_ = _etype520
+	p.ColumnFamilies = make([]*ColumnDescriptor, _size517, _size517)
+	for i := 0; i < _size517; i++ {
+		_elem522 := NewColumnDescriptor()
+		err525 := _elem522.Read(iprot)
+		if err525 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem522ColumnDescriptor", err525)
 		}
-		p.ColumnFamilies[i] = _elem459
+		p.ColumnFamilies[i] = _elem522
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -8308,8 +8576,8 @@ func (p *CreateTableArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter463 := range p.ColumnFamilies {
-			err = Iter463.Write(oprot)
+		for _, Iter526 := range p.ColumnFamilies {
+			err = Iter526.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("ColumnDescriptor", err)
 			}
@@ -8453,27 +8721,27 @@ func (p *CreateTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *CreateTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err466 := p.Io.Read(iprot)
-	if err466 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err466)
+	err529 := p.Io.Read(iprot)
+	if err529 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err529)
 	}
 	return err
 }
 
 func (p *CreateTableResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err469 := p.Ia.Read(iprot)
-	if err469 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err469)
+	err532 := p.Ia.Read(iprot)
+	if err532 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err532)
 	}
 	return err
 }
 
 func (p *CreateTableResult) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Exist = NewAlreadyExists()
-	err472 := p.Exist.Read(iprot)
-	if err472 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.ExistAlreadyExists", err472)
+	err535 := p.Exist.Read(iprot)
+	if err535 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.ExistAlreadyExists", err535)
 	}
 	return err
 }
@@ -8661,11 +8929,11 @@ func (p *DeleteTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *DeleteTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v473, err474 := iprot.ReadBinary()
-	if err474 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err474)
+	v536, err537 := iprot.ReadBinary()
+	if err537 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err537)
 	}
-	p.TableName = Text(v473)
+	p.TableName = Text(v536)
 	return err
 }
 
@@ -8801,9 +9069,9 @@ func (p *DeleteTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *DeleteTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err477 := p.Io.Read(iprot)
-	if err477 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err477)
+	err540 := p.Io.Read(iprot)
+	if err540 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err540)
 	}
 	return err
 }
@@ -8986,51 +9254,51 @@ func (p *GetArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 }
 
 func (p *GetArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v478, err479 := iprot.ReadBinary()
-	if err479 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err479)
+	v541, err542 := iprot.ReadBinary()
+	if err542 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err542)
 	}
-	p.TableName = Text(v478)
+	p.TableName = Text(v541)
 	return err
 }
 
 func (p *GetArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v480, err481 := iprot.ReadBinary()
-	if err481 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err481)
+	v543, err544 := iprot.ReadBinary()
+	if err544 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err544)
 	}
-	p.Row = Text(v480)
+	p.Row = Text(v543)
 	return err
 }
 
 func (p *GetArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v482, err483 := iprot.ReadBinary()
-	if err483 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err483)
+	v545, err546 := iprot.ReadBinary()
+	if err546 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err546)
 	}
-	p.Column = Text(v482)
+	p.Column = Text(v545)
 	return err
 }
 
 func (p *GetArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype487, _vtype488, _size486, err := iprot.ReadMapBegin()
+	_ktype550, _vtype551, _size549, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype487, _vtype488
-	p.Attributes = make(map[string]Text, _size486)
-	for i := 0; i < _size486; i++ {
-		v493, err494 := iprot.ReadString()
-		if err494 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key491", "", err494)
+	_, _ = _ktype550, _vtype551
+	p.Attributes = make(map[string]Text, _size549)
+	for i := 0; i < _size549; i++ {
+		v556, err557 := iprot.ReadString()
+		if err557 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key554", "", err557)
 		}
-		_key491 := v493
-		v495, err496 := iprot.ReadBinary()
-		if err496 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val492", "", err496)
+		_key554 := v556
+		v558, err559 := iprot.ReadBinary()
+		if err559 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val555", "", err559)
 		}
-		_val492 := Text(v495)
-		p.Attributes[_key491] = _val492
+		_val555 := Text(v558)
+		p.Attributes[_key554] = _val555
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -9135,14 +9403,14 @@ func (p *GetArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolExcep
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter497, Viter498 := range p.Attributes {
-			err = oprot.WriteString(Kiter497)
+		for Kiter560, Viter561 := range p.Attributes {
+			err = oprot.WriteString(Kiter560)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter497", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter560", "", err)
 			}
-			err = oprot.WriteBinary(Viter498)
+			err = oprot.WriteBinary(Viter561)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter498", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter561", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -9274,19 +9542,19 @@ func (p *GetResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException)
 }
 
 func (p *GetResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype504, _size501, err := iprot.ReadListBegin()
+	_etype567, _size564, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype504
-	p.Success = make([]*TCell, _size501, _size501)
-	for i := 0; i < _size501; i++ {
-		_elem506 := NewTCell()
-		err509 := _elem506.Read(iprot)
-		if err509 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem506TCell", err509)
+	_ = _etype567
+	p.Success = make([]*TCell, _size564, _size564)
+	for i := 0; i < _size564; i++ {
+		_elem569 := NewTCell()
+		err572 := _elem569.Read(iprot)
+		if err572 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem569TCell", err572)
 		}
-		p.Success[i] = _elem506
+		p.Success[i] = _elem569
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -9297,9 +9565,9 @@ func (p *GetResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolExce
 
 func (p *GetResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err512 := p.Io.Read(iprot)
-	if err512 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err512)
+	err575 := p.Io.Read(iprot)
+	if err575 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err575)
 	}
 	return err
 }
@@ -9340,8 +9608,8 @@ func (p *GetResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolExc
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter513 := range p.Success {
-			err = Iter513.Write(oprot)
+		for _, Iter576 := range p.Success {
+			err = Iter576.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
 			}
@@ -9532,60 +9800,60 @@ func (p *GetVerArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException
 }
 
 func (p *GetVerArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v514, err515 := iprot.ReadBinary()
-	if err515 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err515)
+	v577, err578 := iprot.ReadBinary()
+	if err578 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err578)
 	}
-	p.TableName = Text(v514)
+	p.TableName = Text(v577)
 	return err
 }
 
 func (p *GetVerArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v516, err517 := iprot.ReadBinary()
-	if err517 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err517)
+	v579, err580 := iprot.ReadBinary()
+	if err580 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err580)
 	}
-	p.Row = Text(v516)
+	p.Row = Text(v579)
 	return err
 }
 
 func (p *GetVerArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v518, err519 := iprot.ReadBinary()
-	if err519 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err519)
+	v581, err582 := iprot.ReadBinary()
+	if err582 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err582)
 	}
-	p.Column = Text(v518)
+	p.Column = Text(v581)
 	return err
 }
 
 func (p *GetVerArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v520, err521 := iprot.ReadI32()
-	if err521 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "numVersions", p.ThriftName(), err521)
+	v583, err584 := iprot.ReadI32()
+	if err584 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "numVersions", p.ThriftName(), err584)
 	}
-	p.NumVersions = v520
+	p.NumVersions = v583
 	return err
 }
 
 func (p *GetVerArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype525, _vtype526, _size524, err := iprot.ReadMapBegin()
+	_ktype588, _vtype589, _size587, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype525, _vtype526
-	p.Attributes = make(map[string]Text, _size524)
-	for i := 0; i < _size524; i++ {
-		v531, err532 := iprot.ReadString()
-		if err532 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key529", "", err532)
+	_, _ = _ktype588, _vtype589
+	p.Attributes = make(map[string]Text, _size587)
+	for i := 0; i < _size587; i++ {
+		v594, err595 := iprot.ReadString()
+		if err595 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key592", "", err595)
 		}
-		_key529 := v531
-		v533, err534 := iprot.ReadBinary()
-		if err534 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val530", "", err534)
+		_key592 := v594
+		v596, err597 := iprot.ReadBinary()
+		if err597 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val593", "", err597)
 		}
-		_val530 := Text(v533)
-		p.Attributes[_key529] = _val530
+		_val593 := Text(v596)
+		p.Attributes[_key592] = _val593
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -9710,14 +9978,14 @@ func (p *GetVerArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolEx
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter535, Viter536 := range p.Attributes {
-			err = oprot.WriteString(Kiter535)
+		for Kiter598, Viter599 := range p.Attributes {
+			err = oprot.WriteString(Kiter598)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter535", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter598", "", err)
 			}
-			err = oprot.WriteBinary(Viter536)
+			err = oprot.WriteBinary(Viter599)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter536", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter599", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -9852,19 +10120,19 @@ func (p *GetVerResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcepti
 }
 
 func (p *GetVerResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype542, _size539, err := iprot.ReadListBegin()
+	_etype605, _size602, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype542
-	p.Success = make([]*TCell, _size539, _size539)
-	for i := 0; i < _size539; i++ {
-		_elem544 := NewTCell()
-		err547 := _elem544.Read(iprot)
-		if err547 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem544TCell", err547)
+	_ = _etype605
+	p.Success = make([]*TCell, _size602, _size602)
+	for i := 0; i < _size602; i++ {
+		_elem607 := NewTCell()
+		err610 := _elem607.Read(iprot)
+		if err610 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem607TCell", err610)
 		}
-		p.Success[i] = _elem544
+		p.Success[i] = _elem607
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -9875,9 +10143,9 @@ func (p *GetVerResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *GetVerResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err550 := p.Io.Read(iprot)
-	if err550 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err550)
+	err613 := p.Io.Read(iprot)
+	if err613 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err613)
 	}
 	return err
 }
@@ -9918,8 +10186,8 @@ func (p *GetVerResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocol
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter551 := range p.Success {
-			err = Iter551.Write(oprot)
+		for _, Iter614 := range p.Success {
+			err = Iter614.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
 			}
@@ -10125,69 +10393,69 @@ func (p *GetVerTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcepti
 }
 
 func (p *GetVerTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v552, err553 := iprot.ReadBinary()
-	if err553 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err553)
+	v615, err616 := iprot.ReadBinary()
+	if err616 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err616)
 	}
-	p.TableName = Text(v552)
+	p.TableName = Text(v615)
 	return err
 }
 
 func (p *GetVerTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v554, err555 := iprot.ReadBinary()
-	if err555 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err555)
+	v617, err618 := iprot.ReadBinary()
+	if err618 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err618)
 	}
-	p.Row = Text(v554)
+	p.Row = Text(v617)
 	return err
 }
 
 func (p *GetVerTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v556, err557 := iprot.ReadBinary()
-	if err557 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err557)
+	v619, err620 := iprot.ReadBinary()
+	if err620 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err620)
 	}
-	p.Column = Text(v556)
+	p.Column = Text(v619)
 	return err
 }
 
 func (p *GetVerTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v558, err559 := iprot.ReadI64()
-	if err559 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err559)
+	v621, err622 := iprot.ReadI64()
+	if err622 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err622)
 	}
-	p.Timestamp = v558
+	p.Timestamp = v621
 	return err
 }
 
 func (p *GetVerTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v560, err561 := iprot.ReadI32()
-	if err561 != nil {
-		return thrift.NewTProtocolExceptionReadField(5, "numVersions", p.ThriftName(), err561)
+	v623, err624 := iprot.ReadI32()
+	if err624 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "numVersions", p.ThriftName(), err624)
 	}
-	p.NumVersions = v560
+	p.NumVersions = v623
 	return err
 }
 
 func (p *GetVerTsArgs) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype565, _vtype566, _size564, err := iprot.ReadMapBegin()
+	_ktype628, _vtype629, _size627, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype565, _vtype566
-	p.Attributes = make(map[string]Text, _size564)
-	for i := 0; i < _size564; i++ {
-		v571, err572 := iprot.ReadString()
-		if err572 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key569", "", err572)
+	_, _ = _ktype628, _vtype629
+	p.Attributes = make(map[string]Text, _size627)
+	for i := 0; i < _size627; i++ {
+		v634, err635 := iprot.ReadString()
+		if err635 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key632", "", err635)
 		}
-		_key569 := v571
-		v573, err574 := iprot.ReadBinary()
-		if err574 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val570", "", err574)
+		_key632 := v634
+		v636, err637 := iprot.ReadBinary()
+		if err637 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val633", "", err637)
 		}
-		_val570 := Text(v573)
-		p.Attributes[_key569] = _val570
+		_val633 := Text(v636)
+		p.Attributes[_key632] = _val633
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -10332,14 +10600,14 @@ func (p *GetVerTsArgs) writeField6(oprot thrift.TProtocol) (err thrift.TProtocol
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter575, Viter576 := range p.Attributes {
-			err = oprot.WriteString(Kiter575)
+		for Kiter638, Viter639 := range p.Attributes {
+			err = oprot.WriteString(Kiter638)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter575", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter638", "", err)
 			}
-			err = oprot.WriteBinary(Viter576)
+			err = oprot.WriteBinary(Viter639)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter576", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter639", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -10477,19 +10745,19 @@ func (p *GetVerTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcep
 }
 
 func (p *GetVerTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype582, _size579, err := iprot.ReadListBegin()
+	_etype645, _size642, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype582
-	p.Success = make([]*TCell, _size579, _size579)
-	for i := 0; i < _size579; i++ {
-		_elem584 := NewTCell()
-		err587 := _elem584.Read(iprot)
-		if err587 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem584TCell", err587)
+	_ = _etype645
+	p.Success = make([]*TCell, _size642, _size642)
+	for i := 0; i < _size642; i++ {
+		_elem647 := NewTCell()
+		err650 := _elem647.Read(iprot)
+		if err650 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem647TCell", err650)
 		}
-		p.Success[i] = _elem584
+		p.Success[i] = _elem647
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -10500,9 +10768,9 @@ func (p *GetVerTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtoco
 
 func (p *GetVerTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err590 := p.Io.Read(iprot)
-	if err590 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err590)
+	err653 := p.Io.Read(iprot)
+	if err653 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err653)
 	}
 	return err
 }
@@ -10543,8 +10811,8 @@ func (p *GetVerTsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtoc
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter591 := range p.Success {
-			err = Iter591.Write(oprot)
+		for _, Iter654 := range p.Success {
+			err = Iter654.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
 			}
@@ -10705,42 +10973,42 @@ func (p *GetRowArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException
 }
 
 func (p *GetRowArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v592, err593 := iprot.ReadBinary()
-	if err593 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err593)
+	v655, err656 := iprot.ReadBinary()
+	if err656 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err656)
 	}
-	p.TableName = Text(v592)
+	p.TableName = Text(v655)
 	return err
 }
 
 func (p *GetRowArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v594, err595 := iprot.ReadBinary()
-	if err595 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err595)
+	v657, err658 := iprot.ReadBinary()
+	if err658 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err658)
 	}
-	p.Row = Text(v594)
+	p.Row = Text(v657)
 	return err
 }
 
 func (p *GetRowArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype599, _vtype600, _size598, err := iprot.ReadMapBegin()
+	_ktype662, _vtype663, _size661, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype599, _vtype600
-	p.Attributes = make(map[string]Text, _size598)
-	for i := 0; i < _size598; i++ {
-		v605, err606 := iprot.ReadString()
-		if err606 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key603", "", err606)
+	_, _ = _ktype662, _vtype663
+	p.Attributes = make(map[string]Text, _size661)
+	for i := 0; i < _size661; i++ {
+		v668, err669 := iprot.ReadString()
+		if err669 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key666", "", err669)
 		}
-		_key603 := v605
-		v607, err608 := iprot.ReadBinary()
-		if err608 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val604", "", err608)
+		_key666 := v668
+		v670, err671 := iprot.ReadBinary()
+		if err671 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val667", "", err671)
 		}
-		_val604 := Text(v607)
-		p.Attributes[_key603] = _val604
+		_val667 := Text(v670)
+		p.Attributes[_key666] = _val667
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -10823,14 +11091,14 @@ func (p *GetRowArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolEx
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter609, Viter610 := range p.Attributes {
-			err = oprot.WriteString(Kiter609)
+		for Kiter672, Viter673 := range p.Attributes {
+			err = oprot.WriteString(Kiter672)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter609", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter672", "", err)
 			}
-			err = oprot.WriteBinary(Viter610)
+			err = oprot.WriteBinary(Viter673)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter610", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter673", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -10959,19 +11227,19 @@ func (p *GetRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcepti
 }
 
 func (p *GetRowResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype616, _size613, err := iprot.ReadListBegin()
+	_etype679, _size676, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype616
-	p.Success = make([]*TRowResult, _size613, _size613)
-	for i := 0; i < _size613; i++ {
-		_elem618 := NewTRowResult()
-		err621 := _elem618.Read(iprot)
-		if err621 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem618TRowResult", err621)
+	_ = _etype679
+	p.Success = make([]*TRowResult, _size676, _size676)
+	for i := 0; i < _size676; i++ {
+		_elem681 := NewTRowResult()
+		err684 := _elem681.Read(iprot)
+		if err684 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem681TRowResult", err684)
 		}
-		p.Success[i] = _elem618
+		p.Success[i] = _elem681
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -10982,9 +11250,9 @@ func (p *GetRowResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *GetRowResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err624 := p.Io.Read(iprot)
-	if err624 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err624)
+	err687 := p.Io.Read(iprot)
+	if err687 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err687)
 	}
 	return err
 }
@@ -11025,8 +11293,8 @@ func (p *GetRowResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocol
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter625 := range p.Success {
-			err = Iter625.Write(oprot)
+		for _, Iter688 := range p.Success {
+			err = Iter688.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -11202,37 +11470,37 @@ func (p *GetRowWithColumnsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtoc
 }
 
 func (p *GetRowWithColumnsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v626, err627 := iprot.ReadBinary()
-	if err627 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err627)
+	v689, err690 := iprot.ReadBinary()
+	if err690 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err690)
 	}
-	p.TableName = Text(v626)
+	p.TableName = Text(v689)
 	return err
 }
 
 func (p *GetRowWithColumnsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v628, err629 := iprot.ReadBinary()
-	if err629 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err629)
+	v691, err692 := iprot.ReadBinary()
+	if err692 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err692)
 	}
-	p.Row = Text(v628)
+	p.Row = Text(v691)
 	return err
 }
 
 func (p *GetRowWithColumnsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype635, _size632, err := iprot.ReadListBegin()
+	_etype698, _size695, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype635
-	p.Columns = make([]Text, _size632, _size632)
-	for i := 0; i < _size632; i++ {
-		v638, err639 := iprot.ReadBinary()
-		if err639 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem637", "", err639)
+	_ = _etype698
+	p.Columns = make([]Text, _size695, _size695)
+	for i := 0; i < _size695; i++ {
+		v701, err702 := iprot.ReadBinary()
+		if err702 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem700", "", err702)
 		}
-		_elem637 := Text(v638)
-		p.Columns[i] = _elem637
+		_elem700 := Text(v701)
+		p.Columns[i] = _elem700
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -11242,24 +11510,24 @@ func (p *GetRowWithColumnsArgs) readField3(iprot thrift.TProtocol) (err thrift.T
 }
 
 func (p *GetRowWithColumnsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype643, _vtype644, _size642, err := iprot.ReadMapBegin()
+	_ktype706, _vtype707, _size705, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype643, _vtype644
-	p.Attributes = make(map[string]Text, _size642)
-	for i := 0; i < _size642; i++ {
-		v649, err650 := iprot.ReadString()
-		if err650 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key647", "", err650)
+	_, _ = _ktype706, _vtype707
+	p.Attributes = make(map[string]Text, _size705)
+	for i := 0; i < _size705; i++ {
+		v712, err713 := iprot.ReadString()
+		if err713 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key710", "", err713)
 		}
-		_key647 := v649
-		v651, err652 := iprot.ReadBinary()
-		if err652 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val648", "", err652)
+		_key710 := v712
+		v714, err715 := iprot.ReadBinary()
+		if err715 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val711", "", err715)
 		}
-		_val648 := Text(v651)
-		p.Attributes[_key647] = _val648
+		_val711 := Text(v714)
+		p.Attributes[_key710] = _val711
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -11346,10 +11614,10 @@ func (p *GetRowWithColumnsArgs) writeField3(oprot thrift.TProtocol) (err thrift.
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter653 := range p.Columns {
-			err = oprot.WriteBinary(Iter653)
+		for _, Iter716 := range p.Columns {
+			err = oprot.WriteBinary(Iter716)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter653", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter716", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -11374,14 +11642,14 @@ func (p *GetRowWithColumnsArgs) writeField4(oprot thrift.TProtocol) (err thrift.
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter654, Viter655 := range p.Attributes {
-			err = oprot.WriteString(Kiter654)
+		for Kiter717, Viter718 := range p.Attributes {
+			err = oprot.WriteString(Kiter717)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter654", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter717", "", err)
 			}
-			err = oprot.WriteBinary(Viter655)
+			err = oprot.WriteBinary(Viter718)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter655", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter718", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -11513,19 +11781,19 @@ func (p *GetRowWithColumnsResult) Read(iprot thrift.TProtocol) (err thrift.TProt
 }
 
 func (p *GetRowWithColumnsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype661, _size658, err := iprot.ReadListBegin()
+	_etype724, _size721, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype661
-	p.Success = make([]*TRowResult, _size658, _size658)
-	for i := 0; i < _size658; i++ {
-		_elem663 := NewTRowResult()
-		err666 := _elem663.Read(iprot)
-		if err666 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem663TRowResult", err666)
+	_ = _etype724
+	p.Success = make([]*TRowResult, _size721, _size721)
+	for i := 0; i < _size721; i++ {
+		_elem726 := NewTRowResult()
+		err729 := _elem726.Read(iprot)
+		if err729 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem726TRowResult", err729)
 		}
-		p.Success[i] = _elem663
+		p.Success[i] = _elem726
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -11536,9 +11804,9 @@ func (p *GetRowWithColumnsResult) readField0(iprot thrift.TProtocol) (err thrift
 
 func (p *GetRowWithColumnsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err669 := p.Io.Read(iprot)
-	if err669 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err669)
+	err732 := p.Io.Read(iprot)
+	if err732 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err732)
 	}
 	return err
 }
@@ -11579,8 +11847,8 @@ func (p *GetRowWithColumnsResult) writeField0(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter670 := range p.Success {
-			err = Iter670.Write(oprot)
+		for _, Iter733 := range p.Success {
+			err = Iter733.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -11756,51 +12024,51 @@ func (p *GetRowTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcepti
 }
 
 func (p *GetRowTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v671, err672 := iprot.ReadBinary()
-	if err672 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err672)
+	v734, err735 := iprot.ReadBinary()
+	if err735 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err735)
 	}
-	p.TableName = Text(v671)
+	p.TableName = Text(v734)
 	return err
 }
 
 func (p *GetRowTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v673, err674 := iprot.ReadBinary()
-	if err674 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err674)
+	v736, err737 := iprot.ReadBinary()
+	if err737 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err737)
 	}
-	p.Row = Text(v673)
+	p.Row = Text(v736)
 	return err
 }
 
 func (p *GetRowTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v675, err676 := iprot.ReadI64()
-	if err676 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err676)
+	v738, err739 := iprot.ReadI64()
+	if err739 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err739)
 	}
-	p.Timestamp = v675
+	p.Timestamp = v738
 	return err
 }
 
 func (p *GetRowTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype680, _vtype681, _size679, err := iprot.ReadMapBegin()
+	_ktype743, _vtype744, _size742, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype680, _vtype681
-	p.Attributes = make(map[string]Text, _size679)
-	for i := 0; i < _size679; i++ {
-		v686, err687 := iprot.ReadString()
-		if err687 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key684", "", err687)
+	_, _ = _ktype743, _vtype744
+	p.Attributes = make(map[string]Text, _size742)
+	for i := 0; i < _size742; i++ {
+		v749, err750 := iprot.ReadString()
+		if err750 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key747", "", err750)
 		}
-		_key684 := v686
-		v688, err689 := iprot.ReadBinary()
-		if err689 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val685", "", err689)
+		_key747 := v749
+		v751, err752 := iprot.ReadBinary()
+		if err752 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val748", "", err752)
 		}
-		_val685 := Text(v688)
-		p.Attributes[_key684] = _val685
+		_val748 := Text(v751)
+		p.Attributes[_key747] = _val748
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -11903,14 +12171,14 @@ func (p *GetRowTsArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtocol
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter690, Viter691 := range p.Attributes {
-			err = oprot.WriteString(Kiter690)
+		for Kiter753, Viter754 := range p.Attributes {
+			err = oprot.WriteString(Kiter753)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter690", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter753", "", err)
 			}
-			err = oprot.WriteBinary(Viter691)
+			err = oprot.WriteBinary(Viter754)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter691", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter754", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -12042,19 +12310,19 @@ func (p *GetRowTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcep
 }
 
 func (p *GetRowTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype697, _size694, err := iprot.ReadListBegin()
+	_etype760, _size757, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype697
-	p.Success = make([]*TRowResult, _size694, _size694)
-	for i := 0; i < _size694; i++ {
-		_elem699 := NewTRowResult()
-		err702 := _elem699.Read(iprot)
-		if err702 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem699TRowResult", err702)
+	_ = _etype760
+	p.Success = make([]*TRowResult, _size757, _size757)
+	for i := 0; i < _size757; i++ {
+		_elem762 := NewTRowResult()
+		err765 := _elem762.Read(iprot)
+		if err765 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem762TRowResult", err765)
 		}
-		p.Success[i] = _elem699
+		p.Success[i] = _elem762
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -12065,9 +12333,9 @@ func (p *GetRowTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtoco
 
 func (p *GetRowTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err705 := p.Io.Read(iprot)
-	if err705 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err705)
+	err768 := p.Io.Read(iprot)
+	if err768 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err768)
 	}
 	return err
 }
@@ -12108,8 +12376,8 @@ func (p *GetRowTsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtoc
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter706 := range p.Success {
-			err = Iter706.Write(oprot)
+		for _, Iter769 := range p.Success {
+			err = Iter769.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -12300,37 +12568,37 @@ func (p *GetRowWithColumnsTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProt
 }
 
 func (p *GetRowWithColumnsTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v707, err708 := iprot.ReadBinary()
-	if err708 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err708)
+	v770, err771 := iprot.ReadBinary()
+	if err771 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err771)
 	}
-	p.TableName = Text(v707)
+	p.TableName = Text(v770)
 	return err
 }
 
 func (p *GetRowWithColumnsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v709, err710 := iprot.ReadBinary()
-	if err710 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err710)
+	v772, err773 := iprot.ReadBinary()
+	if err773 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err773)
 	}
-	p.Row = Text(v709)
+	p.Row = Text(v772)
 	return err
 }
 
 func (p *GetRowWithColumnsTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype716, _size713, err := iprot.ReadListBegin()
+	_etype779, _size776, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype716
-	p.Columns = make([]Text, _size713, _size713)
-	for i := 0; i < _size713; i++ {
-		v719, err720 := iprot.ReadBinary()
-		if err720 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem718", "", err720)
+	_ = _etype779
+	p.Columns = make([]Text, _size776, _size776)
+	for i := 0; i < _size776; i++ {
+		v782, err783 := iprot.ReadBinary()
+		if err783 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem781", "", err783)
 		}
-		_elem718 := Text(v719)
-		p.Columns[i] = _elem718
+		_elem781 := Text(v782)
+		p.Columns[i] = _elem781
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -12340,33 +12608,33 @@ func (p *GetRowWithColumnsTsArgs) readField3(iprot thrift.TProtocol) (err thrift
 }
 
 func (p *GetRowWithColumnsTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v721, err722 := iprot.ReadI64()
-	if err722 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err722)
+	v784, err785 := iprot.ReadI64()
+	if err785 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err785)
 	}
-	p.Timestamp = v721
+	p.Timestamp = v784
 	return err
 }
 
 func (p *GetRowWithColumnsTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype726, _vtype727, _size725, err := iprot.ReadMapBegin()
+	_ktype789, _vtype790, _size788, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype726, _vtype727
-	p.Attributes = make(map[string]Text, _size725)
-	for i := 0; i < _size725; i++ {
-		v732, err733 := iprot.ReadString()
-		if err733 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key730", "", err733)
+	_, _ = _ktype789, _vtype790
+	p.Attributes = make(map[string]Text, _size788)
+	for i := 0; i < _size788; i++ {
+		v795, err796 := iprot.ReadString()
+		if err796 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key793", "", err796)
 		}
-		_key730 := v732
-		v734, err735 := iprot.ReadBinary()
-		if err735 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val731", "", err735)
+		_key793 := v795
+		v797, err798 := iprot.ReadBinary()
+		if err798 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val794", "", err798)
 		}
-		_val731 := Text(v734)
-		p.Attributes[_key730] = _val731
+		_val794 := Text(v797)
+		p.Attributes[_key793] = _val794
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -12457,10 +12725,10 @@ func (p *GetRowWithColumnsTsArgs) writeField3(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter736 := range p.Columns {
-			err = oprot.WriteBinary(Iter736)
+		for _, Iter799 := range p.Columns {
+			err = oprot.WriteBinary(Iter799)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter736", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter799", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -12501,14 +12769,14 @@ func (p *GetRowWithColumnsTsArgs) writeField5(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter737, Viter738 := range p.Attributes {
-			err = oprot.WriteString(Kiter737)
+		for Kiter800, Viter801 := range p.Attributes {
+			err = oprot.WriteString(Kiter800)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter737", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter800", "", err)
 			}
-			err = oprot.WriteBinary(Viter738)
+			err = oprot.WriteBinary(Viter801)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter738", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter801", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -12643,19 +12911,19 @@ func (p *GetRowWithColumnsTsResult) Read(iprot thrift.TProtocol) (err thrift.TPr
 }
 
 func (p *GetRowWithColumnsTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype744, _size741, err := iprot.ReadListBegin()
+	_etype807, _size804, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype744
-	p.Success = make([]*TRowResult, _size741, _size741)
-	for i := 0; i < _size741; i++ {
-		_elem746 := NewTRowResult()
-		err749 := _elem746.Read(iprot)
-		if err749 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem746TRowResult", err749)
+	_ = _etype807
+	p.Success = make([]*TRowResult, _size804, _size804)
+	for i := 0; i < _size804; i++ {
+		_elem809 := NewTRowResult()
+		err812 := _elem809.Read(iprot)
+		if err812 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem809TRowResult", err812)
 		}
-		p.Success[i] = _elem746
+		p.Success[i] = _elem809
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -12666,9 +12934,9 @@ func (p *GetRowWithColumnsTsResult) readField0(iprot thrift.TProtocol) (err thri
 
 func (p *GetRowWithColumnsTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err752 := p.Io.Read(iprot)
-	if err752 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err752)
+	err815 := p.Io.Read(iprot)
+	if err815 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err815)
 	}
 	return err
 }
@@ -12709,8 +12977,8 @@ func (p *GetRowWithColumnsTsResult) writeField0(oprot thrift.TProtocol) (err thr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter753 := range p.Success {
-			err = Iter753.Write(oprot)
+		for _, Iter816 := range p.Success {
+			err = Iter816.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -12871,28 +13139,28 @@ func (p *GetRowsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExceptio
 }
 
 func (p *GetRowsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v754, err755 := iprot.ReadBinary()
-	if err755 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err755)
+	v817, err818 := iprot.ReadBinary()
+	if err818 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err818)
 	}
-	p.TableName = Text(v754)
+	p.TableName = Text(v817)
 	return err
 }
 
 func (p *GetRowsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype761, _size758, err := iprot.ReadListBegin()
+	_etype824, _size821, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Rows", "", err)
 	}
-	_ = _etype761
-	p.Rows = make([]Text, _size758, _size758)
-	for i := 0; i < _size758; i++ {
-		v764, err765 := iprot.ReadBinary()
-		if err765 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem763", "", err765)
+	_ = _etype824
+	p.Rows = make([]Text, _size821, _size821)
+	for i := 0; i < _size821; i++ {
+		v827, err828 := iprot.ReadBinary()
+		if err828 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem826", "", err828)
 		}
-		_elem763 := Text(v764)
-		p.Rows[i] = _elem763
+		_elem826 := Text(v827)
+		p.Rows[i] = _elem826
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -12902,24 +13170,24 @@ func (p *GetRowsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolEx
 }
 
 func (p *GetRowsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype769, _vtype770, _size768, err := iprot.ReadMapBegin()
+	_ktype832, _vtype833, _size831, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype769, _vtype770
-	p.Attributes = make(map[string]Text, _size768)
-	for i := 0; i < _size768; i++ {
-		v775, err776 := iprot.ReadString()
-		if err776 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key773", "", err776)
+	_, _ = _ktype832, _vtype833
+	p.Attributes = make(map[string]Text, _size831)
+	for i := 0; i < _size831; i++ {
+		v838, err839 := iprot.ReadString()
+		if err839 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key836", "", err839)
 		}
-		_key773 := v775
-		v777, err778 := iprot.ReadBinary()
-		if err778 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val774", "", err778)
+		_key836 := v838
+		v840, err841 := iprot.ReadBinary()
+		if err841 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val837", "", err841)
 		}
-		_val774 := Text(v777)
-		p.Attributes[_key773] = _val774
+		_val837 := Text(v840)
+		p.Attributes[_key836] = _val837
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -12984,10 +13252,10 @@ func (p *GetRowsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolE
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter779 := range p.Rows {
-			err = oprot.WriteBinary(Iter779)
+		for _, Iter842 := range p.Rows {
+			err = oprot.WriteBinary(Iter842)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter779", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter842", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -13012,14 +13280,14 @@ func (p *GetRowsArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolE
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter780, Viter781 := range p.Attributes {
-			err = oprot.WriteString(Kiter780)
+		for Kiter843, Viter844 := range p.Attributes {
+			err = oprot.WriteString(Kiter843)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter780", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter843", "", err)
 			}
-			err = oprot.WriteBinary(Viter781)
+			err = oprot.WriteBinary(Viter844)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter781", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter844", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -13148,19 +13416,19 @@ func (p *GetRowsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 }
 
 func (p *GetRowsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype787, _size784, err := iprot.ReadListBegin()
+	_etype850, _size847, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype787
-	p.Success = make([]*TRowResult, _size784, _size784)
-	for i := 0; i < _size784; i++ {
-		_elem789 := NewTRowResult()
-		err792 := _elem789.Read(iprot)
-		if err792 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem789TRowResult", err792)
+	_ = _etype850
+	p.Success = make([]*TRowResult, _size847, _size847)
+	for i := 0; i < _size847; i++ {
+		_elem852 := NewTRowResult()
+		err855 := _elem852.Read(iprot)
+		if err855 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem852TRowResult", err855)
 		}
-		p.Success[i] = _elem789
+		p.Success[i] = _elem852
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -13171,9 +13439,9 @@ func (p *GetRowsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocol
 
 func (p *GetRowsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err795 := p.Io.Read(iprot)
-	if err795 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err795)
+	err858 := p.Io.Read(iprot)
+	if err858 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err858)
 	}
 	return err
 }
@@ -13214,8 +13482,8 @@ func (p *GetRowsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter796 := range p.Success {
-			err = Iter796.Write(oprot)
+		for _, Iter859 := range p.Success {
+			err = Iter859.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -13391,28 +13659,28 @@ func (p *GetRowsWithColumnsArgs) Read(iprot thrift.TProtocol) (err thrift.TProto
 }
 
 func (p *GetRowsWithColumnsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v797, err798 := iprot.ReadBinary()
-	if err798 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err798)
+	v860, err861 := iprot.ReadBinary()
+	if err861 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err861)
 	}
-	p.TableName = Text(v797)
+	p.TableName = Text(v860)
 	return err
 }
 
 func (p *GetRowsWithColumnsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype804, _size801, err := iprot.ReadListBegin()
+	_etype867, _size864, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Rows", "", err)
 	}
-	_ = _etype804
-	p.Rows = make([]Text, _size801, _size801)
-	for i := 0; i < _size801; i++ {
-		v807, err808 := iprot.ReadBinary()
-		if err808 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem806", "", err808)
+	_ = _etype867
+	p.Rows = make([]Text, _size864, _size864)
+	for i := 0; i < _size864; i++ {
+		v870, err871 := iprot.ReadBinary()
+		if err871 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem869", "", err871)
 		}
-		_elem806 := Text(v807)
-		p.Rows[i] = _elem806
+		_elem869 := Text(v870)
+		p.Rows[i] = _elem869
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -13422,19 +13690,19 @@ func (p *GetRowsWithColumnsArgs) readField2(iprot thrift.TProtocol) (err thrift.
 }
 
 func (p *GetRowsWithColumnsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype814, _size811, err := iprot.ReadListBegin()
+	_etype877, _size874, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype814
-	p.Columns = make([]Text, _size811, _size811)
-	for i := 0; i < _size811; i++ {
-		v817, err818 := iprot.ReadBinary()
-		if err818 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem816", "", err818)
+	_ = _etype877
+	p.Columns = make([]Text, _size874, _size874)
+	for i := 0; i < _size874; i++ {
+		v880, err881 := iprot.ReadBinary()
+		if err881 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem879", "", err881)
 		}
-		_elem816 := Text(v817)
-		p.Columns[i] = _elem816
+		_elem879 := Text(v880)
+		p.Columns[i] = _elem879
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -13444,24 +13712,24 @@ func (p *GetRowsWithColumnsArgs) readField3(iprot thrift.TProtocol) (err thrift.
 }
 
 func (p *GetRowsWithColumnsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype822, _vtype823, _size821, err := iprot.ReadMapBegin()
+	_ktype885, _vtype886, _size884, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype822, _vtype823
-	p.Attributes = make(map[string]Text, _size821)
-	for i := 0; i < _size821; i++ {
-		v828, err829 := iprot.ReadString()
-		if err829 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key826", "", err829)
+	_, _ = _ktype885, _vtype886
+	p.Attributes = make(map[string]Text, _size884)
+	for i := 0; i < _size884; i++ {
+		v891, err892 := iprot.ReadString()
+		if err892 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key889", "", err892)
 		}
-		_key826 := v828
-		v830, err831 := iprot.ReadBinary()
-		if err831 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val827", "", err831)
+		_key889 := v891
+		v893, err894 := iprot.ReadBinary()
+		if err894 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val890", "", err894)
 		}
-		_val827 := Text(v830)
-		p.Attributes[_key826] = _val827
+		_val890 := Text(v893)
+		p.Attributes[_key889] = _val890
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -13530,10 +13798,10 @@ func (p *GetRowsWithColumnsArgs) writeField2(oprot thrift.TProtocol) (err thrift
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter832 := range p.Rows {
-			err = oprot.WriteBinary(Iter832)
+		for _, Iter895 := range p.Rows {
+			err = oprot.WriteBinary(Iter895)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter832", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter895", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -13558,10 +13826,10 @@ func (p *GetRowsWithColumnsArgs) writeField3(oprot thrift.TProtocol) (err thrift
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter833 := range p.Columns {
-			err = oprot.WriteBinary(Iter833)
+		for _, Iter896 := range p.Columns {
+			err = oprot.WriteBinary(Iter896)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter833", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter896", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -13586,14 +13854,14 @@ func (p *GetRowsWithColumnsArgs) writeField4(oprot thrift.TProtocol) (err thrift
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter834, Viter835 := range p.Attributes {
-			err = oprot.WriteString(Kiter834)
+		for Kiter897, Viter898 := range p.Attributes {
+			err = oprot.WriteString(Kiter897)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter834", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter897", "", err)
 			}
-			err = oprot.WriteBinary(Viter835)
+			err = oprot.WriteBinary(Viter898)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter835", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter898", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -13725,19 +13993,19 @@ func (p *GetRowsWithColumnsResult) Read(iprot thrift.TProtocol) (err thrift.TPro
 }
 
 func (p *GetRowsWithColumnsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype841, _size838, err := iprot.ReadListBegin()
+	_etype904, _size901, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype841
-	p.Success = make([]*TRowResult, _size838, _size838)
-	for i := 0; i < _size838; i++ {
-		_elem843 := NewTRowResult()
-		err846 := _elem843.Read(iprot)
-		if err846 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem843TRowResult", err846)
+	_ = _etype904
+	p.Success = make([]*TRowResult, _size901, _size901)
+	for i := 0; i < _size901; i++ {
+		_elem906 := NewTRowResult()
+		err909 := _elem906.Read(iprot)
+		if err909 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem906TRowResult", err909)
 		}
-		p.Success[i] = _elem843
+		p.Success[i] = _elem906
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -13748,9 +14016,9 @@ func (p *GetRowsWithColumnsResult) readField0(iprot thrift.TProtocol) (err thrif
 
 func (p *GetRowsWithColumnsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err849 := p.Io.Read(iprot)
-	if err849 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err849)
+	err912 := p.Io.Read(iprot)
+	if err912 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err912)
 	}
 	return err
 }
@@ -13791,8 +14059,8 @@ func (p *GetRowsWithColumnsResult) writeField0(oprot thrift.TProtocol) (err thri
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter850 := range p.Success {
-			err = Iter850.Write(oprot)
+		for _, Iter913 := range p.Success {
+			err = Iter913.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -13968,28 +14236,28 @@ func (p *GetRowsTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 }
 
 func (p *GetRowsTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v851, err852 := iprot.ReadBinary()
-	if err852 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err852)
+	v914, err915 := iprot.ReadBinary()
+	if err915 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err915)
 	}
-	p.TableName = Text(v851)
+	p.TableName = Text(v914)
 	return err
 }
 
 func (p *GetRowsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype858, _size855, err := iprot.ReadListBegin()
+	_etype921, _size918, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Rows", "", err)
 	}
-	_ = _etype858
-	p.Rows = make([]Text, _size855, _size855)
-	for i := 0; i < _size855; i++ {
-		v861, err862 := iprot.ReadBinary()
-		if err862 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem860", "", err862)
+	_ = _etype921
+	p.Rows = make([]Text, _size918, _size918)
+	for i := 0; i < _size918; i++ {
+		v924, err925 := iprot.ReadBinary()
+		if err925 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem923", "", err925)
 		}
-		_elem860 := Text(v861)
-		p.Rows[i] = _elem860
+		_elem923 := Text(v924)
+		p.Rows[i] = _elem923
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -13999,33 +14267,33 @@ func (p *GetRowsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *GetRowsTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v863, err864 := iprot.ReadI64()
-	if err864 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err864)
+	v926, err927 := iprot.ReadI64()
+	if err927 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err927)
 	}
-	p.Timestamp = v863
+	p.Timestamp = v926
 	return err
 }
 
 func (p *GetRowsTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype868, _vtype869, _size867, err := iprot.ReadMapBegin()
+	_ktype931, _vtype932, _size930, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype868, _vtype869
-	p.Attributes = make(map[string]Text, _size867)
-	for i := 0; i < _size867; i++ {
-		v874, err875 := iprot.ReadString()
-		if err875 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key872", "", err875)
+	_, _ = _ktype931, _vtype932
+	p.Attributes = make(map[string]Text, _size930)
+	for i := 0; i < _size930; i++ {
+		v937, err938 := iprot.ReadString()
+		if err938 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key935", "", err938)
 		}
-		_key872 := v874
-		v876, err877 := iprot.ReadBinary()
-		if err877 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val873", "", err877)
+		_key935 := v937
+		v939, err940 := iprot.ReadBinary()
+		if err940 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val936", "", err940)
 		}
-		_val873 := Text(v876)
-		p.Attributes[_key872] = _val873
+		_val936 := Text(v939)
+		p.Attributes[_key935] = _val936
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -14094,10 +14362,10 @@ func (p *GetRowsTsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter878 := range p.Rows {
-			err = oprot.WriteBinary(Iter878)
+		for _, Iter941 := range p.Rows {
+			err = oprot.WriteBinary(Iter941)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter878", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter941", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -14138,14 +14406,14 @@ func (p *GetRowsTsArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter879, Viter880 := range p.Attributes {
-			err = oprot.WriteString(Kiter879)
+		for Kiter942, Viter943 := range p.Attributes {
+			err = oprot.WriteString(Kiter942)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter879", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter942", "", err)
 			}
-			err = oprot.WriteBinary(Viter880)
+			err = oprot.WriteBinary(Viter943)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter880", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter943", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -14277,19 +14545,19 @@ func (p *GetRowsTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *GetRowsTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype886, _size883, err := iprot.ReadListBegin()
+	_etype949, _size946, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype886
-	p.Success = make([]*TRowResult, _size883, _size883)
-	for i := 0; i < _size883; i++ {
-		_elem888 := NewTRowResult()
-		err891 := _elem888.Read(iprot)
-		if err891 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem888TRowResult", err891)
+	_ = _etype949
+	p.Success = make([]*TRowResult, _size946, _size946)
+	for i := 0; i < _size946; i++ {
+		_elem951 := NewTRowResult()
+		err954 := _elem951.Read(iprot)
+		if err954 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem951TRowResult", err954)
 		}
-		p.Success[i] = _elem888
+		p.Success[i] = _elem951
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -14300,9 +14568,9 @@ func (p *GetRowsTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtoc
 
 func (p *GetRowsTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err894 := p.Io.Read(iprot)
-	if err894 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err894)
+	err957 := p.Io.Read(iprot)
+	if err957 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err957)
 	}
 	return err
 }
@@ -14343,8 +14611,8 @@ func (p *GetRowsTsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter895 := range p.Success {
-			err = Iter895.Write(oprot)
+		for _, Iter958 := range p.Success {
+			err = Iter958.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -14535,28 +14803,28 @@ func (p *GetRowsWithColumnsTsArgs) Read(iprot thrift.TProtocol) (err thrift.TPro
 }
 
 func (p *GetRowsWithColumnsTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v896, err897 := iprot.ReadBinary()
-	if err897 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err897)
+	v959, err960 := iprot.ReadBinary()
+	if err960 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err960)
 	}
-	p.TableName = Text(v896)
+	p.TableName = Text(v959)
 	return err
 }
 
 func (p *GetRowsWithColumnsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype903, _size900, err := iprot.ReadListBegin()
+	_etype966, _size963, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Rows", "", err)
 	}
-	_ = _etype903
-	p.Rows = make([]Text, _size900, _size900)
-	for i := 0; i < _size900; i++ {
-		v906, err907 := iprot.ReadBinary()
-		if err907 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem905", "", err907)
+	_ = _etype966
+	p.Rows = make([]Text, _size963, _size963)
+	for i := 0; i < _size963; i++ {
+		v969, err970 := iprot.ReadBinary()
+		if err970 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem968", "", err970)
 		}
-		_elem905 := Text(v906)
-		p.Rows[i] = _elem905
+		_elem968 := Text(v969)
+		p.Rows[i] = _elem968
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -14566,19 +14834,19 @@ func (p *GetRowsWithColumnsTsArgs) readField2(iprot thrift.TProtocol) (err thrif
 }
 
 func (p *GetRowsWithColumnsTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype913, _size910, err := iprot.ReadListBegin()
+	_etype976, _size973, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype913
-	p.Columns = make([]Text, _size910, _size910)
-	for i := 0; i < _size910; i++ {
-		v916, err917 := iprot.ReadBinary()
-		if err917 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem915", "", err917)
+	_ = _etype976
+	p.Columns = make([]Text, _size973, _size973)
+	for i := 0; i < _size973; i++ {
+		v979, err980 := iprot.ReadBinary()
+		if err980 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem978", "", err980)
 		}
-		_elem915 := Text(v916)
-		p.Columns[i] = _elem915
+		_elem978 := Text(v979)
+		p.Columns[i] = _elem978
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -14588,33 +14856,33 @@ func (p *GetRowsWithColumnsTsArgs) readField3(iprot thrift.TProtocol) (err thrif
 }
 
 func (p *GetRowsWithColumnsTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v918, err919 := iprot.ReadI64()
-	if err919 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err919)
+	v981, err982 := iprot.ReadI64()
+	if err982 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err982)
 	}
-	p.Timestamp = v918
+	p.Timestamp = v981
 	return err
 }
 
 func (p *GetRowsWithColumnsTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype923, _vtype924, _size922, err := iprot.ReadMapBegin()
+	_ktype986, _vtype987, _size985, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype923, _vtype924
-	p.Attributes = make(map[string]Text, _size922)
-	for i := 0; i < _size922; i++ {
-		v929, err930 := iprot.ReadString()
-		if err930 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key927", "", err930)
+	_, _ = _ktype986, _vtype987
+	p.Attributes = make(map[string]Text, _size985)
+	for i := 0; i < _size985; i++ {
+		v992, err993 := iprot.ReadString()
+		if err993 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key990", "", err993)
 		}
-		_key927 := v929
-		v931, err932 := iprot.ReadBinary()
-		if err932 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val928", "", err932)
+		_key990 := v992
+		v994, err995 := iprot.ReadBinary()
+		if err995 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val991", "", err995)
 		}
-		_val928 := Text(v931)
-		p.Attributes[_key927] = _val928
+		_val991 := Text(v994)
+		p.Attributes[_key990] = _val991
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -14687,10 +14955,10 @@ func (p *GetRowsWithColumnsTsArgs) writeField2(oprot thrift.TProtocol) (err thri
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter933 := range p.Rows {
-			err = oprot.WriteBinary(Iter933)
+		for _, Iter996 := range p.Rows {
+			err = oprot.WriteBinary(Iter996)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter933", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter996", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -14715,10 +14983,10 @@ func (p *GetRowsWithColumnsTsArgs) writeField3(oprot thrift.TProtocol) (err thri
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter934 := range p.Columns {
-			err = oprot.WriteBinary(Iter934)
+		for _, Iter997 := range p.Columns {
+			err = oprot.WriteBinary(Iter997)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter934", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter997", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -14759,14 +15027,14 @@ func (p *GetRowsWithColumnsTsArgs) writeField5(oprot thrift.TProtocol) (err thri
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter935, Viter936 := range p.Attributes {
-			err = oprot.WriteString(Kiter935)
+		for Kiter998, Viter999 := range p.Attributes {
+			err = oprot.WriteString(Kiter998)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter935", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter998", "", err)
 			}
-			err = oprot.WriteBinary(Viter936)
+			err = oprot.WriteBinary(Viter999)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter936", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter999", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -14903,19 +15171,19 @@ func (p *GetRowsWithColumnsTsResult) Read(iprot thrift.TProtocol) (err thrift.TP
 }
 
 func (p *GetRowsWithColumnsTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype942, _size939, err := iprot.ReadListBegin()
+	_etype1005, _size1002, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype942
-	p.Success = make([]*TRowResult, _size939, _size939)
-	for i := 0; i < _size939; i++ {
-		_elem944 := NewTRowResult()
-		err947 := _elem944.Read(iprot)
-		if err947 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem944TRowResult", err947)
+	_ = _etype1005
+	p.Success = make([]*TRowResult, _size1002, _size1002)
+	for i := 0; i < _size1002; i++ {
+		_elem1007 := NewTRowResult()
+		err1010 := _elem1007.Read(iprot)
+		if err1010 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1007TRowResult", err1010)
 		}
-		p.Success[i] = _elem944
+		p.Success[i] = _elem1007
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -14926,9 +15194,9 @@ func (p *GetRowsWithColumnsTsResult) readField0(iprot thrift.TProtocol) (err thr
 
 func (p *GetRowsWithColumnsTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err950 := p.Io.Read(iprot)
-	if err950 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err950)
+	err1013 := p.Io.Read(iprot)
+	if err1013 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1013)
 	}
 	return err
 }
@@ -14969,8 +15237,8 @@ func (p *GetRowsWithColumnsTsResult) writeField0(oprot thrift.TProtocol) (err th
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter951 := range p.Success {
-			err = Iter951.Write(oprot)
+		for _, Iter1014 := range p.Success {
+			err = Iter1014.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -15146,37 +15414,37 @@ func (p *MutateRowArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 }
 
 func (p *MutateRowArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v952, err953 := iprot.ReadBinary()
-	if err953 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err953)
+	v1015, err1016 := iprot.ReadBinary()
+	if err1016 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1016)
 	}
-	p.TableName = Text(v952)
+	p.TableName = Text(v1015)
 	return err
 }
 
 func (p *MutateRowArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v954, err955 := iprot.ReadBinary()
-	if err955 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err955)
+	v1017, err1018 := iprot.ReadBinary()
+	if err1018 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1018)
 	}
-	p.Row = Text(v954)
+	p.Row = Text(v1017)
 	return err
 }
 
 func (p *MutateRowArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype961, _size958, err := iprot.ReadListBegin()
+	_etype1024, _size1021, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Mutations", "", err)
 	}
-	_ = _etype961
-	p.Mutations = make([]*Mutation, _size958, _size958)
-	for i := 0; i < _size958; i++ {
-		_elem963 := NewMutation()
-		err966 := _elem963.Read(iprot)
-		if err966 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem963Mutation", err966)
+	_ = _etype1024
+	p.Mutations = make([]*Mutation, _size1021, _size1021)
+	for i := 0; i < _size1021; i++ {
+		_elem1026 := NewMutation()
+		err1029 := _elem1026.Read(iprot)
+		if err1029 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1026Mutation", err1029)
 		}
-		p.Mutations[i] = _elem963
+		p.Mutations[i] = _elem1026
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -15186,24 +15454,24 @@ func (p *MutateRowArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *MutateRowArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype970, _vtype971, _size969, err := iprot.ReadMapBegin()
+	_ktype1033, _vtype1034, _size1032, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype970, _vtype971
-	p.Attributes = make(map[string]Text, _size969)
-	for i := 0; i < _size969; i++ {
-		v976, err977 := iprot.ReadString()
-		if err977 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key974", "", err977)
+	_, _ = _ktype1033, _vtype1034
+	p.Attributes = make(map[string]Text, _size1032)
+	for i := 0; i < _size1032; i++ {
+		v1039, err1040 := iprot.ReadString()
+		if err1040 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1037", "", err1040)
 		}
-		_key974 := v976
-		v978, err979 := iprot.ReadBinary()
-		if err979 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val975", "", err979)
+		_key1037 := v1039
+		v1041, err1042 := iprot.ReadBinary()
+		if err1042 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1038", "", err1042)
 		}
-		_val975 := Text(v978)
-		p.Attributes[_key974] = _val975
+		_val1038 := Text(v1041)
+		p.Attributes[_key1037] = _val1038
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -15290,8 +15558,8 @@ func (p *MutateRowArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter980 := range p.Mutations {
-			err = Iter980.Write(oprot)
+		for _, Iter1043 := range p.Mutations {
+			err = Iter1043.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("Mutation", err)
 			}
@@ -15318,14 +15586,14 @@ func (p *MutateRowArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter981, Viter982 := range p.Attributes {
-			err = oprot.WriteString(Kiter981)
+		for Kiter1044, Viter1045 := range p.Attributes {
+			err = oprot.WriteString(Kiter1044)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter981", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1044", "", err)
 			}
-			err = oprot.WriteBinary(Viter982)
+			err = oprot.WriteBinary(Viter1045)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter982", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1045", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -15458,18 +15726,18 @@ func (p *MutateRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 
 func (p *MutateRowResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err985 := p.Io.Read(iprot)
-	if err985 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err985)
+	err1048 := p.Io.Read(iprot)
+	if err1048 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1048)
 	}
 	return err
 }
 
 func (p *MutateRowResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err988 := p.Ia.Read(iprot)
-	if err988 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err988)
+	err1051 := p.Ia.Read(iprot)
+	if err1051 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1051)
 	}
 	return err
 }
@@ -15692,37 +15960,37 @@ func (p *MutateRowTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *MutateRowTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v989, err990 := iprot.ReadBinary()
-	if err990 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err990)
+	v1052, err1053 := iprot.ReadBinary()
+	if err1053 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1053)
 	}
-	p.TableName = Text(v989)
+	p.TableName = Text(v1052)
 	return err
 }
 
 func (p *MutateRowTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v991, err992 := iprot.ReadBinary()
-	if err992 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err992)
+	v1054, err1055 := iprot.ReadBinary()
+	if err1055 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1055)
 	}
-	p.Row = Text(v991)
+	p.Row = Text(v1054)
 	return err
 }
 
 func (p *MutateRowTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype998, _size995, err := iprot.ReadListBegin()
+	_etype1061, _size1058, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Mutations", "", err)
 	}
-	_ = _etype998
-	p.Mutations = make([]*Mutation, _size995, _size995)
-	for i := 0; i < _size995; i++ {
-		_elem1000 := NewMutation()
-		err1003 := _elem1000.Read(iprot)
-		if err1003 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1000Mutation", err1003)
+	_ = _etype1061
+	p.Mutations = make([]*Mutation, _size1058, _size1058)
+	for i := 0; i < _size1058; i++ {
+		_elem1063 := NewMutation()
+		err1066 := _elem1063.Read(iprot)
+		if err1066 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1063Mutation", err1066)
 		}
-		p.Mutations[i] = _elem1000
+		p.Mutations[i] = _elem1063
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -15732,33 +16000,33 @@ func (p *MutateRowTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtoc
 }
 
 func (p *MutateRowTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1004, err1005 := iprot.ReadI64()
-	if err1005 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1005)
+	v1067, err1068 := iprot.ReadI64()
+	if err1068 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1068)
 	}
-	p.Timestamp = v1004
+	p.Timestamp = v1067
 	return err
 }
 
 func (p *MutateRowTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1009, _vtype1010, _size1008, err := iprot.ReadMapBegin()
+	_ktype1072, _vtype1073, _size1071, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1009, _vtype1010
-	p.Attributes = make(map[string]Text, _size1008)
-	for i := 0; i < _size1008; i++ {
-		v1015, err1016 := iprot.ReadString()
-		if err1016 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1013", "", err1016)
+	_, _ = _ktype1072, _vtype1073
+	p.Attributes = make(map[string]Text, _size1071)
+	for i := 0; i < _size1071; i++ {
+		v1078, err1079 := iprot.ReadString()
+		if err1079 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1076", "", err1079)
 		}
-		_key1013 := v1015
-		v1017, err1018 := iprot.ReadBinary()
-		if err1018 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1014", "", err1018)
+		_key1076 := v1078
+		v1080, err1081 := iprot.ReadBinary()
+		if err1081 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1077", "", err1081)
 		}
-		_val1014 := Text(v1017)
-		p.Attributes[_key1013] = _val1014
+		_val1077 := Text(v1080)
+		p.Attributes[_key1076] = _val1077
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -15849,8 +16117,8 @@ func (p *MutateRowTsArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1019 := range p.Mutations {
-			err = Iter1019.Write(oprot)
+		for _, Iter1082 := range p.Mutations {
+			err = Iter1082.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("Mutation", err)
 			}
@@ -15893,14 +16161,14 @@ func (p *MutateRowTsArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1020, Viter1021 := range p.Attributes {
-			err = oprot.WriteString(Kiter1020)
+		for Kiter1083, Viter1084 := range p.Attributes {
+			err = oprot.WriteString(Kiter1083)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1020", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1083", "", err)
 			}
-			err = oprot.WriteBinary(Viter1021)
+			err = oprot.WriteBinary(Viter1084)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1021", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1084", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -16036,18 +16304,18 @@ func (p *MutateRowTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *MutateRowTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1024 := p.Io.Read(iprot)
-	if err1024 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1024)
+	err1087 := p.Io.Read(iprot)
+	if err1087 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1087)
 	}
 	return err
 }
 
 func (p *MutateRowTsResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1027 := p.Ia.Read(iprot)
-	if err1027 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1027)
+	err1090 := p.Ia.Read(iprot)
+	if err1090 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1090)
 	}
 	return err
 }
@@ -16240,28 +16508,28 @@ func (p *MutateRowsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcep
 }
 
 func (p *MutateRowsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1028, err1029 := iprot.ReadBinary()
-	if err1029 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1029)
+	v1091, err1092 := iprot.ReadBinary()
+	if err1092 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1092)
 	}
-	p.TableName = Text(v1028)
+	p.TableName = Text(v1091)
 	return err
 }
 
 func (p *MutateRowsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1035, _size1032, err := iprot.ReadListBegin()
+	_etype1098, _size1095, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.RowBatches", "", err)
 	}
-	_ = _etype1035
-	p.RowBatches = make([]*BatchMutation, _size1032, _size1032)
-	for i := 0; i < _size1032; i++ {
-		_elem1037 := NewBatchMutation()
-		err1040 := _elem1037.Read(iprot)
-		if err1040 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1037BatchMutation", err1040)
+	_ = _etype1098
+	p.RowBatches = make([]*BatchMutation, _size1095, _size1095)
+	for i := 0; i < _size1095; i++ {
+		_elem1100 := NewBatchMutation()
+		err1103 := _elem1100.Read(iprot)
+		if err1103 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1100BatchMutation", err1103)
 		}
-		p.RowBatches[i] = _elem1037
+		p.RowBatches[i] = _elem1100
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -16271,24 +16539,24 @@ func (p *MutateRowsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtoco
 }
 
 func (p *MutateRowsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1044, _vtype1045, _size1043, err := iprot.ReadMapBegin()
+	_ktype1107, _vtype1108, _size1106, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1044, _vtype1045
-	p.Attributes = make(map[string]Text, _size1043)
-	for i := 0; i < _size1043; i++ {
-		v1050, err1051 := iprot.ReadString()
-		if err1051 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1048", "", err1051)
+	_, _ = _ktype1107, _vtype1108
+	p.Attributes = make(map[string]Text, _size1106)
+	for i := 0; i < _size1106; i++ {
+		v1113, err1114 := iprot.ReadString()
+		if err1114 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1111", "", err1114)
 		}
-		_key1048 := v1050
-		v1052, err1053 := iprot.ReadBinary()
-		if err1053 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1049", "", err1053)
+		_key1111 := v1113
+		v1115, err1116 := iprot.ReadBinary()
+		if err1116 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1112", "", err1116)
 		}
-		_val1049 := Text(v1052)
-		p.Attributes[_key1048] = _val1049
+		_val1112 := Text(v1115)
+		p.Attributes[_key1111] = _val1112
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -16353,8 +16621,8 @@ func (p *MutateRowsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtoc
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1054 := range p.RowBatches {
-			err = Iter1054.Write(oprot)
+		for _, Iter1117 := range p.RowBatches {
+			err = Iter1117.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("BatchMutation", err)
 			}
@@ -16381,14 +16649,14 @@ func (p *MutateRowsArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtoc
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1055, Viter1056 := range p.Attributes {
-			err = oprot.WriteString(Kiter1055)
+		for Kiter1118, Viter1119 := range p.Attributes {
+			err = oprot.WriteString(Kiter1118)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1055", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1118", "", err)
 			}
-			err = oprot.WriteBinary(Viter1056)
+			err = oprot.WriteBinary(Viter1119)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1056", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1119", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -16518,18 +16786,18 @@ func (p *MutateRowsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 
 func (p *MutateRowsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1059 := p.Io.Read(iprot)
-	if err1059 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1059)
+	err1122 := p.Io.Read(iprot)
+	if err1122 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1122)
 	}
 	return err
 }
 
 func (p *MutateRowsResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1062 := p.Ia.Read(iprot)
-	if err1062 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1062)
+	err1125 := p.Ia.Read(iprot)
+	if err1125 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1125)
 	}
 	return err
 }
@@ -16737,28 +17005,28 @@ func (p *MutateRowsTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *MutateRowsTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1063, err1064 := iprot.ReadBinary()
-	if err1064 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1064)
+	v1126, err1127 := iprot.ReadBinary()
+	if err1127 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1127)
 	}
-	p.TableName = Text(v1063)
+	p.TableName = Text(v1126)
 	return err
 }
 
 func (p *MutateRowsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1070, _size1067, err := iprot.ReadListBegin()
+	_etype1133, _size1130, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.RowBatches", "", err)
 	}
-	_ = _etype1070
-	p.RowBatches = make([]*BatchMutation, _size1067, _size1067)
-	for i := 0; i < _size1067; i++ {
-		_elem1072 := NewBatchMutation()
-		err1075 := _elem1072.Read(iprot)
-		if err1075 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1072BatchMutation", err1075)
+	_ = _etype1133
+	p.RowBatches = make([]*BatchMutation, _size1130, _size1130)
+	for i := 0; i < _size1130; i++ {
+		_elem1135 := NewBatchMutation()
+		err1138 := _elem1135.Read(iprot)
+		if err1138 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1135BatchMutation", err1138)
 		}
-		p.RowBatches[i] = _elem1072
+		p.RowBatches[i] = _elem1135
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -16768,33 +17036,33 @@ func (p *MutateRowsTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProto
 }
 
 func (p *MutateRowsTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1076, err1077 := iprot.ReadI64()
-	if err1077 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err1077)
+	v1139, err1140 := iprot.ReadI64()
+	if err1140 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err1140)
 	}
-	p.Timestamp = v1076
+	p.Timestamp = v1139
 	return err
 }
 
 func (p *MutateRowsTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1081, _vtype1082, _size1080, err := iprot.ReadMapBegin()
+	_ktype1144, _vtype1145, _size1143, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1081, _vtype1082
-	p.Attributes = make(map[string]Text, _size1080)
-	for i := 0; i < _size1080; i++ {
-		v1087, err1088 := iprot.ReadString()
-		if err1088 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1085", "", err1088)
+	_, _ = _ktype1144, _vtype1145
+	p.Attributes = make(map[string]Text, _size1143)
+	for i := 0; i < _size1143; i++ {
+		v1150, err1151 := iprot.ReadString()
+		if err1151 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1148", "", err1151)
 		}
-		_key1085 := v1087
-		v1089, err1090 := iprot.ReadBinary()
-		if err1090 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1086", "", err1090)
+		_key1148 := v1150
+		v1152, err1153 := iprot.ReadBinary()
+		if err1153 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1149", "", err1153)
 		}
-		_val1086 := Text(v1089)
-		p.Attributes[_key1085] = _val1086
+		_val1149 := Text(v1152)
+		p.Attributes[_key1148] = _val1149
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -16863,8 +17131,8 @@ func (p *MutateRowsTsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProt
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1091 := range p.RowBatches {
-			err = Iter1091.Write(oprot)
+		for _, Iter1154 := range p.RowBatches {
+			err = Iter1154.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("BatchMutation", err)
 			}
@@ -16907,14 +17175,14 @@ func (p *MutateRowsTsArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProt
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1092, Viter1093 := range p.Attributes {
-			err = oprot.WriteString(Kiter1092)
+		for Kiter1155, Viter1156 := range p.Attributes {
+			err = oprot.WriteString(Kiter1155)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1092", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1155", "", err)
 			}
-			err = oprot.WriteBinary(Viter1093)
+			err = oprot.WriteBinary(Viter1156)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1093", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1156", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -17047,18 +17315,18 @@ func (p *MutateRowsTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *MutateRowsTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1096 := p.Io.Read(iprot)
-	if err1096 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1096)
+	err1159 := p.Io.Read(iprot)
+	if err1159 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1159)
 	}
 	return err
 }
 
 func (p *MutateRowsTsResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1099 := p.Ia.Read(iprot)
-	if err1099 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1099)
+	err1162 := p.Ia.Read(iprot)
+	if err1162 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1162)
 	}
 	return err
 }
@@ -17266,38 +17534,38 @@ func (p *AtomicIncrementArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *AtomicIncrementArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1100, err1101 := iprot.ReadBinary()
-	if err1101 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1101)
+	v1163, err1164 := iprot.ReadBinary()
+	if err1164 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1164)
 	}
-	p.TableName = Text(v1100)
+	p.TableName = Text(v1163)
 	return err
 }
 
 func (p *AtomicIncrementArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1102, err1103 := iprot.ReadBinary()
-	if err1103 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1103)
+	v1165, err1166 := iprot.ReadBinary()
+	if err1166 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1166)
 	}
-	p.Row = Text(v1102)
+	p.Row = Text(v1165)
 	return err
 }
 
 func (p *AtomicIncrementArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1104, err1105 := iprot.ReadBinary()
-	if err1105 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1105)
+	v1167, err1168 := iprot.ReadBinary()
+	if err1168 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1168)
 	}
-	p.Column = Text(v1104)
+	p.Column = Text(v1167)
 	return err
 }
 
 func (p *AtomicIncrementArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1106, err1107 := iprot.ReadI64()
-	if err1107 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "value", p.ThriftName(), err1107)
+	v1169, err1170 := iprot.ReadI64()
+	if err1170 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "value", p.ThriftName(), err1170)
 	}
-	p.Value = v1106
+	p.Value = v1169
 	return err
 }
 
@@ -17535,28 +17803,28 @@ func (p *AtomicIncrementResult) Read(iprot thrift.TProtocol) (err thrift.TProtoc
 }
 
 func (p *AtomicIncrementResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1108, err1109 := iprot.ReadI64()
-	if err1109 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1109)
+	v1171, err1172 := iprot.ReadI64()
+	if err1172 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1172)
 	}
-	p.Success = v1108
+	p.Success = v1171
 	return err
 }
 
 func (p *AtomicIncrementResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1112 := p.Io.Read(iprot)
-	if err1112 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1112)
+	err1175 := p.Io.Read(iprot)
+	if err1175 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1175)
 	}
 	return err
 }
 
 func (p *AtomicIncrementResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1115 := p.Ia.Read(iprot)
-	if err1115 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1115)
+	err1178 := p.Ia.Read(iprot)
+	if err1178 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1178)
 	}
 	return err
 }
@@ -17787,51 +18055,51 @@ func (p *DeleteAllArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 }
 
 func (p *DeleteAllArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1116, err1117 := iprot.ReadBinary()
-	if err1117 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1117)
+	v1179, err1180 := iprot.ReadBinary()
+	if err1180 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1180)
 	}
-	p.TableName = Text(v1116)
+	p.TableName = Text(v1179)
 	return err
 }
 
 func (p *DeleteAllArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1118, err1119 := iprot.ReadBinary()
-	if err1119 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1119)
+	v1181, err1182 := iprot.ReadBinary()
+	if err1182 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1182)
 	}
-	p.Row = Text(v1118)
+	p.Row = Text(v1181)
 	return err
 }
 
 func (p *DeleteAllArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1120, err1121 := iprot.ReadBinary()
-	if err1121 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1121)
+	v1183, err1184 := iprot.ReadBinary()
+	if err1184 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1184)
 	}
-	p.Column = Text(v1120)
+	p.Column = Text(v1183)
 	return err
 }
 
 func (p *DeleteAllArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1125, _vtype1126, _size1124, err := iprot.ReadMapBegin()
+	_ktype1188, _vtype1189, _size1187, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1125, _vtype1126
-	p.Attributes = make(map[string]Text, _size1124)
-	for i := 0; i < _size1124; i++ {
-		v1131, err1132 := iprot.ReadString()
-		if err1132 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1129", "", err1132)
+	_, _ = _ktype1188, _vtype1189
+	p.Attributes = make(map[string]Text, _size1187)
+	for i := 0; i < _size1187; i++ {
+		v1194, err1195 := iprot.ReadString()
+		if err1195 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1192", "", err1195)
 		}
-		_key1129 := v1131
-		v1133, err1134 := iprot.ReadBinary()
-		if err1134 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1130", "", err1134)
+		_key1192 := v1194
+		v1196, err1197 := iprot.ReadBinary()
+		if err1197 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1193", "", err1197)
 		}
-		_val1130 := Text(v1133)
-		p.Attributes[_key1129] = _val1130
+		_val1193 := Text(v1196)
+		p.Attributes[_key1192] = _val1193
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -17936,14 +18204,14 @@ func (p *DeleteAllArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtoco
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1135, Viter1136 := range p.Attributes {
-			err = oprot.WriteString(Kiter1135)
+		for Kiter1198, Viter1199 := range p.Attributes {
+			err = oprot.WriteString(Kiter1198)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1135", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1198", "", err)
 			}
-			err = oprot.WriteBinary(Viter1136)
+			err = oprot.WriteBinary(Viter1199)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1136", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1199", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -18061,9 +18329,9 @@ func (p *DeleteAllResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 
 func (p *DeleteAllResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1139 := p.Io.Read(iprot)
-	if err1139 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1139)
+	err1202 := p.Io.Read(iprot)
+	if err1202 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1202)
 	}
 	return err
 }
@@ -18261,60 +18529,60 @@ func (p *DeleteAllTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *DeleteAllTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1140, err1141 := iprot.ReadBinary()
-	if err1141 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1141)
+	v1203, err1204 := iprot.ReadBinary()
+	if err1204 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1204)
 	}
-	p.TableName = Text(v1140)
+	p.TableName = Text(v1203)
 	return err
 }
 
 func (p *DeleteAllTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1142, err1143 := iprot.ReadBinary()
-	if err1143 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1143)
+	v1205, err1206 := iprot.ReadBinary()
+	if err1206 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1206)
 	}
-	p.Row = Text(v1142)
+	p.Row = Text(v1205)
 	return err
 }
 
 func (p *DeleteAllTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1144, err1145 := iprot.ReadBinary()
-	if err1145 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1145)
+	v1207, err1208 := iprot.ReadBinary()
+	if err1208 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1208)
 	}
-	p.Column = Text(v1144)
+	p.Column = Text(v1207)
 	return err
 }
 
 func (p *DeleteAllTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1146, err1147 := iprot.ReadI64()
-	if err1147 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1147)
+	v1209, err1210 := iprot.ReadI64()
+	if err1210 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1210)
 	}
-	p.Timestamp = v1146
+	p.Timestamp = v1209
 	return err
 }
 
 func (p *DeleteAllTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1151, _vtype1152, _size1150, err := iprot.ReadMapBegin()
+	_ktype1214, _vtype1215, _size1213, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1151, _vtype1152
-	p.Attributes = make(map[string]Text, _size1150)
-	for i := 0; i < _size1150; i++ {
-		v1157, err1158 := iprot.ReadString()
-		if err1158 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1155", "", err1158)
+	_, _ = _ktype1214, _vtype1215
+	p.Attributes = make(map[string]Text, _size1213)
+	for i := 0; i < _size1213; i++ {
+		v1220, err1221 := iprot.ReadString()
+		if err1221 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1218", "", err1221)
 		}
-		_key1155 := v1157
-		v1159, err1160 := iprot.ReadBinary()
-		if err1160 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1156", "", err1160)
+		_key1218 := v1220
+		v1222, err1223 := iprot.ReadBinary()
+		if err1223 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1219", "", err1223)
 		}
-		_val1156 := Text(v1159)
-		p.Attributes[_key1155] = _val1156
+		_val1219 := Text(v1222)
+		p.Attributes[_key1218] = _val1219
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -18439,14 +18707,14 @@ func (p *DeleteAllTsArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1161, Viter1162 := range p.Attributes {
-			err = oprot.WriteString(Kiter1161)
+		for Kiter1224, Viter1225 := range p.Attributes {
+			err = oprot.WriteString(Kiter1224)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1161", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1224", "", err)
 			}
-			err = oprot.WriteBinary(Viter1162)
+			err = oprot.WriteBinary(Viter1225)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1162", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1225", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -18567,9 +18835,9 @@ func (p *DeleteAllTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *DeleteAllTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1165 := p.Io.Read(iprot)
-	if err1165 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1165)
+	err1228 := p.Io.Read(iprot)
+	if err1228 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1228)
 	}
 	return err
 }
@@ -18737,42 +19005,42 @@ func (p *DeleteAllRowArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *DeleteAllRowArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1166, err1167 := iprot.ReadBinary()
-	if err1167 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1167)
+	v1229, err1230 := iprot.ReadBinary()
+	if err1230 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1230)
 	}
-	p.TableName = Text(v1166)
+	p.TableName = Text(v1229)
 	return err
 }
 
 func (p *DeleteAllRowArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1168, err1169 := iprot.ReadBinary()
-	if err1169 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1169)
+	v1231, err1232 := iprot.ReadBinary()
+	if err1232 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1232)
 	}
-	p.Row = Text(v1168)
+	p.Row = Text(v1231)
 	return err
 }
 
 func (p *DeleteAllRowArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1173, _vtype1174, _size1172, err := iprot.ReadMapBegin()
+	_ktype1236, _vtype1237, _size1235, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1173, _vtype1174
-	p.Attributes = make(map[string]Text, _size1172)
-	for i := 0; i < _size1172; i++ {
-		v1179, err1180 := iprot.ReadString()
-		if err1180 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1177", "", err1180)
+	_, _ = _ktype1236, _vtype1237
+	p.Attributes = make(map[string]Text, _size1235)
+	for i := 0; i < _size1235; i++ {
+		v1242, err1243 := iprot.ReadString()
+		if err1243 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1240", "", err1243)
 		}
-		_key1177 := v1179
-		v1181, err1182 := iprot.ReadBinary()
-		if err1182 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1178", "", err1182)
+		_key1240 := v1242
+		v1244, err1245 := iprot.ReadBinary()
+		if err1245 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1241", "", err1245)
 		}
-		_val1178 := Text(v1181)
-		p.Attributes[_key1177] = _val1178
+		_val1241 := Text(v1244)
+		p.Attributes[_key1240] = _val1241
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -18855,14 +19123,14 @@ func (p *DeleteAllRowArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProt
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1183, Viter1184 := range p.Attributes {
-			err = oprot.WriteString(Kiter1183)
+		for Kiter1246, Viter1247 := range p.Attributes {
+			err = oprot.WriteString(Kiter1246)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1183", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1246", "", err)
 			}
-			err = oprot.WriteBinary(Viter1184)
+			err = oprot.WriteBinary(Viter1247)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1184", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1247", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -18977,9 +19245,9 @@ func (p *DeleteAllRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *DeleteAllRowResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1187 := p.Io.Read(iprot)
-	if err1187 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1187)
+	err1250 := p.Io.Read(iprot)
+	if err1250 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1250)
 	}
 	return err
 }
@@ -19118,9 +19386,9 @@ func (p *IncrementArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 
 func (p *IncrementArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Increment = NewTIncrement()
-	err1190 := p.Increment.Read(iprot)
-	if err1190 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IncrementTIncrement", err1190)
+	err1253 := p.Increment.Read(iprot)
+	if err1253 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IncrementTIncrement", err1253)
 	}
 	return err
 }
@@ -19257,9 +19525,9 @@ func (p *IncrementResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 
 func (p *IncrementResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1193 := p.Io.Read(iprot)
-	if err1193 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1193)
+	err1256 := p.Io.Read(iprot)
+	if err1256 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1256)
 	}
 	return err
 }
@@ -19397,19 +19665,19 @@ func (p *IncrementRowsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 }
 
 func (p *IncrementRowsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1199, _size1196, err := iprot.ReadListBegin()
+	_etype1262, _size1259, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Increments", "", err)
 	}
-	_ = _etype1199
-	p.Increments = make([]*TIncrement, _size1196, _size1196)
-	for i := 0; i < _size1196; i++ {
-		_elem1201 := NewTIncrement()
-		err1204 := _elem1201.Read(iprot)
-		if err1204 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1201TIncrement", err1204)
+	_ = _etype1262
+	p.Increments = make([]*TIncrement, _size1259, _size1259)
+	for i := 0; i < _size1259; i++ {
+		_elem1264 := NewTIncrement()
+		err1267 := _elem1264.Read(iprot)
+		if err1267 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1264TIncrement", err1267)
 		}
-		p.Increments[i] = _elem1201
+		p.Increments[i] = _elem1264
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -19448,8 +19716,8 @@ func (p *IncrementRowsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TPro
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1205 := range p.Increments {
-			err = Iter1205.Write(oprot)
+		for _, Iter1268 := range p.Increments {
+			err = Iter1268.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TIncrement", err)
 			}
@@ -19560,9 +19828,9 @@ func (p *IncrementRowsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 
 func (p *IncrementRowsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1208 := p.Io.Read(iprot)
-	if err1208 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1208)
+	err1271 := p.Io.Read(iprot)
+	if err1271 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1271)
 	}
 	return err
 }
@@ -19745,51 +20013,51 @@ func (p *DeleteAllRowTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 }
 
 func (p *DeleteAllRowTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1209, err1210 := iprot.ReadBinary()
-	if err1210 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1210)
+	v1272, err1273 := iprot.ReadBinary()
+	if err1273 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1273)
 	}
-	p.TableName = Text(v1209)
+	p.TableName = Text(v1272)
 	return err
 }
 
 func (p *DeleteAllRowTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1211, err1212 := iprot.ReadBinary()
-	if err1212 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1212)
+	v1274, err1275 := iprot.ReadBinary()
+	if err1275 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1275)
 	}
-	p.Row = Text(v1211)
+	p.Row = Text(v1274)
 	return err
 }
 
 func (p *DeleteAllRowTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1213, err1214 := iprot.ReadI64()
-	if err1214 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err1214)
+	v1276, err1277 := iprot.ReadI64()
+	if err1277 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err1277)
 	}
-	p.Timestamp = v1213
+	p.Timestamp = v1276
 	return err
 }
 
 func (p *DeleteAllRowTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1218, _vtype1219, _size1217, err := iprot.ReadMapBegin()
+	_ktype1281, _vtype1282, _size1280, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1218, _vtype1219
-	p.Attributes = make(map[string]Text, _size1217)
-	for i := 0; i < _size1217; i++ {
-		v1224, err1225 := iprot.ReadString()
-		if err1225 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1222", "", err1225)
+	_, _ = _ktype1281, _vtype1282
+	p.Attributes = make(map[string]Text, _size1280)
+	for i := 0; i < _size1280; i++ {
+		v1287, err1288 := iprot.ReadString()
+		if err1288 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1285", "", err1288)
 		}
-		_key1222 := v1224
-		v1226, err1227 := iprot.ReadBinary()
-		if err1227 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1223", "", err1227)
+		_key1285 := v1287
+		v1289, err1290 := iprot.ReadBinary()
+		if err1290 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1286", "", err1290)
 		}
-		_val1223 := Text(v1226)
-		p.Attributes[_key1222] = _val1223
+		_val1286 := Text(v1289)
+		p.Attributes[_key1285] = _val1286
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -19892,14 +20160,14 @@ func (p *DeleteAllRowTsArgs) writeField4(oprot thrift.TProtocol) (err thrift.TPr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1228, Viter1229 := range p.Attributes {
-			err = oprot.WriteString(Kiter1228)
+		for Kiter1291, Viter1292 := range p.Attributes {
+			err = oprot.WriteString(Kiter1291)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1228", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1291", "", err)
 			}
-			err = oprot.WriteBinary(Viter1229)
+			err = oprot.WriteBinary(Viter1292)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1229", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1292", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -20017,9 +20285,9 @@ func (p *DeleteAllRowTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtoco
 
 func (p *DeleteAllRowTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1232 := p.Io.Read(iprot)
-	if err1232 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1232)
+	err1295 := p.Io.Read(iprot)
+	if err1295 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1295)
 	}
 	return err
 }
@@ -20187,42 +20455,42 @@ func (p *ScannerOpenWithScanArgs) Read(iprot thrift.TProtocol) (err thrift.TProt
 }
 
 func (p *ScannerOpenWithScanArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1233, err1234 := iprot.ReadBinary()
-	if err1234 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1234)
+	v1296, err1297 := iprot.ReadBinary()
+	if err1297 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1297)
 	}
-	p.TableName = Text(v1233)
+	p.TableName = Text(v1296)
 	return err
 }
 
 func (p *ScannerOpenWithScanArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Scan = NewTScan()
-	err1237 := p.Scan.Read(iprot)
-	if err1237 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.ScanTScan", err1237)
+	err1300 := p.Scan.Read(iprot)
+	if err1300 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.ScanTScan", err1300)
 	}
 	return err
 }
 
 func (p *ScannerOpenWithScanArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1241, _vtype1242, _size1240, err := iprot.ReadMapBegin()
+	_ktype1304, _vtype1305, _size1303, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1241, _vtype1242
-	p.Attributes = make(map[string]Text, _size1240)
-	for i := 0; i < _size1240; i++ {
-		v1247, err1248 := iprot.ReadString()
-		if err1248 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1245", "", err1248)
+	_, _ = _ktype1304, _vtype1305
+	p.Attributes = make(map[string]Text, _size1303)
+	for i := 0; i < _size1303; i++ {
+		v1310, err1311 := iprot.ReadString()
+		if err1311 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1308", "", err1311)
 		}
-		_key1245 := v1247
-		v1249, err1250 := iprot.ReadBinary()
-		if err1250 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1246", "", err1250)
+		_key1308 := v1310
+		v1312, err1313 := iprot.ReadBinary()
+		if err1313 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1309", "", err1313)
 		}
-		_val1246 := Text(v1249)
-		p.Attributes[_key1245] = _val1246
+		_val1309 := Text(v1312)
+		p.Attributes[_key1308] = _val1309
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -20305,14 +20573,14 @@ func (p *ScannerOpenWithScanArgs) writeField3(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1251, Viter1252 := range p.Attributes {
-			err = oprot.WriteString(Kiter1251)
+		for Kiter1314, Viter1315 := range p.Attributes {
+			err = oprot.WriteString(Kiter1314)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1251", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1314", "", err)
 			}
-			err = oprot.WriteBinary(Viter1252)
+			err = oprot.WriteBinary(Viter1315)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1252", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1315", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -20441,19 +20709,19 @@ func (p *ScannerOpenWithScanResult) Read(iprot thrift.TProtocol) (err thrift.TPr
 }
 
 func (p *ScannerOpenWithScanResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1253, err1254 := iprot.ReadI32()
-	if err1254 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1254)
+	v1316, err1317 := iprot.ReadI32()
+	if err1317 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1317)
 	}
-	p.Success = ScannerID(v1253)
+	p.Success = ScannerID(v1316)
 	return err
 }
 
 func (p *ScannerOpenWithScanResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1257 := p.Io.Read(iprot)
-	if err1257 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1257)
+	err1320 := p.Io.Read(iprot)
+	if err1320 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1320)
 	}
 	return err
 }
@@ -20662,37 +20930,37 @@ func (p *ScannerOpenArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *ScannerOpenArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1258, err1259 := iprot.ReadBinary()
-	if err1259 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1259)
+	v1321, err1322 := iprot.ReadBinary()
+	if err1322 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1322)
 	}
-	p.TableName = Text(v1258)
+	p.TableName = Text(v1321)
 	return err
 }
 
 func (p *ScannerOpenArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1260, err1261 := iprot.ReadBinary()
-	if err1261 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1261)
+	v1323, err1324 := iprot.ReadBinary()
+	if err1324 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1324)
 	}
-	p.StartRow = Text(v1260)
+	p.StartRow = Text(v1323)
 	return err
 }
 
 func (p *ScannerOpenArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1267, _size1264, err := iprot.ReadListBegin()
+	_etype1330, _size1327, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype1267
-	p.Columns = make([]Text, _size1264, _size1264)
-	for i := 0; i < _size1264; i++ {
-		v1270, err1271 := iprot.ReadBinary()
-		if err1271 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem1269", "", err1271)
+	_ = _etype1330
+	p.Columns = make([]Text, _size1327, _size1327)
+	for i := 0; i < _size1327; i++ {
+		v1333, err1334 := iprot.ReadBinary()
+		if err1334 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem1332", "", err1334)
 		}
-		_elem1269 := Text(v1270)
-		p.Columns[i] = _elem1269
+		_elem1332 := Text(v1333)
+		p.Columns[i] = _elem1332
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -20702,24 +20970,24 @@ func (p *ScannerOpenArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtoc
 }
 
 func (p *ScannerOpenArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1275, _vtype1276, _size1274, err := iprot.ReadMapBegin()
+	_ktype1338, _vtype1339, _size1337, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1275, _vtype1276
-	p.Attributes = make(map[string]Text, _size1274)
-	for i := 0; i < _size1274; i++ {
-		v1281, err1282 := iprot.ReadString()
-		if err1282 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1279", "", err1282)
+	_, _ = _ktype1338, _vtype1339
+	p.Attributes = make(map[string]Text, _size1337)
+	for i := 0; i < _size1337; i++ {
+		v1344, err1345 := iprot.ReadString()
+		if err1345 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1342", "", err1345)
 		}
-		_key1279 := v1281
-		v1283, err1284 := iprot.ReadBinary()
-		if err1284 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1280", "", err1284)
+		_key1342 := v1344
+		v1346, err1347 := iprot.ReadBinary()
+		if err1347 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1343", "", err1347)
 		}
-		_val1280 := Text(v1283)
-		p.Attributes[_key1279] = _val1280
+		_val1343 := Text(v1346)
+		p.Attributes[_key1342] = _val1343
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -20806,10 +21074,10 @@ func (p *ScannerOpenArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1285 := range p.Columns {
-			err = oprot.WriteBinary(Iter1285)
+		for _, Iter1348 := range p.Columns {
+			err = oprot.WriteBinary(Iter1348)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter1285", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter1348", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -20834,14 +21102,14 @@ func (p *ScannerOpenArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProto
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1286, Viter1287 := range p.Attributes {
-			err = oprot.WriteString(Kiter1286)
+		for Kiter1349, Viter1350 := range p.Attributes {
+			err = oprot.WriteString(Kiter1349)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1286", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1349", "", err)
 			}
-			err = oprot.WriteBinary(Viter1287)
+			err = oprot.WriteBinary(Viter1350)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1287", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1350", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -20973,19 +21241,19 @@ func (p *ScannerOpenResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 }
 
 func (p *ScannerOpenResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1288, err1289 := iprot.ReadI32()
-	if err1289 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1289)
+	v1351, err1352 := iprot.ReadI32()
+	if err1352 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1352)
 	}
-	p.Success = ScannerID(v1288)
+	p.Success = ScannerID(v1351)
 	return err
 }
 
 func (p *ScannerOpenResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1292 := p.Io.Read(iprot)
-	if err1292 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1292)
+	err1355 := p.Io.Read(iprot)
+	if err1355 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1355)
 	}
 	return err
 }
@@ -21210,46 +21478,46 @@ func (p *ScannerOpenWithStopArgs) Read(iprot thrift.TProtocol) (err thrift.TProt
 }
 
 func (p *ScannerOpenWithStopArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1293, err1294 := iprot.ReadBinary()
-	if err1294 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1294)
+	v1356, err1357 := iprot.ReadBinary()
+	if err1357 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1357)
 	}
-	p.TableName = Text(v1293)
+	p.TableName = Text(v1356)
 	return err
 }
 
 func (p *ScannerOpenWithStopArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1295, err1296 := iprot.ReadBinary()
-	if err1296 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1296)
+	v1358, err1359 := iprot.ReadBinary()
+	if err1359 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1359)
 	}
-	p.StartRow = Text(v1295)
+	p.StartRow = Text(v1358)
 	return err
 }
 
 func (p *ScannerOpenWithStopArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1297, err1298 := iprot.ReadBinary()
-	if err1298 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "stopRow", p.ThriftName(), err1298)
+	v1360, err1361 := iprot.ReadBinary()
+	if err1361 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "stopRow", p.ThriftName(), err1361)
 	}
-	p.StopRow = Text(v1297)
+	p.StopRow = Text(v1360)
 	return err
 }
 
 func (p *ScannerOpenWithStopArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1304, _size1301, err := iprot.ReadListBegin()
+	_etype1367, _size1364, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype1304
-	p.Columns = make([]Text, _size1301, _size1301)
-	for i := 0; i < _size1301; i++ {
-		v1307, err1308 := iprot.ReadBinary()
-		if err1308 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem1306", "", err1308)
+	_ = _etype1367
+	p.Columns = make([]Text, _size1364, _size1364)
+	for i := 0; i < _size1364; i++ {
+		v1370, err1371 := iprot.ReadBinary()
+		if err1371 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem1369", "", err1371)
 		}
-		_elem1306 := Text(v1307)
-		p.Columns[i] = _elem1306
+		_elem1369 := Text(v1370)
+		p.Columns[i] = _elem1369
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -21259,24 +21527,24 @@ func (p *ScannerOpenWithStopArgs) readField4(iprot thrift.TProtocol) (err thrift
 }
 
 func (p *ScannerOpenWithStopArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1312, _vtype1313, _size1311, err := iprot.ReadMapBegin()
+	_ktype1375, _vtype1376, _size1374, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1312, _vtype1313
-	p.Attributes = make(map[string]Text, _size1311)
-	for i := 0; i < _size1311; i++ {
-		v1318, err1319 := iprot.ReadString()
-		if err1319 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1316", "", err1319)
+	_, _ = _ktype1375, _vtype1376
+	p.Attributes = make(map[string]Text, _size1374)
+	for i := 0; i < _size1374; i++ {
+		v1381, err1382 := iprot.ReadString()
+		if err1382 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1379", "", err1382)
 		}
-		_key1316 := v1318
-		v1320, err1321 := iprot.ReadBinary()
-		if err1321 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1317", "", err1321)
+		_key1379 := v1381
+		v1383, err1384 := iprot.ReadBinary()
+		if err1384 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1380", "", err1384)
 		}
-		_val1317 := Text(v1320)
-		p.Attributes[_key1316] = _val1317
+		_val1380 := Text(v1383)
+		p.Attributes[_key1379] = _val1380
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -21385,10 +21653,10 @@ func (p *ScannerOpenWithStopArgs) writeField4(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1322 := range p.Columns {
-			err = oprot.WriteBinary(Iter1322)
+		for _, Iter1385 := range p.Columns {
+			err = oprot.WriteBinary(Iter1385)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter1322", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter1385", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -21413,14 +21681,14 @@ func (p *ScannerOpenWithStopArgs) writeField5(oprot thrift.TProtocol) (err thrif
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1323, Viter1324 := range p.Attributes {
-			err = oprot.WriteString(Kiter1323)
+		for Kiter1386, Viter1387 := range p.Attributes {
+			err = oprot.WriteString(Kiter1386)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1323", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1386", "", err)
 			}
-			err = oprot.WriteBinary(Viter1324)
+			err = oprot.WriteBinary(Viter1387)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1324", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1387", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -21555,19 +21823,19 @@ func (p *ScannerOpenWithStopResult) Read(iprot thrift.TProtocol) (err thrift.TPr
 }
 
 func (p *ScannerOpenWithStopResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1325, err1326 := iprot.ReadI32()
-	if err1326 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1326)
+	v1388, err1389 := iprot.ReadI32()
+	if err1389 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1389)
 	}
-	p.Success = ScannerID(v1325)
+	p.Success = ScannerID(v1388)
 	return err
 }
 
 func (p *ScannerOpenWithStopResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1329 := p.Io.Read(iprot)
-	if err1329 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1329)
+	err1392 := p.Io.Read(iprot)
+	if err1392 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1392)
 	}
 	return err
 }
@@ -21773,37 +22041,37 @@ func (p *ScannerOpenWithPrefixArgs) Read(iprot thrift.TProtocol) (err thrift.TPr
 }
 
 func (p *ScannerOpenWithPrefixArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1330, err1331 := iprot.ReadBinary()
-	if err1331 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1331)
+	v1393, err1394 := iprot.ReadBinary()
+	if err1394 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1394)
 	}
-	p.TableName = Text(v1330)
+	p.TableName = Text(v1393)
 	return err
 }
 
 func (p *ScannerOpenWithPrefixArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1332, err1333 := iprot.ReadBinary()
-	if err1333 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "startAndPrefix", p.ThriftName(), err1333)
+	v1395, err1396 := iprot.ReadBinary()
+	if err1396 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "startAndPrefix", p.ThriftName(), err1396)
 	}
-	p.StartAndPrefix = Text(v1332)
+	p.StartAndPrefix = Text(v1395)
 	return err
 }
 
 func (p *ScannerOpenWithPrefixArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1339, _size1336, err := iprot.ReadListBegin()
+	_etype1402, _size1399, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype1339
-	p.Columns = make([]Text, _size1336, _size1336)
-	for i := 0; i < _size1336; i++ {
-		v1342, err1343 := iprot.ReadBinary()
-		if err1343 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem1341", "", err1343)
+	_ = _etype1402
+	p.Columns = make([]Text, _size1399, _size1399)
+	for i := 0; i < _size1399; i++ {
+		v1405, err1406 := iprot.ReadBinary()
+		if err1406 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem1404", "", err1406)
 		}
-		_elem1341 := Text(v1342)
-		p.Columns[i] = _elem1341
+		_elem1404 := Text(v1405)
+		p.Columns[i] = _elem1404
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -21813,24 +22081,24 @@ func (p *ScannerOpenWithPrefixArgs) readField3(iprot thrift.TProtocol) (err thri
 }
 
 func (p *ScannerOpenWithPrefixArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1347, _vtype1348, _size1346, err := iprot.ReadMapBegin()
+	_ktype1410, _vtype1411, _size1409, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1347, _vtype1348
-	p.Attributes = make(map[string]Text, _size1346)
-	for i := 0; i < _size1346; i++ {
-		v1353, err1354 := iprot.ReadString()
-		if err1354 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1351", "", err1354)
+	_, _ = _ktype1410, _vtype1411
+	p.Attributes = make(map[string]Text, _size1409)
+	for i := 0; i < _size1409; i++ {
+		v1416, err1417 := iprot.ReadString()
+		if err1417 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1414", "", err1417)
 		}
-		_key1351 := v1353
-		v1355, err1356 := iprot.ReadBinary()
-		if err1356 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1352", "", err1356)
+		_key1414 := v1416
+		v1418, err1419 := iprot.ReadBinary()
+		if err1419 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1415", "", err1419)
 		}
-		_val1352 := Text(v1355)
-		p.Attributes[_key1351] = _val1352
+		_val1415 := Text(v1418)
+		p.Attributes[_key1414] = _val1415
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -21917,10 +22185,10 @@ func (p *ScannerOpenWithPrefixArgs) writeField3(oprot thrift.TProtocol) (err thr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1357 := range p.Columns {
-			err = oprot.WriteBinary(Iter1357)
+		for _, Iter1420 := range p.Columns {
+			err = oprot.WriteBinary(Iter1420)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter1357", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter1420", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -21945,14 +22213,14 @@ func (p *ScannerOpenWithPrefixArgs) writeField4(oprot thrift.TProtocol) (err thr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1358, Viter1359 := range p.Attributes {
-			err = oprot.WriteString(Kiter1358)
+		for Kiter1421, Viter1422 := range p.Attributes {
+			err = oprot.WriteString(Kiter1421)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1358", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1421", "", err)
 			}
-			err = oprot.WriteBinary(Viter1359)
+			err = oprot.WriteBinary(Viter1422)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1359", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1422", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -22086,19 +22354,19 @@ func (p *ScannerOpenWithPrefixResult) Read(iprot thrift.TProtocol) (err thrift.T
 }
 
 func (p *ScannerOpenWithPrefixResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1360, err1361 := iprot.ReadI32()
-	if err1361 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1361)
+	v1423, err1424 := iprot.ReadI32()
+	if err1424 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1424)
 	}
-	p.Success = ScannerID(v1360)
+	p.Success = ScannerID(v1423)
 	return err
 }
 
 func (p *ScannerOpenWithPrefixResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1364 := p.Io.Read(iprot)
-	if err1364 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1364)
+	err1427 := p.Io.Read(iprot)
+	if err1427 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1427)
 	}
 	return err
 }
@@ -22322,37 +22590,37 @@ func (p *ScannerOpenTsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 }
 
 func (p *ScannerOpenTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1365, err1366 := iprot.ReadBinary()
-	if err1366 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1366)
+	v1428, err1429 := iprot.ReadBinary()
+	if err1429 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1429)
 	}
-	p.TableName = Text(v1365)
+	p.TableName = Text(v1428)
 	return err
 }
 
 func (p *ScannerOpenTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1367, err1368 := iprot.ReadBinary()
-	if err1368 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1368)
+	v1430, err1431 := iprot.ReadBinary()
+	if err1431 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1431)
 	}
-	p.StartRow = Text(v1367)
+	p.StartRow = Text(v1430)
 	return err
 }
 
 func (p *ScannerOpenTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1374, _size1371, err := iprot.ReadListBegin()
+	_etype1437, _size1434, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype1374
-	p.Columns = make([]Text, _size1371, _size1371)
-	for i := 0; i < _size1371; i++ {
-		v1377, err1378 := iprot.ReadBinary()
-		if err1378 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem1376", "", err1378)
+	_ = _etype1437
+	p.Columns = make([]Text, _size1434, _size1434)
+	for i := 0; i < _size1434; i++ {
+		v1440, err1441 := iprot.ReadBinary()
+		if err1441 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem1439", "", err1441)
 		}
-		_elem1376 := Text(v1377)
-		p.Columns[i] = _elem1376
+		_elem1439 := Text(v1440)
+		p.Columns[i] = _elem1439
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -22362,33 +22630,33 @@ func (p *ScannerOpenTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProt
 }
 
 func (p *ScannerOpenTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1379, err1380 := iprot.ReadI64()
-	if err1380 != nil {
-		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1380)
+	v1442, err1443 := iprot.ReadI64()
+	if err1443 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err1443)
 	}
-	p.Timestamp = v1379
+	p.Timestamp = v1442
 	return err
 }
 
 func (p *ScannerOpenTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1384, _vtype1385, _size1383, err := iprot.ReadMapBegin()
+	_ktype1447, _vtype1448, _size1446, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1384, _vtype1385
-	p.Attributes = make(map[string]Text, _size1383)
-	for i := 0; i < _size1383; i++ {
-		v1390, err1391 := iprot.ReadString()
-		if err1391 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1388", "", err1391)
+	_, _ = _ktype1447, _vtype1448
+	p.Attributes = make(map[string]Text, _size1446)
+	for i := 0; i < _size1446; i++ {
+		v1453, err1454 := iprot.ReadString()
+		if err1454 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1451", "", err1454)
 		}
-		_key1388 := v1390
-		v1392, err1393 := iprot.ReadBinary()
-		if err1393 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1389", "", err1393)
+		_key1451 := v1453
+		v1455, err1456 := iprot.ReadBinary()
+		if err1456 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1452", "", err1456)
 		}
-		_val1389 := Text(v1392)
-		p.Attributes[_key1388] = _val1389
+		_val1452 := Text(v1455)
+		p.Attributes[_key1451] = _val1452
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -22479,10 +22747,10 @@ func (p *ScannerOpenTsArgs) writeField3(oprot thrift.TProtocol) (err thrift.TPro
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1394 := range p.Columns {
-			err = oprot.WriteBinary(Iter1394)
+		for _, Iter1457 := range p.Columns {
+			err = oprot.WriteBinary(Iter1457)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter1394", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter1457", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -22523,14 +22791,14 @@ func (p *ScannerOpenTsArgs) writeField5(oprot thrift.TProtocol) (err thrift.TPro
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1395, Viter1396 := range p.Attributes {
-			err = oprot.WriteString(Kiter1395)
+		for Kiter1458, Viter1459 := range p.Attributes {
+			err = oprot.WriteString(Kiter1458)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1395", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1458", "", err)
 			}
-			err = oprot.WriteBinary(Viter1396)
+			err = oprot.WriteBinary(Viter1459)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1396", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1459", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -22665,19 +22933,19 @@ func (p *ScannerOpenTsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 }
 
 func (p *ScannerOpenTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1397, err1398 := iprot.ReadI32()
-	if err1398 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1398)
+	v1460, err1461 := iprot.ReadI32()
+	if err1461 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1461)
 	}
-	p.Success = ScannerID(v1397)
+	p.Success = ScannerID(v1460)
 	return err
 }
 
 func (p *ScannerOpenTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1401 := p.Io.Read(iprot)
-	if err1401 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1401)
+	err1464 := p.Io.Read(iprot)
+	if err1464 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1464)
 	}
 	return err
 }
@@ -22917,46 +23185,46 @@ func (p *ScannerOpenWithStopTsArgs) Read(iprot thrift.TProtocol) (err thrift.TPr
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1402, err1403 := iprot.ReadBinary()
-	if err1403 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1403)
+	v1465, err1466 := iprot.ReadBinary()
+	if err1466 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1466)
 	}
-	p.TableName = Text(v1402)
+	p.TableName = Text(v1465)
 	return err
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1404, err1405 := iprot.ReadBinary()
-	if err1405 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1405)
+	v1467, err1468 := iprot.ReadBinary()
+	if err1468 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "startRow", p.ThriftName(), err1468)
 	}
-	p.StartRow = Text(v1404)
+	p.StartRow = Text(v1467)
 	return err
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1406, err1407 := iprot.ReadBinary()
-	if err1407 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "stopRow", p.ThriftName(), err1407)
+	v1469, err1470 := iprot.ReadBinary()
+	if err1470 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "stopRow", p.ThriftName(), err1470)
 	}
-	p.StopRow = Text(v1406)
+	p.StopRow = Text(v1469)
 	return err
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1413, _size1410, err := iprot.ReadListBegin()
+	_etype1476, _size1473, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype1413
-	p.Columns = make([]Text, _size1410, _size1410)
-	for i := 0; i < _size1410; i++ {
-		v1416, err1417 := iprot.ReadBinary()
-		if err1417 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem1415", "", err1417)
+	_ = _etype1476
+	p.Columns = make([]Text, _size1473, _size1473)
+	for i := 0; i < _size1473; i++ {
+		v1479, err1480 := iprot.ReadBinary()
+		if err1480 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem1478", "", err1480)
 		}
-		_elem1415 := Text(v1416)
-		p.Columns[i] = _elem1415
+		_elem1478 := Text(v1479)
+		p.Columns[i] = _elem1478
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -22966,33 +23234,33 @@ func (p *ScannerOpenWithStopTsArgs) readField4(iprot thrift.TProtocol) (err thri
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1418, err1419 := iprot.ReadI64()
-	if err1419 != nil {
-		return thrift.NewTProtocolExceptionReadField(5, "timestamp", p.ThriftName(), err1419)
+	v1481, err1482 := iprot.ReadI64()
+	if err1482 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "timestamp", p.ThriftName(), err1482)
 	}
-	p.Timestamp = v1418
+	p.Timestamp = v1481
 	return err
 }
 
 func (p *ScannerOpenWithStopTsArgs) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype1423, _vtype1424, _size1422, err := iprot.ReadMapBegin()
+	_ktype1486, _vtype1487, _size1485, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
 	}
-	_, _ = _ktype1423, _vtype1424
-	p.Attributes = make(map[string]Text, _size1422)
-	for i := 0; i < _size1422; i++ {
-		v1429, err1430 := iprot.ReadString()
-		if err1430 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key1427", "", err1430)
+	_, _ = _ktype1486, _vtype1487
+	p.Attributes = make(map[string]Text, _size1485)
+	for i := 0; i < _size1485; i++ {
+		v1492, err1493 := iprot.ReadString()
+		if err1493 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1490", "", err1493)
 		}
-		_key1427 := v1429
-		v1431, err1432 := iprot.ReadBinary()
-		if err1432 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_val1428", "", err1432)
+		_key1490 := v1492
+		v1494, err1495 := iprot.ReadBinary()
+		if err1495 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1491", "", err1495)
 		}
-		_val1428 := Text(v1431)
-		p.Attributes[_key1427] = _val1428
+		_val1491 := Text(v1494)
+		p.Attributes[_key1490] = _val1491
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -23105,10 +23373,10 @@ func (p *ScannerOpenWithStopTsArgs) writeField4(oprot thrift.TProtocol) (err thr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1433 := range p.Columns {
-			err = oprot.WriteBinary(Iter1433)
+		for _, Iter1496 := range p.Columns {
+			err = oprot.WriteBinary(Iter1496)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Iter1433", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter1496", "", err)
 			}
 		}
 		err = oprot.WriteListEnd()
@@ -23149,14 +23417,14 @@ func (p *ScannerOpenWithStopTsArgs) writeField6(oprot thrift.TProtocol) (err thr
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
 		}
-		for Kiter1434, Viter1435 := range p.Attributes {
-			err = oprot.WriteString(Kiter1434)
+		for Kiter1497, Viter1498 := range p.Attributes {
+			err = oprot.WriteString(Kiter1497)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1434", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1497", "", err)
 			}
-			err = oprot.WriteBinary(Viter1435)
+			err = oprot.WriteBinary(Viter1498)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Viter1435", "", err)
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1498", "", err)
 			}
 		}
 		err = oprot.WriteMapEnd()
@@ -23296,19 +23564,19 @@ func (p *ScannerOpenWithStopTsResult) Read(iprot thrift.TProtocol) (err thrift.T
 }
 
 func (p *ScannerOpenWithStopTsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1436, err1437 := iprot.ReadI32()
-	if err1437 != nil {
-		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1437)
+	v1499, err1500 := iprot.ReadI32()
+	if err1500 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1500)
 	}
-	p.Success = ScannerID(v1436)
+	p.Success = ScannerID(v1499)
 	return err
 }
 
 func (p *ScannerOpenWithStopTsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1440 := p.Io.Read(iprot)
-	if err1440 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1440)
+	err1503 := p.Io.Read(iprot)
+	if err1503 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1503)
 	}
 	return err
 }
@@ -23469,11 +23737,11 @@ func (p *ScannerGetArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcep
 }
 
 func (p *ScannerGetArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1441, err1442 := iprot.ReadI32()
-	if err1442 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1442)
+	v1504, err1505 := iprot.ReadI32()
+	if err1505 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1505)
 	}
-	p.Id = ScannerID(v1441)
+	p.Id = ScannerID(v1504)
 	return err
 }
 
@@ -23636,19 +23904,19 @@ func (p *ScannerGetResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *ScannerGetResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1448, _size1445, err := iprot.ReadListBegin()
+	_etype1511, _size1508, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype1448
-	p.Success = make([]*TRowResult, _size1445, _size1445)
-	for i := 0; i < _size1445; i++ {
-		_elem1450 := NewTRowResult()
-		err1453 := _elem1450.Read(iprot)
-		if err1453 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1450TRowResult", err1453)
+	_ = _etype1511
+	p.Success = make([]*TRowResult, _size1508, _size1508)
+	for i := 0; i < _size1508; i++ {
+		_elem1513 := NewTRowResult()
+		err1516 := _elem1513.Read(iprot)
+		if err1516 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1513TRowResult", err1516)
 		}
-		p.Success[i] = _elem1450
+		p.Success[i] = _elem1513
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -23659,18 +23927,18 @@ func (p *ScannerGetResult) readField0(iprot thrift.TProtocol) (err thrift.TProto
 
 func (p *ScannerGetResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1456 := p.Io.Read(iprot)
-	if err1456 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1456)
+	err1519 := p.Io.Read(iprot)
+	if err1519 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1519)
 	}
 	return err
 }
 
 func (p *ScannerGetResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1459 := p.Ia.Read(iprot)
-	if err1459 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1459)
+	err1522 := p.Ia.Read(iprot)
+	if err1522 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1522)
 	}
 	return err
 }
@@ -23715,8 +23983,8 @@ func (p *ScannerGetResult) writeField0(oprot thrift.TProtocol) (err thrift.TProt
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1460 := range p.Success {
-			err = Iter1460.Write(oprot)
+		for _, Iter1523 := range p.Success {
+			err = Iter1523.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -23883,20 +24151,20 @@ func (p *ScannerGetListArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 }
 
 func (p *ScannerGetListArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1461, err1462 := iprot.ReadI32()
-	if err1462 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1462)
+	v1524, err1525 := iprot.ReadI32()
+	if err1525 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1525)
 	}
-	p.Id = ScannerID(v1461)
+	p.Id = ScannerID(v1524)
 	return err
 }
 
 func (p *ScannerGetListArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1463, err1464 := iprot.ReadI32()
-	if err1464 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "nbRows", p.ThriftName(), err1464)
+	v1526, err1527 := iprot.ReadI32()
+	if err1527 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "nbRows", p.ThriftName(), err1527)
 	}
-	p.NbRows = v1463
+	p.NbRows = v1526
 	return err
 }
 
@@ -24082,19 +24350,19 @@ func (p *ScannerGetListResult) Read(iprot thrift.TProtocol) (err thrift.TProtoco
 }
 
 func (p *ScannerGetListResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1470, _size1467, err := iprot.ReadListBegin()
+	_etype1533, _size1530, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype1470
-	p.Success = make([]*TRowResult, _size1467, _size1467)
-	for i := 0; i < _size1467; i++ {
-		_elem1472 := NewTRowResult()
-		err1475 := _elem1472.Read(iprot)
-		if err1475 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1472TRowResult", err1475)
+	_ = _etype1533
+	p.Success = make([]*TRowResult, _size1530, _size1530)
+	for i := 0; i < _size1530; i++ {
+		_elem1535 := NewTRowResult()
+		err1538 := _elem1535.Read(iprot)
+		if err1538 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1535TRowResult", err1538)
 		}
-		p.Success[i] = _elem1472
+		p.Success[i] = _elem1535
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -24105,18 +24373,18 @@ func (p *ScannerGetListResult) readField0(iprot thrift.TProtocol) (err thrift.TP
 
 func (p *ScannerGetListResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1478 := p.Io.Read(iprot)
-	if err1478 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1478)
+	err1541 := p.Io.Read(iprot)
+	if err1541 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1541)
 	}
 	return err
 }
 
 func (p *ScannerGetListResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1481 := p.Ia.Read(iprot)
-	if err1481 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1481)
+	err1544 := p.Ia.Read(iprot)
+	if err1544 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1544)
 	}
 	return err
 }
@@ -24161,8 +24429,8 @@ func (p *ScannerGetListResult) writeField0(oprot thrift.TProtocol) (err thrift.T
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1482 := range p.Success {
-			err = Iter1482.Write(oprot)
+		for _, Iter1545 := range p.Success {
+			err = Iter1545.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TRowResult", err)
 			}
@@ -24314,11 +24582,11 @@ func (p *ScannerCloseArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolExc
 }
 
 func (p *ScannerCloseArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1483, err1484 := iprot.ReadI32()
-	if err1484 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1484)
+	v1546, err1547 := iprot.ReadI32()
+	if err1547 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "id", p.ThriftName(), err1547)
 	}
-	p.Id = ScannerID(v1483)
+	p.Id = ScannerID(v1546)
 	return err
 }
 
@@ -24467,18 +24735,18 @@ func (p *ScannerCloseResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 
 func (p *ScannerCloseResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1487 := p.Io.Read(iprot)
-	if err1487 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1487)
+	err1550 := p.Io.Read(iprot)
+	if err1550 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1550)
 	}
 	return err
 }
 
 func (p *ScannerCloseResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Ia = NewIllegalArgument()
-	err1490 := p.Ia.Read(iprot)
-	if err1490 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1490)
+	err1553 := p.Ia.Read(iprot)
+	if err1553 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1553)
 	}
 	return err
 }
@@ -24671,29 +24939,29 @@ func (p *GetRowOrBeforeArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolE
 }
 
 func (p *GetRowOrBeforeArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1491, err1492 := iprot.ReadBinary()
-	if err1492 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1492)
+	v1554, err1555 := iprot.ReadBinary()
+	if err1555 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1555)
 	}
-	p.TableName = Text(v1491)
+	p.TableName = Text(v1554)
 	return err
 }
 
 func (p *GetRowOrBeforeArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1493, err1494 := iprot.ReadBinary()
-	if err1494 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1494)
+	v1556, err1557 := iprot.ReadBinary()
+	if err1557 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1557)
 	}
-	p.Row = Text(v1493)
+	p.Row = Text(v1556)
 	return err
 }
 
 func (p *GetRowOrBeforeArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1495, err1496 := iprot.ReadBinary()
-	if err1496 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "family", p.ThriftName(), err1496)
+	v1558, err1559 := iprot.ReadBinary()
+	if err1559 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "family", p.ThriftName(), err1559)
 	}
-	p.Family = Text(v1495)
+	p.Family = Text(v1558)
 	return err
 }
 
@@ -24893,19 +25161,19 @@ func (p *GetRowOrBeforeResult) Read(iprot thrift.TProtocol) (err thrift.TProtoco
 }
 
 func (p *GetRowOrBeforeResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype1502, _size1499, err := iprot.ReadListBegin()
+	_etype1565, _size1562, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
 	}
-	_ = _etype1502
-	p.Success = make([]*TCell, _size1499, _size1499)
-	for i := 0; i < _size1499; i++ {
-		_elem1504 := NewTCell()
-		err1507 := _elem1504.Read(iprot)
-		if err1507 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_elem1504TCell", err1507)
+	_ = _etype1565
+	p.Success = make([]*TCell, _size1562, _size1562)
+	for i := 0; i < _size1562; i++ {
+		_elem1567 := NewTCell()
+		err1570 := _elem1567.Read(iprot)
+		if err1570 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1567TCell", err1570)
 		}
-		p.Success[i] = _elem1504
+		p.Success[i] = _elem1567
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -24916,9 +25184,9 @@ func (p *GetRowOrBeforeResult) readField0(iprot thrift.TProtocol) (err thrift.TP
 
 func (p *GetRowOrBeforeResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1510 := p.Io.Read(iprot)
-	if err1510 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1510)
+	err1573 := p.Io.Read(iprot)
+	if err1573 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1573)
 	}
 	return err
 }
@@ -24959,8 +25227,8 @@ func (p *GetRowOrBeforeResult) writeField0(oprot thrift.TProtocol) (err thrift.T
 		if err != nil {
 			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 		}
-		for _, Iter1511 := range p.Success {
-			err = Iter1511.Write(oprot)
+		for _, Iter1574 := range p.Success {
+			err = Iter1574.Write(oprot)
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
 			}
@@ -25091,11 +25359,11 @@ func (p *GetRegionInfoArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolEx
 }
 
 func (p *GetRegionInfoArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v1512, err1513 := iprot.ReadBinary()
-	if err1513 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err1513)
+	v1575, err1576 := iprot.ReadBinary()
+	if err1576 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err1576)
 	}
-	p.Row = Text(v1512)
+	p.Row = Text(v1575)
 	return err
 }
 
@@ -25246,18 +25514,18 @@ func (p *GetRegionInfoResult) Read(iprot thrift.TProtocol) (err thrift.TProtocol
 
 func (p *GetRegionInfoResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Success = NewTRegionInfo()
-	err1516 := p.Success.Read(iprot)
-	if err1516 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTRegionInfo", err1516)
+	err1579 := p.Success.Read(iprot)
+	if err1579 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTRegionInfo", err1579)
 	}
 	return err
 }
 
 func (p *GetRegionInfoResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	p.Io = NewIOError()
-	err1519 := p.Io.Read(iprot)
-	if err1519 != nil {
-		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1519)
+	err1582 := p.Io.Read(iprot)
+	if err1582 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1582)
 	}
 	return err
 }
@@ -25350,3 +25618,1010 @@ func (p *GetRegionInfoResult) TStructFields() thrift.TFieldContainer {
 		thrift.NewTField("io", thrift.STRUCT, 1),
 	})
 }
+
+/**
+ * Attributes:
+ *  - Append: The single append operation to apply
+ */
+type AppendArgs struct {
+	Append *TAppend "append" // 1
+}
+
+var tstructAppendArgs = thrift.NewTStruct("append_args", []thrift.TField{
+	thrift.NewTField("append", thrift.STRUCT, 1),
+})
+
+func (*AppendArgs) GetTStruct() thrift.TStruct { return tstructAppendArgs }
+func NewAppendArgs() *AppendArgs {
+	output := &AppendArgs{}
+	{
+	}
+	return output
+}
+
+func (p *AppendArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAppendArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAppendArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TAppend
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Append = NewTAppend()
+	err1585 := p.Append.Read(iprot)
+	if err1585 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.AppendTAppend", err1585)
+	}
+	return err
+}
+
+func (p *AppendArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("append_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Append != nil {
+		err = oprot.WriteFieldBegin("append", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "append", p.ThriftName(), err)
+		}
+		err = p.Append.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TAppend", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "append", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendArgs) TStructName() string {
+	return "AppendArgs"
+}
+
+func (p *AppendArgs) ThriftName() string {
+	return "append_args"
+}
+
+func (p *AppendArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Append
+	}
+	return nil
+}
+
+func (p *AppendArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("append", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type AppendResult struct {
+	Success []*TCell "success" // 0
+	Io      *IOError "io"      // 1
+}
+
+var tstructAppendResult = thrift.NewTStruct("append_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*AppendResult) GetTStruct() thrift.TStruct { return tstructAppendResult }
+func NewAppendResult() *AppendResult {
+	output := &AppendResult{}
+	{
+	}
+	return output
+}
+
+func (p *AppendResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAppendResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAppendResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //IOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype1591, _size1588, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype1591
+	p.Success = make([]*TCell, _size1588, _size1588)
+	for i := 0; i < _size1588; i++ {
+		_elem1593 := NewTCell()
+		err1596 := _elem1593.Read(iprot)
+		if err1596 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem1593TCell", err1596)
+		}
+		p.Success[i] = _elem1593
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *AppendResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewIOError()
+	err1599 := p.Io.Read(iprot)
+	if err1599 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1599)
+	}
+	return err
+}
+
+func (p *AppendResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("append_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter1600 := range p.Success {
+			err = Iter1600.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("IOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendResult) TStructName() string {
+	return "AppendResult"
+}
+
+func (p *AppendResult) ThriftName() string {
+	return "append_result"
+}
+
+func (p *AppendResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *AppendResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Column: column name
+ *  - Value: the expected value for the column parameter, if not
+ * provided the check is for the non-existence of the
+ * column in question
+ *  - Mput: mutation for the put
+ *  - Attributes: Mutation attributes
+ */
+type CheckAndPutArgs struct {
+	TableName  Text            "tableName"  // 1
+	Row        Text            "row"        // 2
+	Column     Text            "column"     // 3
+	Value      Text            "value"      // 5
+	Mput       *Mutation       "mput"       // 6
+	Attributes map[string]Text "attributes" // 7
+}
+
+var tstructCheckAndPutArgs = thrift.NewTStruct("checkAndPut_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRING, 1),
+	thrift.NewTField("row", thrift.STRING, 2),
+	thrift.NewTField("column", thrift.STRING, 3),
+	thrift.NewTField("value", thrift.STRING, 5),
+	thrift.NewTField("mput", thrift.STRUCT, 6),
+	thrift.NewTField("attributes", thrift.MAP, 7),
+})
+
+func (*CheckAndPutArgs) GetTStruct() thrift.TStruct { return tstructCheckAndPutArgs }
+func NewCheckAndPutArgs() *CheckAndPutArgs {
+	output := &CheckAndPutArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndPutArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndPutArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndPutArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //Mutation
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 7: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1601, err1602 := iprot.ReadBinary()
+	if err1602 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "tableName", p.ThriftName(), err1602)
+	}
+	p.TableName = Text(v1601)
+	return err
+}
+
+func (p *CheckAndPutArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1603, err1604 := iprot.ReadBinary()
+	if err1604 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err1604)
+	}
+	p.Row = Text(v1603)
+	return err
+}
+
+func (p *CheckAndPutArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1605, err1606 := iprot.ReadBinary()
+	if err1606 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "column", p.ThriftName(), err1606)
+	}
+	p.Column = Text(v1605)
+	return err
+}
+
+func (p *CheckAndPutArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1607, err1608 := iprot.ReadBinary()
+	if err1608 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "value", p.ThriftName(), err1608)
+	}
+	p.Value = Text(v1607)
+	return err
+}
+
+func (p *CheckAndPutArgs) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Mput = NewMutation()
+	err1611 := p.Mput.Read(iprot)
+	if err1611 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.MputMutation", err1611)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype1615, _vtype1616, _size1614, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype1615, _vtype1616
+	p.Attributes = make(map[string]Text, _size1614)
+	for i := 0; i < _size1614; i++ {
+		v1621, err1622 := iprot.ReadString()
+		if err1622 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key1619", "", err1622)
+		}
+		_key1619 := v1621
+		v1623, err1624 := iprot.ReadBinary()
+		if err1624 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val1620", "", err1624)
+		}
+		_val1620 := Text(v1623)
+		p.Attributes[_key1619] = _val1620
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndPut_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.TableName)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Column != nil {
+		err = oprot.WriteFieldBegin("column", thrift.STRING, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "column", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Column)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "column", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "column", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Value != nil {
+		err = oprot.WriteFieldBegin("value", thrift.STRING, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Value)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Mput != nil {
+		err = oprot.WriteFieldBegin("mput", thrift.STRUCT, 6)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "mput", p.ThriftName(), err)
+		}
+		err = p.Mput.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("Mutation", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "mput", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		err = oprot.WriteFieldBegin("attributes", thrift.MAP, 7)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "attributes", p.ThriftName(), err)
+		}
+		err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+		}
+		for Kiter1625, Viter1626 := range p.Attributes {
+			err = oprot.WriteString(Kiter1625)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(0, "Kiter1625", "", err)
+			}
+			err = oprot.WriteBinary(Viter1626)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(0, "Viter1626", "", err)
+			}
+		}
+		err = oprot.WriteMapEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "attributes", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) TStructName() string {
+	return "CheckAndPutArgs"
+}
+
+func (p *CheckAndPutArgs) ThriftName() string {
+	return "checkAndPut_args"
+}
+
+func (p *CheckAndPutArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.Row
+	case 3:
+		return p.Column
+	case 5:
+		return p.Value
+	case 6:
+		return p.Mput
+	case 7:
+		return p.Attributes
+	}
+	return nil
+}
+
+func (p *CheckAndPutArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRING, 1),
+		thrift.NewTField("row", thrift.STRING, 2),
+		thrift.NewTField("column", thrift.STRING, 3),
+		thrift.NewTField("value", thrift.STRING, 5),
+		thrift.NewTField("mput", thrift.STRUCT, 6),
+		thrift.NewTField("attributes", thrift.MAP, 7),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ *  - Ia
+ */
+type CheckAndPutResult struct {
+	Success bool             "success" // 0
+	Io      *IOError         "io"      // 1
+	Ia      *IllegalArgument "ia"      // 2
+}
+
+var tstructCheckAndPutResult = thrift.NewTStruct("checkAndPut_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+	thrift.NewTField("ia", thrift.STRUCT, 2),
+})
+
+func (*CheckAndPutResult) GetTStruct() thrift.TStruct { return tstructCheckAndPutResult }
+func NewCheckAndPutResult() *CheckAndPutResult {
+	output := &CheckAndPutResult{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndPutResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndPutResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndPutResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //IOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //IllegalArgument
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1627, err1628 := iprot.ReadBool()
+	if err1628 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err1628)
+	}
+	p.Success = v1627
+	return err
+}
+
+func (p *CheckAndPutResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewIOError()
+	err1631 := p.Io.Read(iprot)
+	if err1631 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoIOError", err1631)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Ia = NewIllegalArgument()
+	err1634 := p.Ia.Read(iprot)
+	if err1634 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaIllegalArgument", err1634)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndPut_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Ia != nil:
+		if err = p.writeField2(oprot); err != nil {
+			return err
+		}
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("IOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Ia != nil {
+		err = oprot.WriteFieldBegin("ia", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+		err = p.Ia.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("IllegalArgument", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) TStructName() string {
+	return "CheckAndPutResult"
+}
+
+func (p *CheckAndPutResult) ThriftName() string {
+	return "checkAndPut_result"
+}
+
+func (p *CheckAndPutResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	case 2:
+		return p.Ia
+	}
+	return nil
+}
+
+func (p *CheckAndPutResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+		thrift.NewTField("ia", thrift.STRUCT, 2),
+	})
+}
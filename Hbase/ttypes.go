@@ -1957,21 +1957,214 @@ func (p *TIncrement) TStructFields() thrift.TFieldContainer {
 	})
 }
 
+/**
+ * Holds column name and the cell.
+ * 
+ * Attributes:
+ *  - ColumnName
+ *  - Cell
+ */
+type TColumn struct {
+	ColumnName Text   "columnName" // 1
+	Cell       *TCell "cell"       // 2
+}
+
+var tstructTColumn = thrift.NewTStruct("TColumn", []thrift.TField{
+	thrift.NewTField("columnName", thrift.STRING, 1),
+	thrift.NewTField("cell", thrift.STRUCT, 2),
+})
+
+func (*TColumn) GetTStruct() thrift.TStruct { return tstructTColumn }
+func NewTColumn() *TColumn {
+	output := &TColumn{}
+	{
+	}
+	return output
+}
+
+func (p *TColumn) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTColumn.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTColumn.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TCell
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumn) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v66, err67 := iprot.ReadBinary()
+	if err67 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "columnName", p.ThriftName(), err67)
+	}
+	p.ColumnName = Text(v66)
+	return err
+}
+
+func (p *TColumn) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Cell = NewTCell()
+	err70 := p.Cell.Read(iprot)
+	if err70 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.CellTCell", err70)
+	}
+	return err
+}
+
+func (p *TColumn) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TColumn")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumn) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.ColumnName != nil {
+		err = oprot.WriteFieldBegin("columnName", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "columnName", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.ColumnName)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "columnName", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "columnName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumn) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Cell != nil {
+		err = oprot.WriteFieldBegin("cell", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "cell", p.ThriftName(), err)
+		}
+		err = p.Cell.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "cell", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumn) TStructName() string {
+	return "TColumn"
+}
+
+func (p *TColumn) ThriftName() string {
+	return "TColumn"
+}
+
+func (p *TColumn) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.ColumnName
+	case 2:
+		return p.Cell
+	}
+	return nil
+}
+
+func (p *TColumn) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("columnName", thrift.STRING, 1),
+		thrift.NewTField("cell", thrift.STRUCT, 2),
+	})
+}
+
 /**
  * Holds row name and then a map of columns to cells.
  * 
  * Attributes:
  *  - Row
  *  - Columns
+ *  - SortedColumns
  */
 type TRowResult struct {
-	Row     Text              "row"     // 1
-	Columns map[string]*TCell "columns" // 2
+	Row           Text              "row"           // 1
+	Columns       map[string]*TCell "columns"       // 2
+	SortedColumns []*TColumn        "sortedColumns" // 3
 }
 
 var tstructTRowResult = thrift.NewTStruct("TRowResult", []thrift.TField{
 	thrift.NewTField("row", thrift.STRING, 1),
 	thrift.NewTField("columns", thrift.MAP, 2),
+	thrift.NewTField("sortedColumns", thrift.LIST, 3),
 })
 
 func (*TRowResult) GetTStruct() thrift.TStruct { return tstructTRowResult }
@@ -1982,6 +2175,14 @@ func NewTRowResult() *TRowResult {
 	return output
 }
 
+func (p *TRowResult) IsSetColumns() bool {
+	return len(p.Columns) > 0
+}
+
+func (p *TRowResult) IsSetSortedColumns() bool {
+	return len(p.SortedColumns) > 0
+}
+
 func (p *TRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	_, err = iprot.ReadStructBegin()
 	if err != nil {
@@ -2024,6 +2225,18 @@ func (p *TRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException
 					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
 				}
 			}
+		case 3: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
 		default:
 			err = iprot.Skip(fieldTypeId)
 			if err != nil {
@@ -2043,33 +2256,33 @@ func (p *TRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException
 }
 
 func (p *TRowResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v66, err67 := iprot.ReadBinary()
-	if err67 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err67)
+	v71, err72 := iprot.ReadBinary()
+	if err72 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err72)
 	}
-	p.Row = Text(v66)
+	p.Row = Text(v71)
 	return err
 }
 
 func (p *TRowResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_ktype71, _vtype72, _size70, err := iprot.ReadMapBegin()
+	_ktype76, _vtype77, _size75, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_, _ = _ktype71, _vtype72
-	p.Columns = make(map[string]*TCell, _size70)
-	for i := 0; i < _size70; i++ {
-		v77, err78 := iprot.ReadString()
-		if err78 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_key75", "", err78)
+	_, _ = _ktype76, _vtype77
+	p.Columns = make(map[string]*TCell, _size75)
+	for i := 0; i < _size75; i++ {
+		v82, err83 := iprot.ReadString()
+		if err83 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key80", "", err83)
 		}
-		_key75 := v77
-		_val76 := NewTCell()
-		err81 := _val76.Read(iprot)
-		if err81 != nil {
-			return thrift.NewTProtocolExceptionReadStruct("_val76TCell", err81)
+		_key80 := v82
+		_val81 := NewTCell()
+		err86 := _val81.Read(iprot)
+		if err86 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_val81TCell", err86)
 		}
-		p.Columns[_key75] = _val76
+		p.Columns[_key80] = _val81
 	}
 	err = iprot.ReadMapEnd()
 	if err != nil {
@@ -2078,6 +2291,28 @@ func (p *TRowResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolExc
 	return err
 }
 
+func (p *TRowResult) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype92, _size89, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.SortedColumns", "", err)
+	}
+	_ = _etype92
+	p.SortedColumns = make([]*TColumn, _size89, _size89)
+	for i := 0; i < _size89; i++ {
+		_elem94 := NewTColumn()
+		err97 := _elem94.Read(iprot)
+		if err97 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem94TColumn", err97)
+		}
+		p.SortedColumns[i] = _elem94
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
 func (p *TRowResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
 	err = oprot.WriteStructBegin("TRowResult")
 	if err != nil {
@@ -2091,6 +2326,10 @@ func (p *TRowResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolExceptio
 	if err != nil {
 		return err
 	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
 	err = oprot.WriteFieldStop()
 	if err != nil {
 		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
@@ -2122,31 +2361,63 @@ func (p *TRowResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolEx
 
 func (p *TRowResult) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
 	if p.Columns != nil {
-		err = oprot.WriteFieldBegin("columns", thrift.MAP, 2)
-		if err != nil {
-			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
-		}
-		err = oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.Columns))
-		if err != nil {
-			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
-		}
-		for Kiter82, Viter83 := range p.Columns {
-			err = oprot.WriteString(Kiter82)
+		if p.IsSetColumns() {
+			err = oprot.WriteFieldBegin("columns", thrift.MAP, 2)
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteField(0, "Kiter82", "", err)
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
 			}
-			err = Viter83.Write(oprot)
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.Columns))
 			if err != nil {
-				return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter98, Viter99 := range p.Columns {
+				err = oprot.WriteString(Kiter98)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter98", "", err)
+				}
+				err = Viter99.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TCell", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
 			}
 		}
-		err = oprot.WriteMapEnd()
-		if err != nil {
-			return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
-		}
-		err = oprot.WriteFieldEnd()
-		if err != nil {
-			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TRowResult) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.SortedColumns != nil {
+		if p.IsSetSortedColumns() {
+			err = oprot.WriteFieldBegin("sortedColumns", thrift.LIST, 3)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "sortedColumns", p.ThriftName(), err)
+			}
+			err = oprot.WriteListBegin(thrift.STRUCT, len(p.SortedColumns))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			for _, Iter100 := range p.SortedColumns {
+				err = Iter100.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TColumn", err)
+				}
+			}
+			err = oprot.WriteListEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "sortedColumns", p.ThriftName(), err)
+			}
 		}
 	}
 	return err
@@ -2168,6 +2439,8 @@ func (p *TRowResult) AttributeByFieldId(id int) interface{} {
 		return p.Row
 	case 2:
 		return p.Columns
+	case 3:
+		return p.SortedColumns
 	}
 	return nil
 }
@@ -2176,6 +2449,7 @@ func (p *TRowResult) TStructFields() thrift.TFieldContainer {
 	return thrift.NewTFieldContainer([]thrift.TField{
 		thrift.NewTField("row", thrift.STRING, 1),
 		thrift.NewTField("columns", thrift.MAP, 2),
+		thrift.NewTField("sortedColumns", thrift.LIST, 3),
 	})
 }
 
@@ -2189,6 +2463,10 @@ func (p *TRowResult) TStructFields() thrift.TFieldContainer {
  *  - Columns
  *  - Caching
  *  - FilterString
+ *  - BatchSize
+ *  - SortColumns
+ *  - Reversed
+ *  - CacheBlocks
  */
 type TScan struct {
 	StartRow     Text   "startRow"     // 1
@@ -2197,6 +2475,10 @@ type TScan struct {
 	Columns      []Text "columns"      // 4
 	Caching      int32  "caching"      // 5
 	FilterString Text   "filterString" // 6
+	BatchSize    int32  "batchSize"    // 7
+	SortColumns  bool   "sortColumns"  // 8
+	Reversed     bool   "reversed"     // 9
+	CacheBlocks  bool   "cacheBlocks"  // 10
 }
 
 var tstructTScan = thrift.NewTStruct("TScan", []thrift.TField{
@@ -2206,6 +2488,10 @@ var tstructTScan = thrift.NewTStruct("TScan", []thrift.TField{
 	thrift.NewTField("columns", thrift.LIST, 4),
 	thrift.NewTField("caching", thrift.I32, 5),
 	thrift.NewTField("filterString", thrift.STRING, 6),
+	thrift.NewTField("batchSize", thrift.I32, 7),
+	thrift.NewTField("sortColumns", thrift.BOOL, 8),
+	thrift.NewTField("reversed", thrift.BOOL, 9),
+	thrift.NewTField("cacheBlocks", thrift.BOOL, 10),
 })
 
 func (*TScan) GetTStruct() thrift.TStruct { return tstructTScan }
@@ -2240,6 +2526,22 @@ func (p *TScan) IsSetFilterString() bool {
 	return p.FilterString != nil
 }
 
+func (p *TScan) IsSetBatchSize() bool {
+	return p.BatchSize != 0
+}
+
+func (p *TScan) IsSetSortColumns() bool {
+	return p.SortColumns != false
+}
+
+func (p *TScan) IsSetReversed() bool {
+	return p.Reversed != false
+}
+
+func (p *TScan) IsSetCacheBlocks() bool {
+	return p.CacheBlocks != false
+}
+
 func (p *TScan) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 	_, err = iprot.ReadStructBegin()
 	if err != nil {
@@ -2330,65 +2632,113 @@ func (p *TScan) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
 				}
 			}
-		default:
-			err = iprot.Skip(fieldTypeId)
-			if err != nil {
-				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		case 7: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
 			}
-		} //switch
-		err = iprot.ReadFieldEnd()
-		if err != nil {
-			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
-		}
-	}
-	err = iprot.ReadStructEnd()
-	if err != nil {
-		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
-	}
-	return err
-}
-
-func (p *TScan) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v84, err85 := iprot.ReadBinary()
-	if err85 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "startRow", p.ThriftName(), err85)
-	}
-	p.StartRow = Text(v84)
-	return err
-}
-
-func (p *TScan) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v86, err87 := iprot.ReadBinary()
-	if err87 != nil {
-		return thrift.NewTProtocolExceptionReadField(2, "stopRow", p.ThriftName(), err87)
-	}
-	p.StopRow = Text(v86)
-	return err
-}
+		case 8: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField8(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 9: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField9(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 10: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField10(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TScan) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v101, err102 := iprot.ReadBinary()
+	if err102 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "startRow", p.ThriftName(), err102)
+	}
+	p.StartRow = Text(v101)
+	return err
+}
+
+func (p *TScan) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v103, err104 := iprot.ReadBinary()
+	if err104 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "stopRow", p.ThriftName(), err104)
+	}
+	p.StopRow = Text(v103)
+	return err
+}
 
 func (p *TScan) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v88, err89 := iprot.ReadI64()
-	if err89 != nil {
-		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err89)
+	v105, err106 := iprot.ReadI64()
+	if err106 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err106)
 	}
-	p.Timestamp = v88
+	p.Timestamp = v105
 	return err
 }
 
 func (p *TScan) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	_etype95, _size92, err := iprot.ReadListBegin()
+	_etype112, _size109, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
 	}
-	_ = _etype95
-	p.Columns = make([]Text, _size92, _size92)
-	for i := 0; i < _size92; i++ {
-		v98, err99 := iprot.ReadBinary()
-		if err99 != nil {
-			return thrift.NewTProtocolExceptionReadField(0, "_elem97", "", err99)
+	_ = _etype112
+	p.Columns = make([]Text, _size109, _size109)
+	for i := 0; i < _size109; i++ {
+		v115, err116 := iprot.ReadBinary()
+		if err116 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem114", "", err116)
 		}
-		_elem97 := Text(v98)
-		p.Columns[i] = _elem97
+		_elem114 := Text(v115)
+		p.Columns[i] = _elem114
 	}
 	err = iprot.ReadListEnd()
 	if err != nil {
@@ -2398,20 +2748,56 @@ func (p *TScan) readField4(iprot thrift.TProtocol) (err thrift.TProtocolExceptio
 }
 
 func (p *TScan) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v100, err101 := iprot.ReadI32()
-	if err101 != nil {
-		return thrift.NewTProtocolExceptionReadField(5, "caching", p.ThriftName(), err101)
+	v117, err118 := iprot.ReadI32()
+	if err118 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "caching", p.ThriftName(), err118)
 	}
-	p.Caching = v100
+	p.Caching = v117
 	return err
 }
 
 func (p *TScan) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v102, err103 := iprot.ReadBinary()
-	if err103 != nil {
-		return thrift.NewTProtocolExceptionReadField(6, "filterString", p.ThriftName(), err103)
+	v119, err120 := iprot.ReadBinary()
+	if err120 != nil {
+		return thrift.NewTProtocolExceptionReadField(6, "filterString", p.ThriftName(), err120)
 	}
-	p.FilterString = Text(v102)
+	p.FilterString = Text(v119)
+	return err
+}
+
+func (p *TScan) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v121, err122 := iprot.ReadI32()
+	if err122 != nil {
+		return thrift.NewTProtocolExceptionReadField(7, "batchSize", p.ThriftName(), err122)
+	}
+	p.BatchSize = v121
+	return err
+}
+
+func (p *TScan) readField8(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v123, err124 := iprot.ReadBool()
+	if err124 != nil {
+		return thrift.NewTProtocolExceptionReadField(8, "sortColumns", p.ThriftName(), err124)
+	}
+	p.SortColumns = v123
+	return err
+}
+
+func (p *TScan) readField9(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v125, err126 := iprot.ReadBool()
+	if err126 != nil {
+		return thrift.NewTProtocolExceptionReadField(9, "reversed", p.ThriftName(), err126)
+	}
+	p.Reversed = v125
+	return err
+}
+
+func (p *TScan) readField10(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v127, err128 := iprot.ReadBool()
+	if err128 != nil {
+		return thrift.NewTProtocolExceptionReadField(10, "cacheBlocks", p.ThriftName(), err128)
+	}
+	p.CacheBlocks = v127
 	return err
 }
 
@@ -2444,6 +2830,22 @@ func (p *TScan) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
 	if err != nil {
 		return err
 	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField8(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField9(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField10(oprot)
+	if err != nil {
+		return err
+	}
 	err = oprot.WriteFieldStop()
 	if err != nil {
 		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
@@ -2524,10 +2926,10 @@ func (p *TScan) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolExcepti
 			if err != nil {
 				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
 			}
-			for _, Iter104 := range p.Columns {
-				err = oprot.WriteBinary(Iter104)
+			for _, Iter129 := range p.Columns {
+				err = oprot.WriteBinary(Iter129)
 				if err != nil {
-					return thrift.NewTProtocolExceptionWriteField(0, "Iter104", "", err)
+					return thrift.NewTProtocolExceptionWriteField(0, "Iter129", "", err)
 				}
 			}
 			err = oprot.WriteListEnd()
@@ -2581,6 +2983,78 @@ func (p *TScan) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolExcepti
 	return err
 }
 
+func (p *TScan) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetBatchSize() {
+		err = oprot.WriteFieldBegin("batchSize", thrift.I32, 7)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "batchSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.BatchSize))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "batchSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "batchSize", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField8(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetSortColumns() {
+		err = oprot.WriteFieldBegin("sortColumns", thrift.BOOL, 8)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "sortColumns", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(p.SortColumns))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "sortColumns", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "sortColumns", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField9(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetReversed() {
+		err = oprot.WriteFieldBegin("reversed", thrift.BOOL, 9)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "reversed", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(p.Reversed))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "reversed", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "reversed", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField10(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCacheBlocks() {
+		err = oprot.WriteFieldBegin("cacheBlocks", thrift.BOOL, 10)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "cacheBlocks", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(p.CacheBlocks))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "cacheBlocks", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "cacheBlocks", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
 func (p *TScan) TStructName() string {
 	return "TScan"
 }
@@ -2605,6 +3079,14 @@ func (p *TScan) AttributeByFieldId(id int) interface{} {
 		return p.Caching
 	case 6:
 		return p.FilterString
+	case 7:
+		return p.BatchSize
+	case 8:
+		return p.SortColumns
+	case 9:
+		return p.Reversed
+	case 10:
+		return p.CacheBlocks
 	}
 	return nil
 }
@@ -2617,6 +3099,344 @@ func (p *TScan) TStructFields() thrift.TFieldContainer {
 		thrift.NewTField("columns", thrift.LIST, 4),
 		thrift.NewTField("caching", thrift.I32, 5),
 		thrift.NewTField("filterString", thrift.STRING, 6),
+		thrift.NewTField("batchSize", thrift.I32, 7),
+		thrift.NewTField("sortColumns", thrift.BOOL, 8),
+		thrift.NewTField("reversed", thrift.BOOL, 9),
+		thrift.NewTField("cacheBlocks", thrift.BOOL, 10),
+	})
+}
+
+/**
+ * An Append object is used to specify the parameters for performing the append operation.
+ * 
+ * Attributes:
+ *  - Table
+ *  - Row
+ *  - Columns
+ *  - Values
+ */
+type TAppend struct {
+	Table   Text   "table"   // 1
+	Row     Text   "row"     // 2
+	Columns []Text "columns" // 3
+	Values  []Text "values"  // 4
+}
+
+var tstructTAppend = thrift.NewTStruct("TAppend", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("row", thrift.STRING, 2),
+	thrift.NewTField("columns", thrift.LIST, 3),
+	thrift.NewTField("values", thrift.LIST, 4),
+})
+
+func (*TAppend) GetTStruct() thrift.TStruct { return tstructTAppend }
+func NewTAppend() *TAppend {
+	output := &TAppend{}
+	{
+	}
+	return output
+}
+
+func (p *TAppend) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTAppend.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTAppend.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //Text
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TAppend) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v130, err131 := iprot.ReadBinary()
+	if err131 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err131)
+	}
+	p.Table = Text(v130)
+	return err
+}
+
+func (p *TAppend) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v132, err133 := iprot.ReadBinary()
+	if err133 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err133)
+	}
+	p.Row = Text(v132)
+	return err
+}
+
+func (p *TAppend) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype139, _size136, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype139
+	p.Columns = make([]Text, _size136, _size136)
+	for i := 0; i < _size136; i++ {
+		v142, err143 := iprot.ReadBinary()
+		if err143 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem141", "", err143)
+		}
+		_elem141 := Text(v142)
+		p.Columns[i] = _elem141
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TAppend) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype149, _size146, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Values", "", err)
+	}
+	_ = _etype149
+	p.Values = make([]Text, _size146, _size146)
+	for i := 0; i < _size146; i++ {
+		v152, err153 := iprot.ReadBinary()
+		if err153 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem151", "", err153)
+		}
+		_elem151 := Text(v152)
+		p.Values[i] = _elem151
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TAppend) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TAppend")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TAppend) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		err = oprot.WriteFieldBegin("columns", thrift.LIST, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "columns", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRING, len(p.Columns))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter154 := range p.Columns {
+			err = oprot.WriteBinary(Iter154)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter154", "", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "columns", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Values != nil {
+		err = oprot.WriteFieldBegin("values", thrift.LIST, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "values", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRING, len(p.Values))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter155 := range p.Values {
+			err = oprot.WriteBinary(Iter155)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter155", "", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "values", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) TStructName() string {
+	return "TAppend"
+}
+
+func (p *TAppend) ThriftName() string {
+	return "TAppend"
+}
+
+func (p *TAppend) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Row
+	case 3:
+		return p.Columns
+	case 4:
+		return p.Values
+	}
+	return nil
+}
+
+func (p *TAppend) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("row", thrift.STRING, 2),
+		thrift.NewTField("columns", thrift.LIST, 3),
+		thrift.NewTField("values", thrift.LIST, 4),
 	})
 }
 
@@ -2693,11 +3513,11 @@ func (p *IOError) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
 }
 
 func (p *IOError) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v105, err106 := iprot.ReadString()
-	if err106 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err106)
+	v156, err157 := iprot.ReadString()
+	if err157 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err157)
 	}
-	p.Message = v105
+	p.Message = v156
 	return err
 }
 
@@ -2833,11 +3653,11 @@ func (p *IllegalArgument) Read(iprot thrift.TProtocol) (err thrift.TProtocolExce
 }
 
 func (p *IllegalArgument) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v107, err108 := iprot.ReadString()
-	if err108 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err108)
+	v158, err159 := iprot.ReadString()
+	if err159 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err159)
 	}
-	p.Message = v107
+	p.Message = v158
 	return err
 }
 
@@ -2973,11 +3793,11 @@ func (p *AlreadyExists) Read(iprot thrift.TProtocol) (err thrift.TProtocolExcept
 }
 
 func (p *AlreadyExists) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
-	v109, err110 := iprot.ReadString()
-	if err110 != nil {
-		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err110)
+	v160, err161 := iprot.ReadString()
+	if err161 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err161)
 	}
-	p.Message = v109
+	p.Message = v160
 	return err
 }
 
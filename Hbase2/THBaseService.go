@@ -0,0 +1,16323 @@
+/* Autogenerated by Thrift Compiler (0.9.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ */
+package Hbase2
+
+import (
+	"fmt"
+	"github.com/sdming/goh/thrift"
+	"math"
+)
+
+// This is a temporary safety measure to ensure that the `math'
+// import does not trip up any generated output that may not
+// happen to use the math import due to not having emited enums.
+//
+// Future clean-ups will deprecate the need for this.
+var _ = fmt.Print
+var _ = math.MinInt32
+
+type ITHBaseService interface {
+	/**
+	 * Test for the existence of columns in the table, as specified in the
+	 * TGet.
+	 *
+	 * @return true if the specified TGet matches one or more keys, false if
+	 * not
+	 *
+	 * Parameters:
+	 *  - Table: the table to check on
+	 *  - Tget: the TGet to check for
+	 */
+	Exists(table []byte, tget *TGet) (retval283 bool, io *TIOError, err error)
+	/**
+	 * Method for getting data from a row.
+	 *
+	 * If the row cannot be found an empty Result is returned. This can be
+	 * checked by the empty field of the TResult
+	 *
+	 * @return the result
+	 *
+	 * Parameters:
+	 *  - Table: the table to get from
+	 *  - Tget: the TGet to fetch
+	 */
+	Get(table []byte, tget *TGet) (retval284 *TResult, io *TIOError, err error)
+	/**
+	 * Method for getting multiple rows.
+	 *
+	 * If a row cannot be found there will be a null value in the result list
+	 * for that TGet at the same position.
+	 *
+	 * So the Results are in the same order as the TGets.
+	 *
+	 * Parameters:
+	 *  - Table: the table to get from
+	 *  - Tgets: a list of TGets to fetch, the Result list will have the Results at corresponding positions or null if there was an error
+	 */
+	GetMultiple(table []byte, tgets []*TGet) (retval285 []*TResult, io *TIOError, err error)
+	/**
+	 * Commit a TPut to a table.
+	 *
+	 * Parameters:
+	 *  - Table: the table to put data in
+	 *  - Tput: the TPut to put
+	 */
+	Put(table []byte, tput *TPut) (io *TIOError, err error)
+	/**
+	 * Atomically checks if a row/family/qualifier value matches the expected
+	 * value. If it does, it adds the TPut.
+	 *
+	 * @return true if the new put was executed, false otherwise
+	 *
+	 * Parameters:
+	 *  - Table: to check in and put to
+	 *  - Row: row to check
+	 *  - Family: column family to check
+	 *  - Qualifier: column qualifier to check
+	 *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+	 *  - Tput: the TPut to put if the check succeeds
+	 */
+	CheckAndPut(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tput *TPut) (retval287 bool, io *TIOError, err error)
+	/**
+	 * Commit a List of Puts to the table.
+	 *
+	 * Parameters:
+	 *  - Table: the table to put data in
+	 *  - Tputs: a list of TPuts to commit
+	 */
+	PutMultiple(table []byte, tputs []*TPut) (io *TIOError, err error)
+	/**
+	 * Deletes as specified by the TDelete.
+	 *
+	 * Note: "delete" is a reserved keyword and cannot be used in Thrift thus
+	 * the inconsistent naming scheme from the other functions.
+	 *
+	 * Parameters:
+	 *  - Table: the table to delete from
+	 *  - Tdelete: the TDelete to delete
+	 */
+	DeleteSingle(table []byte, tdelete *TDelete) (io *TIOError, err error)
+	/**
+	 * Bulk commit a List of TDeletes to the table.
+	 *
+	 * Throws a TIOError if any of the deletes fail.
+	 *
+	 * Always returns an empty list for backwards compatibility.
+	 *
+	 * Parameters:
+	 *  - Table: the table to delete from
+	 *  - Tdeletes: list of TDeletes to delete
+	 */
+	DeleteMultiple(table []byte, tdeletes []*TDelete) (retval290 []*TDelete, io *TIOError, err error)
+	/**
+	 * Atomically checks if a row/family/qualifier value matches the expected
+	 * value. If it does, it adds the delete.
+	 *
+	 * @return true if the new delete was executed, false otherwise
+	 *
+	 * Parameters:
+	 *  - Table: to check in and delete from
+	 *  - Row: row to check
+	 *  - Family: column family to check
+	 *  - Qualifier: column qualifier to check
+	 *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+	 *  - Tdelete: the TDelete to execute if the check succeeds
+	 */
+	CheckAndDelete(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tdelete *TDelete) (retval291 bool, io *TIOError, err error)
+	/**
+	 * Parameters:
+	 *  - Table: the table to increment the value on
+	 *  - Tincrement: the TIncrement to increment
+	 */
+	Increment(table []byte, tincrement *TIncrement) (retval292 *TResult, io *TIOError, err error)
+	/**
+	 * Parameters:
+	 *  - Table: the table to append the value on
+	 *  - Tappend: the TAppend to append
+	 */
+	Append(table []byte, tappend *TAppend) (retval293 *TResult, io *TIOError, err error)
+	/**
+	 * Get a Scanner for the provided TScan object.
+	 *
+	 * @return Scanner Id to be used with other scanner procedures
+	 *
+	 * Parameters:
+	 *  - Table: the table to get the Scanner for
+	 *  - Tscan: the scan object to get a Scanner for
+	 */
+	OpenScanner(table []byte, tscan *TScan) (retval294 int32, io *TIOError, err error)
+	/**
+	 * Grabs multiple rows from a Scanner.
+	 *
+	 * @return Between zero and numRows TResults
+	 *
+	 * Parameters:
+	 *  - ScannerId: the Id of the Scanner to return rows from. This is an Id returned from the openScanner function.
+	 *  - NumRows: number of rows to return
+	 */
+	GetScannerRows(scannerId int32, numRows int32) (retval295 []*TResult, io *TIOError, ia *TIllegalArgument, err error)
+	/**
+	 * Closes the scanner. Should be called to free server side resources
+	 * timely. Typically close once the scanner is not needed anymore, i.e.
+	 * after looping over it to get all the required rows.
+	 *
+	 * Parameters:
+	 *  - ScannerId: the Id of the Scanner to close *
+	 */
+	CloseScanner(scannerId int32) (io *TIOError, ia *TIllegalArgument, err error)
+	/**
+	 * mutateRow performs multiple mutations atomically on a single row.
+	 *
+	 * Parameters:
+	 *  - Table: table to apply the mutations
+	 *  - TrowMutations: mutations to apply
+	 */
+	MutateRow(table []byte, trowMutations *TRowMutations) (io *TIOError, err error)
+	/**
+	 * Get results for the provided TScan object. This helper function opens a
+	 * scanner, get the results and close the scanner.
+	 *
+	 * @return between zero and numRows TResults
+	 *
+	 * Parameters:
+	 *  - Table: the table to get the Scanner for
+	 *  - Tscan: the scan object to get a Scanner for
+	 *  - NumRows: number of rows to return
+	 */
+	GetScannerResults(table []byte, tscan *TScan, numRows int32) (retval298 []*TResult, io *TIOError, err error)
+	/**
+	 * Get a table descriptor.
+	 *
+	 * @return the TableDescriptor of the giving tablename
+	 *
+	 * Parameters:
+	 *  - Table: the tablename of the table to get tableDescriptor
+	 */
+	GetTableDescriptor(table *TTableName) (retval299 *TTableDescriptor, io *TIOError, err error)
+	/**
+	 * Get table descriptors of tables.
+	 *
+	 * @return the TableDescriptor of the giving tablename
+	 *
+	 * Parameters:
+	 *  - Tables: the tablename list of the tables to get tableDescriptor
+	 */
+	GetTableDescriptors(tables []*TTableName) (retval300 []*TTableDescriptor, io *TIOError, err error)
+	/**
+	 * @return true if table exists already, false if not
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename of the tables to check
+	 */
+	TableExists(tableName *TTableName) (retval301 bool, io *TIOError, err error)
+	/**
+	 * Creates a new table with an initial set of empty regions defined by the
+	 * specified split keys. The total number of regions created will be the
+	 * number of split keys plus one. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - Desc: table descriptor for table
+	 *  - SplitKeys: array of split keys for the initial regions of the table
+	 */
+	CreateTable(desc *TTableDescriptor, splitKeys [][]byte) (io *TIOError, err error)
+	/**
+	 * Deletes a table. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to delete
+	 */
+	DeleteTable(tableName *TTableName) (io *TIOError, err error)
+	/**
+	 * Truncate a table. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to truncate
+	 *  - PreserveSplits: whether to preserve previous splits
+	 */
+	TruncateTable(tableName *TTableName, preserveSplits bool) (io *TIOError, err error)
+	/**
+	 * Enable a table
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to enable
+	 */
+	EnableTable(tableName *TTableName) (io *TIOError, err error)
+	/**
+	 * Disable a table
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to disable
+	 */
+	DisableTable(tableName *TTableName) (io *TIOError, err error)
+	/**
+	 * @return true if table is enabled, false if not
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to check
+	 */
+	IsTableEnabled(tableName *TTableName) (retval307 bool, io *TIOError, err error)
+	/**
+	 * Add a column family to an existing table. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to add column family to
+	 *  - Column: column family descriptor of column family to be added
+	 */
+	AddColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (io *TIOError, err error)
+	/**
+	 * Delete a column family from a table. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to delete column family from
+	 *  - Column: name of column family to be deleted
+	 */
+	DeleteColumnFamily(tableName *TTableName, column []byte) (io *TIOError, err error)
+	/**
+	 * Modify an existing column family on a table. Synchronous operation.
+	 *
+	 * Parameters:
+	 *  - TableName: the tablename to modify column family
+	 *  - Column: column family descriptor of column family to be modified
+	 */
+	ModifyColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (io *TIOError, err error)
+	/**
+	 * Modify an existing table
+	 *
+	 * Parameters:
+	 *  - Desc: the descriptor of the table to modify
+	 */
+	ModifyTable(desc *TTableDescriptor) (io *TIOError, err error)
+	/**
+	 * Create a new namespace. Blocks until namespace has been successfully
+	 * created or an exception is thrown
+	 *
+	 * Parameters:
+	 *  - NamespaceDesc: descriptor which describes the new namespace
+	 */
+	CreateNamespace(namespaceDesc *TNamespaceDescriptor) (io *TIOError, err error)
+	/**
+	 * Modify an existing namespace. Blocks until namespace has been
+	 * successfully modified or an exception is thrown
+	 *
+	 * Parameters:
+	 *  - NamespaceDesc: descriptor which describes the new namespace
+	 */
+	ModifyNamespace(namespaceDesc *TNamespaceDescriptor) (io *TIOError, err error)
+	/**
+	 * Delete an existing namespace. Only empty namespaces (no tables) can be
+	 * removed. Blocks until namespace has been successfully deleted or an
+	 * exception is thrown.
+	 *
+	 * Parameters:
+	 *  - Name: namespace name
+	 */
+	DeleteNamespace(name string) (io *TIOError, err error)
+	/**
+	 * Get a namespace descriptor by name.
+	 *
+	 * @return descriptor
+	 *
+	 * Parameters:
+	 *  - Name: name of namespace descriptor
+	 */
+	GetNamespaceDescriptor(name string) (retval315 *TNamespaceDescriptor, io *TIOError, err error)
+	/**
+	 * @return all namespaces
+	 */
+	ListNamespaceDescriptors() (retval316 []*TNamespaceDescriptor, io *TIOError, err error)
+}
+
+type THBaseServiceClient struct {
+	Transport       thrift.TTransport
+	ProtocolFactory thrift.TProtocolFactory
+	InputProtocol   thrift.TProtocol
+	OutputProtocol  thrift.TProtocol
+	SeqId           int32
+}
+
+func NewTHBaseServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *THBaseServiceClient {
+	return &THBaseServiceClient{Transport: t,
+		ProtocolFactory: f,
+		InputProtocol:   f.GetProtocol(t),
+		OutputProtocol:  f.GetProtocol(t),
+		SeqId:           0,
+	}
+}
+
+func NewTHBaseServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *THBaseServiceClient {
+	return &THBaseServiceClient{Transport: t,
+		ProtocolFactory: nil,
+		InputProtocol:   iprot,
+		OutputProtocol:  oprot,
+		SeqId:           0,
+	}
+}
+
+/**
+ * Test for the existence of columns in the table, as specified in the
+ * TGet.
+ *
+ * @return true if the specified TGet matches one or more keys, false if
+ * not
+ *
+ * Parameters:
+ *  - Table: the table to check on
+ *  - Tget: the TGet to check for
+ */
+func (p *THBaseServiceClient) Exists(table []byte, tget *TGet) (retval317 bool, io *TIOError, err error) {
+	err = p.SendExists(table, tget)
+	if err != nil {
+		return
+	}
+	return p.RecvExists()
+}
+
+func (p *THBaseServiceClient) SendExists(table []byte, tget *TGet) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("exists", thrift.CALL, p.SeqId)
+	args318 := NewExistsArgs()
+	args318.Table = table
+	args318.Tget = tget
+	err = args318.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvExists() (value bool, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error320 := thrift.NewTApplicationExceptionDefault()
+		var error321 error
+		error321, err = error320.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error321
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result319 := NewExistsResult()
+	err = result319.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result319.Success
+	if result319.Io != nil {
+		io = result319.Io
+	}
+	return
+}
+
+/**
+ * Method for getting data from a row.
+ *
+ * If the row cannot be found an empty Result is returned. This can be
+ * checked by the empty field of the TResult
+ *
+ * @return the result
+ *
+ * Parameters:
+ *  - Table: the table to get from
+ *  - Tget: the TGet to fetch
+ */
+func (p *THBaseServiceClient) Get(table []byte, tget *TGet) (retval322 *TResult, io *TIOError, err error) {
+	err = p.SendGet(table, tget)
+	if err != nil {
+		return
+	}
+	return p.RecvGet()
+}
+
+func (p *THBaseServiceClient) SendGet(table []byte, tget *TGet) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("get", thrift.CALL, p.SeqId)
+	args323 := NewGetArgs()
+	args323.Table = table
+	args323.Tget = tget
+	err = args323.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGet() (value *TResult, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error325 := thrift.NewTApplicationExceptionDefault()
+		var error326 error
+		error326, err = error325.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error326
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result324 := NewGetResult()
+	err = result324.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result324.Success
+	if result324.Io != nil {
+		io = result324.Io
+	}
+	return
+}
+
+/**
+ * Method for getting multiple rows.
+ *
+ * If a row cannot be found there will be a null value in the result list
+ * for that TGet at the same position.
+ *
+ * So the Results are in the same order as the TGets.
+ *
+ * Parameters:
+ *  - Table: the table to get from
+ *  - Tgets: a list of TGets to fetch, the Result list will have the Results at corresponding positions or null if there was an error
+ */
+func (p *THBaseServiceClient) GetMultiple(table []byte, tgets []*TGet) (retval327 []*TResult, io *TIOError, err error) {
+	err = p.SendGetMultiple(table, tgets)
+	if err != nil {
+		return
+	}
+	return p.RecvGetMultiple()
+}
+
+func (p *THBaseServiceClient) SendGetMultiple(table []byte, tgets []*TGet) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getMultiple", thrift.CALL, p.SeqId)
+	args328 := NewGetMultipleArgs()
+	args328.Table = table
+	args328.Tgets = tgets
+	err = args328.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetMultiple() (value []*TResult, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error330 := thrift.NewTApplicationExceptionDefault()
+		var error331 error
+		error331, err = error330.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error331
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result329 := NewGetMultipleResult()
+	err = result329.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result329.Success
+	if result329.Io != nil {
+		io = result329.Io
+	}
+	return
+}
+
+/**
+ * Commit a TPut to a table.
+ *
+ * Parameters:
+ *  - Table: the table to put data in
+ *  - Tput: the TPut to put
+ */
+func (p *THBaseServiceClient) Put(table []byte, tput *TPut) (io *TIOError, err error) {
+	err = p.SendPut(table, tput)
+	if err != nil {
+		return
+	}
+	return p.RecvPut()
+}
+
+func (p *THBaseServiceClient) SendPut(table []byte, tput *TPut) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("put", thrift.CALL, p.SeqId)
+	args333 := NewPutArgs()
+	args333.Table = table
+	args333.Tput = tput
+	err = args333.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvPut() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error335 := thrift.NewTApplicationExceptionDefault()
+		var error336 error
+		error336, err = error335.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error336
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result334 := NewPutResult()
+	err = result334.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result334.Io != nil {
+		io = result334.Io
+	}
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the TPut.
+ *
+ * @return true if the new put was executed, false otherwise
+ *
+ * Parameters:
+ *  - Table: to check in and put to
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+ *  - Tput: the TPut to put if the check succeeds
+ */
+func (p *THBaseServiceClient) CheckAndPut(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tput *TPut) (retval337 bool, io *TIOError, err error) {
+	err = p.SendCheckAndPut(table, row, family, qualifier, value, tput)
+	if err != nil {
+		return
+	}
+	return p.RecvCheckAndPut()
+}
+
+func (p *THBaseServiceClient) SendCheckAndPut(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tput *TPut) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("checkAndPut", thrift.CALL, p.SeqId)
+	args338 := NewCheckAndPutArgs()
+	args338.Table = table
+	args338.Row = row
+	args338.Family = family
+	args338.Qualifier = qualifier
+	args338.Value = value
+	args338.Tput = tput
+	err = args338.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvCheckAndPut() (value bool, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error340 := thrift.NewTApplicationExceptionDefault()
+		var error341 error
+		error341, err = error340.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error341
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result339 := NewCheckAndPutResult()
+	err = result339.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result339.Success
+	if result339.Io != nil {
+		io = result339.Io
+	}
+	return
+}
+
+/**
+ * Commit a List of Puts to the table.
+ *
+ * Parameters:
+ *  - Table: the table to put data in
+ *  - Tputs: a list of TPuts to commit
+ */
+func (p *THBaseServiceClient) PutMultiple(table []byte, tputs []*TPut) (io *TIOError, err error) {
+	err = p.SendPutMultiple(table, tputs)
+	if err != nil {
+		return
+	}
+	return p.RecvPutMultiple()
+}
+
+func (p *THBaseServiceClient) SendPutMultiple(table []byte, tputs []*TPut) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("putMultiple", thrift.CALL, p.SeqId)
+	args343 := NewPutMultipleArgs()
+	args343.Table = table
+	args343.Tputs = tputs
+	err = args343.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvPutMultiple() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error345 := thrift.NewTApplicationExceptionDefault()
+		var error346 error
+		error346, err = error345.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error346
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result344 := NewPutMultipleResult()
+	err = result344.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result344.Io != nil {
+		io = result344.Io
+	}
+	return
+}
+
+/**
+ * Deletes as specified by the TDelete.
+ *
+ * Note: "delete" is a reserved keyword and cannot be used in Thrift thus
+ * the inconsistent naming scheme from the other functions.
+ *
+ * Parameters:
+ *  - Table: the table to delete from
+ *  - Tdelete: the TDelete to delete
+ */
+func (p *THBaseServiceClient) DeleteSingle(table []byte, tdelete *TDelete) (io *TIOError, err error) {
+	err = p.SendDeleteSingle(table, tdelete)
+	if err != nil {
+		return
+	}
+	return p.RecvDeleteSingle()
+}
+
+func (p *THBaseServiceClient) SendDeleteSingle(table []byte, tdelete *TDelete) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("deleteSingle", thrift.CALL, p.SeqId)
+	args348 := NewDeleteSingleArgs()
+	args348.Table = table
+	args348.Tdelete = tdelete
+	err = args348.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDeleteSingle() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error350 := thrift.NewTApplicationExceptionDefault()
+		var error351 error
+		error351, err = error350.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error351
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result349 := NewDeleteSingleResult()
+	err = result349.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result349.Io != nil {
+		io = result349.Io
+	}
+	return
+}
+
+/**
+ * Bulk commit a List of TDeletes to the table.
+ *
+ * Throws a TIOError if any of the deletes fail.
+ *
+ * Always returns an empty list for backwards compatibility.
+ *
+ * Parameters:
+ *  - Table: the table to delete from
+ *  - Tdeletes: list of TDeletes to delete
+ */
+func (p *THBaseServiceClient) DeleteMultiple(table []byte, tdeletes []*TDelete) (retval352 []*TDelete, io *TIOError, err error) {
+	err = p.SendDeleteMultiple(table, tdeletes)
+	if err != nil {
+		return
+	}
+	return p.RecvDeleteMultiple()
+}
+
+func (p *THBaseServiceClient) SendDeleteMultiple(table []byte, tdeletes []*TDelete) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("deleteMultiple", thrift.CALL, p.SeqId)
+	args353 := NewDeleteMultipleArgs()
+	args353.Table = table
+	args353.Tdeletes = tdeletes
+	err = args353.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDeleteMultiple() (value []*TDelete, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error355 := thrift.NewTApplicationExceptionDefault()
+		var error356 error
+		error356, err = error355.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error356
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result354 := NewDeleteMultipleResult()
+	err = result354.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result354.Success
+	if result354.Io != nil {
+		io = result354.Io
+	}
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the delete.
+ *
+ * @return true if the new delete was executed, false otherwise
+ *
+ * Parameters:
+ *  - Table: to check in and delete from
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+ *  - Tdelete: the TDelete to execute if the check succeeds
+ */
+func (p *THBaseServiceClient) CheckAndDelete(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tdelete *TDelete) (retval357 bool, io *TIOError, err error) {
+	err = p.SendCheckAndDelete(table, row, family, qualifier, value, tdelete)
+	if err != nil {
+		return
+	}
+	return p.RecvCheckAndDelete()
+}
+
+func (p *THBaseServiceClient) SendCheckAndDelete(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tdelete *TDelete) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("checkAndDelete", thrift.CALL, p.SeqId)
+	args358 := NewCheckAndDeleteArgs()
+	args358.Table = table
+	args358.Row = row
+	args358.Family = family
+	args358.Qualifier = qualifier
+	args358.Value = value
+	args358.Tdelete = tdelete
+	err = args358.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvCheckAndDelete() (value bool, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error360 := thrift.NewTApplicationExceptionDefault()
+		var error361 error
+		error361, err = error360.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error361
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result359 := NewCheckAndDeleteResult()
+	err = result359.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result359.Success
+	if result359.Io != nil {
+		io = result359.Io
+	}
+	return
+}
+
+/**
+ * Parameters:
+ *  - Table: the table to increment the value on
+ *  - Tincrement: the TIncrement to increment
+ */
+func (p *THBaseServiceClient) Increment(table []byte, tincrement *TIncrement) (retval362 *TResult, io *TIOError, err error) {
+	err = p.SendIncrement(table, tincrement)
+	if err != nil {
+		return
+	}
+	return p.RecvIncrement()
+}
+
+func (p *THBaseServiceClient) SendIncrement(table []byte, tincrement *TIncrement) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("increment", thrift.CALL, p.SeqId)
+	args363 := NewIncrementArgs()
+	args363.Table = table
+	args363.Tincrement = tincrement
+	err = args363.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvIncrement() (value *TResult, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error365 := thrift.NewTApplicationExceptionDefault()
+		var error366 error
+		error366, err = error365.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error366
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result364 := NewIncrementResult()
+	err = result364.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result364.Success
+	if result364.Io != nil {
+		io = result364.Io
+	}
+	return
+}
+
+/**
+ * Parameters:
+ *  - Table: the table to append the value on
+ *  - Tappend: the TAppend to append
+ */
+func (p *THBaseServiceClient) Append(table []byte, tappend *TAppend) (retval367 *TResult, io *TIOError, err error) {
+	err = p.SendAppend(table, tappend)
+	if err != nil {
+		return
+	}
+	return p.RecvAppend()
+}
+
+func (p *THBaseServiceClient) SendAppend(table []byte, tappend *TAppend) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("append", thrift.CALL, p.SeqId)
+	args368 := NewAppendArgs()
+	args368.Table = table
+	args368.Tappend = tappend
+	err = args368.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvAppend() (value *TResult, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error370 := thrift.NewTApplicationExceptionDefault()
+		var error371 error
+		error371, err = error370.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error371
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result369 := NewAppendResult()
+	err = result369.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result369.Success
+	if result369.Io != nil {
+		io = result369.Io
+	}
+	return
+}
+
+/**
+ * Get a Scanner for the provided TScan object.
+ *
+ * @return Scanner Id to be used with other scanner procedures
+ *
+ * Parameters:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ */
+func (p *THBaseServiceClient) OpenScanner(table []byte, tscan *TScan) (retval372 int32, io *TIOError, err error) {
+	err = p.SendOpenScanner(table, tscan)
+	if err != nil {
+		return
+	}
+	return p.RecvOpenScanner()
+}
+
+func (p *THBaseServiceClient) SendOpenScanner(table []byte, tscan *TScan) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("openScanner", thrift.CALL, p.SeqId)
+	args373 := NewOpenScannerArgs()
+	args373.Table = table
+	args373.Tscan = tscan
+	err = args373.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvOpenScanner() (value int32, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error375 := thrift.NewTApplicationExceptionDefault()
+		var error376 error
+		error376, err = error375.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error376
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result374 := NewOpenScannerResult()
+	err = result374.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result374.Success
+	if result374.Io != nil {
+		io = result374.Io
+	}
+	return
+}
+
+/**
+ * Grabs multiple rows from a Scanner.
+ *
+ * @return Between zero and numRows TResults
+ *
+ * Parameters:
+ *  - ScannerId: the Id of the Scanner to return rows from. This is an Id returned from the openScanner function.
+ *  - NumRows: number of rows to return
+ */
+func (p *THBaseServiceClient) GetScannerRows(scannerId int32, numRows int32) (retval377 []*TResult, io *TIOError, ia *TIllegalArgument, err error) {
+	err = p.SendGetScannerRows(scannerId, numRows)
+	if err != nil {
+		return
+	}
+	return p.RecvGetScannerRows()
+}
+
+func (p *THBaseServiceClient) SendGetScannerRows(scannerId int32, numRows int32) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getScannerRows", thrift.CALL, p.SeqId)
+	args378 := NewGetScannerRowsArgs()
+	args378.ScannerId = scannerId
+	args378.NumRows = numRows
+	err = args378.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetScannerRows() (value []*TResult, io *TIOError, ia *TIllegalArgument, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error380 := thrift.NewTApplicationExceptionDefault()
+		var error381 error
+		error381, err = error380.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error381
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result379 := NewGetScannerRowsResult()
+	err = result379.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result379.Success
+	if result379.Io != nil {
+		io = result379.Io
+	}
+	if result379.Ia != nil {
+		ia = result379.Ia
+	}
+	return
+}
+
+/**
+ * Closes the scanner. Should be called to free server side resources
+ * timely. Typically close once the scanner is not needed anymore, i.e.
+ * after looping over it to get all the required rows.
+ *
+ * Parameters:
+ *  - ScannerId: the Id of the Scanner to close *
+ */
+func (p *THBaseServiceClient) CloseScanner(scannerId int32) (io *TIOError, ia *TIllegalArgument, err error) {
+	err = p.SendCloseScanner(scannerId)
+	if err != nil {
+		return
+	}
+	return p.RecvCloseScanner()
+}
+
+func (p *THBaseServiceClient) SendCloseScanner(scannerId int32) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("closeScanner", thrift.CALL, p.SeqId)
+	args383 := NewCloseScannerArgs()
+	args383.ScannerId = scannerId
+	err = args383.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvCloseScanner() (io *TIOError, ia *TIllegalArgument, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error385 := thrift.NewTApplicationExceptionDefault()
+		var error386 error
+		error386, err = error385.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error386
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result384 := NewCloseScannerResult()
+	err = result384.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result384.Io != nil {
+		io = result384.Io
+	}
+	if result384.Ia != nil {
+		ia = result384.Ia
+	}
+	return
+}
+
+/**
+ * mutateRow performs multiple mutations atomically on a single row.
+ *
+ * Parameters:
+ *  - Table: table to apply the mutations
+ *  - TrowMutations: mutations to apply
+ */
+func (p *THBaseServiceClient) MutateRow(table []byte, trowMutations *TRowMutations) (io *TIOError, err error) {
+	err = p.SendMutateRow(table, trowMutations)
+	if err != nil {
+		return
+	}
+	return p.RecvMutateRow()
+}
+
+func (p *THBaseServiceClient) SendMutateRow(table []byte, trowMutations *TRowMutations) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("mutateRow", thrift.CALL, p.SeqId)
+	args388 := NewMutateRowArgs()
+	args388.Table = table
+	args388.TrowMutations = trowMutations
+	err = args388.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvMutateRow() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error390 := thrift.NewTApplicationExceptionDefault()
+		var error391 error
+		error391, err = error390.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error391
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result389 := NewMutateRowResult()
+	err = result389.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result389.Io != nil {
+		io = result389.Io
+	}
+	return
+}
+
+/**
+ * Get results for the provided TScan object. This helper function opens a
+ * scanner, get the results and close the scanner.
+ *
+ * @return between zero and numRows TResults
+ *
+ * Parameters:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ *  - NumRows: number of rows to return
+ */
+func (p *THBaseServiceClient) GetScannerResults(table []byte, tscan *TScan, numRows int32) (retval392 []*TResult, io *TIOError, err error) {
+	err = p.SendGetScannerResults(table, tscan, numRows)
+	if err != nil {
+		return
+	}
+	return p.RecvGetScannerResults()
+}
+
+func (p *THBaseServiceClient) SendGetScannerResults(table []byte, tscan *TScan, numRows int32) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getScannerResults", thrift.CALL, p.SeqId)
+	args393 := NewGetScannerResultsArgs()
+	args393.Table = table
+	args393.Tscan = tscan
+	args393.NumRows = numRows
+	err = args393.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetScannerResults() (value []*TResult, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error395 := thrift.NewTApplicationExceptionDefault()
+		var error396 error
+		error396, err = error395.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error396
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result394 := NewGetScannerResultsResult()
+	err = result394.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result394.Success
+	if result394.Io != nil {
+		io = result394.Io
+	}
+	return
+}
+
+/**
+ * Get a table descriptor.
+ *
+ * @return the TableDescriptor of the giving tablename
+ *
+ * Parameters:
+ *  - Table: the tablename of the table to get tableDescriptor
+ */
+func (p *THBaseServiceClient) GetTableDescriptor(table *TTableName) (retval397 *TTableDescriptor, io *TIOError, err error) {
+	err = p.SendGetTableDescriptor(table)
+	if err != nil {
+		return
+	}
+	return p.RecvGetTableDescriptor()
+}
+
+func (p *THBaseServiceClient) SendGetTableDescriptor(table *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getTableDescriptor", thrift.CALL, p.SeqId)
+	args398 := NewGetTableDescriptorArgs()
+	args398.Table = table
+	err = args398.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetTableDescriptor() (value *TTableDescriptor, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error400 := thrift.NewTApplicationExceptionDefault()
+		var error401 error
+		error401, err = error400.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error401
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result399 := NewGetTableDescriptorResult()
+	err = result399.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result399.Success
+	if result399.Io != nil {
+		io = result399.Io
+	}
+	return
+}
+
+/**
+ * Get table descriptors of tables.
+ *
+ * @return the TableDescriptor of the giving tablename
+ *
+ * Parameters:
+ *  - Tables: the tablename list of the tables to get tableDescriptor
+ */
+func (p *THBaseServiceClient) GetTableDescriptors(tables []*TTableName) (retval402 []*TTableDescriptor, io *TIOError, err error) {
+	err = p.SendGetTableDescriptors(tables)
+	if err != nil {
+		return
+	}
+	return p.RecvGetTableDescriptors()
+}
+
+func (p *THBaseServiceClient) SendGetTableDescriptors(tables []*TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getTableDescriptors", thrift.CALL, p.SeqId)
+	args403 := NewGetTableDescriptorsArgs()
+	args403.Tables = tables
+	err = args403.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetTableDescriptors() (value []*TTableDescriptor, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error405 := thrift.NewTApplicationExceptionDefault()
+		var error406 error
+		error406, err = error405.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error406
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result404 := NewGetTableDescriptorsResult()
+	err = result404.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result404.Success
+	if result404.Io != nil {
+		io = result404.Io
+	}
+	return
+}
+
+/**
+ * @return true if table exists already, false if not
+ *
+ * Parameters:
+ *  - TableName: the tablename of the tables to check
+ */
+func (p *THBaseServiceClient) TableExists(tableName *TTableName) (retval407 bool, io *TIOError, err error) {
+	err = p.SendTableExists(tableName)
+	if err != nil {
+		return
+	}
+	return p.RecvTableExists()
+}
+
+func (p *THBaseServiceClient) SendTableExists(tableName *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("tableExists", thrift.CALL, p.SeqId)
+	args408 := NewTableExistsArgs()
+	args408.TableName = tableName
+	err = args408.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvTableExists() (value bool, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error410 := thrift.NewTApplicationExceptionDefault()
+		var error411 error
+		error411, err = error410.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error411
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result409 := NewTableExistsResult()
+	err = result409.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result409.Success
+	if result409.Io != nil {
+		io = result409.Io
+	}
+	return
+}
+
+/**
+ * Creates a new table with an initial set of empty regions defined by the
+ * specified split keys. The total number of regions created will be the
+ * number of split keys plus one. Synchronous operation.
+ *
+ * Parameters:
+ *  - Desc: table descriptor for table
+ *  - SplitKeys: array of split keys for the initial regions of the table
+ */
+func (p *THBaseServiceClient) CreateTable(desc *TTableDescriptor, splitKeys [][]byte) (io *TIOError, err error) {
+	err = p.SendCreateTable(desc, splitKeys)
+	if err != nil {
+		return
+	}
+	return p.RecvCreateTable()
+}
+
+func (p *THBaseServiceClient) SendCreateTable(desc *TTableDescriptor, splitKeys [][]byte) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("createTable", thrift.CALL, p.SeqId)
+	args413 := NewCreateTableArgs()
+	args413.Desc = desc
+	args413.SplitKeys = splitKeys
+	err = args413.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvCreateTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error415 := thrift.NewTApplicationExceptionDefault()
+		var error416 error
+		error416, err = error415.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error416
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result414 := NewCreateTableResult()
+	err = result414.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result414.Io != nil {
+		io = result414.Io
+	}
+	return
+}
+
+/**
+ * Deletes a table. Synchronous operation.
+ *
+ * Parameters:
+ *  - TableName: the tablename to delete
+ */
+func (p *THBaseServiceClient) DeleteTable(tableName *TTableName) (io *TIOError, err error) {
+	err = p.SendDeleteTable(tableName)
+	if err != nil {
+		return
+	}
+	return p.RecvDeleteTable()
+}
+
+func (p *THBaseServiceClient) SendDeleteTable(tableName *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("deleteTable", thrift.CALL, p.SeqId)
+	args418 := NewDeleteTableArgs()
+	args418.TableName = tableName
+	err = args418.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDeleteTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error420 := thrift.NewTApplicationExceptionDefault()
+		var error421 error
+		error421, err = error420.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error421
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result419 := NewDeleteTableResult()
+	err = result419.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result419.Io != nil {
+		io = result419.Io
+	}
+	return
+}
+
+/**
+ * Truncate a table. Synchronous operation.
+ *
+ * Parameters:
+ *  - TableName: the tablename to truncate
+ *  - PreserveSplits: whether to preserve previous splits
+ */
+func (p *THBaseServiceClient) TruncateTable(tableName *TTableName, preserveSplits bool) (io *TIOError, err error) {
+	err = p.SendTruncateTable(tableName, preserveSplits)
+	if err != nil {
+		return
+	}
+	return p.RecvTruncateTable()
+}
+
+func (p *THBaseServiceClient) SendTruncateTable(tableName *TTableName, preserveSplits bool) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("truncateTable", thrift.CALL, p.SeqId)
+	args423 := NewTruncateTableArgs()
+	args423.TableName = tableName
+	args423.PreserveSplits = preserveSplits
+	err = args423.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvTruncateTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error425 := thrift.NewTApplicationExceptionDefault()
+		var error426 error
+		error426, err = error425.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error426
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result424 := NewTruncateTableResult()
+	err = result424.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result424.Io != nil {
+		io = result424.Io
+	}
+	return
+}
+
+/**
+ * Enable a table
+ *
+ * Parameters:
+ *  - TableName: the tablename to enable
+ */
+func (p *THBaseServiceClient) EnableTable(tableName *TTableName) (io *TIOError, err error) {
+	err = p.SendEnableTable(tableName)
+	if err != nil {
+		return
+	}
+	return p.RecvEnableTable()
+}
+
+func (p *THBaseServiceClient) SendEnableTable(tableName *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("enableTable", thrift.CALL, p.SeqId)
+	args428 := NewEnableTableArgs()
+	args428.TableName = tableName
+	err = args428.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvEnableTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error430 := thrift.NewTApplicationExceptionDefault()
+		var error431 error
+		error431, err = error430.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error431
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result429 := NewEnableTableResult()
+	err = result429.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result429.Io != nil {
+		io = result429.Io
+	}
+	return
+}
+
+/**
+ * Disable a table
+ *
+ * Parameters:
+ *  - TableName: the tablename to disable
+ */
+func (p *THBaseServiceClient) DisableTable(tableName *TTableName) (io *TIOError, err error) {
+	err = p.SendDisableTable(tableName)
+	if err != nil {
+		return
+	}
+	return p.RecvDisableTable()
+}
+
+func (p *THBaseServiceClient) SendDisableTable(tableName *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("disableTable", thrift.CALL, p.SeqId)
+	args433 := NewDisableTableArgs()
+	args433.TableName = tableName
+	err = args433.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDisableTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error435 := thrift.NewTApplicationExceptionDefault()
+		var error436 error
+		error436, err = error435.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error436
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result434 := NewDisableTableResult()
+	err = result434.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result434.Io != nil {
+		io = result434.Io
+	}
+	return
+}
+
+/**
+ * @return true if table is enabled, false if not
+ *
+ * Parameters:
+ *  - TableName: the tablename to check
+ */
+func (p *THBaseServiceClient) IsTableEnabled(tableName *TTableName) (retval437 bool, io *TIOError, err error) {
+	err = p.SendIsTableEnabled(tableName)
+	if err != nil {
+		return
+	}
+	return p.RecvIsTableEnabled()
+}
+
+func (p *THBaseServiceClient) SendIsTableEnabled(tableName *TTableName) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("isTableEnabled", thrift.CALL, p.SeqId)
+	args438 := NewIsTableEnabledArgs()
+	args438.TableName = tableName
+	err = args438.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvIsTableEnabled() (value bool, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error440 := thrift.NewTApplicationExceptionDefault()
+		var error441 error
+		error441, err = error440.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error441
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result439 := NewIsTableEnabledResult()
+	err = result439.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result439.Success
+	if result439.Io != nil {
+		io = result439.Io
+	}
+	return
+}
+
+/**
+ * Add a column family to an existing table. Synchronous operation.
+ *
+ * Parameters:
+ *  - TableName: the tablename to add column family to
+ *  - Column: column family descriptor of column family to be added
+ */
+func (p *THBaseServiceClient) AddColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (io *TIOError, err error) {
+	err = p.SendAddColumnFamily(tableName, column)
+	if err != nil {
+		return
+	}
+	return p.RecvAddColumnFamily()
+}
+
+func (p *THBaseServiceClient) SendAddColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("addColumnFamily", thrift.CALL, p.SeqId)
+	args443 := NewAddColumnFamilyArgs()
+	args443.TableName = tableName
+	args443.Column = column
+	err = args443.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvAddColumnFamily() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error445 := thrift.NewTApplicationExceptionDefault()
+		var error446 error
+		error446, err = error445.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error446
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result444 := NewAddColumnFamilyResult()
+	err = result444.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result444.Io != nil {
+		io = result444.Io
+	}
+	return
+}
+
+/**
+ * Delete a column family from a table. Synchronous operation.
+ *
+ * Parameters:
+ *  - TableName: the tablename to delete column family from
+ *  - Column: name of column family to be deleted
+ */
+func (p *THBaseServiceClient) DeleteColumnFamily(tableName *TTableName, column []byte) (io *TIOError, err error) {
+	err = p.SendDeleteColumnFamily(tableName, column)
+	if err != nil {
+		return
+	}
+	return p.RecvDeleteColumnFamily()
+}
+
+func (p *THBaseServiceClient) SendDeleteColumnFamily(tableName *TTableName, column []byte) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("deleteColumnFamily", thrift.CALL, p.SeqId)
+	args448 := NewDeleteColumnFamilyArgs()
+	args448.TableName = tableName
+	args448.Column = column
+	err = args448.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDeleteColumnFamily() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error450 := thrift.NewTApplicationExceptionDefault()
+		var error451 error
+		error451, err = error450.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error451
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result449 := NewDeleteColumnFamilyResult()
+	err = result449.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result449.Io != nil {
+		io = result449.Io
+	}
+	return
+}
+
+/**
+ * Modify an existing column family on a table. Synchronous operation.
+ *
+ * Parameters:
+ *  - TableName: the tablename to modify column family
+ *  - Column: column family descriptor of column family to be modified
+ */
+func (p *THBaseServiceClient) ModifyColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (io *TIOError, err error) {
+	err = p.SendModifyColumnFamily(tableName, column)
+	if err != nil {
+		return
+	}
+	return p.RecvModifyColumnFamily()
+}
+
+func (p *THBaseServiceClient) SendModifyColumnFamily(tableName *TTableName, column *TColumnFamilyDescriptor) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("modifyColumnFamily", thrift.CALL, p.SeqId)
+	args453 := NewModifyColumnFamilyArgs()
+	args453.TableName = tableName
+	args453.Column = column
+	err = args453.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvModifyColumnFamily() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error455 := thrift.NewTApplicationExceptionDefault()
+		var error456 error
+		error456, err = error455.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error456
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result454 := NewModifyColumnFamilyResult()
+	err = result454.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result454.Io != nil {
+		io = result454.Io
+	}
+	return
+}
+
+/**
+ * Modify an existing table
+ *
+ * Parameters:
+ *  - Desc: the descriptor of the table to modify
+ */
+func (p *THBaseServiceClient) ModifyTable(desc *TTableDescriptor) (io *TIOError, err error) {
+	err = p.SendModifyTable(desc)
+	if err != nil {
+		return
+	}
+	return p.RecvModifyTable()
+}
+
+func (p *THBaseServiceClient) SendModifyTable(desc *TTableDescriptor) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("modifyTable", thrift.CALL, p.SeqId)
+	args458 := NewModifyTableArgs()
+	args458.Desc = desc
+	err = args458.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvModifyTable() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error460 := thrift.NewTApplicationExceptionDefault()
+		var error461 error
+		error461, err = error460.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error461
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result459 := NewModifyTableResult()
+	err = result459.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result459.Io != nil {
+		io = result459.Io
+	}
+	return
+}
+
+/**
+ * Create a new namespace. Blocks until namespace has been successfully
+ * created or an exception is thrown
+ *
+ * Parameters:
+ *  - NamespaceDesc: descriptor which describes the new namespace
+ */
+func (p *THBaseServiceClient) CreateNamespace(namespaceDesc *TNamespaceDescriptor) (io *TIOError, err error) {
+	err = p.SendCreateNamespace(namespaceDesc)
+	if err != nil {
+		return
+	}
+	return p.RecvCreateNamespace()
+}
+
+func (p *THBaseServiceClient) SendCreateNamespace(namespaceDesc *TNamespaceDescriptor) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("createNamespace", thrift.CALL, p.SeqId)
+	args463 := NewCreateNamespaceArgs()
+	args463.NamespaceDesc = namespaceDesc
+	err = args463.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvCreateNamespace() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error465 := thrift.NewTApplicationExceptionDefault()
+		var error466 error
+		error466, err = error465.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error466
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result464 := NewCreateNamespaceResult()
+	err = result464.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result464.Io != nil {
+		io = result464.Io
+	}
+	return
+}
+
+/**
+ * Modify an existing namespace. Blocks until namespace has been
+ * successfully modified or an exception is thrown
+ *
+ * Parameters:
+ *  - NamespaceDesc: descriptor which describes the new namespace
+ */
+func (p *THBaseServiceClient) ModifyNamespace(namespaceDesc *TNamespaceDescriptor) (io *TIOError, err error) {
+	err = p.SendModifyNamespace(namespaceDesc)
+	if err != nil {
+		return
+	}
+	return p.RecvModifyNamespace()
+}
+
+func (p *THBaseServiceClient) SendModifyNamespace(namespaceDesc *TNamespaceDescriptor) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("modifyNamespace", thrift.CALL, p.SeqId)
+	args468 := NewModifyNamespaceArgs()
+	args468.NamespaceDesc = namespaceDesc
+	err = args468.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvModifyNamespace() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error470 := thrift.NewTApplicationExceptionDefault()
+		var error471 error
+		error471, err = error470.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error471
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result469 := NewModifyNamespaceResult()
+	err = result469.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result469.Io != nil {
+		io = result469.Io
+	}
+	return
+}
+
+/**
+ * Delete an existing namespace. Only empty namespaces (no tables) can be
+ * removed. Blocks until namespace has been successfully deleted or an
+ * exception is thrown.
+ *
+ * Parameters:
+ *  - Name: namespace name
+ */
+func (p *THBaseServiceClient) DeleteNamespace(name string) (io *TIOError, err error) {
+	err = p.SendDeleteNamespace(name)
+	if err != nil {
+		return
+	}
+	return p.RecvDeleteNamespace()
+}
+
+func (p *THBaseServiceClient) SendDeleteNamespace(name string) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("deleteNamespace", thrift.CALL, p.SeqId)
+	args473 := NewDeleteNamespaceArgs()
+	args473.Name = name
+	err = args473.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvDeleteNamespace() (io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error475 := thrift.NewTApplicationExceptionDefault()
+		var error476 error
+		error476, err = error475.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error476
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result474 := NewDeleteNamespaceResult()
+	err = result474.Read(iprot)
+	iprot.ReadMessageEnd()
+	if result474.Io != nil {
+		io = result474.Io
+	}
+	return
+}
+
+/**
+ * Get a namespace descriptor by name.
+ *
+ * @return descriptor
+ *
+ * Parameters:
+ *  - Name: name of namespace descriptor
+ */
+func (p *THBaseServiceClient) GetNamespaceDescriptor(name string) (retval477 *TNamespaceDescriptor, io *TIOError, err error) {
+	err = p.SendGetNamespaceDescriptor(name)
+	if err != nil {
+		return
+	}
+	return p.RecvGetNamespaceDescriptor()
+}
+
+func (p *THBaseServiceClient) SendGetNamespaceDescriptor(name string) (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("getNamespaceDescriptor", thrift.CALL, p.SeqId)
+	args478 := NewGetNamespaceDescriptorArgs()
+	args478.Name = name
+	err = args478.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvGetNamespaceDescriptor() (value *TNamespaceDescriptor, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error480 := thrift.NewTApplicationExceptionDefault()
+		var error481 error
+		error481, err = error480.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error481
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result479 := NewGetNamespaceDescriptorResult()
+	err = result479.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result479.Success
+	if result479.Io != nil {
+		io = result479.Io
+	}
+	return
+}
+
+/**
+ * @return all namespaces
+ */
+func (p *THBaseServiceClient) ListNamespaceDescriptors() (retval482 []*TNamespaceDescriptor, io *TIOError, err error) {
+	err = p.SendListNamespaceDescriptors()
+	if err != nil {
+		return
+	}
+	return p.RecvListNamespaceDescriptors()
+}
+
+func (p *THBaseServiceClient) SendListNamespaceDescriptors() (err error) {
+	oprot := p.OutputProtocol
+	if oprot != nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	oprot.WriteMessageBegin("listNamespaceDescriptors", thrift.CALL, p.SeqId)
+	args483 := NewListNamespaceDescriptorsArgs()
+	err = args483.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Transport().Flush()
+	return
+}
+
+func (p *THBaseServiceClient) RecvListNamespaceDescriptors() (value []*TNamespaceDescriptor, io *TIOError, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	_, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error485 := thrift.NewTApplicationExceptionDefault()
+		var error486 error
+		error486, err = error485.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error486
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ping failed: out of sequence response")
+		return
+	}
+	result484 := NewListNamespaceDescriptorsResult()
+	err = result484.Read(iprot)
+	iprot.ReadMessageEnd()
+	value = result484.Success
+	if result484.Io != nil {
+		io = result484.Io
+	}
+	return
+}
+
+type THBaseServiceProcessor struct {
+	handler      ITHBaseService
+	processorMap map[string]thrift.TProcessorFunction
+}
+
+func (p *THBaseServiceProcessor) Handler() ITHBaseService {
+	return p.handler
+}
+
+func (p *THBaseServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *THBaseServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, exists bool) {
+	processor, exists = p.processorMap[key]
+	return processor, exists
+}
+
+func (p *THBaseServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewTHBaseServiceProcessor(handler ITHBaseService) *THBaseServiceProcessor {
+
+	self487 := &THBaseServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self487.processorMap["exists"] = &tHBaseServiceProcessorExists{handler: handler}
+	self487.processorMap["get"] = &tHBaseServiceProcessorGet{handler: handler}
+	self487.processorMap["getMultiple"] = &tHBaseServiceProcessorGetMultiple{handler: handler}
+	self487.processorMap["put"] = &tHBaseServiceProcessorPut{handler: handler}
+	self487.processorMap["checkAndPut"] = &tHBaseServiceProcessorCheckAndPut{handler: handler}
+	self487.processorMap["putMultiple"] = &tHBaseServiceProcessorPutMultiple{handler: handler}
+	self487.processorMap["deleteSingle"] = &tHBaseServiceProcessorDeleteSingle{handler: handler}
+	self487.processorMap["deleteMultiple"] = &tHBaseServiceProcessorDeleteMultiple{handler: handler}
+	self487.processorMap["checkAndDelete"] = &tHBaseServiceProcessorCheckAndDelete{handler: handler}
+	self487.processorMap["increment"] = &tHBaseServiceProcessorIncrement{handler: handler}
+	self487.processorMap["append"] = &tHBaseServiceProcessorAppend{handler: handler}
+	self487.processorMap["openScanner"] = &tHBaseServiceProcessorOpenScanner{handler: handler}
+	self487.processorMap["getScannerRows"] = &tHBaseServiceProcessorGetScannerRows{handler: handler}
+	self487.processorMap["closeScanner"] = &tHBaseServiceProcessorCloseScanner{handler: handler}
+	self487.processorMap["mutateRow"] = &tHBaseServiceProcessorMutateRow{handler: handler}
+	self487.processorMap["getScannerResults"] = &tHBaseServiceProcessorGetScannerResults{handler: handler}
+	self487.processorMap["getTableDescriptor"] = &tHBaseServiceProcessorGetTableDescriptor{handler: handler}
+	self487.processorMap["getTableDescriptors"] = &tHBaseServiceProcessorGetTableDescriptors{handler: handler}
+	self487.processorMap["tableExists"] = &tHBaseServiceProcessorTableExists{handler: handler}
+	self487.processorMap["createTable"] = &tHBaseServiceProcessorCreateTable{handler: handler}
+	self487.processorMap["deleteTable"] = &tHBaseServiceProcessorDeleteTable{handler: handler}
+	self487.processorMap["truncateTable"] = &tHBaseServiceProcessorTruncateTable{handler: handler}
+	self487.processorMap["enableTable"] = &tHBaseServiceProcessorEnableTable{handler: handler}
+	self487.processorMap["disableTable"] = &tHBaseServiceProcessorDisableTable{handler: handler}
+	self487.processorMap["isTableEnabled"] = &tHBaseServiceProcessorIsTableEnabled{handler: handler}
+	self487.processorMap["addColumnFamily"] = &tHBaseServiceProcessorAddColumnFamily{handler: handler}
+	self487.processorMap["deleteColumnFamily"] = &tHBaseServiceProcessorDeleteColumnFamily{handler: handler}
+	self487.processorMap["modifyColumnFamily"] = &tHBaseServiceProcessorModifyColumnFamily{handler: handler}
+	self487.processorMap["modifyTable"] = &tHBaseServiceProcessorModifyTable{handler: handler}
+	self487.processorMap["createNamespace"] = &tHBaseServiceProcessorCreateNamespace{handler: handler}
+	self487.processorMap["modifyNamespace"] = &tHBaseServiceProcessorModifyNamespace{handler: handler}
+	self487.processorMap["deleteNamespace"] = &tHBaseServiceProcessorDeleteNamespace{handler: handler}
+	self487.processorMap["getNamespaceDescriptor"] = &tHBaseServiceProcessorGetNamespaceDescriptor{handler: handler}
+	self487.processorMap["listNamespaceDescriptors"] = &tHBaseServiceProcessorListNamespaceDescriptors{handler: handler}
+	return self487
+}
+
+func (p *THBaseServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	process, nameFound := p.GetProcessorFunction(name)
+	if !nameFound || process == nil {
+		iprot.Skip(thrift.STRUCT)
+		iprot.ReadMessageEnd()
+		x488 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+		oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+		x488.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return false, x488
+	}
+	return process.Process(seqId, iprot, oprot)
+}
+
+type tHBaseServiceProcessorExists struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorExists) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewExistsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("exists", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewExistsResult()
+	if result.Success, result.Io, err = p.handler.Exists(args.Table, args.Tget); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing exists: "+err.Error())
+		oprot.WriteMessageBegin("exists", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("exists", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGet struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGet) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("get", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetResult()
+	if result.Success, result.Io, err = p.handler.Get(args.Table, args.Tget); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing get: "+err.Error())
+		oprot.WriteMessageBegin("get", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("get", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetMultiple struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetMultiple) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetMultipleArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetMultipleResult()
+	if result.Success, result.Io, err = p.handler.GetMultiple(args.Table, args.Tgets); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMultiple: "+err.Error())
+		oprot.WriteMessageBegin("getMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getMultiple", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorPut struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorPut) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewPutArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("put", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewPutResult()
+	if result.Io, err = p.handler.Put(args.Table, args.Tput); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing put: "+err.Error())
+		oprot.WriteMessageBegin("put", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("put", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorCheckAndPut struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorCheckAndPut) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCheckAndPutArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("checkAndPut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCheckAndPutResult()
+	if result.Success, result.Io, err = p.handler.CheckAndPut(args.Table, args.Row, args.Family, args.Qualifier, args.Value, args.Tput); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkAndPut: "+err.Error())
+		oprot.WriteMessageBegin("checkAndPut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("checkAndPut", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorPutMultiple struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorPutMultiple) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewPutMultipleArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("putMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewPutMultipleResult()
+	if result.Io, err = p.handler.PutMultiple(args.Table, args.Tputs); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing putMultiple: "+err.Error())
+		oprot.WriteMessageBegin("putMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("putMultiple", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDeleteSingle struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDeleteSingle) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDeleteSingleArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteSingle", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDeleteSingleResult()
+	if result.Io, err = p.handler.DeleteSingle(args.Table, args.Tdelete); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteSingle: "+err.Error())
+		oprot.WriteMessageBegin("deleteSingle", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("deleteSingle", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDeleteMultiple struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDeleteMultiple) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDeleteMultipleArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDeleteMultipleResult()
+	if result.Success, result.Io, err = p.handler.DeleteMultiple(args.Table, args.Tdeletes); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteMultiple: "+err.Error())
+		oprot.WriteMessageBegin("deleteMultiple", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("deleteMultiple", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorCheckAndDelete struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorCheckAndDelete) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCheckAndDeleteArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("checkAndDelete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCheckAndDeleteResult()
+	if result.Success, result.Io, err = p.handler.CheckAndDelete(args.Table, args.Row, args.Family, args.Qualifier, args.Value, args.Tdelete); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkAndDelete: "+err.Error())
+		oprot.WriteMessageBegin("checkAndDelete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("checkAndDelete", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorIncrement struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorIncrement) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewIncrementArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("increment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewIncrementResult()
+	if result.Success, result.Io, err = p.handler.Increment(args.Table, args.Tincrement); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing increment: "+err.Error())
+		oprot.WriteMessageBegin("increment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("increment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorAppend struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorAppend) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewAppendArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("append", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewAppendResult()
+	if result.Success, result.Io, err = p.handler.Append(args.Table, args.Tappend); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing append: "+err.Error())
+		oprot.WriteMessageBegin("append", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("append", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorOpenScanner struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorOpenScanner) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewOpenScannerArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("openScanner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewOpenScannerResult()
+	if result.Success, result.Io, err = p.handler.OpenScanner(args.Table, args.Tscan); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing openScanner: "+err.Error())
+		oprot.WriteMessageBegin("openScanner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("openScanner", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetScannerRows struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetScannerRows) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetScannerRowsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getScannerRows", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetScannerRowsResult()
+	if result.Success, result.Io, result.Ia, err = p.handler.GetScannerRows(args.ScannerId, args.NumRows); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getScannerRows: "+err.Error())
+		oprot.WriteMessageBegin("getScannerRows", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getScannerRows", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorCloseScanner struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorCloseScanner) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCloseScannerArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("closeScanner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCloseScannerResult()
+	if result.Io, result.Ia, err = p.handler.CloseScanner(args.ScannerId); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing closeScanner: "+err.Error())
+		oprot.WriteMessageBegin("closeScanner", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("closeScanner", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorMutateRow struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorMutateRow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewMutateRowArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("mutateRow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewMutateRowResult()
+	if result.Io, err = p.handler.MutateRow(args.Table, args.TrowMutations); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mutateRow: "+err.Error())
+		oprot.WriteMessageBegin("mutateRow", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("mutateRow", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetScannerResults struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetScannerResults) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetScannerResultsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getScannerResults", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetScannerResultsResult()
+	if result.Success, result.Io, err = p.handler.GetScannerResults(args.Table, args.Tscan, args.NumRows); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getScannerResults: "+err.Error())
+		oprot.WriteMessageBegin("getScannerResults", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getScannerResults", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetTableDescriptor struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetTableDescriptor) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetTableDescriptorArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getTableDescriptor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetTableDescriptorResult()
+	if result.Success, result.Io, err = p.handler.GetTableDescriptor(args.Table); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTableDescriptor: "+err.Error())
+		oprot.WriteMessageBegin("getTableDescriptor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getTableDescriptor", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetTableDescriptors struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetTableDescriptors) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetTableDescriptorsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getTableDescriptors", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetTableDescriptorsResult()
+	if result.Success, result.Io, err = p.handler.GetTableDescriptors(args.Tables); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTableDescriptors: "+err.Error())
+		oprot.WriteMessageBegin("getTableDescriptors", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getTableDescriptors", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorTableExists struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorTableExists) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewTableExistsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("tableExists", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewTableExistsResult()
+	if result.Success, result.Io, err = p.handler.TableExists(args.TableName); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing tableExists: "+err.Error())
+		oprot.WriteMessageBegin("tableExists", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("tableExists", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorCreateTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorCreateTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCreateTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("createTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCreateTableResult()
+	if result.Io, err = p.handler.CreateTable(args.Desc, args.SplitKeys); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createTable: "+err.Error())
+		oprot.WriteMessageBegin("createTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("createTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDeleteTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDeleteTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDeleteTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDeleteTableResult()
+	if result.Io, err = p.handler.DeleteTable(args.TableName); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteTable: "+err.Error())
+		oprot.WriteMessageBegin("deleteTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("deleteTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorTruncateTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorTruncateTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewTruncateTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("truncateTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewTruncateTableResult()
+	if result.Io, err = p.handler.TruncateTable(args.TableName, args.PreserveSplits); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing truncateTable: "+err.Error())
+		oprot.WriteMessageBegin("truncateTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("truncateTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorEnableTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorEnableTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewEnableTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("enableTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewEnableTableResult()
+	if result.Io, err = p.handler.EnableTable(args.TableName); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing enableTable: "+err.Error())
+		oprot.WriteMessageBegin("enableTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("enableTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDisableTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDisableTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDisableTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("disableTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDisableTableResult()
+	if result.Io, err = p.handler.DisableTable(args.TableName); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing disableTable: "+err.Error())
+		oprot.WriteMessageBegin("disableTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("disableTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorIsTableEnabled struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorIsTableEnabled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewIsTableEnabledArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("isTableEnabled", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewIsTableEnabledResult()
+	if result.Success, result.Io, err = p.handler.IsTableEnabled(args.TableName); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing isTableEnabled: "+err.Error())
+		oprot.WriteMessageBegin("isTableEnabled", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("isTableEnabled", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorAddColumnFamily struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorAddColumnFamily) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewAddColumnFamilyArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("addColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewAddColumnFamilyResult()
+	if result.Io, err = p.handler.AddColumnFamily(args.TableName, args.Column); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing addColumnFamily: "+err.Error())
+		oprot.WriteMessageBegin("addColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("addColumnFamily", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDeleteColumnFamily struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDeleteColumnFamily) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDeleteColumnFamilyArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDeleteColumnFamilyResult()
+	if result.Io, err = p.handler.DeleteColumnFamily(args.TableName, args.Column); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteColumnFamily: "+err.Error())
+		oprot.WriteMessageBegin("deleteColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("deleteColumnFamily", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorModifyColumnFamily struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorModifyColumnFamily) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewModifyColumnFamilyArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("modifyColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewModifyColumnFamilyResult()
+	if result.Io, err = p.handler.ModifyColumnFamily(args.TableName, args.Column); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing modifyColumnFamily: "+err.Error())
+		oprot.WriteMessageBegin("modifyColumnFamily", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("modifyColumnFamily", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorModifyTable struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorModifyTable) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewModifyTableArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("modifyTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewModifyTableResult()
+	if result.Io, err = p.handler.ModifyTable(args.Desc); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing modifyTable: "+err.Error())
+		oprot.WriteMessageBegin("modifyTable", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("modifyTable", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorCreateNamespace struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorCreateNamespace) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewCreateNamespaceArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("createNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewCreateNamespaceResult()
+	if result.Io, err = p.handler.CreateNamespace(args.NamespaceDesc); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createNamespace: "+err.Error())
+		oprot.WriteMessageBegin("createNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("createNamespace", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorModifyNamespace struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorModifyNamespace) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewModifyNamespaceArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("modifyNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewModifyNamespaceResult()
+	if result.Io, err = p.handler.ModifyNamespace(args.NamespaceDesc); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing modifyNamespace: "+err.Error())
+		oprot.WriteMessageBegin("modifyNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("modifyNamespace", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorDeleteNamespace struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorDeleteNamespace) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewDeleteNamespaceArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewDeleteNamespaceResult()
+	if result.Io, err = p.handler.DeleteNamespace(args.Name); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteNamespace: "+err.Error())
+		oprot.WriteMessageBegin("deleteNamespace", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("deleteNamespace", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorGetNamespaceDescriptor struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorGetNamespaceDescriptor) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewGetNamespaceDescriptorArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getNamespaceDescriptor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewGetNamespaceDescriptorResult()
+	if result.Success, result.Io, err = p.handler.GetNamespaceDescriptor(args.Name); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getNamespaceDescriptor: "+err.Error())
+		oprot.WriteMessageBegin("getNamespaceDescriptor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("getNamespaceDescriptor", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type tHBaseServiceProcessorListNamespaceDescriptors struct {
+	handler ITHBaseService
+}
+
+func (p *tHBaseServiceProcessorListNamespaceDescriptors) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NewListNamespaceDescriptorsArgs()
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("listNamespaceDescriptors", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	iprot.ReadMessageEnd()
+	result := NewListNamespaceDescriptorsResult()
+	if result.Success, result.Io, err = p.handler.ListNamespaceDescriptors(); err != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listNamespaceDescriptors: "+err.Error())
+		oprot.WriteMessageBegin("listNamespaceDescriptors", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Transport().Flush()
+		return
+	}
+	if err2 := oprot.WriteMessageBegin("listNamespaceDescriptors", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 := result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 := oprot.Transport().Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+/**
+ * Attributes:
+ *  - Table: the table to check on
+ *  - Tget: the TGet to check for
+ */
+type ExistsArgs struct {
+	Table []byte "table" // 1
+	Tget  *TGet  "tget"  // 2
+}
+
+var tstructExistsArgs = thrift.NewTStruct("exists_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tget", thrift.STRUCT, 2),
+})
+
+func (*ExistsArgs) GetTStruct() thrift.TStruct { return tstructExistsArgs }
+func NewExistsArgs() *ExistsArgs {
+	output := &ExistsArgs{}
+	{
+	}
+	return output
+}
+
+func (p *ExistsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructExistsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructExistsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TGet
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ExistsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v489, err490 := iprot.ReadBinary()
+	if err490 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err490)
+	}
+	p.Table = v489
+	return err
+}
+
+func (p *ExistsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tget = NewTGet()
+	err491 := p.Tget.Read(iprot)
+	if err491 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TgetTGet", err491)
+	}
+	return err
+}
+
+func (p *ExistsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("exists_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ExistsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ExistsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tget != nil {
+		err = oprot.WriteFieldBegin("tget", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tget", p.ThriftName(), err)
+		}
+		err = p.Tget.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TGet", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tget", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ExistsArgs) TStructName() string {
+	return "ExistsArgs"
+}
+
+func (p *ExistsArgs) ThriftName() string {
+	return "exists_args"
+}
+
+func (p *ExistsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tget
+	}
+	return nil
+}
+
+func (p *ExistsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tget", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type ExistsResult struct {
+	Success bool      "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructExistsResult = thrift.NewTStruct("exists_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*ExistsResult) GetTStruct() thrift.TStruct { return tstructExistsResult }
+func NewExistsResult() *ExistsResult {
+	output := &ExistsResult{}
+	{
+	}
+	return output
+}
+
+func (p *ExistsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructExistsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructExistsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ExistsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v492, err493 := iprot.ReadBool()
+	if err493 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err493)
+	}
+	p.Success = v492
+	return err
+}
+
+func (p *ExistsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err494 := p.Io.Read(iprot)
+	if err494 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err494)
+	}
+	return err
+}
+
+func (p *ExistsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("exists_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ExistsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ExistsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ExistsResult) TStructName() string {
+	return "ExistsResult"
+}
+
+func (p *ExistsResult) ThriftName() string {
+	return "exists_result"
+}
+
+func (p *ExistsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *ExistsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to get from
+ *  - Tget: the TGet to fetch
+ */
+type GetArgs struct {
+	Table []byte "table" // 1
+	Tget  *TGet  "tget"  // 2
+}
+
+var tstructGetArgs = thrift.NewTStruct("get_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tget", thrift.STRUCT, 2),
+})
+
+func (*GetArgs) GetTStruct() thrift.TStruct { return tstructGetArgs }
+func NewGetArgs() *GetArgs {
+	output := &GetArgs{}
+	{
+	}
+	return output
+}
+
+func (p *GetArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TGet
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v495, err496 := iprot.ReadBinary()
+	if err496 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err496)
+	}
+	p.Table = v495
+	return err
+}
+
+func (p *GetArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tget = NewTGet()
+	err497 := p.Tget.Read(iprot)
+	if err497 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TgetTGet", err497)
+	}
+	return err
+}
+
+func (p *GetArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("get_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tget != nil {
+		err = oprot.WriteFieldBegin("tget", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tget", p.ThriftName(), err)
+		}
+		err = p.Tget.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TGet", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tget", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetArgs) TStructName() string {
+	return "GetArgs"
+}
+
+func (p *GetArgs) ThriftName() string {
+	return "get_args"
+}
+
+func (p *GetArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tget
+	}
+	return nil
+}
+
+func (p *GetArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tget", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetResult struct {
+	Success *TResult  "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructGetResult = thrift.NewTStruct("get_result", []thrift.TField{
+	thrift.NewTField("success", thrift.STRUCT, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetResult) GetTStruct() thrift.TStruct { return tstructGetResult }
+func NewGetResult() *GetResult {
+	output := &GetResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //TResult
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Success = NewTResult()
+	err498 := p.Success.Read(iprot)
+	if err498 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTResult", err498)
+	}
+	return err
+}
+
+func (p *GetResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err499 := p.Io.Read(iprot)
+	if err499 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err499)
+	}
+	return err
+}
+
+func (p *GetResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("get_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = p.Success.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetResult) TStructName() string {
+	return "GetResult"
+}
+
+func (p *GetResult) ThriftName() string {
+	return "get_result"
+}
+
+func (p *GetResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.STRUCT, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to get from
+ *  - Tgets: a list of TGets to fetch, the Result list will have the Results at corresponding positions or null if there was an error
+ */
+type GetMultipleArgs struct {
+	Table []byte  "table" // 1
+	Tgets []*TGet "tgets" // 2
+}
+
+var tstructGetMultipleArgs = thrift.NewTStruct("getMultiple_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tgets", thrift.LIST, 2),
+})
+
+func (*GetMultipleArgs) GetTStruct() thrift.TStruct { return tstructGetMultipleArgs }
+func NewGetMultipleArgs() *GetMultipleArgs {
+	output := &GetMultipleArgs{}
+	{
+	}
+	return output
+}
+
+func (p *GetMultipleArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetMultipleArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetMultipleArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetMultipleArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v500, err501 := iprot.ReadBinary()
+	if err501 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err501)
+	}
+	p.Table = v500
+	return err
+}
+
+func (p *GetMultipleArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype503, _size502, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Tgets", "", err)
+	}
+	_ = _etype503
+	p.Tgets = make([]*TGet, _size502, _size502)
+	for i := 0; i < _size502; i++ {
+		_elem504 := NewTGet()
+		err505 := _elem504.Read(iprot)
+		if err505 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem504TGet", err505)
+		}
+		p.Tgets[i] = _elem504
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetMultipleArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getMultiple_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetMultipleArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetMultipleArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tgets != nil {
+		err = oprot.WriteFieldBegin("tgets", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tgets", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Tgets))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter506 := range p.Tgets {
+			err = Iter506.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TGet", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tgets", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetMultipleArgs) TStructName() string {
+	return "GetMultipleArgs"
+}
+
+func (p *GetMultipleArgs) ThriftName() string {
+	return "getMultiple_args"
+}
+
+func (p *GetMultipleArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tgets
+	}
+	return nil
+}
+
+func (p *GetMultipleArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tgets", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetMultipleResult struct {
+	Success []*TResult "success" // 0
+	Io      *TIOError  "io"      // 1
+}
+
+var tstructGetMultipleResult = thrift.NewTStruct("getMultiple_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetMultipleResult) GetTStruct() thrift.TStruct { return tstructGetMultipleResult }
+func NewGetMultipleResult() *GetMultipleResult {
+	output := &GetMultipleResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetMultipleResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetMultipleResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetMultipleResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetMultipleResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype508, _size507, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype508
+	p.Success = make([]*TResult, _size507, _size507)
+	for i := 0; i < _size507; i++ {
+		_elem509 := NewTResult()
+		err510 := _elem509.Read(iprot)
+		if err510 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem509TResult", err510)
+		}
+		p.Success[i] = _elem509
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetMultipleResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err511 := p.Io.Read(iprot)
+	if err511 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err511)
+	}
+	return err
+}
+
+func (p *GetMultipleResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getMultiple_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetMultipleResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter512 := range p.Success {
+			err = Iter512.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetMultipleResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetMultipleResult) TStructName() string {
+	return "GetMultipleResult"
+}
+
+func (p *GetMultipleResult) ThriftName() string {
+	return "getMultiple_result"
+}
+
+func (p *GetMultipleResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetMultipleResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to put data in
+ *  - Tput: the TPut to put
+ */
+type PutArgs struct {
+	Table []byte "table" // 1
+	Tput  *TPut  "tput"  // 2
+}
+
+var tstructPutArgs = thrift.NewTStruct("put_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tput", thrift.STRUCT, 2),
+})
+
+func (*PutArgs) GetTStruct() thrift.TStruct { return tstructPutArgs }
+func NewPutArgs() *PutArgs {
+	output := &PutArgs{}
+	{
+	}
+	return output
+}
+
+func (p *PutArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructPutArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructPutArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TPut
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v513, err514 := iprot.ReadBinary()
+	if err514 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err514)
+	}
+	p.Table = v513
+	return err
+}
+
+func (p *PutArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tput = NewTPut()
+	err515 := p.Tput.Read(iprot)
+	if err515 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TputTPut", err515)
+	}
+	return err
+}
+
+func (p *PutArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("put_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tput != nil {
+		err = oprot.WriteFieldBegin("tput", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tput", p.ThriftName(), err)
+		}
+		err = p.Tput.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TPut", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tput", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutArgs) TStructName() string {
+	return "PutArgs"
+}
+
+func (p *PutArgs) ThriftName() string {
+	return "put_args"
+}
+
+func (p *PutArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tput
+	}
+	return nil
+}
+
+func (p *PutArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tput", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type PutResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructPutResult = thrift.NewTStruct("put_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*PutResult) GetTStruct() thrift.TStruct { return tstructPutResult }
+func NewPutResult() *PutResult {
+	output := &PutResult{}
+	{
+	}
+	return output
+}
+
+func (p *PutResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructPutResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructPutResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err516 := p.Io.Read(iprot)
+	if err516 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err516)
+	}
+	return err
+}
+
+func (p *PutResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("put_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutResult) TStructName() string {
+	return "PutResult"
+}
+
+func (p *PutResult) ThriftName() string {
+	return "put_result"
+}
+
+func (p *PutResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *PutResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: to check in and put to
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+ *  - Tput: the TPut to put if the check succeeds
+ */
+type CheckAndPutArgs struct {
+	Table     []byte "table"     // 1
+	Row       []byte "row"       // 2
+	Family    []byte "family"    // 3
+	Qualifier []byte "qualifier" // 4
+	Value     []byte "value"     // 5
+	Tput      *TPut  "tput"      // 6
+}
+
+var tstructCheckAndPutArgs = thrift.NewTStruct("checkAndPut_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("row", thrift.STRING, 2),
+	thrift.NewTField("family", thrift.STRING, 3),
+	thrift.NewTField("qualifier", thrift.STRING, 4),
+	thrift.NewTField("value", thrift.STRING, 5),
+	thrift.NewTField("tput", thrift.STRUCT, 6),
+})
+
+func (*CheckAndPutArgs) GetTStruct() thrift.TStruct { return tstructCheckAndPutArgs }
+func NewCheckAndPutArgs() *CheckAndPutArgs {
+	output := &CheckAndPutArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndPutArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndPutArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndPutArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //TPut
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v517, err518 := iprot.ReadBinary()
+	if err518 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err518)
+	}
+	p.Table = v517
+	return err
+}
+
+func (p *CheckAndPutArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v519, err520 := iprot.ReadBinary()
+	if err520 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err520)
+	}
+	p.Row = v519
+	return err
+}
+
+func (p *CheckAndPutArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v521, err522 := iprot.ReadBinary()
+	if err522 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "family", p.ThriftName(), err522)
+	}
+	p.Family = v521
+	return err
+}
+
+func (p *CheckAndPutArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v523, err524 := iprot.ReadBinary()
+	if err524 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "qualifier", p.ThriftName(), err524)
+	}
+	p.Qualifier = v523
+	return err
+}
+
+func (p *CheckAndPutArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v525, err526 := iprot.ReadBinary()
+	if err526 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "value", p.ThriftName(), err526)
+	}
+	p.Value = v525
+	return err
+}
+
+func (p *CheckAndPutArgs) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tput = NewTPut()
+	err527 := p.Tput.Read(iprot)
+	if err527 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TputTPut", err527)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndPut_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Family != nil {
+		err = oprot.WriteFieldBegin("family", thrift.STRING, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Family)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Qualifier)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Value != nil {
+		err = oprot.WriteFieldBegin("value", thrift.STRING, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Value)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tput != nil {
+		err = oprot.WriteFieldBegin("tput", thrift.STRUCT, 6)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "tput", p.ThriftName(), err)
+		}
+		err = p.Tput.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TPut", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "tput", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutArgs) TStructName() string {
+	return "CheckAndPutArgs"
+}
+
+func (p *CheckAndPutArgs) ThriftName() string {
+	return "checkAndPut_args"
+}
+
+func (p *CheckAndPutArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Row
+	case 3:
+		return p.Family
+	case 4:
+		return p.Qualifier
+	case 5:
+		return p.Value
+	case 6:
+		return p.Tput
+	}
+	return nil
+}
+
+func (p *CheckAndPutArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("row", thrift.STRING, 2),
+		thrift.NewTField("family", thrift.STRING, 3),
+		thrift.NewTField("qualifier", thrift.STRING, 4),
+		thrift.NewTField("value", thrift.STRING, 5),
+		thrift.NewTField("tput", thrift.STRUCT, 6),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type CheckAndPutResult struct {
+	Success bool      "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructCheckAndPutResult = thrift.NewTStruct("checkAndPut_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*CheckAndPutResult) GetTStruct() thrift.TStruct { return tstructCheckAndPutResult }
+func NewCheckAndPutResult() *CheckAndPutResult {
+	output := &CheckAndPutResult{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndPutResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndPutResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndPutResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v528, err529 := iprot.ReadBool()
+	if err529 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err529)
+	}
+	p.Success = v528
+	return err
+}
+
+func (p *CheckAndPutResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err530 := p.Io.Read(iprot)
+	if err530 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err530)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndPut_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndPutResult) TStructName() string {
+	return "CheckAndPutResult"
+}
+
+func (p *CheckAndPutResult) ThriftName() string {
+	return "checkAndPut_result"
+}
+
+func (p *CheckAndPutResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *CheckAndPutResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to put data in
+ *  - Tputs: a list of TPuts to commit
+ */
+type PutMultipleArgs struct {
+	Table []byte  "table" // 1
+	Tputs []*TPut "tputs" // 2
+}
+
+var tstructPutMultipleArgs = thrift.NewTStruct("putMultiple_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tputs", thrift.LIST, 2),
+})
+
+func (*PutMultipleArgs) GetTStruct() thrift.TStruct { return tstructPutMultipleArgs }
+func NewPutMultipleArgs() *PutMultipleArgs {
+	output := &PutMultipleArgs{}
+	{
+	}
+	return output
+}
+
+func (p *PutMultipleArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructPutMultipleArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructPutMultipleArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutMultipleArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v531, err532 := iprot.ReadBinary()
+	if err532 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err532)
+	}
+	p.Table = v531
+	return err
+}
+
+func (p *PutMultipleArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype534, _size533, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Tputs", "", err)
+	}
+	_ = _etype534
+	p.Tputs = make([]*TPut, _size533, _size533)
+	for i := 0; i < _size533; i++ {
+		_elem535 := NewTPut()
+		err536 := _elem535.Read(iprot)
+		if err536 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem535TPut", err536)
+		}
+		p.Tputs[i] = _elem535
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *PutMultipleArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("putMultiple_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutMultipleArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutMultipleArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tputs != nil {
+		err = oprot.WriteFieldBegin("tputs", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tputs", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Tputs))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter537 := range p.Tputs {
+			err = Iter537.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TPut", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tputs", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutMultipleArgs) TStructName() string {
+	return "PutMultipleArgs"
+}
+
+func (p *PutMultipleArgs) ThriftName() string {
+	return "putMultiple_args"
+}
+
+func (p *PutMultipleArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tputs
+	}
+	return nil
+}
+
+func (p *PutMultipleArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tputs", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type PutMultipleResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructPutMultipleResult = thrift.NewTStruct("putMultiple_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*PutMultipleResult) GetTStruct() thrift.TStruct { return tstructPutMultipleResult }
+func NewPutMultipleResult() *PutMultipleResult {
+	output := &PutMultipleResult{}
+	{
+	}
+	return output
+}
+
+func (p *PutMultipleResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructPutMultipleResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructPutMultipleResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutMultipleResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err538 := p.Io.Read(iprot)
+	if err538 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err538)
+	}
+	return err
+}
+
+func (p *PutMultipleResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("putMultiple_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *PutMultipleResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *PutMultipleResult) TStructName() string {
+	return "PutMultipleResult"
+}
+
+func (p *PutMultipleResult) ThriftName() string {
+	return "putMultiple_result"
+}
+
+func (p *PutMultipleResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *PutMultipleResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to delete from
+ *  - Tdelete: the TDelete to delete
+ */
+type DeleteSingleArgs struct {
+	Table   []byte   "table"   // 1
+	Tdelete *TDelete "tdelete" // 2
+}
+
+var tstructDeleteSingleArgs = thrift.NewTStruct("deleteSingle_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tdelete", thrift.STRUCT, 2),
+})
+
+func (*DeleteSingleArgs) GetTStruct() thrift.TStruct { return tstructDeleteSingleArgs }
+func NewDeleteSingleArgs() *DeleteSingleArgs {
+	output := &DeleteSingleArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteSingleArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteSingleArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteSingleArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TDelete
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteSingleArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v539, err540 := iprot.ReadBinary()
+	if err540 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err540)
+	}
+	p.Table = v539
+	return err
+}
+
+func (p *DeleteSingleArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tdelete = NewTDelete()
+	err541 := p.Tdelete.Read(iprot)
+	if err541 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TdeleteTDelete", err541)
+	}
+	return err
+}
+
+func (p *DeleteSingleArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteSingle_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteSingleArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteSingleArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tdelete != nil {
+		err = oprot.WriteFieldBegin("tdelete", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tdelete", p.ThriftName(), err)
+		}
+		err = p.Tdelete.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TDelete", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tdelete", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteSingleArgs) TStructName() string {
+	return "DeleteSingleArgs"
+}
+
+func (p *DeleteSingleArgs) ThriftName() string {
+	return "deleteSingle_args"
+}
+
+func (p *DeleteSingleArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tdelete
+	}
+	return nil
+}
+
+func (p *DeleteSingleArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tdelete", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type DeleteSingleResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructDeleteSingleResult = thrift.NewTStruct("deleteSingle_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DeleteSingleResult) GetTStruct() thrift.TStruct { return tstructDeleteSingleResult }
+func NewDeleteSingleResult() *DeleteSingleResult {
+	output := &DeleteSingleResult{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteSingleResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteSingleResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteSingleResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteSingleResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err542 := p.Io.Read(iprot)
+	if err542 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err542)
+	}
+	return err
+}
+
+func (p *DeleteSingleResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteSingle_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteSingleResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteSingleResult) TStructName() string {
+	return "DeleteSingleResult"
+}
+
+func (p *DeleteSingleResult) ThriftName() string {
+	return "deleteSingle_result"
+}
+
+func (p *DeleteSingleResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DeleteSingleResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to delete from
+ *  - Tdeletes: list of TDeletes to delete
+ */
+type DeleteMultipleArgs struct {
+	Table    []byte     "table"    // 1
+	Tdeletes []*TDelete "tdeletes" // 2
+}
+
+var tstructDeleteMultipleArgs = thrift.NewTStruct("deleteMultiple_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tdeletes", thrift.LIST, 2),
+})
+
+func (*DeleteMultipleArgs) GetTStruct() thrift.TStruct { return tstructDeleteMultipleArgs }
+func NewDeleteMultipleArgs() *DeleteMultipleArgs {
+	output := &DeleteMultipleArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteMultipleArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteMultipleArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteMultipleArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v543, err544 := iprot.ReadBinary()
+	if err544 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err544)
+	}
+	p.Table = v543
+	return err
+}
+
+func (p *DeleteMultipleArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype546, _size545, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Tdeletes", "", err)
+	}
+	_ = _etype546
+	p.Tdeletes = make([]*TDelete, _size545, _size545)
+	for i := 0; i < _size545; i++ {
+		_elem547 := NewTDelete()
+		err548 := _elem547.Read(iprot)
+		if err548 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem547TDelete", err548)
+		}
+		p.Tdeletes[i] = _elem547
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteMultiple_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteMultipleArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tdeletes != nil {
+		err = oprot.WriteFieldBegin("tdeletes", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tdeletes", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Tdeletes))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter549 := range p.Tdeletes {
+			err = Iter549.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TDelete", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tdeletes", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteMultipleArgs) TStructName() string {
+	return "DeleteMultipleArgs"
+}
+
+func (p *DeleteMultipleArgs) ThriftName() string {
+	return "deleteMultiple_args"
+}
+
+func (p *DeleteMultipleArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tdeletes
+	}
+	return nil
+}
+
+func (p *DeleteMultipleArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tdeletes", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type DeleteMultipleResult struct {
+	Success []*TDelete "success" // 0
+	Io      *TIOError  "io"      // 1
+}
+
+var tstructDeleteMultipleResult = thrift.NewTStruct("deleteMultiple_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DeleteMultipleResult) GetTStruct() thrift.TStruct { return tstructDeleteMultipleResult }
+func NewDeleteMultipleResult() *DeleteMultipleResult {
+	output := &DeleteMultipleResult{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteMultipleResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteMultipleResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteMultipleResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype551, _size550, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype551
+	p.Success = make([]*TDelete, _size550, _size550)
+	for i := 0; i < _size550; i++ {
+		_elem552 := NewTDelete()
+		err553 := _elem552.Read(iprot)
+		if err553 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem552TDelete", err553)
+		}
+		p.Success[i] = _elem552
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err554 := p.Io.Read(iprot)
+	if err554 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err554)
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteMultiple_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter555 := range p.Success {
+			err = Iter555.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TDelete", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteMultipleResult) TStructName() string {
+	return "DeleteMultipleResult"
+}
+
+func (p *DeleteMultipleResult) ThriftName() string {
+	return "deleteMultiple_result"
+}
+
+func (p *DeleteMultipleResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DeleteMultipleResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: to check in and delete from
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, if not provided the check is for the non-existence of the column in question
+ *  - Tdelete: the TDelete to execute if the check succeeds
+ */
+type CheckAndDeleteArgs struct {
+	Table     []byte   "table"     // 1
+	Row       []byte   "row"       // 2
+	Family    []byte   "family"    // 3
+	Qualifier []byte   "qualifier" // 4
+	Value     []byte   "value"     // 5
+	Tdelete   *TDelete "tdelete"   // 6
+}
+
+var tstructCheckAndDeleteArgs = thrift.NewTStruct("checkAndDelete_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("row", thrift.STRING, 2),
+	thrift.NewTField("family", thrift.STRING, 3),
+	thrift.NewTField("qualifier", thrift.STRING, 4),
+	thrift.NewTField("value", thrift.STRING, 5),
+	thrift.NewTField("tdelete", thrift.STRUCT, 6),
+})
+
+func (*CheckAndDeleteArgs) GetTStruct() thrift.TStruct { return tstructCheckAndDeleteArgs }
+func NewCheckAndDeleteArgs() *CheckAndDeleteArgs {
+	output := &CheckAndDeleteArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndDeleteArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndDeleteArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndDeleteArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //TDelete
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v556, err557 := iprot.ReadBinary()
+	if err557 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err557)
+	}
+	p.Table = v556
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v558, err559 := iprot.ReadBinary()
+	if err559 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "row", p.ThriftName(), err559)
+	}
+	p.Row = v558
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v560, err561 := iprot.ReadBinary()
+	if err561 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "family", p.ThriftName(), err561)
+	}
+	p.Family = v560
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v562, err563 := iprot.ReadBinary()
+	if err563 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "qualifier", p.ThriftName(), err563)
+	}
+	p.Qualifier = v562
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v564, err565 := iprot.ReadBinary()
+	if err565 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "value", p.ThriftName(), err565)
+	}
+	p.Value = v564
+	return err
+}
+
+func (p *CheckAndDeleteArgs) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tdelete = NewTDelete()
+	err566 := p.Tdelete.Read(iprot)
+	if err566 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TdeleteTDelete", err566)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndDelete_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Family != nil {
+		err = oprot.WriteFieldBegin("family", thrift.STRING, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Family)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "family", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Qualifier)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "qualifier", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Value != nil {
+		err = oprot.WriteFieldBegin("value", thrift.STRING, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Value)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "value", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tdelete != nil {
+		err = oprot.WriteFieldBegin("tdelete", thrift.STRUCT, 6)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "tdelete", p.ThriftName(), err)
+		}
+		err = p.Tdelete.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TDelete", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "tdelete", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteArgs) TStructName() string {
+	return "CheckAndDeleteArgs"
+}
+
+func (p *CheckAndDeleteArgs) ThriftName() string {
+	return "checkAndDelete_args"
+}
+
+func (p *CheckAndDeleteArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Row
+	case 3:
+		return p.Family
+	case 4:
+		return p.Qualifier
+	case 5:
+		return p.Value
+	case 6:
+		return p.Tdelete
+	}
+	return nil
+}
+
+func (p *CheckAndDeleteArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("row", thrift.STRING, 2),
+		thrift.NewTField("family", thrift.STRING, 3),
+		thrift.NewTField("qualifier", thrift.STRING, 4),
+		thrift.NewTField("value", thrift.STRING, 5),
+		thrift.NewTField("tdelete", thrift.STRUCT, 6),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type CheckAndDeleteResult struct {
+	Success bool      "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructCheckAndDeleteResult = thrift.NewTStruct("checkAndDelete_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*CheckAndDeleteResult) GetTStruct() thrift.TStruct { return tstructCheckAndDeleteResult }
+func NewCheckAndDeleteResult() *CheckAndDeleteResult {
+	output := &CheckAndDeleteResult{}
+	{
+	}
+	return output
+}
+
+func (p *CheckAndDeleteResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCheckAndDeleteResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCheckAndDeleteResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v567, err568 := iprot.ReadBool()
+	if err568 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err568)
+	}
+	p.Success = v567
+	return err
+}
+
+func (p *CheckAndDeleteResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err569 := p.Io.Read(iprot)
+	if err569 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err569)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("checkAndDelete_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CheckAndDeleteResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CheckAndDeleteResult) TStructName() string {
+	return "CheckAndDeleteResult"
+}
+
+func (p *CheckAndDeleteResult) ThriftName() string {
+	return "checkAndDelete_result"
+}
+
+func (p *CheckAndDeleteResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *CheckAndDeleteResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to increment the value on
+ *  - Tincrement: the TIncrement to increment
+ */
+type IncrementArgs struct {
+	Table      []byte      "table"      // 1
+	Tincrement *TIncrement "tincrement" // 2
+}
+
+var tstructIncrementArgs = thrift.NewTStruct("increment_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tincrement", thrift.STRUCT, 2),
+})
+
+func (*IncrementArgs) GetTStruct() thrift.TStruct { return tstructIncrementArgs }
+func NewIncrementArgs() *IncrementArgs {
+	output := &IncrementArgs{}
+	{
+	}
+	return output
+}
+
+func (p *IncrementArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructIncrementArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructIncrementArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TIncrement
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IncrementArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v570, err571 := iprot.ReadBinary()
+	if err571 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err571)
+	}
+	p.Table = v570
+	return err
+}
+
+func (p *IncrementArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tincrement = NewTIncrement()
+	err572 := p.Tincrement.Read(iprot)
+	if err572 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TincrementTIncrement", err572)
+	}
+	return err
+}
+
+func (p *IncrementArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("increment_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IncrementArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IncrementArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tincrement != nil {
+		err = oprot.WriteFieldBegin("tincrement", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tincrement", p.ThriftName(), err)
+		}
+		err = p.Tincrement.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIncrement", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tincrement", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IncrementArgs) TStructName() string {
+	return "IncrementArgs"
+}
+
+func (p *IncrementArgs) ThriftName() string {
+	return "increment_args"
+}
+
+func (p *IncrementArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tincrement
+	}
+	return nil
+}
+
+func (p *IncrementArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tincrement", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type IncrementResult struct {
+	Success *TResult  "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructIncrementResult = thrift.NewTStruct("increment_result", []thrift.TField{
+	thrift.NewTField("success", thrift.STRUCT, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*IncrementResult) GetTStruct() thrift.TStruct { return tstructIncrementResult }
+func NewIncrementResult() *IncrementResult {
+	output := &IncrementResult{}
+	{
+	}
+	return output
+}
+
+func (p *IncrementResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructIncrementResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructIncrementResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //TResult
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IncrementResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Success = NewTResult()
+	err573 := p.Success.Read(iprot)
+	if err573 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTResult", err573)
+	}
+	return err
+}
+
+func (p *IncrementResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err574 := p.Io.Read(iprot)
+	if err574 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err574)
+	}
+	return err
+}
+
+func (p *IncrementResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("increment_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IncrementResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = p.Success.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IncrementResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IncrementResult) TStructName() string {
+	return "IncrementResult"
+}
+
+func (p *IncrementResult) ThriftName() string {
+	return "increment_result"
+}
+
+func (p *IncrementResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *IncrementResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.STRUCT, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to append the value on
+ *  - Tappend: the TAppend to append
+ */
+type AppendArgs struct {
+	Table   []byte   "table"   // 1
+	Tappend *TAppend "tappend" // 2
+}
+
+var tstructAppendArgs = thrift.NewTStruct("append_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tappend", thrift.STRUCT, 2),
+})
+
+func (*AppendArgs) GetTStruct() thrift.TStruct { return tstructAppendArgs }
+func NewAppendArgs() *AppendArgs {
+	output := &AppendArgs{}
+	{
+	}
+	return output
+}
+
+func (p *AppendArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAppendArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAppendArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TAppend
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v575, err576 := iprot.ReadBinary()
+	if err576 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err576)
+	}
+	p.Table = v575
+	return err
+}
+
+func (p *AppendArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tappend = NewTAppend()
+	err577 := p.Tappend.Read(iprot)
+	if err577 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TappendTAppend", err577)
+	}
+	return err
+}
+
+func (p *AppendArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("append_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tappend != nil {
+		err = oprot.WriteFieldBegin("tappend", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tappend", p.ThriftName(), err)
+		}
+		err = p.Tappend.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TAppend", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tappend", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendArgs) TStructName() string {
+	return "AppendArgs"
+}
+
+func (p *AppendArgs) ThriftName() string {
+	return "append_args"
+}
+
+func (p *AppendArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tappend
+	}
+	return nil
+}
+
+func (p *AppendArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tappend", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type AppendResult struct {
+	Success *TResult  "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructAppendResult = thrift.NewTStruct("append_result", []thrift.TField{
+	thrift.NewTField("success", thrift.STRUCT, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*AppendResult) GetTStruct() thrift.TStruct { return tstructAppendResult }
+func NewAppendResult() *AppendResult {
+	output := &AppendResult{}
+	{
+	}
+	return output
+}
+
+func (p *AppendResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAppendResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAppendResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //TResult
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Success = NewTResult()
+	err578 := p.Success.Read(iprot)
+	if err578 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTResult", err578)
+	}
+	return err
+}
+
+func (p *AppendResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err579 := p.Io.Read(iprot)
+	if err579 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err579)
+	}
+	return err
+}
+
+func (p *AppendResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("append_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AppendResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = p.Success.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AppendResult) TStructName() string {
+	return "AppendResult"
+}
+
+func (p *AppendResult) ThriftName() string {
+	return "append_result"
+}
+
+func (p *AppendResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *AppendResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.STRUCT, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ */
+type OpenScannerArgs struct {
+	Table []byte "table" // 1
+	Tscan *TScan "tscan" // 2
+}
+
+var tstructOpenScannerArgs = thrift.NewTStruct("openScanner_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tscan", thrift.STRUCT, 2),
+})
+
+func (*OpenScannerArgs) GetTStruct() thrift.TStruct { return tstructOpenScannerArgs }
+func NewOpenScannerArgs() *OpenScannerArgs {
+	output := &OpenScannerArgs{}
+	{
+	}
+	return output
+}
+
+func (p *OpenScannerArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructOpenScannerArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructOpenScannerArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TScan
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *OpenScannerArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v580, err581 := iprot.ReadBinary()
+	if err581 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err581)
+	}
+	p.Table = v580
+	return err
+}
+
+func (p *OpenScannerArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tscan = NewTScan()
+	err582 := p.Tscan.Read(iprot)
+	if err582 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TscanTScan", err582)
+	}
+	return err
+}
+
+func (p *OpenScannerArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("openScanner_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *OpenScannerArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *OpenScannerArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tscan != nil {
+		err = oprot.WriteFieldBegin("tscan", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tscan", p.ThriftName(), err)
+		}
+		err = p.Tscan.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TScan", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tscan", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *OpenScannerArgs) TStructName() string {
+	return "OpenScannerArgs"
+}
+
+func (p *OpenScannerArgs) ThriftName() string {
+	return "openScanner_args"
+}
+
+func (p *OpenScannerArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tscan
+	}
+	return nil
+}
+
+func (p *OpenScannerArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tscan", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type OpenScannerResult struct {
+	Success int32     "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructOpenScannerResult = thrift.NewTStruct("openScanner_result", []thrift.TField{
+	thrift.NewTField("success", thrift.I32, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*OpenScannerResult) GetTStruct() thrift.TStruct { return tstructOpenScannerResult }
+func NewOpenScannerResult() *OpenScannerResult {
+	output := &OpenScannerResult{}
+	{
+	}
+	return output
+}
+
+func (p *OpenScannerResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructOpenScannerResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructOpenScannerResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *OpenScannerResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v583, err584 := iprot.ReadI32()
+	if err584 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err584)
+	}
+	p.Success = v583
+	return err
+}
+
+func (p *OpenScannerResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err585 := p.Io.Read(iprot)
+	if err585 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err585)
+	}
+	return err
+}
+
+func (p *OpenScannerResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("openScanner_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *OpenScannerResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.I32, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteI32(int32(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *OpenScannerResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *OpenScannerResult) TStructName() string {
+	return "OpenScannerResult"
+}
+
+func (p *OpenScannerResult) ThriftName() string {
+	return "openScanner_result"
+}
+
+func (p *OpenScannerResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *OpenScannerResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.I32, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - ScannerId: the Id of the Scanner to return rows from. This is an Id returned from the openScanner function.
+ *  - NumRows: number of rows to return
+ */
+type GetScannerRowsArgs struct {
+	ScannerId int32 "scannerId" // 1
+	NumRows   int32 "numRows"   // 2
+}
+
+var tstructGetScannerRowsArgs = thrift.NewTStruct("getScannerRows_args", []thrift.TField{
+	thrift.NewTField("scannerId", thrift.I32, 1),
+	thrift.NewTField("numRows", thrift.I32, 2),
+})
+
+func (*GetScannerRowsArgs) GetTStruct() thrift.TStruct { return tstructGetScannerRowsArgs }
+func NewGetScannerRowsArgs() *GetScannerRowsArgs {
+	output := &GetScannerRowsArgs{}
+	{
+		output.NumRows = 1
+	}
+	return output
+}
+
+func (p *GetScannerRowsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetScannerRowsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetScannerRowsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v586, err587 := iprot.ReadI32()
+	if err587 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "scannerId", p.ThriftName(), err587)
+	}
+	p.ScannerId = v586
+	return err
+}
+
+func (p *GetScannerRowsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v588, err589 := iprot.ReadI32()
+	if err589 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "numRows", p.ThriftName(), err589)
+	}
+	p.NumRows = v588
+	return err
+}
+
+func (p *GetScannerRowsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getScannerRows_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("scannerId", thrift.I32, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	err = oprot.WriteI32(int32(p.ScannerId))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("numRows", thrift.I32, 2)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "numRows", p.ThriftName(), err)
+	}
+	err = oprot.WriteI32(int32(p.NumRows))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "numRows", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "numRows", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsArgs) TStructName() string {
+	return "GetScannerRowsArgs"
+}
+
+func (p *GetScannerRowsArgs) ThriftName() string {
+	return "getScannerRows_args"
+}
+
+func (p *GetScannerRowsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.ScannerId
+	case 2:
+		return p.NumRows
+	}
+	return nil
+}
+
+func (p *GetScannerRowsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("scannerId", thrift.I32, 1),
+		thrift.NewTField("numRows", thrift.I32, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ *  - Ia: if the scannerId is invalid
+ */
+type GetScannerRowsResult struct {
+	Success []*TResult        "success" // 0
+	Io      *TIOError         "io"      // 1
+	Ia      *TIllegalArgument "ia"      // 2
+}
+
+var tstructGetScannerRowsResult = thrift.NewTStruct("getScannerRows_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+	thrift.NewTField("ia", thrift.STRUCT, 2),
+})
+
+func (*GetScannerRowsResult) GetTStruct() thrift.TStruct { return tstructGetScannerRowsResult }
+func NewGetScannerRowsResult() *GetScannerRowsResult {
+	output := &GetScannerRowsResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetScannerRowsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetScannerRowsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetScannerRowsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TIllegalArgument
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype591, _size590, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype591
+	p.Success = make([]*TResult, _size590, _size590)
+	for i := 0; i < _size590; i++ {
+		_elem592 := NewTResult()
+		err593 := _elem592.Read(iprot)
+		if err593 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem592TResult", err593)
+		}
+		p.Success[i] = _elem592
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err594 := p.Io.Read(iprot)
+	if err594 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err594)
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Ia = NewTIllegalArgument()
+	err595 := p.Ia.Read(iprot)
+	if err595 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaTIllegalArgument", err595)
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getScannerRows_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Ia != nil:
+		if err = p.writeField2(oprot); err != nil {
+			return err
+		}
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter596 := range p.Success {
+			err = Iter596.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Ia != nil {
+		err = oprot.WriteFieldBegin("ia", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+		err = p.Ia.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIllegalArgument", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerRowsResult) TStructName() string {
+	return "GetScannerRowsResult"
+}
+
+func (p *GetScannerRowsResult) ThriftName() string {
+	return "getScannerRows_result"
+}
+
+func (p *GetScannerRowsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	case 2:
+		return p.Ia
+	}
+	return nil
+}
+
+func (p *GetScannerRowsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+		thrift.NewTField("ia", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - ScannerId: the Id of the Scanner to close *
+ */
+type CloseScannerArgs struct {
+	ScannerId int32 "scannerId" // 1
+}
+
+var tstructCloseScannerArgs = thrift.NewTStruct("closeScanner_args", []thrift.TField{
+	thrift.NewTField("scannerId", thrift.I32, 1),
+})
+
+func (*CloseScannerArgs) GetTStruct() thrift.TStruct { return tstructCloseScannerArgs }
+func NewCloseScannerArgs() *CloseScannerArgs {
+	output := &CloseScannerArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CloseScannerArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCloseScannerArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCloseScannerArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CloseScannerArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v597, err598 := iprot.ReadI32()
+	if err598 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "scannerId", p.ThriftName(), err598)
+	}
+	p.ScannerId = v597
+	return err
+}
+
+func (p *CloseScannerArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("closeScanner_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CloseScannerArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("scannerId", thrift.I32, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	err = oprot.WriteI32(int32(p.ScannerId))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "scannerId", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CloseScannerArgs) TStructName() string {
+	return "CloseScannerArgs"
+}
+
+func (p *CloseScannerArgs) ThriftName() string {
+	return "closeScanner_args"
+}
+
+func (p *CloseScannerArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.ScannerId
+	}
+	return nil
+}
+
+func (p *CloseScannerArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("scannerId", thrift.I32, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ *  - Ia: if the scannerId is invalid
+ */
+type CloseScannerResult struct {
+	Io *TIOError         "io" // 1
+	Ia *TIllegalArgument "ia" // 2
+}
+
+var tstructCloseScannerResult = thrift.NewTStruct("closeScanner_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+	thrift.NewTField("ia", thrift.STRUCT, 2),
+})
+
+func (*CloseScannerResult) GetTStruct() thrift.TStruct { return tstructCloseScannerResult }
+func NewCloseScannerResult() *CloseScannerResult {
+	output := &CloseScannerResult{}
+	{
+	}
+	return output
+}
+
+func (p *CloseScannerResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCloseScannerResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCloseScannerResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TIllegalArgument
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CloseScannerResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err599 := p.Io.Read(iprot)
+	if err599 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err599)
+	}
+	return err
+}
+
+func (p *CloseScannerResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Ia = NewTIllegalArgument()
+	err600 := p.Ia.Read(iprot)
+	if err600 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IaTIllegalArgument", err600)
+	}
+	return err
+}
+
+func (p *CloseScannerResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("closeScanner_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Ia != nil:
+		if err = p.writeField2(oprot); err != nil {
+			return err
+		}
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CloseScannerResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CloseScannerResult) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Ia != nil {
+		err = oprot.WriteFieldBegin("ia", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+		err = p.Ia.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIllegalArgument", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "ia", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CloseScannerResult) TStructName() string {
+	return "CloseScannerResult"
+}
+
+func (p *CloseScannerResult) ThriftName() string {
+	return "closeScanner_result"
+}
+
+func (p *CloseScannerResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	case 2:
+		return p.Ia
+	}
+	return nil
+}
+
+func (p *CloseScannerResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+		thrift.NewTField("ia", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: table to apply the mutations
+ *  - TrowMutations: mutations to apply
+ */
+type MutateRowArgs struct {
+	Table         []byte         "table"         // 1
+	TrowMutations *TRowMutations "trowMutations" // 2
+}
+
+var tstructMutateRowArgs = thrift.NewTStruct("mutateRow_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("trowMutations", thrift.STRUCT, 2),
+})
+
+func (*MutateRowArgs) GetTStruct() thrift.TStruct { return tstructMutateRowArgs }
+func NewMutateRowArgs() *MutateRowArgs {
+	output := &MutateRowArgs{}
+	{
+	}
+	return output
+}
+
+func (p *MutateRowArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructMutateRowArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructMutateRowArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TRowMutations
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *MutateRowArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v601, err602 := iprot.ReadBinary()
+	if err602 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err602)
+	}
+	p.Table = v601
+	return err
+}
+
+func (p *MutateRowArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TrowMutations = NewTRowMutations()
+	err603 := p.TrowMutations.Read(iprot)
+	if err603 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TrowMutationsTRowMutations", err603)
+	}
+	return err
+}
+
+func (p *MutateRowArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("mutateRow_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *MutateRowArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *MutateRowArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TrowMutations != nil {
+		err = oprot.WriteFieldBegin("trowMutations", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "trowMutations", p.ThriftName(), err)
+		}
+		err = p.TrowMutations.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TRowMutations", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "trowMutations", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *MutateRowArgs) TStructName() string {
+	return "MutateRowArgs"
+}
+
+func (p *MutateRowArgs) ThriftName() string {
+	return "mutateRow_args"
+}
+
+func (p *MutateRowArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.TrowMutations
+	}
+	return nil
+}
+
+func (p *MutateRowArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("trowMutations", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type MutateRowResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructMutateRowResult = thrift.NewTStruct("mutateRow_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*MutateRowResult) GetTStruct() thrift.TStruct { return tstructMutateRowResult }
+func NewMutateRowResult() *MutateRowResult {
+	output := &MutateRowResult{}
+	{
+	}
+	return output
+}
+
+func (p *MutateRowResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructMutateRowResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructMutateRowResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *MutateRowResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err604 := p.Io.Read(iprot)
+	if err604 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err604)
+	}
+	return err
+}
+
+func (p *MutateRowResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("mutateRow_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *MutateRowResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *MutateRowResult) TStructName() string {
+	return "MutateRowResult"
+}
+
+func (p *MutateRowResult) ThriftName() string {
+	return "mutateRow_result"
+}
+
+func (p *MutateRowResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *MutateRowResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ *  - NumRows: number of rows to return
+ */
+type GetScannerResultsArgs struct {
+	Table   []byte "table"   // 1
+	Tscan   *TScan "tscan"   // 2
+	NumRows int32  "numRows" // 3
+}
+
+var tstructGetScannerResultsArgs = thrift.NewTStruct("getScannerResults_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRING, 1),
+	thrift.NewTField("tscan", thrift.STRUCT, 2),
+	thrift.NewTField("numRows", thrift.I32, 3),
+})
+
+func (*GetScannerResultsArgs) GetTStruct() thrift.TStruct { return tstructGetScannerResultsArgs }
+func NewGetScannerResultsArgs() *GetScannerResultsArgs {
+	output := &GetScannerResultsArgs{}
+	{
+		output.NumRows = 1
+	}
+	return output
+}
+
+func (p *GetScannerResultsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetScannerResultsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetScannerResultsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TScan
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v605, err606 := iprot.ReadBinary()
+	if err606 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "table", p.ThriftName(), err606)
+	}
+	p.Table = v605
+	return err
+}
+
+func (p *GetScannerResultsArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Tscan = NewTScan()
+	err607 := p.Tscan.Read(iprot)
+	if err607 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TscanTScan", err607)
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v608, err609 := iprot.ReadI32()
+	if err609 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "numRows", p.ThriftName(), err609)
+	}
+	p.NumRows = v608
+	return err
+}
+
+func (p *GetScannerResultsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getScannerResults_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Table)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tscan != nil {
+		err = oprot.WriteFieldBegin("tscan", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tscan", p.ThriftName(), err)
+		}
+		err = p.Tscan.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TScan", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "tscan", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("numRows", thrift.I32, 3)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(3, "numRows", p.ThriftName(), err)
+	}
+	err = oprot.WriteI32(int32(p.NumRows))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(3, "numRows", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(3, "numRows", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsArgs) TStructName() string {
+	return "GetScannerResultsArgs"
+}
+
+func (p *GetScannerResultsArgs) ThriftName() string {
+	return "getScannerResults_args"
+}
+
+func (p *GetScannerResultsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	case 2:
+		return p.Tscan
+	case 3:
+		return p.NumRows
+	}
+	return nil
+}
+
+func (p *GetScannerResultsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRING, 1),
+		thrift.NewTField("tscan", thrift.STRUCT, 2),
+		thrift.NewTField("numRows", thrift.I32, 3),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetScannerResultsResult struct {
+	Success []*TResult "success" // 0
+	Io      *TIOError  "io"      // 1
+}
+
+var tstructGetScannerResultsResult = thrift.NewTStruct("getScannerResults_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetScannerResultsResult) GetTStruct() thrift.TStruct { return tstructGetScannerResultsResult }
+func NewGetScannerResultsResult() *GetScannerResultsResult {
+	output := &GetScannerResultsResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetScannerResultsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetScannerResultsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetScannerResultsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype611, _size610, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype611
+	p.Success = make([]*TResult, _size610, _size610)
+	for i := 0; i < _size610; i++ {
+		_elem612 := NewTResult()
+		err613 := _elem612.Read(iprot)
+		if err613 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem612TResult", err613)
+		}
+		p.Success[i] = _elem612
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err614 := p.Io.Read(iprot)
+	if err614 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err614)
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getScannerResults_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter615 := range p.Success {
+			err = Iter615.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TResult", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetScannerResultsResult) TStructName() string {
+	return "GetScannerResultsResult"
+}
+
+func (p *GetScannerResultsResult) ThriftName() string {
+	return "getScannerResults_result"
+}
+
+func (p *GetScannerResultsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetScannerResultsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Table: the tablename of the table to get tableDescriptor
+ */
+type GetTableDescriptorArgs struct {
+	Table *TTableName "table" // 1
+}
+
+var tstructGetTableDescriptorArgs = thrift.NewTStruct("getTableDescriptor_args", []thrift.TField{
+	thrift.NewTField("table", thrift.STRUCT, 1),
+})
+
+func (*GetTableDescriptorArgs) GetTStruct() thrift.TStruct { return tstructGetTableDescriptorArgs }
+func NewGetTableDescriptorArgs() *GetTableDescriptorArgs {
+	output := &GetTableDescriptorArgs{}
+	{
+	}
+	return output
+}
+
+func (p *GetTableDescriptorArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetTableDescriptorArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetTableDescriptorArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Table = NewTTableName()
+	err616 := p.Table.Read(iprot)
+	if err616 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableTTableName", err616)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getTableDescriptor_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Table != nil {
+		err = oprot.WriteFieldBegin("table", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+		err = p.Table.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "table", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorArgs) TStructName() string {
+	return "GetTableDescriptorArgs"
+}
+
+func (p *GetTableDescriptorArgs) ThriftName() string {
+	return "getTableDescriptor_args"
+}
+
+func (p *GetTableDescriptorArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Table
+	}
+	return nil
+}
+
+func (p *GetTableDescriptorArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("table", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetTableDescriptorResult struct {
+	Success *TTableDescriptor "success" // 0
+	Io      *TIOError         "io"      // 1
+}
+
+var tstructGetTableDescriptorResult = thrift.NewTStruct("getTableDescriptor_result", []thrift.TField{
+	thrift.NewTField("success", thrift.STRUCT, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetTableDescriptorResult) GetTStruct() thrift.TStruct { return tstructGetTableDescriptorResult }
+func NewGetTableDescriptorResult() *GetTableDescriptorResult {
+	output := &GetTableDescriptorResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetTableDescriptorResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetTableDescriptorResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetTableDescriptorResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //TTableDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Success = NewTTableDescriptor()
+	err617 := p.Success.Read(iprot)
+	if err617 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTTableDescriptor", err617)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err618 := p.Io.Read(iprot)
+	if err618 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err618)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getTableDescriptor_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = p.Success.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorResult) TStructName() string {
+	return "GetTableDescriptorResult"
+}
+
+func (p *GetTableDescriptorResult) ThriftName() string {
+	return "getTableDescriptor_result"
+}
+
+func (p *GetTableDescriptorResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetTableDescriptorResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.STRUCT, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Tables: the tablename list of the tables to get tableDescriptor
+ */
+type GetTableDescriptorsArgs struct {
+	Tables []*TTableName "tables" // 1
+}
+
+var tstructGetTableDescriptorsArgs = thrift.NewTStruct("getTableDescriptors_args", []thrift.TField{
+	thrift.NewTField("tables", thrift.LIST, 1),
+})
+
+func (*GetTableDescriptorsArgs) GetTStruct() thrift.TStruct { return tstructGetTableDescriptorsArgs }
+func NewGetTableDescriptorsArgs() *GetTableDescriptorsArgs {
+	output := &GetTableDescriptorsArgs{}
+	{
+	}
+	return output
+}
+
+func (p *GetTableDescriptorsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetTableDescriptorsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetTableDescriptorsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype620, _size619, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Tables", "", err)
+	}
+	_ = _etype620
+	p.Tables = make([]*TTableName, _size619, _size619)
+	for i := 0; i < _size619; i++ {
+		_elem621 := NewTTableName()
+		err622 := _elem621.Read(iprot)
+		if err622 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem621TTableName", err622)
+		}
+		p.Tables[i] = _elem621
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getTableDescriptors_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tables != nil {
+		err = oprot.WriteFieldBegin("tables", thrift.LIST, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tables", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Tables))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter623 := range p.Tables {
+			err = Iter623.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tables", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsArgs) TStructName() string {
+	return "GetTableDescriptorsArgs"
+}
+
+func (p *GetTableDescriptorsArgs) ThriftName() string {
+	return "getTableDescriptors_args"
+}
+
+func (p *GetTableDescriptorsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Tables
+	}
+	return nil
+}
+
+func (p *GetTableDescriptorsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tables", thrift.LIST, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetTableDescriptorsResult struct {
+	Success []*TTableDescriptor "success" // 0
+	Io      *TIOError           "io"      // 1
+}
+
+var tstructGetTableDescriptorsResult = thrift.NewTStruct("getTableDescriptors_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetTableDescriptorsResult) GetTStruct() thrift.TStruct {
+	return tstructGetTableDescriptorsResult
+}
+func NewGetTableDescriptorsResult() *GetTableDescriptorsResult {
+	output := &GetTableDescriptorsResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetTableDescriptorsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetTableDescriptorsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetTableDescriptorsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype625, _size624, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype625
+	p.Success = make([]*TTableDescriptor, _size624, _size624)
+	for i := 0; i < _size624; i++ {
+		_elem626 := NewTTableDescriptor()
+		err627 := _elem626.Read(iprot)
+		if err627 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem626TTableDescriptor", err627)
+		}
+		p.Success[i] = _elem626
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err628 := p.Io.Read(iprot)
+	if err628 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err628)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getTableDescriptors_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter629 := range p.Success {
+			err = Iter629.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TTableDescriptor", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetTableDescriptorsResult) TStructName() string {
+	return "GetTableDescriptorsResult"
+}
+
+func (p *GetTableDescriptorsResult) ThriftName() string {
+	return "getTableDescriptors_result"
+}
+
+func (p *GetTableDescriptorsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetTableDescriptorsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename of the tables to check
+ */
+type TableExistsArgs struct {
+	TableName *TTableName "tableName" // 1
+}
+
+var tstructTableExistsArgs = thrift.NewTStruct("tableExists_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+})
+
+func (*TableExistsArgs) GetTStruct() thrift.TStruct { return tstructTableExistsArgs }
+func NewTableExistsArgs() *TableExistsArgs {
+	output := &TableExistsArgs{}
+	{
+	}
+	return output
+}
+
+func (p *TableExistsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTableExistsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTableExistsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TableExistsArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err630 := p.TableName.Read(iprot)
+	if err630 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err630)
+	}
+	return err
+}
+
+func (p *TableExistsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("tableExists_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TableExistsArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TableExistsArgs) TStructName() string {
+	return "TableExistsArgs"
+}
+
+func (p *TableExistsArgs) ThriftName() string {
+	return "tableExists_args"
+}
+
+func (p *TableExistsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	}
+	return nil
+}
+
+func (p *TableExistsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type TableExistsResult struct {
+	Success bool      "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructTableExistsResult = thrift.NewTStruct("tableExists_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*TableExistsResult) GetTStruct() thrift.TStruct { return tstructTableExistsResult }
+func NewTableExistsResult() *TableExistsResult {
+	output := &TableExistsResult{}
+	{
+	}
+	return output
+}
+
+func (p *TableExistsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTableExistsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTableExistsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TableExistsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v631, err632 := iprot.ReadBool()
+	if err632 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err632)
+	}
+	p.Success = v631
+	return err
+}
+
+func (p *TableExistsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err633 := p.Io.Read(iprot)
+	if err633 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err633)
+	}
+	return err
+}
+
+func (p *TableExistsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("tableExists_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TableExistsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TableExistsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TableExistsResult) TStructName() string {
+	return "TableExistsResult"
+}
+
+func (p *TableExistsResult) ThriftName() string {
+	return "tableExists_result"
+}
+
+func (p *TableExistsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *TableExistsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Desc: table descriptor for table
+ *  - SplitKeys: array of split keys for the initial regions of the table
+ */
+type CreateTableArgs struct {
+	Desc      *TTableDescriptor "desc"      // 1
+	SplitKeys [][]byte          "splitKeys" // 2
+}
+
+var tstructCreateTableArgs = thrift.NewTStruct("createTable_args", []thrift.TField{
+	thrift.NewTField("desc", thrift.STRUCT, 1),
+	thrift.NewTField("splitKeys", thrift.LIST, 2),
+})
+
+func (*CreateTableArgs) GetTStruct() thrift.TStruct { return tstructCreateTableArgs }
+func NewCreateTableArgs() *CreateTableArgs {
+	output := &CreateTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CreateTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCreateTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCreateTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Desc = NewTTableDescriptor()
+	err634 := p.Desc.Read(iprot)
+	if err634 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.DescTTableDescriptor", err634)
+	}
+	return err
+}
+
+func (p *CreateTableArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype636, _size635, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.SplitKeys", "", err)
+	}
+	_ = _etype636
+	p.SplitKeys = make([][]byte, _size635, _size635)
+	for i := 0; i < _size635; i++ {
+		v638, err639 := iprot.ReadBinary()
+		if err639 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_elem637", "", err639)
+		}
+		_elem637 := v638
+		p.SplitKeys[i] = _elem637
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *CreateTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("createTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Desc != nil {
+		err = oprot.WriteFieldBegin("desc", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "desc", p.ThriftName(), err)
+		}
+		err = p.Desc.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "desc", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CreateTableArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.SplitKeys != nil {
+		err = oprot.WriteFieldBegin("splitKeys", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "splitKeys", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRING, len(p.SplitKeys))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter640 := range p.SplitKeys {
+			err = oprot.WriteBinary(Iter640)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(0, "Iter640", "", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "splitKeys", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CreateTableArgs) TStructName() string {
+	return "CreateTableArgs"
+}
+
+func (p *CreateTableArgs) ThriftName() string {
+	return "createTable_args"
+}
+
+func (p *CreateTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Desc
+	case 2:
+		return p.SplitKeys
+	}
+	return nil
+}
+
+func (p *CreateTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("desc", thrift.STRUCT, 1),
+		thrift.NewTField("splitKeys", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type CreateTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructCreateTableResult = thrift.NewTStruct("createTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*CreateTableResult) GetTStruct() thrift.TStruct { return tstructCreateTableResult }
+func NewCreateTableResult() *CreateTableResult {
+	output := &CreateTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *CreateTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCreateTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCreateTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err641 := p.Io.Read(iprot)
+	if err641 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err641)
+	}
+	return err
+}
+
+func (p *CreateTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("createTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CreateTableResult) TStructName() string {
+	return "CreateTableResult"
+}
+
+func (p *CreateTableResult) ThriftName() string {
+	return "createTable_result"
+}
+
+func (p *CreateTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *CreateTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to delete
+ */
+type DeleteTableArgs struct {
+	TableName *TTableName "tableName" // 1
+}
+
+var tstructDeleteTableArgs = thrift.NewTStruct("deleteTable_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+})
+
+func (*DeleteTableArgs) GetTStruct() thrift.TStruct { return tstructDeleteTableArgs }
+func NewDeleteTableArgs() *DeleteTableArgs {
+	output := &DeleteTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err642 := p.TableName.Read(iprot)
+	if err642 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err642)
+	}
+	return err
+}
+
+func (p *DeleteTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteTableArgs) TStructName() string {
+	return "DeleteTableArgs"
+}
+
+func (p *DeleteTableArgs) ThriftName() string {
+	return "deleteTable_args"
+}
+
+func (p *DeleteTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	}
+	return nil
+}
+
+func (p *DeleteTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type DeleteTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructDeleteTableResult = thrift.NewTStruct("deleteTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DeleteTableResult) GetTStruct() thrift.TStruct { return tstructDeleteTableResult }
+func NewDeleteTableResult() *DeleteTableResult {
+	output := &DeleteTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err643 := p.Io.Read(iprot)
+	if err643 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err643)
+	}
+	return err
+}
+
+func (p *DeleteTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteTableResult) TStructName() string {
+	return "DeleteTableResult"
+}
+
+func (p *DeleteTableResult) ThriftName() string {
+	return "deleteTable_result"
+}
+
+func (p *DeleteTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DeleteTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to truncate
+ *  - PreserveSplits: whether to preserve previous splits
+ */
+type TruncateTableArgs struct {
+	TableName      *TTableName "tableName"      // 1
+	PreserveSplits bool        "preserveSplits" // 2
+}
+
+var tstructTruncateTableArgs = thrift.NewTStruct("truncateTable_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+	thrift.NewTField("preserveSplits", thrift.BOOL, 2),
+})
+
+func (*TruncateTableArgs) GetTStruct() thrift.TStruct { return tstructTruncateTableArgs }
+func NewTruncateTableArgs() *TruncateTableArgs {
+	output := &TruncateTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *TruncateTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTruncateTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTruncateTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TruncateTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err644 := p.TableName.Read(iprot)
+	if err644 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err644)
+	}
+	return err
+}
+
+func (p *TruncateTableArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v645, err646 := iprot.ReadBool()
+	if err646 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "preserveSplits", p.ThriftName(), err646)
+	}
+	p.PreserveSplits = v645
+	return err
+}
+
+func (p *TruncateTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("truncateTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TruncateTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TruncateTableArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("preserveSplits", thrift.BOOL, 2)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "preserveSplits", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.PreserveSplits))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "preserveSplits", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "preserveSplits", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TruncateTableArgs) TStructName() string {
+	return "TruncateTableArgs"
+}
+
+func (p *TruncateTableArgs) ThriftName() string {
+	return "truncateTable_args"
+}
+
+func (p *TruncateTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.PreserveSplits
+	}
+	return nil
+}
+
+func (p *TruncateTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+		thrift.NewTField("preserveSplits", thrift.BOOL, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type TruncateTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructTruncateTableResult = thrift.NewTStruct("truncateTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*TruncateTableResult) GetTStruct() thrift.TStruct { return tstructTruncateTableResult }
+func NewTruncateTableResult() *TruncateTableResult {
+	output := &TruncateTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *TruncateTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTruncateTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTruncateTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TruncateTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err647 := p.Io.Read(iprot)
+	if err647 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err647)
+	}
+	return err
+}
+
+func (p *TruncateTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("truncateTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TruncateTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TruncateTableResult) TStructName() string {
+	return "TruncateTableResult"
+}
+
+func (p *TruncateTableResult) ThriftName() string {
+	return "truncateTable_result"
+}
+
+func (p *TruncateTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *TruncateTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to enable
+ */
+type EnableTableArgs struct {
+	TableName *TTableName "tableName" // 1
+}
+
+var tstructEnableTableArgs = thrift.NewTStruct("enableTable_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+})
+
+func (*EnableTableArgs) GetTStruct() thrift.TStruct { return tstructEnableTableArgs }
+func NewEnableTableArgs() *EnableTableArgs {
+	output := &EnableTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *EnableTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructEnableTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructEnableTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *EnableTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err648 := p.TableName.Read(iprot)
+	if err648 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err648)
+	}
+	return err
+}
+
+func (p *EnableTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("enableTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *EnableTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *EnableTableArgs) TStructName() string {
+	return "EnableTableArgs"
+}
+
+func (p *EnableTableArgs) ThriftName() string {
+	return "enableTable_args"
+}
+
+func (p *EnableTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	}
+	return nil
+}
+
+func (p *EnableTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type EnableTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructEnableTableResult = thrift.NewTStruct("enableTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*EnableTableResult) GetTStruct() thrift.TStruct { return tstructEnableTableResult }
+func NewEnableTableResult() *EnableTableResult {
+	output := &EnableTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *EnableTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructEnableTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructEnableTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *EnableTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err649 := p.Io.Read(iprot)
+	if err649 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err649)
+	}
+	return err
+}
+
+func (p *EnableTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("enableTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *EnableTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *EnableTableResult) TStructName() string {
+	return "EnableTableResult"
+}
+
+func (p *EnableTableResult) ThriftName() string {
+	return "enableTable_result"
+}
+
+func (p *EnableTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *EnableTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to disable
+ */
+type DisableTableArgs struct {
+	TableName *TTableName "tableName" // 1
+}
+
+var tstructDisableTableArgs = thrift.NewTStruct("disableTable_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+})
+
+func (*DisableTableArgs) GetTStruct() thrift.TStruct { return tstructDisableTableArgs }
+func NewDisableTableArgs() *DisableTableArgs {
+	output := &DisableTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DisableTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDisableTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDisableTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DisableTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err650 := p.TableName.Read(iprot)
+	if err650 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err650)
+	}
+	return err
+}
+
+func (p *DisableTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("disableTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DisableTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DisableTableArgs) TStructName() string {
+	return "DisableTableArgs"
+}
+
+func (p *DisableTableArgs) ThriftName() string {
+	return "disableTable_args"
+}
+
+func (p *DisableTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	}
+	return nil
+}
+
+func (p *DisableTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type DisableTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructDisableTableResult = thrift.NewTStruct("disableTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DisableTableResult) GetTStruct() thrift.TStruct { return tstructDisableTableResult }
+func NewDisableTableResult() *DisableTableResult {
+	output := &DisableTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *DisableTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDisableTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDisableTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DisableTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err651 := p.Io.Read(iprot)
+	if err651 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err651)
+	}
+	return err
+}
+
+func (p *DisableTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("disableTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DisableTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DisableTableResult) TStructName() string {
+	return "DisableTableResult"
+}
+
+func (p *DisableTableResult) ThriftName() string {
+	return "disableTable_result"
+}
+
+func (p *DisableTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DisableTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to check
+ */
+type IsTableEnabledArgs struct {
+	TableName *TTableName "tableName" // 1
+}
+
+var tstructIsTableEnabledArgs = thrift.NewTStruct("isTableEnabled_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+})
+
+func (*IsTableEnabledArgs) GetTStruct() thrift.TStruct { return tstructIsTableEnabledArgs }
+func NewIsTableEnabledArgs() *IsTableEnabledArgs {
+	output := &IsTableEnabledArgs{}
+	{
+	}
+	return output
+}
+
+func (p *IsTableEnabledArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructIsTableEnabledArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructIsTableEnabledArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IsTableEnabledArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err652 := p.TableName.Read(iprot)
+	if err652 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err652)
+	}
+	return err
+}
+
+func (p *IsTableEnabledArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("isTableEnabled_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IsTableEnabledArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IsTableEnabledArgs) TStructName() string {
+	return "IsTableEnabledArgs"
+}
+
+func (p *IsTableEnabledArgs) ThriftName() string {
+	return "isTableEnabled_args"
+}
+
+func (p *IsTableEnabledArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	}
+	return nil
+}
+
+func (p *IsTableEnabledArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type IsTableEnabledResult struct {
+	Success bool      "success" // 0
+	Io      *TIOError "io"      // 1
+}
+
+var tstructIsTableEnabledResult = thrift.NewTStruct("isTableEnabled_result", []thrift.TField{
+	thrift.NewTField("success", thrift.BOOL, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*IsTableEnabledResult) GetTStruct() thrift.TStruct { return tstructIsTableEnabledResult }
+func NewIsTableEnabledResult() *IsTableEnabledResult {
+	output := &IsTableEnabledResult{}
+	{
+	}
+	return output
+}
+
+func (p *IsTableEnabledResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructIsTableEnabledResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructIsTableEnabledResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IsTableEnabledResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v653, err654 := iprot.ReadBool()
+	if err654 != nil {
+		return thrift.NewTProtocolExceptionReadField(0, "success", p.ThriftName(), err654)
+	}
+	p.Success = v653
+	return err
+}
+
+func (p *IsTableEnabledResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err655 := p.Io.Read(iprot)
+	if err655 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err655)
+	}
+	return err
+}
+
+func (p *IsTableEnabledResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("isTableEnabled_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IsTableEnabledResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("success", thrift.BOOL, 0)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteBool(bool(p.Success))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *IsTableEnabledResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *IsTableEnabledResult) TStructName() string {
+	return "IsTableEnabledResult"
+}
+
+func (p *IsTableEnabledResult) ThriftName() string {
+	return "isTableEnabled_result"
+}
+
+func (p *IsTableEnabledResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *IsTableEnabledResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.BOOL, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to add column family to
+ *  - Column: column family descriptor of column family to be added
+ */
+type AddColumnFamilyArgs struct {
+	TableName *TTableName              "tableName" // 1
+	Column    *TColumnFamilyDescriptor "column"    // 2
+}
+
+var tstructAddColumnFamilyArgs = thrift.NewTStruct("addColumnFamily_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+	thrift.NewTField("column", thrift.STRUCT, 2),
+})
+
+func (*AddColumnFamilyArgs) GetTStruct() thrift.TStruct { return tstructAddColumnFamilyArgs }
+func NewAddColumnFamilyArgs() *AddColumnFamilyArgs {
+	output := &AddColumnFamilyArgs{}
+	{
+	}
+	return output
+}
+
+func (p *AddColumnFamilyArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAddColumnFamilyArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAddColumnFamilyArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TColumnFamilyDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err656 := p.TableName.Read(iprot)
+	if err656 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err656)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Column = NewTColumnFamilyDescriptor()
+	err657 := p.Column.Read(iprot)
+	if err657 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.ColumnTColumnFamilyDescriptor", err657)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("addColumnFamily_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Column != nil {
+		err = oprot.WriteFieldBegin("column", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+		err = p.Column.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TColumnFamilyDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AddColumnFamilyArgs) TStructName() string {
+	return "AddColumnFamilyArgs"
+}
+
+func (p *AddColumnFamilyArgs) ThriftName() string {
+	return "addColumnFamily_args"
+}
+
+func (p *AddColumnFamilyArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.Column
+	}
+	return nil
+}
+
+func (p *AddColumnFamilyArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+		thrift.NewTField("column", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type AddColumnFamilyResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructAddColumnFamilyResult = thrift.NewTStruct("addColumnFamily_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*AddColumnFamilyResult) GetTStruct() thrift.TStruct { return tstructAddColumnFamilyResult }
+func NewAddColumnFamilyResult() *AddColumnFamilyResult {
+	output := &AddColumnFamilyResult{}
+	{
+	}
+	return output
+}
+
+func (p *AddColumnFamilyResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructAddColumnFamilyResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructAddColumnFamilyResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err658 := p.Io.Read(iprot)
+	if err658 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err658)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("addColumnFamily_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *AddColumnFamilyResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *AddColumnFamilyResult) TStructName() string {
+	return "AddColumnFamilyResult"
+}
+
+func (p *AddColumnFamilyResult) ThriftName() string {
+	return "addColumnFamily_result"
+}
+
+func (p *AddColumnFamilyResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *AddColumnFamilyResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to delete column family from
+ *  - Column: name of column family to be deleted
+ */
+type DeleteColumnFamilyArgs struct {
+	TableName *TTableName "tableName" // 1
+	Column    []byte      "column"    // 2
+}
+
+var tstructDeleteColumnFamilyArgs = thrift.NewTStruct("deleteColumnFamily_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+	thrift.NewTField("column", thrift.STRING, 2),
+})
+
+func (*DeleteColumnFamilyArgs) GetTStruct() thrift.TStruct { return tstructDeleteColumnFamilyArgs }
+func NewDeleteColumnFamilyArgs() *DeleteColumnFamilyArgs {
+	output := &DeleteColumnFamilyArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteColumnFamilyArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteColumnFamilyArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteColumnFamilyArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err659 := p.TableName.Read(iprot)
+	if err659 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err659)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v660, err661 := iprot.ReadBinary()
+	if err661 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "column", p.ThriftName(), err661)
+	}
+	p.Column = v660
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteColumnFamily_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Column != nil {
+		err = oprot.WriteFieldBegin("column", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Column)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyArgs) TStructName() string {
+	return "DeleteColumnFamilyArgs"
+}
+
+func (p *DeleteColumnFamilyArgs) ThriftName() string {
+	return "deleteColumnFamily_args"
+}
+
+func (p *DeleteColumnFamilyArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.Column
+	}
+	return nil
+}
+
+func (p *DeleteColumnFamilyArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+		thrift.NewTField("column", thrift.STRING, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type DeleteColumnFamilyResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructDeleteColumnFamilyResult = thrift.NewTStruct("deleteColumnFamily_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DeleteColumnFamilyResult) GetTStruct() thrift.TStruct { return tstructDeleteColumnFamilyResult }
+func NewDeleteColumnFamilyResult() *DeleteColumnFamilyResult {
+	output := &DeleteColumnFamilyResult{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteColumnFamilyResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteColumnFamilyResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteColumnFamilyResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err662 := p.Io.Read(iprot)
+	if err662 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err662)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteColumnFamily_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteColumnFamilyResult) TStructName() string {
+	return "DeleteColumnFamilyResult"
+}
+
+func (p *DeleteColumnFamilyResult) ThriftName() string {
+	return "deleteColumnFamily_result"
+}
+
+func (p *DeleteColumnFamilyResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DeleteColumnFamilyResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - TableName: the tablename to modify column family
+ *  - Column: column family descriptor of column family to be modified
+ */
+type ModifyColumnFamilyArgs struct {
+	TableName *TTableName              "tableName" // 1
+	Column    *TColumnFamilyDescriptor "column"    // 2
+}
+
+var tstructModifyColumnFamilyArgs = thrift.NewTStruct("modifyColumnFamily_args", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+	thrift.NewTField("column", thrift.STRUCT, 2),
+})
+
+func (*ModifyColumnFamilyArgs) GetTStruct() thrift.TStruct { return tstructModifyColumnFamilyArgs }
+func NewModifyColumnFamilyArgs() *ModifyColumnFamilyArgs {
+	output := &ModifyColumnFamilyArgs{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyColumnFamilyArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyColumnFamilyArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyColumnFamilyArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TColumnFamilyDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err663 := p.TableName.Read(iprot)
+	if err663 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err663)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Column = NewTColumnFamilyDescriptor()
+	err664 := p.Column.Read(iprot)
+	if err664 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.ColumnTColumnFamilyDescriptor", err664)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyColumnFamily_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Column != nil {
+		err = oprot.WriteFieldBegin("column", thrift.STRUCT, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+		err = p.Column.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TColumnFamilyDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "column", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyArgs) TStructName() string {
+	return "ModifyColumnFamilyArgs"
+}
+
+func (p *ModifyColumnFamilyArgs) ThriftName() string {
+	return "modifyColumnFamily_args"
+}
+
+func (p *ModifyColumnFamilyArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.Column
+	}
+	return nil
+}
+
+func (p *ModifyColumnFamilyArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+		thrift.NewTField("column", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type ModifyColumnFamilyResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructModifyColumnFamilyResult = thrift.NewTStruct("modifyColumnFamily_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*ModifyColumnFamilyResult) GetTStruct() thrift.TStruct { return tstructModifyColumnFamilyResult }
+func NewModifyColumnFamilyResult() *ModifyColumnFamilyResult {
+	output := &ModifyColumnFamilyResult{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyColumnFamilyResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyColumnFamilyResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyColumnFamilyResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err665 := p.Io.Read(iprot)
+	if err665 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err665)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyColumnFamily_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyColumnFamilyResult) TStructName() string {
+	return "ModifyColumnFamilyResult"
+}
+
+func (p *ModifyColumnFamilyResult) ThriftName() string {
+	return "modifyColumnFamily_result"
+}
+
+func (p *ModifyColumnFamilyResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *ModifyColumnFamilyResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Desc: the descriptor of the table to modify
+ */
+type ModifyTableArgs struct {
+	Desc *TTableDescriptor "desc" // 1
+}
+
+var tstructModifyTableArgs = thrift.NewTStruct("modifyTable_args", []thrift.TField{
+	thrift.NewTField("desc", thrift.STRUCT, 1),
+})
+
+func (*ModifyTableArgs) GetTStruct() thrift.TStruct { return tstructModifyTableArgs }
+func NewModifyTableArgs() *ModifyTableArgs {
+	output := &ModifyTableArgs{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyTableArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyTableArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyTableArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyTableArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Desc = NewTTableDescriptor()
+	err666 := p.Desc.Read(iprot)
+	if err666 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.DescTTableDescriptor", err666)
+	}
+	return err
+}
+
+func (p *ModifyTableArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyTable_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyTableArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Desc != nil {
+		err = oprot.WriteFieldBegin("desc", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "desc", p.ThriftName(), err)
+		}
+		err = p.Desc.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "desc", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyTableArgs) TStructName() string {
+	return "ModifyTableArgs"
+}
+
+func (p *ModifyTableArgs) ThriftName() string {
+	return "modifyTable_args"
+}
+
+func (p *ModifyTableArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Desc
+	}
+	return nil
+}
+
+func (p *ModifyTableArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("desc", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type ModifyTableResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructModifyTableResult = thrift.NewTStruct("modifyTable_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*ModifyTableResult) GetTStruct() thrift.TStruct { return tstructModifyTableResult }
+func NewModifyTableResult() *ModifyTableResult {
+	output := &ModifyTableResult{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyTableResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyTableResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyTableResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyTableResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err667 := p.Io.Read(iprot)
+	if err667 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err667)
+	}
+	return err
+}
+
+func (p *ModifyTableResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyTable_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyTableResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyTableResult) TStructName() string {
+	return "ModifyTableResult"
+}
+
+func (p *ModifyTableResult) ThriftName() string {
+	return "modifyTable_result"
+}
+
+func (p *ModifyTableResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *ModifyTableResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - NamespaceDesc: descriptor which describes the new namespace
+ */
+type CreateNamespaceArgs struct {
+	NamespaceDesc *TNamespaceDescriptor "namespaceDesc" // 1
+}
+
+var tstructCreateNamespaceArgs = thrift.NewTStruct("createNamespace_args", []thrift.TField{
+	thrift.NewTField("namespaceDesc", thrift.STRUCT, 1),
+})
+
+func (*CreateNamespaceArgs) GetTStruct() thrift.TStruct { return tstructCreateNamespaceArgs }
+func NewCreateNamespaceArgs() *CreateNamespaceArgs {
+	output := &CreateNamespaceArgs{}
+	{
+	}
+	return output
+}
+
+func (p *CreateNamespaceArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCreateNamespaceArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCreateNamespaceArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TNamespaceDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateNamespaceArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.NamespaceDesc = NewTNamespaceDescriptor()
+	err668 := p.NamespaceDesc.Read(iprot)
+	if err668 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.NamespaceDescTNamespaceDescriptor", err668)
+	}
+	return err
+}
+
+func (p *CreateNamespaceArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("createNamespace_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateNamespaceArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.NamespaceDesc != nil {
+		err = oprot.WriteFieldBegin("namespaceDesc", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "namespaceDesc", p.ThriftName(), err)
+		}
+		err = p.NamespaceDesc.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TNamespaceDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "namespaceDesc", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CreateNamespaceArgs) TStructName() string {
+	return "CreateNamespaceArgs"
+}
+
+func (p *CreateNamespaceArgs) ThriftName() string {
+	return "createNamespace_args"
+}
+
+func (p *CreateNamespaceArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.NamespaceDesc
+	}
+	return nil
+}
+
+func (p *CreateNamespaceArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("namespaceDesc", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type CreateNamespaceResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructCreateNamespaceResult = thrift.NewTStruct("createNamespace_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*CreateNamespaceResult) GetTStruct() thrift.TStruct { return tstructCreateNamespaceResult }
+func NewCreateNamespaceResult() *CreateNamespaceResult {
+	output := &CreateNamespaceResult{}
+	{
+	}
+	return output
+}
+
+func (p *CreateNamespaceResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructCreateNamespaceResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructCreateNamespaceResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateNamespaceResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err669 := p.Io.Read(iprot)
+	if err669 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err669)
+	}
+	return err
+}
+
+func (p *CreateNamespaceResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("createNamespace_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *CreateNamespaceResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *CreateNamespaceResult) TStructName() string {
+	return "CreateNamespaceResult"
+}
+
+func (p *CreateNamespaceResult) ThriftName() string {
+	return "createNamespace_result"
+}
+
+func (p *CreateNamespaceResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *CreateNamespaceResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - NamespaceDesc: descriptor which describes the new namespace
+ */
+type ModifyNamespaceArgs struct {
+	NamespaceDesc *TNamespaceDescriptor "namespaceDesc" // 1
+}
+
+var tstructModifyNamespaceArgs = thrift.NewTStruct("modifyNamespace_args", []thrift.TField{
+	thrift.NewTField("namespaceDesc", thrift.STRUCT, 1),
+})
+
+func (*ModifyNamespaceArgs) GetTStruct() thrift.TStruct { return tstructModifyNamespaceArgs }
+func NewModifyNamespaceArgs() *ModifyNamespaceArgs {
+	output := &ModifyNamespaceArgs{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyNamespaceArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyNamespaceArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyNamespaceArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TNamespaceDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.NamespaceDesc = NewTNamespaceDescriptor()
+	err670 := p.NamespaceDesc.Read(iprot)
+	if err670 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.NamespaceDescTNamespaceDescriptor", err670)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyNamespace_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.NamespaceDesc != nil {
+		err = oprot.WriteFieldBegin("namespaceDesc", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "namespaceDesc", p.ThriftName(), err)
+		}
+		err = p.NamespaceDesc.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TNamespaceDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "namespaceDesc", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyNamespaceArgs) TStructName() string {
+	return "ModifyNamespaceArgs"
+}
+
+func (p *ModifyNamespaceArgs) ThriftName() string {
+	return "modifyNamespace_args"
+}
+
+func (p *ModifyNamespaceArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.NamespaceDesc
+	}
+	return nil
+}
+
+func (p *ModifyNamespaceArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("namespaceDesc", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type ModifyNamespaceResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructModifyNamespaceResult = thrift.NewTStruct("modifyNamespace_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*ModifyNamespaceResult) GetTStruct() thrift.TStruct { return tstructModifyNamespaceResult }
+func NewModifyNamespaceResult() *ModifyNamespaceResult {
+	output := &ModifyNamespaceResult{}
+	{
+	}
+	return output
+}
+
+func (p *ModifyNamespaceResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructModifyNamespaceResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructModifyNamespaceResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err671 := p.Io.Read(iprot)
+	if err671 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err671)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("modifyNamespace_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ModifyNamespaceResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ModifyNamespaceResult) TStructName() string {
+	return "ModifyNamespaceResult"
+}
+
+func (p *ModifyNamespaceResult) ThriftName() string {
+	return "modifyNamespace_result"
+}
+
+func (p *ModifyNamespaceResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *ModifyNamespaceResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Name: namespace name
+ */
+type DeleteNamespaceArgs struct {
+	Name string "name" // 1
+}
+
+var tstructDeleteNamespaceArgs = thrift.NewTStruct("deleteNamespace_args", []thrift.TField{
+	thrift.NewTField("name", thrift.STRING, 1),
+})
+
+func (*DeleteNamespaceArgs) GetTStruct() thrift.TStruct { return tstructDeleteNamespaceArgs }
+func NewDeleteNamespaceArgs() *DeleteNamespaceArgs {
+	output := &DeleteNamespaceArgs{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteNamespaceArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteNamespaceArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteNamespaceArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v672, err673 := iprot.ReadString()
+	if err673 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "name", p.ThriftName(), err673)
+	}
+	p.Name = v672
+	return err
+}
+
+func (p *DeleteNamespaceArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteNamespace_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("name", thrift.STRING, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteString(p.Name)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceArgs) TStructName() string {
+	return "DeleteNamespaceArgs"
+}
+
+func (p *DeleteNamespaceArgs) ThriftName() string {
+	return "deleteNamespace_args"
+}
+
+func (p *DeleteNamespaceArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Name
+	}
+	return nil
+}
+
+func (p *DeleteNamespaceArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("name", thrift.STRING, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Io
+ */
+type DeleteNamespaceResult struct {
+	Io *TIOError "io" // 1
+}
+
+var tstructDeleteNamespaceResult = thrift.NewTStruct("deleteNamespace_result", []thrift.TField{
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*DeleteNamespaceResult) GetTStruct() thrift.TStruct { return tstructDeleteNamespaceResult }
+func NewDeleteNamespaceResult() *DeleteNamespaceResult {
+	output := &DeleteNamespaceResult{}
+	{
+	}
+	return output
+}
+
+func (p *DeleteNamespaceResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructDeleteNamespaceResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructDeleteNamespaceResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err674 := p.Io.Read(iprot)
+	if err674 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err674)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("deleteNamespace_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *DeleteNamespaceResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *DeleteNamespaceResult) TStructName() string {
+	return "DeleteNamespaceResult"
+}
+
+func (p *DeleteNamespaceResult) ThriftName() string {
+	return "deleteNamespace_result"
+}
+
+func (p *DeleteNamespaceResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *DeleteNamespaceResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Name: name of namespace descriptor
+ */
+type GetNamespaceDescriptorArgs struct {
+	Name string "name" // 1
+}
+
+var tstructGetNamespaceDescriptorArgs = thrift.NewTStruct("getNamespaceDescriptor_args", []thrift.TField{
+	thrift.NewTField("name", thrift.STRING, 1),
+})
+
+func (*GetNamespaceDescriptorArgs) GetTStruct() thrift.TStruct {
+	return tstructGetNamespaceDescriptorArgs
+}
+func NewGetNamespaceDescriptorArgs() *GetNamespaceDescriptorArgs {
+	output := &GetNamespaceDescriptorArgs{}
+	{
+	}
+	return output
+}
+
+func (p *GetNamespaceDescriptorArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetNamespaceDescriptorArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetNamespaceDescriptorArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorArgs) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v675, err676 := iprot.ReadString()
+	if err676 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "name", p.ThriftName(), err676)
+	}
+	p.Name = v675
+	return err
+}
+
+func (p *GetNamespaceDescriptorArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getNamespaceDescriptor_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorArgs) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("name", thrift.STRING, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteString(p.Name)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorArgs) TStructName() string {
+	return "GetNamespaceDescriptorArgs"
+}
+
+func (p *GetNamespaceDescriptorArgs) ThriftName() string {
+	return "getNamespaceDescriptor_args"
+}
+
+func (p *GetNamespaceDescriptorArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Name
+	}
+	return nil
+}
+
+func (p *GetNamespaceDescriptorArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("name", thrift.STRING, 1),
+	})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type GetNamespaceDescriptorResult struct {
+	Success *TNamespaceDescriptor "success" // 0
+	Io      *TIOError             "io"      // 1
+}
+
+var tstructGetNamespaceDescriptorResult = thrift.NewTStruct("getNamespaceDescriptor_result", []thrift.TField{
+	thrift.NewTField("success", thrift.STRUCT, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*GetNamespaceDescriptorResult) GetTStruct() thrift.TStruct {
+	return tstructGetNamespaceDescriptorResult
+}
+func NewGetNamespaceDescriptorResult() *GetNamespaceDescriptorResult {
+	output := &GetNamespaceDescriptorResult{}
+	{
+	}
+	return output
+}
+
+func (p *GetNamespaceDescriptorResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructGetNamespaceDescriptorResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructGetNamespaceDescriptorResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //TNamespaceDescriptor
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Success = NewTNamespaceDescriptor()
+	err677 := p.Success.Read(iprot)
+	if err677 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.SuccessTNamespaceDescriptor", err677)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err678 := p.Io.Read(iprot)
+	if err678 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err678)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("getNamespaceDescriptor_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = p.Success.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TNamespaceDescriptor", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *GetNamespaceDescriptorResult) TStructName() string {
+	return "GetNamespaceDescriptorResult"
+}
+
+func (p *GetNamespaceDescriptorResult) ThriftName() string {
+	return "getNamespaceDescriptor_result"
+}
+
+func (p *GetNamespaceDescriptorResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *GetNamespaceDescriptorResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.STRUCT, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
+
+type ListNamespaceDescriptorsArgs struct {
+}
+
+var tstructListNamespaceDescriptorsArgs = thrift.NewTStruct("listNamespaceDescriptors_args", []thrift.TField{})
+
+func (*ListNamespaceDescriptorsArgs) GetTStruct() thrift.TStruct {
+	return tstructListNamespaceDescriptorsArgs
+}
+func NewListNamespaceDescriptorsArgs() *ListNamespaceDescriptorsArgs {
+	output := &ListNamespaceDescriptorsArgs{}
+	{
+	}
+	return output
+}
+
+func (p *ListNamespaceDescriptorsArgs) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructListNamespaceDescriptorsArgs.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructListNamespaceDescriptorsArgs.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsArgs) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("listNamespaceDescriptors_args")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsArgs) TStructName() string {
+	return "ListNamespaceDescriptorsArgs"
+}
+
+func (p *ListNamespaceDescriptorsArgs) ThriftName() string {
+	return "listNamespaceDescriptors_args"
+}
+
+func (p *ListNamespaceDescriptorsArgs) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	}
+	return nil
+}
+
+func (p *ListNamespaceDescriptorsArgs) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{})
+}
+
+/**
+ * Attributes:
+ *  - Success
+ *  - Io
+ */
+type ListNamespaceDescriptorsResult struct {
+	Success []*TNamespaceDescriptor "success" // 0
+	Io      *TIOError               "io"      // 1
+}
+
+var tstructListNamespaceDescriptorsResult = thrift.NewTStruct("listNamespaceDescriptors_result", []thrift.TField{
+	thrift.NewTField("success", thrift.LIST, 0),
+	thrift.NewTField("io", thrift.STRUCT, 1),
+})
+
+func (*ListNamespaceDescriptorsResult) GetTStruct() thrift.TStruct {
+	return tstructListNamespaceDescriptorsResult
+}
+func NewListNamespaceDescriptorsResult() *ListNamespaceDescriptorsResult {
+	output := &ListNamespaceDescriptorsResult{}
+	{
+	}
+	return output
+}
+
+func (p *ListNamespaceDescriptorsResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructListNamespaceDescriptorsResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructListNamespaceDescriptorsResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField0(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 1: //TIOError
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) readField0(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype680, _size679, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Success", "", err)
+	}
+	_ = _etype680
+	p.Success = make([]*TNamespaceDescriptor, _size679, _size679)
+	for i := 0; i < _size679; i++ {
+		_elem681 := NewTNamespaceDescriptor()
+		err682 := _elem681.Read(iprot)
+		if err682 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem681TNamespaceDescriptor", err682)
+		}
+		p.Success[i] = _elem681
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Io = NewTIOError()
+	err683 := p.Io.Read(iprot)
+	if err683 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.IoTIOError", err683)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("listNamespaceDescriptors_result")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	switch {
+	case p.Io != nil:
+		if err = p.writeField1(oprot); err != nil {
+			return err
+		}
+	default:
+		if err = p.writeField0(oprot); err != nil {
+			return err
+		}
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) writeField0(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Success != nil {
+		err = oprot.WriteFieldBegin("success", thrift.LIST, 0)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Success))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter684 := range p.Success {
+			err = Iter684.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TNamespaceDescriptor", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(0, "success", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Io != nil {
+		err = oprot.WriteFieldBegin("io", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+		err = p.Io.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TIOError", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "io", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *ListNamespaceDescriptorsResult) TStructName() string {
+	return "ListNamespaceDescriptorsResult"
+}
+
+func (p *ListNamespaceDescriptorsResult) ThriftName() string {
+	return "listNamespaceDescriptors_result"
+}
+
+func (p *ListNamespaceDescriptorsResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 0:
+		return p.Success
+	case 1:
+		return p.Io
+	}
+	return nil
+}
+
+func (p *ListNamespaceDescriptorsResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("success", thrift.LIST, 0),
+		thrift.NewTField("io", thrift.STRUCT, 1),
+	})
+}
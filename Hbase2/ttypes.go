@@ -0,0 +1,7122 @@
+/* Autogenerated by Thrift Compiler (0.9.0)
+ *
+ * DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+ */
+package Hbase2
+
+import (
+	"fmt"
+	"github.com/sdming/goh/thrift"
+	"math"
+)
+
+// This is a temporary safety measure to ensure that the `math'
+// import does not trip up any generated output that may not
+// happen to use the math import due to not having emited enums.
+//
+// Future clean-ups will deprecate the need for this.
+var _ = fmt.Print
+var _ = math.MinInt32
+
+/**
+ * Specify type of delete:
+ *  - DELETE_COLUMN means exactly one version will be removed,
+ *  - DELETE_COLUMNS means previous versions will also be removed.
+ */
+type TDeleteType int
+
+const (
+	TDeleteType_DELETE_COLUMN  TDeleteType = 0
+	TDeleteType_DELETE_COLUMNS TDeleteType = 1
+)
+
+func (p TDeleteType) String() string {
+	switch p {
+	case TDeleteType_DELETE_COLUMN:
+		return "DELETE_COLUMN"
+	case TDeleteType_DELETE_COLUMNS:
+		return "DELETE_COLUMNS"
+	}
+	return "<UNSET>"
+}
+
+func FromTDeleteTypeString(s string) TDeleteType {
+	switch s {
+	case "DELETE_COLUMN":
+		return TDeleteType_DELETE_COLUMN
+	case "DELETE_COLUMNS":
+		return TDeleteType_DELETE_COLUMNS
+	}
+	return TDeleteType(-10000)
+}
+
+func (p TDeleteType) Value() int {
+	return int(p)
+}
+
+func (p TDeleteType) IsEnum() bool {
+	return true
+}
+
+/**
+ * Specify Durability:
+ *  - USE_DEFAULT Use column family's default setting to determine durability.
+ *  - SKIP_WAL Do not write the Mutation to the WAL
+ *  - ASYNC_WAL Write the Mutation to the WAL asynchronously
+ *  - SYNC_WAL Write the Mutation to the WAL synchronously.
+ *  - FSYNC_WAL Write the Mutation to the WAL synchronously and force the entries to disk.
+ */
+type TDurability int
+
+const (
+	TDurability_USE_DEFAULT TDurability = 0
+	TDurability_SKIP_WAL    TDurability = 1
+	TDurability_ASYNC_WAL   TDurability = 2
+	TDurability_SYNC_WAL    TDurability = 3
+	TDurability_FSYNC_WAL   TDurability = 4
+)
+
+func (p TDurability) String() string {
+	switch p {
+	case TDurability_USE_DEFAULT:
+		return "USE_DEFAULT"
+	case TDurability_SKIP_WAL:
+		return "SKIP_WAL"
+	case TDurability_ASYNC_WAL:
+		return "ASYNC_WAL"
+	case TDurability_SYNC_WAL:
+		return "SYNC_WAL"
+	case TDurability_FSYNC_WAL:
+		return "FSYNC_WAL"
+	}
+	return "<UNSET>"
+}
+
+func FromTDurabilityString(s string) TDurability {
+	switch s {
+	case "USE_DEFAULT":
+		return TDurability_USE_DEFAULT
+	case "SKIP_WAL":
+		return TDurability_SKIP_WAL
+	case "ASYNC_WAL":
+		return TDurability_ASYNC_WAL
+	case "SYNC_WAL":
+		return TDurability_SYNC_WAL
+	case "FSYNC_WAL":
+		return TDurability_FSYNC_WAL
+	}
+	return TDurability(-10000)
+}
+
+func (p TDurability) Value() int {
+	return int(p)
+}
+
+func (p TDurability) IsEnum() bool {
+	return true
+}
+
+/**
+ * Thrift wrapper around
+ * org.apache.hadoop.hbase.regionserver.BloomType
+ */
+type TBloomFilterType int
+
+const (
+	TBloomFilterType_NONE                   TBloomFilterType = 0
+	TBloomFilterType_ROW                    TBloomFilterType = 1
+	TBloomFilterType_ROWCOL                 TBloomFilterType = 2
+	TBloomFilterType_ROWPREFIX_FIXED_LENGTH TBloomFilterType = 3
+)
+
+func (p TBloomFilterType) String() string {
+	switch p {
+	case TBloomFilterType_NONE:
+		return "NONE"
+	case TBloomFilterType_ROW:
+		return "ROW"
+	case TBloomFilterType_ROWCOL:
+		return "ROWCOL"
+	case TBloomFilterType_ROWPREFIX_FIXED_LENGTH:
+		return "ROWPREFIX_FIXED_LENGTH"
+	}
+	return "<UNSET>"
+}
+
+func FromTBloomFilterTypeString(s string) TBloomFilterType {
+	switch s {
+	case "NONE":
+		return TBloomFilterType_NONE
+	case "ROW":
+		return TBloomFilterType_ROW
+	case "ROWCOL":
+		return TBloomFilterType_ROWCOL
+	case "ROWPREFIX_FIXED_LENGTH":
+		return TBloomFilterType_ROWPREFIX_FIXED_LENGTH
+	}
+	return TBloomFilterType(-10000)
+}
+
+func (p TBloomFilterType) Value() int {
+	return int(p)
+}
+
+func (p TBloomFilterType) IsEnum() bool {
+	return true
+}
+
+/**
+ * Thrift wrapper around
+ * org.apache.hadoop.hbase.io.compress.Algorithm
+ */
+type TCompressionAlgorithm int
+
+const (
+	TCompressionAlgorithm_LZO    TCompressionAlgorithm = 0
+	TCompressionAlgorithm_GZ     TCompressionAlgorithm = 1
+	TCompressionAlgorithm_NONE   TCompressionAlgorithm = 2
+	TCompressionAlgorithm_SNAPPY TCompressionAlgorithm = 3
+	TCompressionAlgorithm_LZ4    TCompressionAlgorithm = 4
+	TCompressionAlgorithm_BZIP2  TCompressionAlgorithm = 5
+	TCompressionAlgorithm_ZSTD   TCompressionAlgorithm = 6
+)
+
+func (p TCompressionAlgorithm) String() string {
+	switch p {
+	case TCompressionAlgorithm_LZO:
+		return "LZO"
+	case TCompressionAlgorithm_GZ:
+		return "GZ"
+	case TCompressionAlgorithm_NONE:
+		return "NONE"
+	case TCompressionAlgorithm_SNAPPY:
+		return "SNAPPY"
+	case TCompressionAlgorithm_LZ4:
+		return "LZ4"
+	case TCompressionAlgorithm_BZIP2:
+		return "BZIP2"
+	case TCompressionAlgorithm_ZSTD:
+		return "ZSTD"
+	}
+	return "<UNSET>"
+}
+
+func FromTCompressionAlgorithmString(s string) TCompressionAlgorithm {
+	switch s {
+	case "LZO":
+		return TCompressionAlgorithm_LZO
+	case "GZ":
+		return TCompressionAlgorithm_GZ
+	case "NONE":
+		return TCompressionAlgorithm_NONE
+	case "SNAPPY":
+		return TCompressionAlgorithm_SNAPPY
+	case "LZ4":
+		return TCompressionAlgorithm_LZ4
+	case "BZIP2":
+		return TCompressionAlgorithm_BZIP2
+	case "ZSTD":
+		return TCompressionAlgorithm_ZSTD
+	}
+	return TCompressionAlgorithm(-10000)
+}
+
+func (p TCompressionAlgorithm) Value() int {
+	return int(p)
+}
+
+func (p TCompressionAlgorithm) IsEnum() bool {
+	return true
+}
+
+/**
+ * Thrift wrapper around
+ * org.apache.hadoop.hbase.io.encoding.DataBlockEncoding
+ */
+type TDataBlockEncoding int
+
+const (
+	TDataBlockEncoding_NONE         TDataBlockEncoding = 0
+	TDataBlockEncoding_PREFIX       TDataBlockEncoding = 2
+	TDataBlockEncoding_DIFF         TDataBlockEncoding = 3
+	TDataBlockEncoding_FAST_DIFF    TDataBlockEncoding = 4
+	TDataBlockEncoding_ROW_INDEX_V1 TDataBlockEncoding = 7
+)
+
+func (p TDataBlockEncoding) String() string {
+	switch p {
+	case TDataBlockEncoding_NONE:
+		return "NONE"
+	case TDataBlockEncoding_PREFIX:
+		return "PREFIX"
+	case TDataBlockEncoding_DIFF:
+		return "DIFF"
+	case TDataBlockEncoding_FAST_DIFF:
+		return "FAST_DIFF"
+	case TDataBlockEncoding_ROW_INDEX_V1:
+		return "ROW_INDEX_V1"
+	}
+	return "<UNSET>"
+}
+
+func FromTDataBlockEncodingString(s string) TDataBlockEncoding {
+	switch s {
+	case "NONE":
+		return TDataBlockEncoding_NONE
+	case "PREFIX":
+		return TDataBlockEncoding_PREFIX
+	case "DIFF":
+		return TDataBlockEncoding_DIFF
+	case "FAST_DIFF":
+		return TDataBlockEncoding_FAST_DIFF
+	case "ROW_INDEX_V1":
+		return TDataBlockEncoding_ROW_INDEX_V1
+	}
+	return TDataBlockEncoding(-10000)
+}
+
+func (p TDataBlockEncoding) Value() int {
+	return int(p)
+}
+
+func (p TDataBlockEncoding) IsEnum() bool {
+	return true
+}
+
+/**
+ * Thrift wrapper around
+ * org.apache.hadoop.hbase.KeepDeletedCells
+ */
+type TKeepDeletedCells int
+
+const (
+	TKeepDeletedCells_FALSE TKeepDeletedCells = 0
+	TKeepDeletedCells_TRUE  TKeepDeletedCells = 1
+	TKeepDeletedCells_TTL   TKeepDeletedCells = 2
+)
+
+func (p TKeepDeletedCells) String() string {
+	switch p {
+	case TKeepDeletedCells_FALSE:
+		return "FALSE"
+	case TKeepDeletedCells_TRUE:
+		return "TRUE"
+	case TKeepDeletedCells_TTL:
+		return "TTL"
+	}
+	return "<UNSET>"
+}
+
+func FromTKeepDeletedCellsString(s string) TKeepDeletedCells {
+	switch s {
+	case "FALSE":
+		return TKeepDeletedCells_FALSE
+	case "TRUE":
+		return TKeepDeletedCells_TRUE
+	case "TTL":
+		return TKeepDeletedCells_TTL
+	}
+	return TKeepDeletedCells(-10000)
+}
+
+func (p TKeepDeletedCells) Value() int {
+	return int(p)
+}
+
+func (p TKeepDeletedCells) IsEnum() bool {
+	return true
+}
+
+/**
+ * Attributes:
+ *  - MinStamp
+ *  - MaxStamp
+ */
+type TTimeRange struct {
+	MinStamp int64 "minStamp" // 1
+	MaxStamp int64 "maxStamp" // 2
+}
+
+var tstructTTimeRange = thrift.NewTStruct("TTimeRange", []thrift.TField{
+	thrift.NewTField("minStamp", thrift.I64, 1),
+	thrift.NewTField("maxStamp", thrift.I64, 2),
+})
+
+func (*TTimeRange) GetTStruct() thrift.TStruct { return tstructTTimeRange }
+func NewTTimeRange() *TTimeRange {
+	output := &TTimeRange{}
+	{
+	}
+	return output
+}
+
+func (p *TTimeRange) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTTimeRange.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTTimeRange.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTimeRange) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v1, err2 := iprot.ReadI64()
+	if err2 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "minStamp", p.ThriftName(), err2)
+	}
+	p.MinStamp = v1
+	return err
+}
+
+func (p *TTimeRange) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v3, err4 := iprot.ReadI64()
+	if err4 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "maxStamp", p.ThriftName(), err4)
+	}
+	p.MaxStamp = v3
+	return err
+}
+
+func (p *TTimeRange) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TTimeRange")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTimeRange) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("minStamp", thrift.I64, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "minStamp", p.ThriftName(), err)
+	}
+	err = oprot.WriteI64(int64(p.MinStamp))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "minStamp", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "minStamp", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTimeRange) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("maxStamp", thrift.I64, 2)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "maxStamp", p.ThriftName(), err)
+	}
+	err = oprot.WriteI64(int64(p.MaxStamp))
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "maxStamp", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(2, "maxStamp", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTimeRange) TStructName() string {
+	return "TTimeRange"
+}
+
+func (p *TTimeRange) ThriftName() string {
+	return "TTimeRange"
+}
+
+func (p *TTimeRange) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.MinStamp
+	case 2:
+		return p.MaxStamp
+	}
+	return nil
+}
+
+func (p *TTimeRange) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("minStamp", thrift.I64, 1),
+		thrift.NewTField("maxStamp", thrift.I64, 2),
+	})
+}
+
+/**
+ * Addresses a single cell or multiple cells in a HBase table by column
+ * family and optionally a column qualifier and timestamp
+ *
+ * Attributes:
+ *  - Family
+ *  - Qualifier
+ *  - Timestamp
+ */
+type TColumn struct {
+	Family    []byte "family"    // 1
+	Qualifier []byte "qualifier" // 2
+	Timestamp int64  "timestamp" // 3
+}
+
+var tstructTColumn = thrift.NewTStruct("TColumn", []thrift.TField{
+	thrift.NewTField("family", thrift.STRING, 1),
+	thrift.NewTField("qualifier", thrift.STRING, 2),
+	thrift.NewTField("timestamp", thrift.I64, 3),
+})
+
+func (*TColumn) GetTStruct() thrift.TStruct { return tstructTColumn }
+func NewTColumn() *TColumn {
+	output := &TColumn{}
+	{
+	}
+	return output
+}
+
+func (p *TColumn) IsSetQualifier() bool {
+	return p.Qualifier != nil
+}
+
+func (p *TColumn) IsSetTimestamp() bool {
+	return p.Timestamp != 0
+}
+
+func (p *TColumn) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTColumn.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTColumn.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumn) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v5, err6 := iprot.ReadBinary()
+	if err6 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "family", p.ThriftName(), err6)
+	}
+	p.Family = v5
+	return err
+}
+
+func (p *TColumn) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v7, err8 := iprot.ReadBinary()
+	if err8 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "qualifier", p.ThriftName(), err8)
+	}
+	p.Qualifier = v7
+	return err
+}
+
+func (p *TColumn) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v9, err10 := iprot.ReadI64()
+	if err10 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err10)
+	}
+	p.Timestamp = v9
+	return err
+}
+
+func (p *TColumn) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TColumn")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumn) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Family != nil {
+		err = oprot.WriteFieldBegin("family", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Family)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumn) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		if p.IsSetQualifier() {
+			err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.Qualifier)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TColumn) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimestamp() {
+		err = oprot.WriteFieldBegin("timestamp", thrift.I64, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Timestamp))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumn) TStructName() string {
+	return "TColumn"
+}
+
+func (p *TColumn) ThriftName() string {
+	return "TColumn"
+}
+
+func (p *TColumn) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Family
+	case 2:
+		return p.Qualifier
+	case 3:
+		return p.Timestamp
+	}
+	return nil
+}
+
+func (p *TColumn) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("family", thrift.STRING, 1),
+		thrift.NewTField("qualifier", thrift.STRING, 2),
+		thrift.NewTField("timestamp", thrift.I64, 3),
+	})
+}
+
+/**
+ * Represents a single cell and its value.
+ *
+ * Attributes:
+ *  - Family
+ *  - Qualifier
+ *  - Value
+ *  - Timestamp
+ *  - Tags
+ */
+type TColumnValue struct {
+	Family    []byte "family"    // 1
+	Qualifier []byte "qualifier" // 2
+	Value     []byte "value"     // 3
+	Timestamp int64  "timestamp" // 4
+	Tags      []byte "tags"      // 5
+}
+
+var tstructTColumnValue = thrift.NewTStruct("TColumnValue", []thrift.TField{
+	thrift.NewTField("family", thrift.STRING, 1),
+	thrift.NewTField("qualifier", thrift.STRING, 2),
+	thrift.NewTField("value", thrift.STRING, 3),
+	thrift.NewTField("timestamp", thrift.I64, 4),
+	thrift.NewTField("tags", thrift.STRING, 5),
+})
+
+func (*TColumnValue) GetTStruct() thrift.TStruct { return tstructTColumnValue }
+func NewTColumnValue() *TColumnValue {
+	output := &TColumnValue{}
+	{
+	}
+	return output
+}
+
+func (p *TColumnValue) IsSetTimestamp() bool {
+	return p.Timestamp != 0
+}
+
+func (p *TColumnValue) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *TColumnValue) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTColumnValue.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTColumnValue.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnValue) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v11, err12 := iprot.ReadBinary()
+	if err12 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "family", p.ThriftName(), err12)
+	}
+	p.Family = v11
+	return err
+}
+
+func (p *TColumnValue) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v13, err14 := iprot.ReadBinary()
+	if err14 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "qualifier", p.ThriftName(), err14)
+	}
+	p.Qualifier = v13
+	return err
+}
+
+func (p *TColumnValue) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v15, err16 := iprot.ReadBinary()
+	if err16 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "value", p.ThriftName(), err16)
+	}
+	p.Value = v15
+	return err
+}
+
+func (p *TColumnValue) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v17, err18 := iprot.ReadI64()
+	if err18 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "timestamp", p.ThriftName(), err18)
+	}
+	p.Timestamp = v17
+	return err
+}
+
+func (p *TColumnValue) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v19, err20 := iprot.ReadBinary()
+	if err20 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "tags", p.ThriftName(), err20)
+	}
+	p.Tags = v19
+	return err
+}
+
+func (p *TColumnValue) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TColumnValue")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnValue) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Family != nil {
+		err = oprot.WriteFieldBegin("family", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Family)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnValue) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Qualifier)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnValue) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Value != nil {
+		err = oprot.WriteFieldBegin("value", thrift.STRING, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Value)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "value", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "value", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnValue) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimestamp() {
+		err = oprot.WriteFieldBegin("timestamp", thrift.I64, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Timestamp))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "timestamp", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnValue) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Tags != nil {
+		if p.IsSetTags() {
+			err = oprot.WriteFieldBegin("tags", thrift.STRING, 5)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(5, "tags", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.Tags)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(5, "tags", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(5, "tags", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TColumnValue) TStructName() string {
+	return "TColumnValue"
+}
+
+func (p *TColumnValue) ThriftName() string {
+	return "TColumnValue"
+}
+
+func (p *TColumnValue) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Family
+	case 2:
+		return p.Qualifier
+	case 3:
+		return p.Value
+	case 4:
+		return p.Timestamp
+	case 5:
+		return p.Tags
+	}
+	return nil
+}
+
+func (p *TColumnValue) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("family", thrift.STRING, 1),
+		thrift.NewTField("qualifier", thrift.STRING, 2),
+		thrift.NewTField("value", thrift.STRING, 3),
+		thrift.NewTField("timestamp", thrift.I64, 4),
+		thrift.NewTField("tags", thrift.STRING, 5),
+	})
+}
+
+/**
+ * if no Result is found, row and columnValues will not be set.
+ *
+ * Attributes:
+ *  - Row
+ *  - ColumnValues
+ */
+type TResult struct {
+	Row          []byte          "row"          // 1
+	ColumnValues []*TColumnValue "columnValues" // 2
+}
+
+var tstructTResult = thrift.NewTStruct("TResult", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columnValues", thrift.LIST, 2),
+})
+
+func (*TResult) GetTStruct() thrift.TStruct { return tstructTResult }
+func NewTResult() *TResult {
+	output := &TResult{}
+	{
+	}
+	return output
+}
+
+func (p *TResult) IsSetRow() bool {
+	return p.Row != nil
+}
+
+func (p *TResult) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTResult.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTResult.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TResult) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v21, err22 := iprot.ReadBinary()
+	if err22 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err22)
+	}
+	p.Row = v21
+	return err
+}
+
+func (p *TResult) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype24, _size23, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.ColumnValues", "", err)
+	}
+	_ = _etype24
+	p.ColumnValues = make([]*TColumnValue, _size23, _size23)
+	for i := 0; i < _size23; i++ {
+		_elem25 := NewTColumnValue()
+		err26 := _elem25.Read(iprot)
+		if err26 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem25TColumnValue", err26)
+		}
+		p.ColumnValues[i] = _elem25
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TResult) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TResult")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TResult) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		if p.IsSetRow() {
+			err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.Row)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TResult) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.ColumnValues != nil {
+		err = oprot.WriteFieldBegin("columnValues", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columnValues", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnValues))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter27 := range p.ColumnValues {
+			err = Iter27.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TColumnValue", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columnValues", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TResult) TStructName() string {
+	return "TResult"
+}
+
+func (p *TResult) ThriftName() string {
+	return "TResult"
+}
+
+func (p *TResult) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.ColumnValues
+	}
+	return nil
+}
+
+func (p *TResult) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columnValues", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Used to perform Get operations on a single row.
+ *
+ * The scope can be further narrowed down by specifying a list of columns
+ * or column families.
+ *
+ * To get everything for a row, instantiate a Get object with just the row
+ * to get. To further define the scope of what to get you can add a
+ * timestamp or time range with an optional maximum number of versions to
+ * return.
+ *
+ * If you specify a time range and a timestamp the range is ignored.
+ * Timestamps on TColumns are ignored.
+ *
+ * Attributes:
+ *  - Row
+ *  - Columns
+ *  - Timestamp
+ *  - TimeRange
+ *  - MaxVersions
+ *  - FilterString
+ *  - Attributes
+ */
+type TGet struct {
+	Row          []byte            "row"          // 1
+	Columns      []*TColumn        "columns"      // 2
+	Timestamp    int64             "timestamp"    // 3
+	TimeRange    *TTimeRange       "timeRange"    // 4
+	MaxVersions  int32             "maxVersions"  // 5
+	FilterString []byte            "filterString" // 6
+	Attributes   map[string][]byte "attributes"   // 7
+}
+
+var tstructTGet = thrift.NewTStruct("TGet", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columns", thrift.LIST, 2),
+	thrift.NewTField("timestamp", thrift.I64, 3),
+	thrift.NewTField("timeRange", thrift.STRUCT, 4),
+	thrift.NewTField("maxVersions", thrift.I32, 5),
+	thrift.NewTField("filterString", thrift.STRING, 6),
+	thrift.NewTField("attributes", thrift.MAP, 7),
+})
+
+func (*TGet) GetTStruct() thrift.TStruct { return tstructTGet }
+func NewTGet() *TGet {
+	output := &TGet{}
+	{
+	}
+	return output
+}
+
+func (p *TGet) IsSetColumns() bool {
+	return len(p.Columns) > 0
+}
+
+func (p *TGet) IsSetTimestamp() bool {
+	return p.Timestamp != 0
+}
+
+func (p *TGet) IsSetTimeRange() bool {
+	return p.TimeRange != nil
+}
+
+func (p *TGet) IsSetMaxVersions() bool {
+	return p.MaxVersions != 0
+}
+
+func (p *TGet) IsSetFilterString() bool {
+	return p.FilterString != nil
+}
+
+func (p *TGet) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TGet) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTGet.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTGet.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //TTimeRange
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 7: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TGet) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v28, err29 := iprot.ReadBinary()
+	if err29 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err29)
+	}
+	p.Row = v28
+	return err
+}
+
+func (p *TGet) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype31, _size30, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype31
+	p.Columns = make([]*TColumn, _size30, _size30)
+	for i := 0; i < _size30; i++ {
+		_elem32 := NewTColumn()
+		err33 := _elem32.Read(iprot)
+		if err33 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem32TColumn", err33)
+		}
+		p.Columns[i] = _elem32
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TGet) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v34, err35 := iprot.ReadI64()
+	if err35 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err35)
+	}
+	p.Timestamp = v34
+	return err
+}
+
+func (p *TGet) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TimeRange = NewTTimeRange()
+	err36 := p.TimeRange.Read(iprot)
+	if err36 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TimeRangeTTimeRange", err36)
+	}
+	return err
+}
+
+func (p *TGet) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v37, err38 := iprot.ReadI32()
+	if err38 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "maxVersions", p.ThriftName(), err38)
+	}
+	p.MaxVersions = v37
+	return err
+}
+
+func (p *TGet) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v39, err40 := iprot.ReadBinary()
+	if err40 != nil {
+		return thrift.NewTProtocolExceptionReadField(6, "filterString", p.ThriftName(), err40)
+	}
+	p.FilterString = v39
+	return err
+}
+
+func (p *TGet) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype42, _vtype43, _size41, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype42, _vtype43
+	p.Attributes = make(map[string][]byte, _size41)
+	for i := 0; i < _size41; i++ {
+		v46, err47 := iprot.ReadString()
+		if err47 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key44", "", err47)
+		}
+		_key44 := v46
+		v48, err49 := iprot.ReadBinary()
+		if err49 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val45", "", err49)
+		}
+		_val45 := v48
+		p.Attributes[_key44] = _val45
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TGet) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TGet")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TGet) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		if p.IsSetColumns() {
+			err = oprot.WriteFieldBegin("columns", thrift.LIST, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+			err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			for _, Iter50 := range p.Columns {
+				err = Iter50.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TColumn", err)
+				}
+			}
+			err = oprot.WriteListEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimestamp() {
+		err = oprot.WriteFieldBegin("timestamp", thrift.I64, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Timestamp))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TimeRange != nil {
+		if p.IsSetTimeRange() {
+			err = oprot.WriteFieldBegin("timeRange", thrift.STRUCT, 4)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(4, "timeRange", p.ThriftName(), err)
+			}
+			err = p.TimeRange.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TTimeRange", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(4, "timeRange", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMaxVersions() {
+		err = oprot.WriteFieldBegin("maxVersions", thrift.I32, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.MaxVersions))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.FilterString != nil {
+		if p.IsSetFilterString() {
+			err = oprot.WriteFieldBegin("filterString", thrift.STRING, 6)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "filterString", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.FilterString)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "filterString", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "filterString", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TGet) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 7)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(7, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter51, Viter52 := range p.Attributes {
+				err = oprot.WriteString(Kiter51)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter51", "", err)
+				}
+				err = oprot.WriteBinary(Viter52)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter52", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(7, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TGet) TStructName() string {
+	return "TGet"
+}
+
+func (p *TGet) ThriftName() string {
+	return "TGet"
+}
+
+func (p *TGet) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.Columns
+	case 3:
+		return p.Timestamp
+	case 4:
+		return p.TimeRange
+	case 5:
+		return p.MaxVersions
+	case 6:
+		return p.FilterString
+	case 7:
+		return p.Attributes
+	}
+	return nil
+}
+
+func (p *TGet) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columns", thrift.LIST, 2),
+		thrift.NewTField("timestamp", thrift.I64, 3),
+		thrift.NewTField("timeRange", thrift.STRUCT, 4),
+		thrift.NewTField("maxVersions", thrift.I32, 5),
+		thrift.NewTField("filterString", thrift.STRING, 6),
+		thrift.NewTField("attributes", thrift.MAP, 7),
+	})
+}
+
+/**
+ * Used to perform Put operations for a single row.
+ *
+ * Add column values to this object and they'll be added. You can provide a
+ * default timestamp if the column values don't have one. If you don't
+ * provide a default timestamp the current time is inserted.
+ *
+ * Attributes:
+ *  - Row
+ *  - ColumnValues
+ *  - Timestamp
+ *  - Attributes
+ *  - Durability
+ */
+type TPut struct {
+	Row          []byte            "row"          // 1
+	ColumnValues []*TColumnValue   "columnValues" // 2
+	Timestamp    int64             "timestamp"    // 3
+	Attributes   map[string][]byte "attributes"   // 5
+	Durability   TDurability       "durability"   // 6
+}
+
+var tstructTPut = thrift.NewTStruct("TPut", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columnValues", thrift.LIST, 2),
+	thrift.NewTField("timestamp", thrift.I64, 3),
+	thrift.NewTField("attributes", thrift.MAP, 5),
+	thrift.NewTField("durability", thrift.I32, 6),
+})
+
+func (*TPut) GetTStruct() thrift.TStruct { return tstructTPut }
+func NewTPut() *TPut {
+	output := &TPut{}
+	{
+	}
+	return output
+}
+
+func (p *TPut) IsSetTimestamp() bool {
+	return p.Timestamp != 0
+}
+
+func (p *TPut) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TPut) IsSetDurability() bool {
+	return p.Durability != 0
+}
+
+func (p *TPut) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTPut.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTPut.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //TDurability
+			if fieldTypeId == thrift.I32 {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TPut) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v53, err54 := iprot.ReadBinary()
+	if err54 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err54)
+	}
+	p.Row = v53
+	return err
+}
+
+func (p *TPut) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype56, _size55, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.ColumnValues", "", err)
+	}
+	_ = _etype56
+	p.ColumnValues = make([]*TColumnValue, _size55, _size55)
+	for i := 0; i < _size55; i++ {
+		_elem57 := NewTColumnValue()
+		err58 := _elem57.Read(iprot)
+		if err58 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem57TColumnValue", err58)
+		}
+		p.ColumnValues[i] = _elem57
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TPut) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v59, err60 := iprot.ReadI64()
+	if err60 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err60)
+	}
+	p.Timestamp = v59
+	return err
+}
+
+func (p *TPut) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype62, _vtype63, _size61, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype62, _vtype63
+	p.Attributes = make(map[string][]byte, _size61)
+	for i := 0; i < _size61; i++ {
+		v66, err67 := iprot.ReadString()
+		if err67 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key64", "", err67)
+		}
+		_key64 := v66
+		v68, err69 := iprot.ReadBinary()
+		if err69 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val65", "", err69)
+		}
+		_val65 := v68
+		p.Attributes[_key64] = _val65
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TPut) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v70, err71 := iprot.ReadI32()
+	if err71 != nil {
+		return thrift.NewTProtocolExceptionReadField(6, "durability", p.ThriftName(), err71)
+	}
+	p.Durability = TDurability(v70)
+	return err
+}
+
+func (p *TPut) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TPut")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TPut) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TPut) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.ColumnValues != nil {
+		err = oprot.WriteFieldBegin("columnValues", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columnValues", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnValues))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter72 := range p.ColumnValues {
+			err = Iter72.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TColumnValue", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columnValues", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TPut) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimestamp() {
+		err = oprot.WriteFieldBegin("timestamp", thrift.I64, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Timestamp))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TPut) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 5)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(5, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter73, Viter74 := range p.Attributes {
+				err = oprot.WriteString(Kiter73)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter73", "", err)
+				}
+				err = oprot.WriteBinary(Viter74)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter74", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(5, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TPut) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDurability() {
+		err = oprot.WriteFieldBegin("durability", thrift.I32, 6)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Durability))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "durability", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TPut) TStructName() string {
+	return "TPut"
+}
+
+func (p *TPut) ThriftName() string {
+	return "TPut"
+}
+
+func (p *TPut) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.ColumnValues
+	case 3:
+		return p.Timestamp
+	case 5:
+		return p.Attributes
+	case 6:
+		return p.Durability
+	}
+	return nil
+}
+
+func (p *TPut) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columnValues", thrift.LIST, 2),
+		thrift.NewTField("timestamp", thrift.I64, 3),
+		thrift.NewTField("attributes", thrift.MAP, 5),
+		thrift.NewTField("durability", thrift.I32, 6),
+	})
+}
+
+/**
+ * Used to perform Delete operations on a single row.
+ *
+ * The scope can be further narrowed down by specifying a list of columns
+ * or column families as TColumns.
+ *
+ * Specifying only a family in a TColumn will delete the whole family. If a
+ * timestamp is specified all versions with a timestamp less than or equal
+ * to this will be deleted. If no timestamp is specified the current time
+ * will be used.
+ *
+ * Specifying a family and a column qualifier in a TColumn will delete only
+ * this qualifier. If a timestamp is specified only versions equal to this
+ * timestamp will be deleted. If no timestamp is specified the most recent
+ * version will be deleted. To delete all previous versions, specify the
+ * DELETE_COLUMNS TDeleteType.
+ *
+ * The top level timestamp is only used if a complete row should be deleted
+ * (i.e. no columns are passed) and if it is specified it works the same
+ * way as if you had added a TColumn for every column family and this
+ * timestamp (i.e. all versions older than or equal in all column families
+ * will be deleted)
+ *
+ * Attributes:
+ *  - Row
+ *  - Columns
+ *  - Timestamp
+ *  - DeleteType
+ *  - Attributes
+ *  - Durability
+ */
+type TDelete struct {
+	Row        []byte            "row"        // 1
+	Columns    []*TColumn        "columns"    // 2
+	Timestamp  int64             "timestamp"  // 3
+	DeleteType TDeleteType       "deleteType" // 4
+	Attributes map[string][]byte "attributes" // 6
+	Durability TDurability       "durability" // 7
+}
+
+var tstructTDelete = thrift.NewTStruct("TDelete", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columns", thrift.LIST, 2),
+	thrift.NewTField("timestamp", thrift.I64, 3),
+	thrift.NewTField("deleteType", thrift.I32, 4),
+	thrift.NewTField("attributes", thrift.MAP, 6),
+	thrift.NewTField("durability", thrift.I32, 7),
+})
+
+func (*TDelete) GetTStruct() thrift.TStruct { return tstructTDelete }
+func NewTDelete() *TDelete {
+	output := &TDelete{}
+	{
+		output.DeleteType = TDeleteType_DELETE_COLUMNS
+	}
+	return output
+}
+
+func (p *TDelete) IsSetColumns() bool {
+	return len(p.Columns) > 0
+}
+
+func (p *TDelete) IsSetTimestamp() bool {
+	return p.Timestamp != 0
+}
+
+func (p *TDelete) IsSetDeleteType() bool {
+	return p.DeleteType != TDeleteType_DELETE_COLUMNS
+}
+
+func (p *TDelete) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TDelete) IsSetDurability() bool {
+	return p.Durability != 0
+}
+
+func (p *TDelete) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTDelete.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTDelete.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //TDeleteType
+			if fieldTypeId == thrift.I32 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 7: //TDurability
+			if fieldTypeId == thrift.I32 {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TDelete) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v75, err76 := iprot.ReadBinary()
+	if err76 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err76)
+	}
+	p.Row = v75
+	return err
+}
+
+func (p *TDelete) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype78, _size77, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype78
+	p.Columns = make([]*TColumn, _size77, _size77)
+	for i := 0; i < _size77; i++ {
+		_elem79 := NewTColumn()
+		err80 := _elem79.Read(iprot)
+		if err80 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem79TColumn", err80)
+		}
+		p.Columns[i] = _elem79
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TDelete) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v81, err82 := iprot.ReadI64()
+	if err82 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "timestamp", p.ThriftName(), err82)
+	}
+	p.Timestamp = v81
+	return err
+}
+
+func (p *TDelete) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v83, err84 := iprot.ReadI32()
+	if err84 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "deleteType", p.ThriftName(), err84)
+	}
+	p.DeleteType = TDeleteType(v83)
+	return err
+}
+
+func (p *TDelete) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype86, _vtype87, _size85, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype86, _vtype87
+	p.Attributes = make(map[string][]byte, _size85)
+	for i := 0; i < _size85; i++ {
+		v90, err91 := iprot.ReadString()
+		if err91 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key88", "", err91)
+		}
+		_key88 := v90
+		v92, err93 := iprot.ReadBinary()
+		if err93 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val89", "", err93)
+		}
+		_val89 := v92
+		p.Attributes[_key88] = _val89
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TDelete) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v94, err95 := iprot.ReadI32()
+	if err95 != nil {
+		return thrift.NewTProtocolExceptionReadField(7, "durability", p.ThriftName(), err95)
+	}
+	p.Durability = TDurability(v94)
+	return err
+}
+
+func (p *TDelete) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TDelete")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TDelete) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TDelete) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		if p.IsSetColumns() {
+			err = oprot.WriteFieldBegin("columns", thrift.LIST, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+			err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			for _, Iter96 := range p.Columns {
+				err = Iter96.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TColumn", err)
+				}
+			}
+			err = oprot.WriteListEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TDelete) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimestamp() {
+		err = oprot.WriteFieldBegin("timestamp", thrift.I64, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Timestamp))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "timestamp", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TDelete) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDeleteType() {
+		err = oprot.WriteFieldBegin("deleteType", thrift.I32, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "deleteType", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.DeleteType))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "deleteType", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "deleteType", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TDelete) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 6)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter97, Viter98 := range p.Attributes {
+				err = oprot.WriteString(Kiter97)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter97", "", err)
+				}
+				err = oprot.WriteBinary(Viter98)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter98", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TDelete) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDurability() {
+		err = oprot.WriteFieldBegin("durability", thrift.I32, 7)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Durability))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "durability", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TDelete) TStructName() string {
+	return "TDelete"
+}
+
+func (p *TDelete) ThriftName() string {
+	return "TDelete"
+}
+
+func (p *TDelete) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.Columns
+	case 3:
+		return p.Timestamp
+	case 4:
+		return p.DeleteType
+	case 6:
+		return p.Attributes
+	case 7:
+		return p.Durability
+	}
+	return nil
+}
+
+func (p *TDelete) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columns", thrift.LIST, 2),
+		thrift.NewTField("timestamp", thrift.I64, 3),
+		thrift.NewTField("deleteType", thrift.I32, 4),
+		thrift.NewTField("attributes", thrift.MAP, 6),
+		thrift.NewTField("durability", thrift.I32, 7),
+	})
+}
+
+/**
+ * Represents a single cell and the amount to increment it by
+ *
+ * Attributes:
+ *  - Family
+ *  - Qualifier
+ *  - Amount
+ */
+type TColumnIncrement struct {
+	Family    []byte "family"    // 1
+	Qualifier []byte "qualifier" // 2
+	Amount    int64  "amount"    // 3
+}
+
+var tstructTColumnIncrement = thrift.NewTStruct("TColumnIncrement", []thrift.TField{
+	thrift.NewTField("family", thrift.STRING, 1),
+	thrift.NewTField("qualifier", thrift.STRING, 2),
+	thrift.NewTField("amount", thrift.I64, 3),
+})
+
+func (*TColumnIncrement) GetTStruct() thrift.TStruct { return tstructTColumnIncrement }
+func NewTColumnIncrement() *TColumnIncrement {
+	output := &TColumnIncrement{}
+	{
+		output.Amount = 1
+	}
+	return output
+}
+
+func (p *TColumnIncrement) IsSetAmount() bool {
+	return p.Amount != 1
+}
+
+func (p *TColumnIncrement) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTColumnIncrement.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTColumnIncrement.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //i64
+			if fieldTypeId == thrift.I64 {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnIncrement) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v99, err100 := iprot.ReadBinary()
+	if err100 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "family", p.ThriftName(), err100)
+	}
+	p.Family = v99
+	return err
+}
+
+func (p *TColumnIncrement) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v101, err102 := iprot.ReadBinary()
+	if err102 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "qualifier", p.ThriftName(), err102)
+	}
+	p.Qualifier = v101
+	return err
+}
+
+func (p *TColumnIncrement) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v103, err104 := iprot.ReadI64()
+	if err104 != nil {
+		return thrift.NewTProtocolExceptionReadField(3, "amount", p.ThriftName(), err104)
+	}
+	p.Amount = v103
+	return err
+}
+
+func (p *TColumnIncrement) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TColumnIncrement")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnIncrement) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Family != nil {
+		err = oprot.WriteFieldBegin("family", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Family)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "family", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnIncrement) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Qualifier)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnIncrement) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetAmount() {
+		err = oprot.WriteFieldBegin("amount", thrift.I64, 3)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "amount", p.ThriftName(), err)
+		}
+		err = oprot.WriteI64(int64(p.Amount))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "amount", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(3, "amount", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnIncrement) TStructName() string {
+	return "TColumnIncrement"
+}
+
+func (p *TColumnIncrement) ThriftName() string {
+	return "TColumnIncrement"
+}
+
+func (p *TColumnIncrement) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Family
+	case 2:
+		return p.Qualifier
+	case 3:
+		return p.Amount
+	}
+	return nil
+}
+
+func (p *TColumnIncrement) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("family", thrift.STRING, 1),
+		thrift.NewTField("qualifier", thrift.STRING, 2),
+		thrift.NewTField("amount", thrift.I64, 3),
+	})
+}
+
+/**
+ * Used to perform Increment operations for a single row.
+ *
+ * You can specify how much each column is incremented by.
+ *
+ * Attributes:
+ *  - Row
+ *  - Columns
+ *  - Attributes
+ *  - Durability
+ */
+type TIncrement struct {
+	Row        []byte              "row"        // 1
+	Columns    []*TColumnIncrement "columns"    // 2
+	Attributes map[string][]byte   "attributes" // 4
+	Durability TDurability         "durability" // 5
+}
+
+var tstructTIncrement = thrift.NewTStruct("TIncrement", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columns", thrift.LIST, 2),
+	thrift.NewTField("attributes", thrift.MAP, 4),
+	thrift.NewTField("durability", thrift.I32, 5),
+})
+
+func (*TIncrement) GetTStruct() thrift.TStruct { return tstructTIncrement }
+func NewTIncrement() *TIncrement {
+	output := &TIncrement{}
+	{
+	}
+	return output
+}
+
+func (p *TIncrement) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TIncrement) IsSetDurability() bool {
+	return p.Durability != 0
+}
+
+func (p *TIncrement) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTIncrement.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTIncrement.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //TDurability
+			if fieldTypeId == thrift.I32 {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIncrement) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v105, err106 := iprot.ReadBinary()
+	if err106 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err106)
+	}
+	p.Row = v105
+	return err
+}
+
+func (p *TIncrement) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype108, _size107, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype108
+	p.Columns = make([]*TColumnIncrement, _size107, _size107)
+	for i := 0; i < _size107; i++ {
+		_elem109 := NewTColumnIncrement()
+		err110 := _elem109.Read(iprot)
+		if err110 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem109TColumnIncrement", err110)
+		}
+		p.Columns[i] = _elem109
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TIncrement) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype112, _vtype113, _size111, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype112, _vtype113
+	p.Attributes = make(map[string][]byte, _size111)
+	for i := 0; i < _size111; i++ {
+		v116, err117 := iprot.ReadString()
+		if err117 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key114", "", err117)
+		}
+		_key114 := v116
+		v118, err119 := iprot.ReadBinary()
+		if err119 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val115", "", err119)
+		}
+		_val115 := v118
+		p.Attributes[_key114] = _val115
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TIncrement) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v120, err121 := iprot.ReadI32()
+	if err121 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "durability", p.ThriftName(), err121)
+	}
+	p.Durability = TDurability(v120)
+	return err
+}
+
+func (p *TIncrement) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TIncrement")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIncrement) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TIncrement) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		err = oprot.WriteFieldBegin("columns", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter122 := range p.Columns {
+			err = Iter122.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TColumnIncrement", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TIncrement) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 4)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(4, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter123, Viter124 := range p.Attributes {
+				err = oprot.WriteString(Kiter123)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter123", "", err)
+				}
+				err = oprot.WriteBinary(Viter124)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter124", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(4, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TIncrement) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDurability() {
+		err = oprot.WriteFieldBegin("durability", thrift.I32, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Durability))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "durability", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TIncrement) TStructName() string {
+	return "TIncrement"
+}
+
+func (p *TIncrement) ThriftName() string {
+	return "TIncrement"
+}
+
+func (p *TIncrement) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.Columns
+	case 4:
+		return p.Attributes
+	case 5:
+		return p.Durability
+	}
+	return nil
+}
+
+func (p *TIncrement) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columns", thrift.LIST, 2),
+		thrift.NewTField("attributes", thrift.MAP, 4),
+		thrift.NewTField("durability", thrift.I32, 5),
+	})
+}
+
+/**
+ * Used to perform Append operations for a single row, the values are
+ * appended to the current values of the columns
+ *
+ * Attributes:
+ *  - Row
+ *  - Columns
+ *  - Attributes
+ *  - Durability
+ */
+type TAppend struct {
+	Row        []byte            "row"        // 1
+	Columns    []*TColumnValue   "columns"    // 2
+	Attributes map[string][]byte "attributes" // 3
+	Durability TDurability       "durability" // 4
+}
+
+var tstructTAppend = thrift.NewTStruct("TAppend", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("columns", thrift.LIST, 2),
+	thrift.NewTField("attributes", thrift.MAP, 3),
+	thrift.NewTField("durability", thrift.I32, 4),
+})
+
+func (*TAppend) GetTStruct() thrift.TStruct { return tstructTAppend }
+func NewTAppend() *TAppend {
+	output := &TAppend{}
+	{
+	}
+	return output
+}
+
+func (p *TAppend) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TAppend) IsSetDurability() bool {
+	return p.Durability != 0
+}
+
+func (p *TAppend) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTAppend.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTAppend.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //TDurability
+			if fieldTypeId == thrift.I32 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TAppend) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v125, err126 := iprot.ReadBinary()
+	if err126 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err126)
+	}
+	p.Row = v125
+	return err
+}
+
+func (p *TAppend) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype128, _size127, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype128
+	p.Columns = make([]*TColumnValue, _size127, _size127)
+	for i := 0; i < _size127; i++ {
+		_elem129 := NewTColumnValue()
+		err130 := _elem129.Read(iprot)
+		if err130 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem129TColumnValue", err130)
+		}
+		p.Columns[i] = _elem129
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TAppend) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype132, _vtype133, _size131, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype132, _vtype133
+	p.Attributes = make(map[string][]byte, _size131)
+	for i := 0; i < _size131; i++ {
+		v136, err137 := iprot.ReadString()
+		if err137 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key134", "", err137)
+		}
+		_key134 := v136
+		v138, err139 := iprot.ReadBinary()
+		if err139 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val135", "", err139)
+		}
+		_val135 := v138
+		p.Attributes[_key134] = _val135
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TAppend) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v140, err141 := iprot.ReadI32()
+	if err141 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "durability", p.ThriftName(), err141)
+	}
+	p.Durability = TDurability(v140)
+	return err
+}
+
+func (p *TAppend) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TAppend")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TAppend) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		err = oprot.WriteFieldBegin("columns", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter142 := range p.Columns {
+			err = Iter142.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TColumnValue", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 3)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter143, Viter144 := range p.Attributes {
+				err = oprot.WriteString(Kiter143)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter143", "", err)
+				}
+				err = oprot.WriteBinary(Viter144)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter144", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TAppend) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDurability() {
+		err = oprot.WriteFieldBegin("durability", thrift.I32, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Durability))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TAppend) TStructName() string {
+	return "TAppend"
+}
+
+func (p *TAppend) ThriftName() string {
+	return "TAppend"
+}
+
+func (p *TAppend) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.Columns
+	case 3:
+		return p.Attributes
+	case 4:
+		return p.Durability
+	}
+	return nil
+}
+
+func (p *TAppend) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("columns", thrift.LIST, 2),
+		thrift.NewTField("attributes", thrift.MAP, 3),
+		thrift.NewTField("durability", thrift.I32, 4),
+	})
+}
+
+/**
+ * Any timestamps in the columns are ignored, use timeRange to select by
+ * timestamp. Max versions defaults to 1.
+ *
+ * Attributes:
+ *  - StartRow
+ *  - StopRow
+ *  - Columns
+ *  - Caching
+ *  - MaxVersions
+ *  - TimeRange
+ *  - FilterString
+ *  - BatchSize
+ *  - Attributes
+ *  - Reversed
+ */
+type TScan struct {
+	StartRow     []byte            "startRow"     // 1
+	StopRow      []byte            "stopRow"      // 2
+	Columns      []*TColumn        "columns"      // 3
+	Caching      int32             "caching"      // 4
+	MaxVersions  int32             "maxVersions"  // 5
+	TimeRange    *TTimeRange       "timeRange"    // 6
+	FilterString []byte            "filterString" // 7
+	BatchSize    int32             "batchSize"    // 8
+	Attributes   map[string][]byte "attributes"   // 9
+	Reversed     bool              "reversed"     // 11
+}
+
+var tstructTScan = thrift.NewTStruct("TScan", []thrift.TField{
+	thrift.NewTField("startRow", thrift.STRING, 1),
+	thrift.NewTField("stopRow", thrift.STRING, 2),
+	thrift.NewTField("columns", thrift.LIST, 3),
+	thrift.NewTField("caching", thrift.I32, 4),
+	thrift.NewTField("maxVersions", thrift.I32, 5),
+	thrift.NewTField("timeRange", thrift.STRUCT, 6),
+	thrift.NewTField("filterString", thrift.STRING, 7),
+	thrift.NewTField("batchSize", thrift.I32, 8),
+	thrift.NewTField("attributes", thrift.MAP, 9),
+	thrift.NewTField("reversed", thrift.BOOL, 11),
+})
+
+func (*TScan) GetTStruct() thrift.TStruct { return tstructTScan }
+func NewTScan() *TScan {
+	output := &TScan{}
+	{
+		output.MaxVersions = 1
+	}
+	return output
+}
+
+func (p *TScan) IsSetStartRow() bool {
+	return p.StartRow != nil
+}
+
+func (p *TScan) IsSetStopRow() bool {
+	return p.StopRow != nil
+}
+
+func (p *TScan) IsSetColumns() bool {
+	return len(p.Columns) > 0
+}
+
+func (p *TScan) IsSetCaching() bool {
+	return p.Caching != 0
+}
+
+func (p *TScan) IsSetMaxVersions() bool {
+	return p.MaxVersions != 1
+}
+
+func (p *TScan) IsSetTimeRange() bool {
+	return p.TimeRange != nil
+}
+
+func (p *TScan) IsSetFilterString() bool {
+	return p.FilterString != nil
+}
+
+func (p *TScan) IsSetBatchSize() bool {
+	return p.BatchSize != 0
+}
+
+func (p *TScan) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TScan) IsSetReversed() bool {
+	return p.Reversed != false
+}
+
+func (p *TScan) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTScan.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTScan.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //TTimeRange
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 7: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 8: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField8(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 9: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField9(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 11: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField11(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TScan) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v145, err146 := iprot.ReadBinary()
+	if err146 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "startRow", p.ThriftName(), err146)
+	}
+	p.StartRow = v145
+	return err
+}
+
+func (p *TScan) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v147, err148 := iprot.ReadBinary()
+	if err148 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "stopRow", p.ThriftName(), err148)
+	}
+	p.StopRow = v147
+	return err
+}
+
+func (p *TScan) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype150, _size149, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype150
+	p.Columns = make([]*TColumn, _size149, _size149)
+	for i := 0; i < _size149; i++ {
+		_elem151 := NewTColumn()
+		err152 := _elem151.Read(iprot)
+		if err152 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem151TColumn", err152)
+		}
+		p.Columns[i] = _elem151
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TScan) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v153, err154 := iprot.ReadI32()
+	if err154 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "caching", p.ThriftName(), err154)
+	}
+	p.Caching = v153
+	return err
+}
+
+func (p *TScan) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v155, err156 := iprot.ReadI32()
+	if err156 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "maxVersions", p.ThriftName(), err156)
+	}
+	p.MaxVersions = v155
+	return err
+}
+
+func (p *TScan) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TimeRange = NewTTimeRange()
+	err157 := p.TimeRange.Read(iprot)
+	if err157 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TimeRangeTTimeRange", err157)
+	}
+	return err
+}
+
+func (p *TScan) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v158, err159 := iprot.ReadBinary()
+	if err159 != nil {
+		return thrift.NewTProtocolExceptionReadField(7, "filterString", p.ThriftName(), err159)
+	}
+	p.FilterString = v158
+	return err
+}
+
+func (p *TScan) readField8(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v160, err161 := iprot.ReadI32()
+	if err161 != nil {
+		return thrift.NewTProtocolExceptionReadField(8, "batchSize", p.ThriftName(), err161)
+	}
+	p.BatchSize = v160
+	return err
+}
+
+func (p *TScan) readField9(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype163, _vtype164, _size162, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype163, _vtype164
+	p.Attributes = make(map[string][]byte, _size162)
+	for i := 0; i < _size162; i++ {
+		v167, err168 := iprot.ReadString()
+		if err168 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key165", "", err168)
+		}
+		_key165 := v167
+		v169, err170 := iprot.ReadBinary()
+		if err170 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val166", "", err170)
+		}
+		_val166 := v169
+		p.Attributes[_key165] = _val166
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TScan) readField11(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v171, err172 := iprot.ReadBool()
+	if err172 != nil {
+		return thrift.NewTProtocolExceptionReadField(11, "reversed", p.ThriftName(), err172)
+	}
+	p.Reversed = v171
+	return err
+}
+
+func (p *TScan) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TScan")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField8(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField9(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField11(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TScan) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.StartRow != nil {
+		if p.IsSetStartRow() {
+			err = oprot.WriteFieldBegin("startRow", thrift.STRING, 1)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "startRow", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.StartRow)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "startRow", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "startRow", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.StopRow != nil {
+		if p.IsSetStopRow() {
+			err = oprot.WriteFieldBegin("stopRow", thrift.STRING, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "stopRow", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.StopRow)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "stopRow", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "stopRow", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		if p.IsSetColumns() {
+			err = oprot.WriteFieldBegin("columns", thrift.LIST, 3)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "columns", p.ThriftName(), err)
+			}
+			err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			for _, Iter173 := range p.Columns {
+				err = Iter173.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TColumn", err)
+				}
+			}
+			err = oprot.WriteListEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "columns", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCaching() {
+		err = oprot.WriteFieldBegin("caching", thrift.I32, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "caching", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Caching))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "caching", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "caching", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMaxVersions() {
+		err = oprot.WriteFieldBegin("maxVersions", thrift.I32, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.MaxVersions))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "maxVersions", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TimeRange != nil {
+		if p.IsSetTimeRange() {
+			err = oprot.WriteFieldBegin("timeRange", thrift.STRUCT, 6)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "timeRange", p.ThriftName(), err)
+			}
+			err = p.TimeRange.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TTimeRange", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(6, "timeRange", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.FilterString != nil {
+		if p.IsSetFilterString() {
+			err = oprot.WriteFieldBegin("filterString", thrift.STRING, 7)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(7, "filterString", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.FilterString)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(7, "filterString", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(7, "filterString", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField8(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetBatchSize() {
+		err = oprot.WriteFieldBegin("batchSize", thrift.I32, 8)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "batchSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.BatchSize))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "batchSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "batchSize", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField9(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 9)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(9, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter174, Viter175 := range p.Attributes {
+				err = oprot.WriteString(Kiter174)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter174", "", err)
+				}
+				err = oprot.WriteBinary(Viter175)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter175", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(9, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TScan) writeField11(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetReversed() {
+		err = oprot.WriteFieldBegin("reversed", thrift.BOOL, 11)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "reversed", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(p.Reversed))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "reversed", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "reversed", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TScan) TStructName() string {
+	return "TScan"
+}
+
+func (p *TScan) ThriftName() string {
+	return "TScan"
+}
+
+func (p *TScan) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.StartRow
+	case 2:
+		return p.StopRow
+	case 3:
+		return p.Columns
+	case 4:
+		return p.Caching
+	case 5:
+		return p.MaxVersions
+	case 6:
+		return p.TimeRange
+	case 7:
+		return p.FilterString
+	case 8:
+		return p.BatchSize
+	case 9:
+		return p.Attributes
+	case 11:
+		return p.Reversed
+	}
+	return nil
+}
+
+func (p *TScan) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("startRow", thrift.STRING, 1),
+		thrift.NewTField("stopRow", thrift.STRING, 2),
+		thrift.NewTField("columns", thrift.LIST, 3),
+		thrift.NewTField("caching", thrift.I32, 4),
+		thrift.NewTField("maxVersions", thrift.I32, 5),
+		thrift.NewTField("timeRange", thrift.STRUCT, 6),
+		thrift.NewTField("filterString", thrift.STRING, 7),
+		thrift.NewTField("batchSize", thrift.I32, 8),
+		thrift.NewTField("attributes", thrift.MAP, 9),
+		thrift.NewTField("reversed", thrift.BOOL, 11),
+	})
+}
+
+/**
+ * Atomic mutation for the specified row. It can be either Put or Delete.
+ *
+ * Attributes:
+ *  - Put
+ *  - DeleteSingle
+ */
+type TMutation struct {
+	Put          *TPut    "put"          // 1
+	DeleteSingle *TDelete "deleteSingle" // 2
+}
+
+var tstructTMutation = thrift.NewTStruct("TMutation", []thrift.TField{
+	thrift.NewTField("put", thrift.STRUCT, 1),
+	thrift.NewTField("deleteSingle", thrift.STRUCT, 2),
+})
+
+func (*TMutation) GetTStruct() thrift.TStruct { return tstructTMutation }
+func NewTMutation() *TMutation {
+	output := &TMutation{}
+	{
+	}
+	return output
+}
+
+func (p *TMutation) IsSetPut() bool {
+	return p.Put != nil
+}
+
+func (p *TMutation) IsSetDeleteSingle() bool {
+	return p.DeleteSingle != nil
+}
+
+func (p *TMutation) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTMutation.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTMutation.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TPut
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //TDelete
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TMutation) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.Put = NewTPut()
+	err176 := p.Put.Read(iprot)
+	if err176 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.PutTPut", err176)
+	}
+	return err
+}
+
+func (p *TMutation) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.DeleteSingle = NewTDelete()
+	err177 := p.DeleteSingle.Read(iprot)
+	if err177 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.DeleteSingleTDelete", err177)
+	}
+	return err
+}
+
+func (p *TMutation) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TMutation")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TMutation) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Put != nil {
+		if p.IsSetPut() {
+			err = oprot.WriteFieldBegin("put", thrift.STRUCT, 1)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "put", p.ThriftName(), err)
+			}
+			err = p.Put.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TPut", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "put", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TMutation) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.DeleteSingle != nil {
+		if p.IsSetDeleteSingle() {
+			err = oprot.WriteFieldBegin("deleteSingle", thrift.STRUCT, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "deleteSingle", p.ThriftName(), err)
+			}
+			err = p.DeleteSingle.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TDelete", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "deleteSingle", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TMutation) TStructName() string {
+	return "TMutation"
+}
+
+func (p *TMutation) ThriftName() string {
+	return "TMutation"
+}
+
+func (p *TMutation) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Put
+	case 2:
+		return p.DeleteSingle
+	}
+	return nil
+}
+
+func (p *TMutation) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("put", thrift.STRUCT, 1),
+		thrift.NewTField("deleteSingle", thrift.STRUCT, 2),
+	})
+}
+
+/**
+ * A TRowMutations object is used to apply a number of Mutations to a
+ * single row.
+ *
+ * Attributes:
+ *  - Row
+ *  - Mutations
+ */
+type TRowMutations struct {
+	Row       []byte       "row"       // 1
+	Mutations []*TMutation "mutations" // 2
+}
+
+var tstructTRowMutations = thrift.NewTStruct("TRowMutations", []thrift.TField{
+	thrift.NewTField("row", thrift.STRING, 1),
+	thrift.NewTField("mutations", thrift.LIST, 2),
+})
+
+func (*TRowMutations) GetTStruct() thrift.TStruct { return tstructTRowMutations }
+func NewTRowMutations() *TRowMutations {
+	output := &TRowMutations{}
+	{
+	}
+	return output
+}
+
+func (p *TRowMutations) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTRowMutations.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTRowMutations.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TRowMutations) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v178, err179 := iprot.ReadBinary()
+	if err179 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "row", p.ThriftName(), err179)
+	}
+	p.Row = v178
+	return err
+}
+
+func (p *TRowMutations) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype181, _size180, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Mutations", "", err)
+	}
+	_ = _etype181
+	p.Mutations = make([]*TMutation, _size180, _size180)
+	for i := 0; i < _size180; i++ {
+		_elem182 := NewTMutation()
+		err183 := _elem182.Read(iprot)
+		if err183 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem182TMutation", err183)
+		}
+		p.Mutations[i] = _elem182
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TRowMutations) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TRowMutations")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TRowMutations) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Row != nil {
+		err = oprot.WriteFieldBegin("row", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Row)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "row", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TRowMutations) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Mutations != nil {
+		err = oprot.WriteFieldBegin("mutations", thrift.LIST, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "mutations", p.ThriftName(), err)
+		}
+		err = oprot.WriteListBegin(thrift.STRUCT, len(p.Mutations))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		for _, Iter184 := range p.Mutations {
+			err = Iter184.Write(oprot)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteStruct("TMutation", err)
+			}
+		}
+		err = oprot.WriteListEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "mutations", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TRowMutations) TStructName() string {
+	return "TRowMutations"
+}
+
+func (p *TRowMutations) ThriftName() string {
+	return "TRowMutations"
+}
+
+func (p *TRowMutations) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Row
+	case 2:
+		return p.Mutations
+	}
+	return nil
+}
+
+func (p *TRowMutations) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("row", thrift.STRING, 1),
+		thrift.NewTField("mutations", thrift.LIST, 2),
+	})
+}
+
+/**
+ * Thrift wrapper around org.apache.hadoop.hbase.TableName
+ *
+ * Attributes:
+ *  - Ns: namespace name
+ *  - Qualifier: tablename
+ */
+type TTableName struct {
+	Ns        []byte "ns"        // 1
+	Qualifier []byte "qualifier" // 2
+}
+
+var tstructTTableName = thrift.NewTStruct("TTableName", []thrift.TField{
+	thrift.NewTField("ns", thrift.STRING, 1),
+	thrift.NewTField("qualifier", thrift.STRING, 2),
+})
+
+func (*TTableName) GetTStruct() thrift.TStruct { return tstructTTableName }
+func NewTTableName() *TTableName {
+	output := &TTableName{}
+	{
+	}
+	return output
+}
+
+func (p *TTableName) IsSetNs() bool {
+	return p.Ns != nil
+}
+
+func (p *TTableName) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTTableName.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTTableName.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTableName) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v185, err186 := iprot.ReadBinary()
+	if err186 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "ns", p.ThriftName(), err186)
+	}
+	p.Ns = v185
+	return err
+}
+
+func (p *TTableName) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v187, err188 := iprot.ReadBinary()
+	if err188 != nil {
+		return thrift.NewTProtocolExceptionReadField(2, "qualifier", p.ThriftName(), err188)
+	}
+	p.Qualifier = v187
+	return err
+}
+
+func (p *TTableName) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TTableName")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTableName) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Ns != nil {
+		if p.IsSetNs() {
+			err = oprot.WriteFieldBegin("ns", thrift.STRING, 1)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "ns", p.ThriftName(), err)
+			}
+			err = oprot.WriteBinary(p.Ns)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "ns", p.ThriftName(), err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(1, "ns", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TTableName) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Qualifier != nil {
+		err = oprot.WriteFieldBegin("qualifier", thrift.STRING, 2)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Qualifier)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(2, "qualifier", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TTableName) TStructName() string {
+	return "TTableName"
+}
+
+func (p *TTableName) ThriftName() string {
+	return "TTableName"
+}
+
+func (p *TTableName) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Ns
+	case 2:
+		return p.Qualifier
+	}
+	return nil
+}
+
+func (p *TTableName) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("ns", thrift.STRING, 1),
+		thrift.NewTField("qualifier", thrift.STRING, 2),
+	})
+}
+
+/**
+ * Thrift wrapper around
+ * org.apache.hadoop.hbase.client.ColumnFamilyDescriptor
+ *
+ * Unset fields take the defaults of the column family builder.
+ *
+ * Attributes:
+ *  - Name
+ *  - Attributes
+ *  - Configuration
+ *  - BlockSize
+ *  - BloomnFilterType
+ *  - CompressionType
+ *  - DfsReplication
+ *  - DataBlockEncoding
+ *  - KeepDeletedCells
+ *  - MaxVersions
+ *  - MinVersions
+ *  - Scope
+ *  - TimeToLive
+ *  - BlockCacheEnabled
+ *  - CacheBloomsOnWrite
+ *  - CacheDataOnWrite
+ *  - CacheIndexesOnWrite
+ *  - CompressTags
+ *  - EvictBlocksOnClose
+ *  - InMemory
+ */
+type TColumnFamilyDescriptor struct {
+	Name                []byte                "name"                // 1
+	Attributes          map[string][]byte     "attributes"          // 2
+	Configuration       map[string]string     "configuration"       // 3
+	BlockSize           int32                 "blockSize"           // 4
+	BloomnFilterType    TBloomFilterType      "bloomnFilterType"    // 5
+	CompressionType     TCompressionAlgorithm "compressionType"     // 6
+	DfsReplication      int16                 "dfsReplication"      // 7
+	DataBlockEncoding   TDataBlockEncoding    "dataBlockEncoding"   // 8
+	KeepDeletedCells    TKeepDeletedCells     "keepDeletedCells"    // 9
+	MaxVersions         int32                 "maxVersions"         // 10
+	MinVersions         int32                 "minVersions"         // 11
+	Scope               int32                 "scope"               // 12
+	TimeToLive          int32                 "timeToLive"          // 13
+	BlockCacheEnabled   *bool                 "blockCacheEnabled"   // 14
+	CacheBloomsOnWrite  *bool                 "cacheBloomsOnWrite"  // 15
+	CacheDataOnWrite    *bool                 "cacheDataOnWrite"    // 16
+	CacheIndexesOnWrite *bool                 "cacheIndexesOnWrite" // 17
+	CompressTags        *bool                 "compressTags"        // 18
+	EvictBlocksOnClose  *bool                 "evictBlocksOnClose"  // 19
+	InMemory            *bool                 "inMemory"            // 20
+}
+
+var tstructTColumnFamilyDescriptor = thrift.NewTStruct("TColumnFamilyDescriptor", []thrift.TField{
+	thrift.NewTField("name", thrift.STRING, 1),
+	thrift.NewTField("attributes", thrift.MAP, 2),
+	thrift.NewTField("configuration", thrift.MAP, 3),
+	thrift.NewTField("blockSize", thrift.I32, 4),
+	thrift.NewTField("bloomnFilterType", thrift.I32, 5),
+	thrift.NewTField("compressionType", thrift.I32, 6),
+	thrift.NewTField("dfsReplication", thrift.I16, 7),
+	thrift.NewTField("dataBlockEncoding", thrift.I32, 8),
+	thrift.NewTField("keepDeletedCells", thrift.I32, 9),
+	thrift.NewTField("maxVersions", thrift.I32, 10),
+	thrift.NewTField("minVersions", thrift.I32, 11),
+	thrift.NewTField("scope", thrift.I32, 12),
+	thrift.NewTField("timeToLive", thrift.I32, 13),
+	thrift.NewTField("blockCacheEnabled", thrift.BOOL, 14),
+	thrift.NewTField("cacheBloomsOnWrite", thrift.BOOL, 15),
+	thrift.NewTField("cacheDataOnWrite", thrift.BOOL, 16),
+	thrift.NewTField("cacheIndexesOnWrite", thrift.BOOL, 17),
+	thrift.NewTField("compressTags", thrift.BOOL, 18),
+	thrift.NewTField("evictBlocksOnClose", thrift.BOOL, 19),
+	thrift.NewTField("inMemory", thrift.BOOL, 20),
+})
+
+func (*TColumnFamilyDescriptor) GetTStruct() thrift.TStruct { return tstructTColumnFamilyDescriptor }
+func NewTColumnFamilyDescriptor() *TColumnFamilyDescriptor {
+	output := &TColumnFamilyDescriptor{}
+	{
+		output.BlockSize = 65536
+		output.BloomnFilterType = TBloomFilterType_ROW
+		output.CompressionType = TCompressionAlgorithm_NONE
+		output.DataBlockEncoding = TDataBlockEncoding_NONE
+		output.KeepDeletedCells = TKeepDeletedCells_FALSE
+		output.MaxVersions = 1
+		output.MinVersions = 0
+		output.Scope = 0
+		output.TimeToLive = 2147483647
+	}
+	return output
+}
+
+func (p *TColumnFamilyDescriptor) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TColumnFamilyDescriptor) IsSetConfiguration() bool {
+	return len(p.Configuration) > 0
+}
+
+func (p *TColumnFamilyDescriptor) IsSetBlockSize() bool {
+	return p.BlockSize != 65536
+}
+
+func (p *TColumnFamilyDescriptor) IsSetBloomnFilterType() bool {
+	return p.BloomnFilterType != TBloomFilterType_ROW
+}
+
+func (p *TColumnFamilyDescriptor) IsSetCompressionType() bool {
+	return p.CompressionType != TCompressionAlgorithm_NONE
+}
+
+func (p *TColumnFamilyDescriptor) IsSetDfsReplication() bool {
+	return p.DfsReplication != 0
+}
+
+func (p *TColumnFamilyDescriptor) IsSetDataBlockEncoding() bool {
+	return p.DataBlockEncoding != TDataBlockEncoding_NONE
+}
+
+func (p *TColumnFamilyDescriptor) IsSetKeepDeletedCells() bool {
+	return p.KeepDeletedCells != TKeepDeletedCells_FALSE
+}
+
+func (p *TColumnFamilyDescriptor) IsSetMaxVersions() bool {
+	return p.MaxVersions != 1
+}
+
+func (p *TColumnFamilyDescriptor) IsSetMinVersions() bool {
+	return p.MinVersions != 0
+}
+
+func (p *TColumnFamilyDescriptor) IsSetScope() bool {
+	return p.Scope != 0
+}
+
+func (p *TColumnFamilyDescriptor) IsSetTimeToLive() bool {
+	return p.TimeToLive != 2147483647
+}
+
+func (p *TColumnFamilyDescriptor) IsSetBlockCacheEnabled() bool {
+	return p.BlockCacheEnabled != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetCacheBloomsOnWrite() bool {
+	return p.CacheBloomsOnWrite != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetCacheDataOnWrite() bool {
+	return p.CacheDataOnWrite != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetCacheIndexesOnWrite() bool {
+	return p.CacheIndexesOnWrite != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetCompressTags() bool {
+	return p.CompressTags != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetEvictBlocksOnClose() bool {
+	return p.EvictBlocksOnClose != nil
+}
+
+func (p *TColumnFamilyDescriptor) IsSetInMemory() bool {
+	return p.InMemory != nil
+}
+
+func (p *TColumnFamilyDescriptor) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTColumnFamilyDescriptor.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTColumnFamilyDescriptor.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 5: //TBloomFilterType
+			if fieldTypeId == thrift.I32 {
+				err = p.readField5(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 6: //TCompressionAlgorithm
+			if fieldTypeId == thrift.I32 {
+				err = p.readField6(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 7: //i16
+			if fieldTypeId == thrift.I16 {
+				err = p.readField7(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 8: //TDataBlockEncoding
+			if fieldTypeId == thrift.I32 {
+				err = p.readField8(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 9: //TKeepDeletedCells
+			if fieldTypeId == thrift.I32 {
+				err = p.readField9(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 10: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField10(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 11: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField11(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 12: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField12(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 13: //i32
+			if fieldTypeId == thrift.I32 {
+				err = p.readField13(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 14: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField14(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 15: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField15(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 16: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField16(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 17: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField17(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 18: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField18(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 19: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField19(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 20: //bool
+			if fieldTypeId == thrift.BOOL {
+				err = p.readField20(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v189, err190 := iprot.ReadBinary()
+	if err190 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "name", p.ThriftName(), err190)
+	}
+	p.Name = v189
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype192, _vtype193, _size191, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype192, _vtype193
+	p.Attributes = make(map[string][]byte, _size191)
+	for i := 0; i < _size191; i++ {
+		v196, err197 := iprot.ReadString()
+		if err197 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key194", "", err197)
+		}
+		_key194 := v196
+		v198, err199 := iprot.ReadBinary()
+		if err199 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val195", "", err199)
+		}
+		_val195 := v198
+		p.Attributes[_key194] = _val195
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype201, _vtype202, _size200, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Configuration", "", err)
+	}
+	_, _ = _ktype201, _vtype202
+	p.Configuration = make(map[string]string, _size200)
+	for i := 0; i < _size200; i++ {
+		v205, err206 := iprot.ReadString()
+		if err206 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key203", "", err206)
+		}
+		_key203 := v205
+		v207, err208 := iprot.ReadString()
+		if err208 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val204", "", err208)
+		}
+		_val204 := v207
+		p.Configuration[_key203] = _val204
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v209, err210 := iprot.ReadI32()
+	if err210 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "blockSize", p.ThriftName(), err210)
+	}
+	p.BlockSize = v209
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField5(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v211, err212 := iprot.ReadI32()
+	if err212 != nil {
+		return thrift.NewTProtocolExceptionReadField(5, "bloomnFilterType", p.ThriftName(), err212)
+	}
+	p.BloomnFilterType = TBloomFilterType(v211)
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField6(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v213, err214 := iprot.ReadI32()
+	if err214 != nil {
+		return thrift.NewTProtocolExceptionReadField(6, "compressionType", p.ThriftName(), err214)
+	}
+	p.CompressionType = TCompressionAlgorithm(v213)
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField7(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v215, err216 := iprot.ReadI16()
+	if err216 != nil {
+		return thrift.NewTProtocolExceptionReadField(7, "dfsReplication", p.ThriftName(), err216)
+	}
+	p.DfsReplication = v215
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField8(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v217, err218 := iprot.ReadI32()
+	if err218 != nil {
+		return thrift.NewTProtocolExceptionReadField(8, "dataBlockEncoding", p.ThriftName(), err218)
+	}
+	p.DataBlockEncoding = TDataBlockEncoding(v217)
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField9(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v219, err220 := iprot.ReadI32()
+	if err220 != nil {
+		return thrift.NewTProtocolExceptionReadField(9, "keepDeletedCells", p.ThriftName(), err220)
+	}
+	p.KeepDeletedCells = TKeepDeletedCells(v219)
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField10(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v221, err222 := iprot.ReadI32()
+	if err222 != nil {
+		return thrift.NewTProtocolExceptionReadField(10, "maxVersions", p.ThriftName(), err222)
+	}
+	p.MaxVersions = v221
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField11(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v223, err224 := iprot.ReadI32()
+	if err224 != nil {
+		return thrift.NewTProtocolExceptionReadField(11, "minVersions", p.ThriftName(), err224)
+	}
+	p.MinVersions = v223
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField12(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v225, err226 := iprot.ReadI32()
+	if err226 != nil {
+		return thrift.NewTProtocolExceptionReadField(12, "scope", p.ThriftName(), err226)
+	}
+	p.Scope = v225
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField13(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v227, err228 := iprot.ReadI32()
+	if err228 != nil {
+		return thrift.NewTProtocolExceptionReadField(13, "timeToLive", p.ThriftName(), err228)
+	}
+	p.TimeToLive = v227
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField14(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v229, err230 := iprot.ReadBool()
+	if err230 != nil {
+		return thrift.NewTProtocolExceptionReadField(14, "blockCacheEnabled", p.ThriftName(), err230)
+	}
+	p.BlockCacheEnabled = &v229
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField15(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v231, err232 := iprot.ReadBool()
+	if err232 != nil {
+		return thrift.NewTProtocolExceptionReadField(15, "cacheBloomsOnWrite", p.ThriftName(), err232)
+	}
+	p.CacheBloomsOnWrite = &v231
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField16(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v233, err234 := iprot.ReadBool()
+	if err234 != nil {
+		return thrift.NewTProtocolExceptionReadField(16, "cacheDataOnWrite", p.ThriftName(), err234)
+	}
+	p.CacheDataOnWrite = &v233
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField17(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v235, err236 := iprot.ReadBool()
+	if err236 != nil {
+		return thrift.NewTProtocolExceptionReadField(17, "cacheIndexesOnWrite", p.ThriftName(), err236)
+	}
+	p.CacheIndexesOnWrite = &v235
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField18(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v237, err238 := iprot.ReadBool()
+	if err238 != nil {
+		return thrift.NewTProtocolExceptionReadField(18, "compressTags", p.ThriftName(), err238)
+	}
+	p.CompressTags = &v237
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField19(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v239, err240 := iprot.ReadBool()
+	if err240 != nil {
+		return thrift.NewTProtocolExceptionReadField(19, "evictBlocksOnClose", p.ThriftName(), err240)
+	}
+	p.EvictBlocksOnClose = &v239
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) readField20(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v241, err242 := iprot.ReadBool()
+	if err242 != nil {
+		return thrift.NewTProtocolExceptionReadField(20, "inMemory", p.ThriftName(), err242)
+	}
+	p.InMemory = &v241
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TColumnFamilyDescriptor")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField5(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField6(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField7(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField8(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField9(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField10(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField11(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField12(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField13(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField14(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField15(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField16(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField17(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField18(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField19(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField20(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Name != nil {
+		err = oprot.WriteFieldBegin("name", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+		}
+		err = oprot.WriteBinary(p.Name)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter243, Viter244 := range p.Attributes {
+				err = oprot.WriteString(Kiter243)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter243", "", err)
+				}
+				err = oprot.WriteBinary(Viter244)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter244", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Configuration != nil {
+		if p.IsSetConfiguration() {
+			err = oprot.WriteFieldBegin("configuration", thrift.MAP, 3)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "configuration", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Configuration))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter245, Viter246 := range p.Configuration {
+				err = oprot.WriteString(Kiter245)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter245", "", err)
+				}
+				err = oprot.WriteString(Viter246)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter246", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "configuration", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetBlockSize() {
+		err = oprot.WriteFieldBegin("blockSize", thrift.I32, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "blockSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.BlockSize))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "blockSize", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "blockSize", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField5(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetBloomnFilterType() {
+		err = oprot.WriteFieldBegin("bloomnFilterType", thrift.I32, 5)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "bloomnFilterType", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.BloomnFilterType))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "bloomnFilterType", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(5, "bloomnFilterType", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField6(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCompressionType() {
+		err = oprot.WriteFieldBegin("compressionType", thrift.I32, 6)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "compressionType", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.CompressionType))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "compressionType", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(6, "compressionType", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField7(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDfsReplication() {
+		err = oprot.WriteFieldBegin("dfsReplication", thrift.I16, 7)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "dfsReplication", p.ThriftName(), err)
+		}
+		err = oprot.WriteI16(int16(p.DfsReplication))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "dfsReplication", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(7, "dfsReplication", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField8(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDataBlockEncoding() {
+		err = oprot.WriteFieldBegin("dataBlockEncoding", thrift.I32, 8)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "dataBlockEncoding", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.DataBlockEncoding))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "dataBlockEncoding", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(8, "dataBlockEncoding", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField9(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetKeepDeletedCells() {
+		err = oprot.WriteFieldBegin("keepDeletedCells", thrift.I32, 9)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "keepDeletedCells", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.KeepDeletedCells))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "keepDeletedCells", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(9, "keepDeletedCells", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField10(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMaxVersions() {
+		err = oprot.WriteFieldBegin("maxVersions", thrift.I32, 10)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.MaxVersions))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "maxVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(10, "maxVersions", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField11(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMinVersions() {
+		err = oprot.WriteFieldBegin("minVersions", thrift.I32, 11)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "minVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.MinVersions))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "minVersions", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(11, "minVersions", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField12(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetScope() {
+		err = oprot.WriteFieldBegin("scope", thrift.I32, 12)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(12, "scope", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Scope))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(12, "scope", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(12, "scope", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField13(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetTimeToLive() {
+		err = oprot.WriteFieldBegin("timeToLive", thrift.I32, 13)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(13, "timeToLive", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.TimeToLive))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(13, "timeToLive", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(13, "timeToLive", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField14(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetBlockCacheEnabled() {
+		err = oprot.WriteFieldBegin("blockCacheEnabled", thrift.BOOL, 14)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(14, "blockCacheEnabled", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.BlockCacheEnabled))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(14, "blockCacheEnabled", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(14, "blockCacheEnabled", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField15(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCacheBloomsOnWrite() {
+		err = oprot.WriteFieldBegin("cacheBloomsOnWrite", thrift.BOOL, 15)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(15, "cacheBloomsOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.CacheBloomsOnWrite))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(15, "cacheBloomsOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(15, "cacheBloomsOnWrite", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField16(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCacheDataOnWrite() {
+		err = oprot.WriteFieldBegin("cacheDataOnWrite", thrift.BOOL, 16)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(16, "cacheDataOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.CacheDataOnWrite))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(16, "cacheDataOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(16, "cacheDataOnWrite", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField17(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCacheIndexesOnWrite() {
+		err = oprot.WriteFieldBegin("cacheIndexesOnWrite", thrift.BOOL, 17)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(17, "cacheIndexesOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.CacheIndexesOnWrite))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(17, "cacheIndexesOnWrite", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(17, "cacheIndexesOnWrite", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField18(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetCompressTags() {
+		err = oprot.WriteFieldBegin("compressTags", thrift.BOOL, 18)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(18, "compressTags", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.CompressTags))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(18, "compressTags", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(18, "compressTags", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField19(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetEvictBlocksOnClose() {
+		err = oprot.WriteFieldBegin("evictBlocksOnClose", thrift.BOOL, 19)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(19, "evictBlocksOnClose", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.EvictBlocksOnClose))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(19, "evictBlocksOnClose", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(19, "evictBlocksOnClose", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) writeField20(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetInMemory() {
+		err = oprot.WriteFieldBegin("inMemory", thrift.BOOL, 20)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(20, "inMemory", p.ThriftName(), err)
+		}
+		err = oprot.WriteBool(bool(*p.InMemory))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(20, "inMemory", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(20, "inMemory", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TColumnFamilyDescriptor) TStructName() string {
+	return "TColumnFamilyDescriptor"
+}
+
+func (p *TColumnFamilyDescriptor) ThriftName() string {
+	return "TColumnFamilyDescriptor"
+}
+
+func (p *TColumnFamilyDescriptor) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Name
+	case 2:
+		return p.Attributes
+	case 3:
+		return p.Configuration
+	case 4:
+		return p.BlockSize
+	case 5:
+		return p.BloomnFilterType
+	case 6:
+		return p.CompressionType
+	case 7:
+		return p.DfsReplication
+	case 8:
+		return p.DataBlockEncoding
+	case 9:
+		return p.KeepDeletedCells
+	case 10:
+		return p.MaxVersions
+	case 11:
+		return p.MinVersions
+	case 12:
+		return p.Scope
+	case 13:
+		return p.TimeToLive
+	case 14:
+		return p.BlockCacheEnabled
+	case 15:
+		return p.CacheBloomsOnWrite
+	case 16:
+		return p.CacheDataOnWrite
+	case 17:
+		return p.CacheIndexesOnWrite
+	case 18:
+		return p.CompressTags
+	case 19:
+		return p.EvictBlocksOnClose
+	case 20:
+		return p.InMemory
+	}
+	return nil
+}
+
+func (p *TColumnFamilyDescriptor) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("name", thrift.STRING, 1),
+		thrift.NewTField("attributes", thrift.MAP, 2),
+		thrift.NewTField("configuration", thrift.MAP, 3),
+		thrift.NewTField("blockSize", thrift.I32, 4),
+		thrift.NewTField("bloomnFilterType", thrift.I32, 5),
+		thrift.NewTField("compressionType", thrift.I32, 6),
+		thrift.NewTField("dfsReplication", thrift.I16, 7),
+		thrift.NewTField("dataBlockEncoding", thrift.I32, 8),
+		thrift.NewTField("keepDeletedCells", thrift.I32, 9),
+		thrift.NewTField("maxVersions", thrift.I32, 10),
+		thrift.NewTField("minVersions", thrift.I32, 11),
+		thrift.NewTField("scope", thrift.I32, 12),
+		thrift.NewTField("timeToLive", thrift.I32, 13),
+		thrift.NewTField("blockCacheEnabled", thrift.BOOL, 14),
+		thrift.NewTField("cacheBloomsOnWrite", thrift.BOOL, 15),
+		thrift.NewTField("cacheDataOnWrite", thrift.BOOL, 16),
+		thrift.NewTField("cacheIndexesOnWrite", thrift.BOOL, 17),
+		thrift.NewTField("compressTags", thrift.BOOL, 18),
+		thrift.NewTField("evictBlocksOnClose", thrift.BOOL, 19),
+		thrift.NewTField("inMemory", thrift.BOOL, 20),
+	})
+}
+
+/**
+ * Thrift wrapper around org.apache.hadoop.hbase.client.TableDescriptor
+ *
+ * Attributes:
+ *  - TableName
+ *  - Columns
+ *  - Attributes
+ *  - Durability
+ */
+type TTableDescriptor struct {
+	TableName  *TTableName                "tableName"  // 1
+	Columns    []*TColumnFamilyDescriptor "columns"    // 2
+	Attributes map[string][]byte          "attributes" // 3
+	Durability TDurability                "durability" // 4
+}
+
+var tstructTTableDescriptor = thrift.NewTStruct("TTableDescriptor", []thrift.TField{
+	thrift.NewTField("tableName", thrift.STRUCT, 1),
+	thrift.NewTField("columns", thrift.LIST, 2),
+	thrift.NewTField("attributes", thrift.MAP, 3),
+	thrift.NewTField("durability", thrift.I32, 4),
+})
+
+func (*TTableDescriptor) GetTStruct() thrift.TStruct { return tstructTTableDescriptor }
+func NewTTableDescriptor() *TTableDescriptor {
+	output := &TTableDescriptor{}
+	{
+	}
+	return output
+}
+
+func (p *TTableDescriptor) IsSetColumns() bool {
+	return len(p.Columns) > 0
+}
+
+func (p *TTableDescriptor) IsSetAttributes() bool {
+	return len(p.Attributes) > 0
+}
+
+func (p *TTableDescriptor) IsSetDurability() bool {
+	return p.Durability != 0
+}
+
+func (p *TTableDescriptor) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTTableDescriptor.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTTableDescriptor.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //TTableName
+			if fieldTypeId == thrift.STRUCT {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.LIST {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 3: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField3(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 4: //TDurability
+			if fieldTypeId == thrift.I32 {
+				err = p.readField4(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTableDescriptor) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	p.TableName = NewTTableName()
+	err247 := p.TableName.Read(iprot)
+	if err247 != nil {
+		return thrift.NewTProtocolExceptionReadStruct("p.TableNameTTableName", err247)
+	}
+	return err
+}
+
+func (p *TTableDescriptor) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_etype249, _size248, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Columns", "", err)
+	}
+	_ = _etype249
+	p.Columns = make([]*TColumnFamilyDescriptor, _size248, _size248)
+	for i := 0; i < _size248; i++ {
+		_elem250 := NewTColumnFamilyDescriptor()
+		err251 := _elem250.Read(iprot)
+		if err251 != nil {
+			return thrift.NewTProtocolExceptionReadStruct("_elem250TColumnFamilyDescriptor", err251)
+		}
+		p.Columns[i] = _elem250
+	}
+	err = iprot.ReadListEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "list", err)
+	}
+	return err
+}
+
+func (p *TTableDescriptor) readField3(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype253, _vtype254, _size252, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Attributes", "", err)
+	}
+	_, _ = _ktype253, _vtype254
+	p.Attributes = make(map[string][]byte, _size252)
+	for i := 0; i < _size252; i++ {
+		v257, err258 := iprot.ReadString()
+		if err258 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key255", "", err258)
+		}
+		_key255 := v257
+		v259, err260 := iprot.ReadBinary()
+		if err260 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val256", "", err260)
+		}
+		_val256 := v259
+		p.Attributes[_key255] = _val256
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TTableDescriptor) readField4(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v261, err262 := iprot.ReadI32()
+	if err262 != nil {
+		return thrift.NewTProtocolExceptionReadField(4, "durability", p.ThriftName(), err262)
+	}
+	p.Durability = TDurability(v261)
+	return err
+}
+
+func (p *TTableDescriptor) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TTableDescriptor")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField3(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField4(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TTableDescriptor) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.TableName != nil {
+		err = oprot.WriteFieldBegin("tableName", thrift.STRUCT, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+		err = p.TableName.Write(oprot)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteStruct("TTableName", err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "tableName", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TTableDescriptor) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Columns != nil {
+		if p.IsSetColumns() {
+			err = oprot.WriteFieldBegin("columns", thrift.LIST, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+			err = oprot.WriteListBegin(thrift.STRUCT, len(p.Columns))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			for _, Iter263 := range p.Columns {
+				err = Iter263.Write(oprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteStruct("TColumnFamilyDescriptor", err)
+				}
+			}
+			err = oprot.WriteListEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "list", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "columns", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TTableDescriptor) writeField3(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Attributes != nil {
+		if p.IsSetAttributes() {
+			err = oprot.WriteFieldBegin("attributes", thrift.MAP, 3)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "attributes", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Attributes))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter264, Viter265 := range p.Attributes {
+				err = oprot.WriteString(Kiter264)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter264", "", err)
+				}
+				err = oprot.WriteBinary(Viter265)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter265", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(3, "attributes", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TTableDescriptor) writeField4(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetDurability() {
+		err = oprot.WriteFieldBegin("durability", thrift.I32, 4)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteI32(int32(p.Durability))
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(4, "durability", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TTableDescriptor) TStructName() string {
+	return "TTableDescriptor"
+}
+
+func (p *TTableDescriptor) ThriftName() string {
+	return "TTableDescriptor"
+}
+
+func (p *TTableDescriptor) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.TableName
+	case 2:
+		return p.Columns
+	case 3:
+		return p.Attributes
+	case 4:
+		return p.Durability
+	}
+	return nil
+}
+
+func (p *TTableDescriptor) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("tableName", thrift.STRUCT, 1),
+		thrift.NewTField("columns", thrift.LIST, 2),
+		thrift.NewTField("attributes", thrift.MAP, 3),
+		thrift.NewTField("durability", thrift.I32, 4),
+	})
+}
+
+/**
+ * Thrift wrapper around org.apache.hadoop.hbase.NamespaceDescriptor
+ *
+ * Attributes:
+ *  - Name
+ *  - Configuration
+ */
+type TNamespaceDescriptor struct {
+	Name          string            "name"          // 1
+	Configuration map[string]string "configuration" // 2
+}
+
+var tstructTNamespaceDescriptor = thrift.NewTStruct("TNamespaceDescriptor", []thrift.TField{
+	thrift.NewTField("name", thrift.STRING, 1),
+	thrift.NewTField("configuration", thrift.MAP, 2),
+})
+
+func (*TNamespaceDescriptor) GetTStruct() thrift.TStruct { return tstructTNamespaceDescriptor }
+func NewTNamespaceDescriptor() *TNamespaceDescriptor {
+	output := &TNamespaceDescriptor{}
+	{
+	}
+	return output
+}
+
+func (p *TNamespaceDescriptor) IsSetConfiguration() bool {
+	return len(p.Configuration) > 0
+}
+
+func (p *TNamespaceDescriptor) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTNamespaceDescriptor.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTNamespaceDescriptor.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		case 2: //
+			if fieldTypeId == thrift.MAP {
+				err = p.readField2(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TNamespaceDescriptor) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v266, err267 := iprot.ReadString()
+	if err267 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "name", p.ThriftName(), err267)
+	}
+	p.Name = v266
+	return err
+}
+
+func (p *TNamespaceDescriptor) readField2(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_ktype269, _vtype270, _size268, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "p.Configuration", "", err)
+	}
+	_, _ = _ktype269, _vtype270
+	p.Configuration = make(map[string]string, _size268)
+	for i := 0; i < _size268; i++ {
+		v273, err274 := iprot.ReadString()
+		if err274 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_key271", "", err274)
+		}
+		_key271 := v273
+		v275, err276 := iprot.ReadString()
+		if err276 != nil {
+			return thrift.NewTProtocolExceptionReadField(0, "_val272", "", err276)
+		}
+		_val272 := v275
+		p.Configuration[_key271] = _val272
+	}
+	err = iprot.ReadMapEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadField(-1, "", "map", err)
+	}
+	return err
+}
+
+func (p *TNamespaceDescriptor) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TNamespaceDescriptor")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = p.writeField2(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TNamespaceDescriptor) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteFieldBegin("name", thrift.STRING, 1)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteString(p.Name)
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	err = oprot.WriteFieldEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(1, "name", p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TNamespaceDescriptor) writeField2(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.Configuration != nil {
+		if p.IsSetConfiguration() {
+			err = oprot.WriteFieldBegin("configuration", thrift.MAP, 2)
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "configuration", p.ThriftName(), err)
+			}
+			err = oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Configuration))
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			for Kiter277, Viter278 := range p.Configuration {
+				err = oprot.WriteString(Kiter277)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Kiter277", "", err)
+				}
+				err = oprot.WriteString(Viter278)
+				if err != nil {
+					return thrift.NewTProtocolExceptionWriteField(0, "Viter278", "", err)
+				}
+			}
+			err = oprot.WriteMapEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(-1, "", "map", err)
+			}
+			err = oprot.WriteFieldEnd()
+			if err != nil {
+				return thrift.NewTProtocolExceptionWriteField(2, "configuration", p.ThriftName(), err)
+			}
+		}
+	}
+	return err
+}
+
+func (p *TNamespaceDescriptor) TStructName() string {
+	return "TNamespaceDescriptor"
+}
+
+func (p *TNamespaceDescriptor) ThriftName() string {
+	return "TNamespaceDescriptor"
+}
+
+func (p *TNamespaceDescriptor) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Name
+	case 2:
+		return p.Configuration
+	}
+	return nil
+}
+
+func (p *TNamespaceDescriptor) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("name", thrift.STRING, 1),
+		thrift.NewTField("configuration", thrift.MAP, 2),
+	})
+}
+
+/**
+ * A TIOError exception signals that an error occurred communicating to the
+ * HBase master or a HBase region server. Also used to return more general
+ * HBase error conditions.
+ *
+ * Attributes:
+ *  - Message
+ */
+type TIOError struct {
+	Message string "message" // 1
+}
+
+var tstructTIOError = thrift.NewTStruct("TIOError", []thrift.TField{
+	thrift.NewTField("message", thrift.STRING, 1),
+})
+
+func (*TIOError) GetTStruct() thrift.TStruct { return tstructTIOError }
+func NewTIOError() *TIOError {
+	output := &TIOError{}
+	{
+	}
+	return output
+}
+
+func (p *TIOError) IsSetMessage() bool {
+	return p.Message != ""
+}
+
+func (p *TIOError) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTIOError.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTIOError.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIOError) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v279, err280 := iprot.ReadString()
+	if err280 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err280)
+	}
+	p.Message = v279
+	return err
+}
+
+func (p *TIOError) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TIOError")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIOError) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMessage() {
+		err = oprot.WriteFieldBegin("message", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+		err = oprot.WriteString(p.Message)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TIOError) TStructName() string {
+	return "TIOError"
+}
+
+func (p *TIOError) ThriftName() string {
+	return "TIOError"
+}
+
+func (p *TIOError) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Message
+	}
+	return nil
+}
+
+func (p *TIOError) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("message", thrift.STRING, 1),
+	})
+}
+
+/**
+ * A TIllegalArgument exception indicates an illegal or invalid argument
+ * was passed into a procedure.
+ *
+ * Attributes:
+ *  - Message
+ */
+type TIllegalArgument struct {
+	Message string "message" // 1
+}
+
+var tstructTIllegalArgument = thrift.NewTStruct("TIllegalArgument", []thrift.TField{
+	thrift.NewTField("message", thrift.STRING, 1),
+})
+
+func (*TIllegalArgument) GetTStruct() thrift.TStruct { return tstructTIllegalArgument }
+func NewTIllegalArgument() *TIllegalArgument {
+	output := &TIllegalArgument{}
+	{
+	}
+	return output
+}
+
+func (p *TIllegalArgument) IsSetMessage() bool {
+	return p.Message != ""
+}
+
+func (p *TIllegalArgument) Read(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	_, err = iprot.ReadStructBegin()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	for {
+		fieldName, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if fieldId < 0 {
+			fieldId = int16(tstructTIllegalArgument.FieldIdFromFieldName(fieldName))
+			fieldTypeId = tstructTIllegalArgument.FieldFromFieldName(fieldName).TypeId()
+		}
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1: //string
+			if fieldTypeId == thrift.STRING {
+				err = p.readField1(iprot)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			} else {
+				err = iprot.Skip(fieldTypeId)
+				if err != nil {
+					return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+				}
+			}
+		default:
+			err = iprot.Skip(fieldTypeId)
+			if err != nil {
+				return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+			}
+		} //switch
+		err = iprot.ReadFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionReadField(int(fieldId), fieldName, p.ThriftName(), err)
+		}
+	}
+	err = iprot.ReadStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionReadStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIllegalArgument) readField1(iprot thrift.TProtocol) (err thrift.TProtocolException) {
+	v281, err282 := iprot.ReadString()
+	if err282 != nil {
+		return thrift.NewTProtocolExceptionReadField(1, "message", p.ThriftName(), err282)
+	}
+	p.Message = v281
+	return err
+}
+
+func (p *TIllegalArgument) Write(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	err = oprot.WriteStructBegin("TIllegalArgument")
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	err = p.writeField1(oprot)
+	if err != nil {
+		return err
+	}
+	err = oprot.WriteFieldStop()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteField(-1, "STOP", p.ThriftName(), err)
+	}
+	err = oprot.WriteStructEnd()
+	if err != nil {
+		return thrift.NewTProtocolExceptionWriteStruct(p.ThriftName(), err)
+	}
+	return err
+}
+
+func (p *TIllegalArgument) writeField1(oprot thrift.TProtocol) (err thrift.TProtocolException) {
+	if p.IsSetMessage() {
+		err = oprot.WriteFieldBegin("message", thrift.STRING, 1)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+		err = oprot.WriteString(p.Message)
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+		err = oprot.WriteFieldEnd()
+		if err != nil {
+			return thrift.NewTProtocolExceptionWriteField(1, "message", p.ThriftName(), err)
+		}
+	}
+	return err
+}
+
+func (p *TIllegalArgument) TStructName() string {
+	return "TIllegalArgument"
+}
+
+func (p *TIllegalArgument) ThriftName() string {
+	return "TIllegalArgument"
+}
+
+func (p *TIllegalArgument) AttributeByFieldId(id int) interface{} {
+	switch id {
+	default:
+		return nil
+	case 1:
+		return p.Message
+	}
+	return nil
+}
+
+func (p *TIllegalArgument) TStructFields() thrift.TFieldContainer {
+	return thrift.NewTFieldContainer([]thrift.TField{
+		thrift.NewTField("message", thrift.STRING, 1),
+	})
+}
+
+func init() {
+}
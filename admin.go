@@ -0,0 +1,164 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	waitMinBackoff = 100 * time.Millisecond
+	waitMaxBackoff = 2 * time.Second
+)
+
+/*
+tableExists report whether tableName is a table of the server
+*/
+func (client *HClient) tableExists(tableName string) (bool, error) {
+	names, err := client.GetTableNames()
+	if err != nil {
+		return false, err
+	}
+	for _, name := range names {
+		if name == tableName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+/*
+EnsureTable creates tableName with families unless it exists, a table created by someone else
+at the same time counts as success. created is true if this call created the table.
+*/
+func (client *HClient) EnsureTable(tableName string, families []*ColumnDescriptor) (created bool, err error) {
+	exists, err := client.tableExists(tableName)
+	if err != nil || exists {
+		return false, err
+	}
+
+	exists, err = client.CreateTable(tableName, families)
+	if err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+/*
+DropTableIfExists disables tableName if it is enabled and deletes it, dropped is false if there was no such table.
+A table that is disabled by someone else at the same time is still deleted, one dropped by someone else counts as success.
+*/
+func (client *HClient) DropTableIfExists(tableName string) (dropped bool, err error) {
+	exists, err := client.tableExists(tableName)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	enabled, err := client.IsTableEnabled(tableName)
+	if err == nil && enabled {
+		if err = client.DisableTable(tableName); err != nil {
+			// the table may have been disabled meanwhile, hbase fails with TableNotEnabledException then
+			if enabled, e := client.IsTableEnabled(tableName); e == nil && !enabled {
+				err = nil
+			}
+		}
+	}
+	if err == nil {
+		err = client.DeleteTable(tableName)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	// the table may have been dropped meanwhile
+	if exists, e := client.tableExists(tableName); e == nil && !exists {
+		return false, nil
+	}
+	return false, err
+}
+
+/*
+TruncateError is returned by TruncateTable when the table was dropped but could not be created again,
+Families are the column families to create it with
+*/
+type TruncateError struct {
+	Table    string
+	Families []*ColumnDescriptor
+	Err      error
+}
+
+func (e *TruncateError) Error() string {
+	return fmt.Sprint("goh: table ", e.Table, " was dropped but not created again: ", e.Err)
+}
+
+/*
+TruncateTable deletes all rows of tableName by dropping it and creating it again with the same families,
+the split points of the table are not kept as thrift can not create pre-split tables.
+If the table can not be created after the drop, the error is a *TruncateError holding its families.
+*/
+func (client *HClient) TruncateTable(tableName string) error {
+	families, err := client.GetColumnDescriptors(tableName)
+	if err != nil {
+		return err
+	}
+	if _, err = client.DropTableIfExists(tableName); err != nil {
+		return err
+	}
+
+	descriptors := sortedFamilies(families)
+	if _, err = client.EnsureTable(tableName, descriptors); err != nil {
+		return &TruncateError{Table: tableName, Families: descriptors, Err: err}
+	}
+	return nil
+}
+
+/*
+CloneSchema creates table target with the column families of table source, it fails with ErrTableExists if target exists
+*/
+func (client *HClient) CloneSchema(source, target string) error {
+	families, err := client.GetColumnDescriptors(source)
+	if err != nil {
+		return err
+	}
+
+	exists, err := client.CreateTable(target, sortedFamilies(families))
+	if err != nil {
+		return err
+	}
+	if exists {
+		return newHbaseError(nil, nil, ErrTableExists)
+	}
+	return nil
+}
+
+/*
+WaitForTableState polls IsTableEnabled until the table is enabled, or disabled if enabled is false,
+the wait between polls starts at 100ms and doubles up to 2s. It returns ctx.Err() if ctx is done first.
+*/
+func (client *HClient) WaitForTableState(ctx context.Context, tableName string, enabled bool) error {
+	backoff := waitMinBackoff
+	for {
+		state, err := client.IsTableEnabled(tableName)
+		if err != nil {
+			return err
+		}
+		if state == enabled {
+			return nil
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > waitMaxBackoff {
+			backoff = waitMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+)
+
+func TestTruncateTable(t *testing.T) {
+	_, client := newGatewayClient(t)
+
+	before, err := client.GetColumnDescriptors("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.TruncateTable("test"); err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := client.GetColumnDescriptors("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Errorf("families after truncate = %v, want %v", after, before)
+	}
+	if cells, _ := client.Get("test", []byte("a"), "cf:x", nil); len(cells) != 0 {
+		t.Errorf("truncated table has rows: %v", cells)
+	}
+	if enabled, _ := client.IsTableEnabled("test"); !enabled {
+		t.Error("truncated table is disabled")
+	}
+
+	if err = client.TruncateTable("missing"); err == nil {
+		t.Error("TruncateTable of a missing table should fail")
+	}
+}
+
+func TestEnsureDropTable(t *testing.T) {
+	_, client := newGatewayClient(t)
+	families := []*goh.ColumnDescriptor{goh.NewColumnDescriptorDefault("cf")}
+
+	for i, want := range []bool{true, false} {
+		created, err := client.EnsureTable("new", families)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created != want {
+			t.Errorf("EnsureTable #%d created = %v, want %v", i, created, want)
+		}
+	}
+	if created, err := client.EnsureTable("test", families); err != nil || created {
+		t.Errorf("EnsureTable of an existing table = %v, %v", created, err)
+	}
+
+	if err := client.DisableTable("new"); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []bool{true, false} {
+		dropped, err := client.DropTableIfExists("new")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dropped != want {
+			t.Errorf("DropTableIfExists #%d dropped = %v, want %v", i, dropped, want)
+		}
+	}
+
+	if dropped, err := client.DropTableIfExists("test"); err != nil || !dropped {
+		t.Errorf("DropTableIfExists of an enabled table = %v, %v", dropped, err)
+	}
+	if tables, _ := client.GetTableNames(); len(tables) != 0 {
+		t.Errorf("tables after drop = %v", tables)
+	}
+}
+
+func TestDropTableRaced(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.raced = true
+	if dropped, err := client.DropTableIfExists("test"); err != nil || !dropped {
+		t.Errorf("DropTableIfExists of a table disabled meanwhile = %v, %v", dropped, err)
+	}
+	if tables, _ := client.GetTableNames(); len(tables) != 0 {
+		t.Errorf("tables after drop = %v", tables)
+	}
+}
+
+func TestTruncateTableRecreateFails(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	before, err := client.GetColumnDescriptors("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler.noCreate = true
+	err = client.TruncateTable("test")
+	e, ok := err.(*goh.TruncateError)
+	if !ok {
+		t.Fatalf("TruncateTable = %v, want a TruncateError", err)
+	}
+	if e.Table != "test" || len(e.Families) != 1 || !reflect.DeepEqual(e.Families[0], before["cf:"]) {
+		t.Errorf("TruncateError = %+v", e)
+	}
+
+	handler.noCreate = false
+	if _, err = client.CreateTable(e.Table, e.Families); err != nil {
+		t.Fatal(err)
+	}
+	if after, _ := client.GetColumnDescriptors("test"); !reflect.DeepEqual(before, after) {
+		t.Errorf("families after create = %v, want %v", after, before)
+	}
+}
+
+func TestWaitForTableState(t *testing.T) {
+	_, client := newGatewayClient(t)
+
+	if err := client.WaitForTableState(context.Background(), "test", true); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	if err := client.WaitForTableState(ctx, "test", false); err != context.DeadlineExceeded {
+		t.Errorf("WaitForTableState = %v, want context.DeadlineExceeded", err)
+	}
+
+	other, err := client.NewConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		other.DisableTable("test")
+	}()
+	if err = client.WaitForTableState(context.Background(), "test", false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.WaitForTableState(context.Background(), "missing", true); err == nil {
+		t.Error("WaitForTableState of a missing table should fail")
+	}
+}
+
+func TestCloneSchema(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.createTable("wide", "a", "b", "c")
+
+	if err := client.CloneSchema("wide", "clone"); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := client.GetColumnDescriptors("wide")
+	got, err := client.GetColumnDescriptors("clone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cloned families = %v, want %v", got, want)
+	}
+
+	if err = client.CloneSchema("wide", "test"); !isHbaseError(err, goh.ErrTableExists) {
+		t.Errorf("CloneSchema to an existing table = %v, want ErrTableExists", err)
+	}
+	if err = client.CloneSchema("missing", "other"); err == nil {
+		t.Error("CloneSchema of a missing table should fail")
+	}
+}
@@ -0,0 +1,331 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+Future is the pending result of an asynchronous call.
+Asynchronous calls are pipelined on the connection of the client, blocking calls must not be made while futures are pending.
+*/
+type Future struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newFuture() *Future {
+	return &Future{done: make(chan struct{})}
+}
+
+func (f *Future) complete(value interface{}, err error) {
+	f.value = value
+	f.err = err
+	close(f.done)
+}
+
+/*
+Done returns a channel closed when the call completes
+*/
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+/*
+Wait waits for the call to complete and returns its error, or the error of ctx if it is done first
+*/
+func (f *Future) Wait(ctx context.Context) error {
+	_, err := f.wait(ctx)
+	return err
+}
+
+func (f *Future) wait(ctx context.Context) (interface{}, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+/*
+CellsFuture is the pending result of GetAsync
+*/
+type CellsFuture struct {
+	*Future
+}
+
+/*
+Wait waits for the cells, or the error of ctx if it is done first
+*/
+func (f CellsFuture) Wait(ctx context.Context) ([]*Hbase.TCell, error) {
+	value, err := f.wait(ctx)
+	cells, _ := value.([]*Hbase.TCell)
+	return cells, err
+}
+
+/*
+RowsFuture is the pending result of GetRowAsync and the other row reads
+*/
+type RowsFuture struct {
+	*Future
+}
+
+/*
+Wait waits for the rows, or the error of ctx if it is done first
+*/
+func (f RowsFuture) Wait(ctx context.Context) ([]*Hbase.TRowResult, error) {
+	value, err := f.wait(ctx)
+	rows, _ := value.([]*Hbase.TRowResult)
+	return rows, err
+}
+
+/*
+pipeline sends requests without waiting for the previous responses,
+a single reader matches the responses to the pending calls by seqid
+*/
+type pipeline struct {
+	client *HClient
+	hbase  *Hbase.HbaseClient
+
+	mu      sync.Mutex
+	pending map[int32]*pendingCall
+	reading bool
+}
+
+type pendingCall struct {
+	future *Future
+	read   func(iprot thrift.TProtocol) (interface{}, error)
+}
+
+func newPipeline(client *HClient, hbase *Hbase.HbaseClient) *pipeline {
+	return &pipeline{
+		client:  client,
+		hbase:   hbase,
+		pending: make(map[int32]*pendingCall),
+	}
+}
+
+/*
+canPipeline reports whether requests can be sent on trans while a response is read from iprot,
+http round trips a request at a time and theader keeps the state of the frame read in the transport its writes use
+*/
+func canPipeline(trans thrift.TTransport, iprot thrift.TProtocol) bool {
+	switch trans.(type) {
+	case *thrift.THttpClient, *thrift.THeaderTransport:
+		return false
+	}
+	_, header := iprot.(*thrift.THeaderProtocol)
+	return !header
+}
+
+/*
+call sends a request with send and returns the future its response is read into by read
+*/
+func (p *pipeline) call(send func(hbase *Hbase.HbaseClient) error, read func(iprot thrift.TProtocol) (interface{}, error)) *Future {
+	f := newFuture()
+	trans := p.hbase.Transport
+	if monitor, ok := trans.(*transportMonitor); ok {
+		trans = monitor.TTransport
+	}
+	if !canPipeline(trans, p.hbase.InputProtocol) {
+		f.complete(nil, ErrAsyncNotSupported)
+		return f
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Send* increments the seqid before writing the request
+	seqId := p.hbase.SeqId + 1
+	p.pending[seqId] = &pendingCall{future: f, read: read}
+	if err := send(p.hbase); err != nil {
+		delete(p.pending, seqId)
+		f.complete(nil, err)
+		return f
+	}
+
+	if !p.reading {
+		p.reading = true
+		go p.readResponses(p.hbase.InputProtocol)
+	}
+	return f
+}
+
+/*
+readResponses reads responses until no call is pending
+*/
+func (p *pipeline) readResponses(iprot thrift.TProtocol) {
+	for {
+		p.mu.Lock()
+		if len(p.pending) == 0 {
+			p.reading = false
+			p.mu.Unlock()
+			return
+		}
+		p.mu.Unlock()
+
+		_, typeId, seqId, err := iprot.ReadMessageBegin()
+		if err != nil {
+			p.failAll(err)
+			return
+		}
+
+		p.mu.Lock()
+		call := p.pending[seqId]
+		delete(p.pending, seqId)
+		p.mu.Unlock()
+
+		if call == nil {
+			// the stream can not be trusted anymore
+			p.failAll(thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "out of sequence response"))
+			return
+		}
+
+		if typeId == thrift.EXCEPTION {
+			x := thrift.NewTApplicationExceptionDefault()
+			e, err := x.Read(iprot)
+			if err != nil {
+				call.future.complete(nil, err)
+				p.failAll(err)
+				return
+			}
+			iprot.ReadMessageEnd()
+			call.future.complete(nil, e)
+			continue
+		}
+
+		value, e := call.read(iprot)
+		call.future.complete(value, e)
+		if _, ok := e.(*HbaseError); e != nil && !ok {
+			// a response that could not be decoded leaves the stream out of sync
+			p.failAll(e)
+			return
+		}
+	}
+}
+
+func (p *pipeline) failAll(err error) {
+	p.client.markBroken(p.hbase, err)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for seqId, call := range p.pending {
+		call.future.complete(nil, err)
+		delete(p.pending, seqId)
+	}
+	p.reading = false
+}
+
+/*
+asyncCall make an asynchronous call on the pipeline of the current connection, reconnecting a broken client
+*/
+func (client *HClient) asyncCall(send func(hbase *Hbase.HbaseClient) error, read func(iprot thrift.TProtocol) (interface{}, error)) *Future {
+	if _, err := client.conn(); err != nil {
+		f := newFuture()
+		f.complete(nil, err)
+		return f
+	}
+
+	client.mu.Lock()
+	pipe := client.pipe
+	client.mu.Unlock()
+	return pipe.call(send, read)
+}
+
+func readRows(result interface {
+	Read(thrift.TProtocol) thrift.TProtocolException
+}, iprot thrift.TProtocol, value func() ([]*Hbase.TRowResult, *Hbase.IOError)) (interface{}, error) {
+	if err := result.Read(iprot); err != nil {
+		return nil, err
+	}
+	iprot.ReadMessageEnd()
+	rows, io := value()
+	if err := checkHbaseError(io, nil); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+/*
+GetAsync sends a Get without waiting for the response
+*/
+func (client *HClient) GetAsync(tableName string, row []byte, column string, attributes map[string][]byte) CellsFuture {
+	return CellsFuture{client.asyncCall(func(hbase *Hbase.HbaseClient) error {
+		return hbase.SendGet(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), toHbaseTextMap(attributes))
+	}, func(iprot thrift.TProtocol) (interface{}, error) {
+		result := Hbase.NewGetResult()
+		if err := result.Read(iprot); err != nil {
+			return nil, err
+		}
+		iprot.ReadMessageEnd()
+		if err := checkHbaseError(result.Io, nil); err != nil {
+			return nil, err
+		}
+		return result.Success, nil
+	})}
+}
+
+/*
+GetRowAsync sends a GetRow without waiting for the response
+*/
+func (client *HClient) GetRowAsync(tableName string, row []byte, attributes map[string][]byte) RowsFuture {
+	return RowsFuture{client.asyncCall(func(hbase *Hbase.HbaseClient) error {
+		return hbase.SendGetRow(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextMap(attributes))
+	}, func(iprot thrift.TProtocol) (interface{}, error) {
+		result := Hbase.NewGetRowResult()
+		return readRows(result, iprot, func() ([]*Hbase.TRowResult, *Hbase.IOError) { return result.Success, result.Io })
+	})}
+}
+
+/*
+GetRowWithColumnsAsync sends a GetRowWithColumns without waiting for the response
+*/
+func (client *HClient) GetRowWithColumnsAsync(tableName string, row []byte, columns []string, attributes map[string][]byte) RowsFuture {
+	return RowsFuture{client.asyncCall(func(hbase *Hbase.HbaseClient) error {
+		return hbase.SendGetRowWithColumns(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	}, func(iprot thrift.TProtocol) (interface{}, error) {
+		result := Hbase.NewGetRowWithColumnsResult()
+		return readRows(result, iprot, func() ([]*Hbase.TRowResult, *Hbase.IOError) { return result.Success, result.Io })
+	})}
+}
+
+/*
+GetRowsAsync sends a GetRows without waiting for the response
+*/
+func (client *HClient) GetRowsAsync(tableName string, rows [][]byte, attributes map[string][]byte) RowsFuture {
+	return RowsFuture{client.asyncCall(func(hbase *Hbase.HbaseClient) error {
+		return hbase.SendGetRows(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextMap(attributes))
+	}, func(iprot thrift.TProtocol) (interface{}, error) {
+		result := Hbase.NewGetRowsResult()
+		return readRows(result, iprot, func() ([]*Hbase.TRowResult, *Hbase.IOError) { return result.Success, result.Io })
+	})}
+}
+
+/*
+MutateRowAsync sends a MutateRow without waiting for the response
+*/
+func (client *HClient) MutateRowAsync(tableName string, row []byte, mutations []*Hbase.Mutation, attributes map[string][]byte) *Future {
+	return client.asyncCall(func(hbase *Hbase.HbaseClient) error {
+		client.invalidateRow(tableName, row)
+		return hbase.SendMutateRow(Hbase.Text(tableName), Hbase.Text(row), mutations, toHbaseTextMap(attributes))
+	}, func(iprot thrift.TProtocol) (interface{}, error) {
+		// reads made while the mutation was in flight may have been cached
+		client.invalidateRow(tableName, row)
+		result := Hbase.NewMutateRowResult()
+		if err := result.Read(iprot); err != nil {
+			return nil, err
+		}
+		iprot.ReadMessageEnd()
+		return nil, checkHbaseArgError(result.Io, result.Ia, nil)
+	})
+}
@@ -0,0 +1,201 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+serveReversed reads n getRow requests and answers them in reverse order once all have arrived
+*/
+func serveReversed(t *testing.T, l net.Listener, n int, received chan<- struct{}) {
+	conn, err := l.Accept()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer conn.Close()
+	socket, _ := thrift.NewTSocketConn(conn)
+	trans := thrift.NewTFramedTransport(socket)
+	protocol := thrift.NewTBinaryProtocolFactoryDefault().GetProtocol(trans)
+
+	seqIds := make([]int32, n)
+	rows := make([]Hbase.Text, n)
+	for i := 0; i < n; i++ {
+		_, _, seqId, err := protocol.ReadMessageBegin()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		args := Hbase.NewGetRowArgs()
+		if err := args.Read(protocol); err != nil {
+			t.Error(err)
+			return
+		}
+		protocol.ReadMessageEnd()
+		seqIds[i], rows[i] = seqId, args.Row
+	}
+	close(received)
+
+	for i := n - 1; i >= 0; i-- {
+		result := Hbase.NewGetRowResult()
+		result.Success = []*Hbase.TRowResult{&Hbase.TRowResult{Row: rows[i]}}
+		protocol.WriteMessageBegin("getRow", thrift.REPLY, seqIds[i])
+		result.Write(protocol)
+		protocol.WriteMessageEnd()
+		trans.Flush()
+	}
+}
+
+func TestAsyncPipelined(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	received := make(chan struct{})
+	go serveReversed(t, l, 3, received)
+
+	client, err := goh.NewTcpClient(l.Addr().String(), goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	keys := []string{"row1", "row2", "row3"}
+	futures := make([]goh.RowsFuture, len(keys))
+	for i, key := range keys {
+		futures[i] = client.GetRowAsync("test", []byte(key), nil)
+	}
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("requests were not pipelined")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := futures[0].Wait(ctx); err != context.Canceled && err != nil {
+		t.Errorf("Wait with a canceled context = %v", err)
+	}
+
+	for i, key := range keys {
+		rows, err := futures[i].Wait(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 1 || string(rows[0].Row) != key {
+			t.Errorf("future %d got %v, want row %s", i, rows, key)
+		}
+	}
+}
+
+func TestAsyncCalls(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	handler.put("test", "row1", "cf:a", "value1", 1)
+	addr := newTcpTestServer(t, Hbase.NewHbaseProcessor(handler), thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	cell := client.GetAsync("test", []byte("row1"), "cf:a", nil)
+	mutate := client.MutateRowAsync("test", []byte("row2"), []*Hbase.Mutation{goh.NewMutation("cf:b", []byte("value2"))}, nil)
+	rows := client.GetRowsAsync("test", [][]byte{[]byte("row1"), []byte("row2")}, nil)
+	missing := client.GetRowAsync("missing", []byte("row1"), nil)
+
+	cells, err := cell.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 1 || string(cells[0].Value) != "value1" {
+		t.Errorf("GetAsync = %v", cells)
+	}
+	if err = mutate.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	results, err := rows.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Errorf("GetRowsAsync returned %d rows", len(results))
+	}
+	if _, err = missing.Wait(ctx); err == nil {
+		t.Error("GetRowAsync on a missing table succeeded")
+	} else if herr, ok := err.(*goh.HbaseError); !ok || herr.IOErr == nil {
+		t.Errorf("GetRowAsync on a missing table = %v", err)
+	}
+
+	// blocking calls work again once no future is pending
+	data, err := client.GetRowWithColumns("test", []byte("row2"), []string{"cf:b"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1 || string(data[0].Columns["cf:b"].Value) != "value2" {
+		t.Errorf("GetRowWithColumns = %v", data)
+	}
+}
+
+func TestAsyncHttp(t *testing.T) {
+	_, client := newHttpTestServer(t, goh.TBinaryProtocol)
+	if _, err := client.GetRowAsync("test", []byte("row1"), nil).Wait(context.Background()); err != goh.ErrAsyncNotSupported {
+		t.Errorf("GetRowAsync over http = %v", err)
+	}
+}
+
+func TestAsyncTHeader(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	handler.put("test", "row1", "cf:a", "value1", 1)
+	addr := newTcpTestServer(t, Hbase.NewHbaseProcessor(handler), thrift.NewTTransportFactory(), thrift.NewTHeaderProtocolFactory())
+
+	client, err := goh.NewTcpClient(addr, goh.THeaderProtocol, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	futures := make([]goh.RowsFuture, 8)
+	for i := range futures {
+		futures[i] = client.GetRowAsync("test", []byte("row1"), nil)
+	}
+	for _, f := range futures {
+		if _, err := f.Wait(context.Background()); err != goh.ErrAsyncNotSupported {
+			t.Errorf("GetRowAsync over theader = %v", err)
+		}
+	}
+
+	// the connection is untouched, blocking calls still work
+	data, err := client.Get("test", []byte("row1"), "cf:a", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1 || string(data[0].Value) != "value1" {
+		t.Errorf("Get = %v", data)
+	}
+}
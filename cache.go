@@ -0,0 +1,326 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"container/list"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+RowCacheOptions control the row cache of a client
+*/
+type RowCacheOptions struct {
+	MaxBytes    int64         // size limit of the cached rows, 64 MiB if 0
+	TTL         time.Duration // how long a row is served from the cache, 1 minute if 0
+	NegativeTTL time.Duration // how long a missing row is served from the cache, missing rows are not cached if 0
+}
+
+/*
+RowCacheStats are the counters of the row cache of a client since it was enabled
+*/
+type RowCacheStats struct {
+	Hits          int64 // reads served from the cache, NegativeHits included
+	NegativeHits  int64 // reads of missing rows served from the cache
+	Misses        int64 // reads sent to the server
+	Evictions     int64 // rows dropped to stay under MaxBytes
+	Invalidations int64 // rows dropped by writes through the client
+	Entries       int   // rows in the cache
+	Bytes         int64 // size of the rows in the cache
+}
+
+/*
+HitRatio return the share of reads served from the cache, 0 before the first read
+*/
+func (s RowCacheStats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type cacheRow struct {
+	table string
+	row   string
+}
+
+type cacheEntry struct {
+	key     cacheRow
+	columns string // columnsKey of the columns read
+	data    []*Hbase.TRowResult
+	size    int64
+	expires time.Time
+}
+
+/*
+rowCache is a LRU cache of the results of GetRow and GetRowWithColumns
+*/
+type rowCache struct {
+	opts RowCacheOptions
+
+	mu    sync.Mutex
+	lru   *list.List                            // of *cacheEntry, the most recently used first
+	rows  map[cacheRow]map[string]*list.Element // the entries of a row by the columns read
+	gen   uint64                                // incremented by every invalidation
+	stats RowCacheStats
+}
+
+/*
+EnableRowCache puts a LRU cache in front of GetRow and GetRowWithColumns, and the methods built on them, replacing the cache the client had.
+Writes through the client (MutateRow(s), DeleteAll*, increments, Append and CheckAndPut) drop the cached rows they touch,
+writes by other clients, connections from NewConnection included, are seen when the rows expire.
+Reads with attributes are not cached. The results of cached reads are shared and must not be modified.
+*/
+func (client *HClient) EnableRowCache(opts *RowCacheOptions) {
+	o := RowCacheOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxBytes <= 0 {
+		o.MaxBytes = 64 << 20
+	}
+	if o.TTL <= 0 {
+		o.TTL = time.Minute
+	}
+
+	cache := &rowCache{opts: o, lru: list.New(), rows: make(map[cacheRow]map[string]*list.Element)}
+	client.mu.Lock()
+	client.cache = cache
+	client.mu.Unlock()
+}
+
+/*
+DisableRowCache drops the row cache of the client
+*/
+func (client *HClient) DisableRowCache() {
+	client.mu.Lock()
+	client.cache = nil
+	client.mu.Unlock()
+}
+
+/*
+RowCacheStats return the counters of the row cache, zero if the cache is not enabled
+*/
+func (client *HClient) RowCacheStats() RowCacheStats {
+	cache := client.rowCache()
+	if cache == nil {
+		return RowCacheStats{}
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.stats
+}
+
+func (client *HClient) rowCache() *rowCache {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.cache
+}
+
+/*
+cachedRow serves a read of row from the cache, calling read on a miss and caching its result
+*/
+func (client *HClient) cachedRow(tableName string, row []byte, columns []string, attributes map[string][]byte, read func() ([]*Hbase.TRowResult, error)) ([]*Hbase.TRowResult, error) {
+	cache := client.rowCache()
+	if cache == nil || len(attributes) > 0 {
+		return read()
+	}
+
+	key, cols := cacheRow{tableName, string(row)}, columnsKey(columns)
+	data, ok, gen := cache.get(key, cols)
+	if ok {
+		return data, nil
+	}
+	data, err := read()
+	if err == nil {
+		cache.add(key, cols, data, gen)
+	}
+	return data, err
+}
+
+/*
+invalidateRow drops the cached reads of row
+*/
+func (client *HClient) invalidateRow(tableName string, row []byte) {
+	if cache := client.rowCache(); cache != nil {
+		cache.invalidateRow(cacheRow{tableName, string(row)})
+	}
+}
+
+/*
+invalidateBatches drops the cached reads of the rows of batches
+*/
+func (client *HClient) invalidateBatches(tableName string, batches []*Hbase.BatchMutation) {
+	for _, batch := range batches {
+		client.invalidateRow(tableName, batch.Row)
+	}
+}
+
+/*
+invalidateIncrements drops the cached reads of the rows of increments
+*/
+func (client *HClient) invalidateIncrements(increments []*Hbase.TIncrement) {
+	for _, increment := range increments {
+		client.invalidateRow(string(increment.Table), increment.Row)
+	}
+}
+
+/*
+invalidateTable drops the cached reads of every row of a table
+*/
+func (client *HClient) invalidateTable(tableName string) {
+	if cache := client.rowCache(); cache != nil {
+		cache.invalidateTable(tableName)
+	}
+}
+
+/*
+get return the cached read and whether it was found, with the generation to give to add after reading from the server
+*/
+func (c *rowCache) get(key cacheRow, columns string) ([]*Hbase.TRowResult, bool, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.rows[key][columns]; ok {
+		entry := e.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			c.lru.MoveToFront(e)
+			c.stats.Hits++
+			if len(entry.data) == 0 {
+				c.stats.NegativeHits++
+			}
+			return entry.data, true, c.gen
+		}
+		c.remove(e)
+	}
+	c.stats.Misses++
+	return nil, false, c.gen
+}
+
+/*
+add caches a read made at generation gen, unless a write invalidated rows since then
+*/
+func (c *rowCache) add(key cacheRow, columns string, data []*Hbase.TRowResult, gen uint64) {
+	ttl := c.opts.TTL
+	if len(data) == 0 {
+		ttl = c.opts.NegativeTTL
+	}
+	size := entrySize(key, columns, data)
+	if ttl <= 0 || size > c.opts.MaxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if e, ok := c.rows[key][columns]; ok {
+		c.remove(e)
+	}
+
+	entry := &cacheEntry{key: key, columns: columns, data: data, size: size, expires: time.Now().Add(ttl)}
+	if c.rows[key] == nil {
+		c.rows[key] = make(map[string]*list.Element)
+	}
+	c.rows[key][columns] = c.lru.PushFront(entry)
+	c.stats.Entries++
+	c.stats.Bytes += size
+
+	for c.stats.Bytes > c.opts.MaxBytes {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+/*
+invalidateRow drops the cached reads of a row
+*/
+func (c *rowCache) invalidateRow(key cacheRow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	c.drop(c.rows[key])
+}
+
+/*
+invalidateTable drops the cached reads of every row of a table
+*/
+func (c *rowCache) invalidateTable(tableName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	for key, entries := range c.rows {
+		if key.table == tableName {
+			c.drop(entries)
+		}
+	}
+}
+
+/*
+drop removes the entries of a row as invalidated, c.mu is held
+*/
+func (c *rowCache) drop(entries map[string]*list.Element) {
+	for _, e := range entries {
+		c.remove(e)
+		c.stats.Invalidations++
+	}
+}
+
+/*
+remove drops an entry, c.mu is held
+*/
+func (c *rowCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*cacheEntry)
+	entries := c.rows[entry.key]
+	delete(entries, entry.columns)
+	if len(entries) == 0 {
+		delete(c.rows, entry.key)
+	}
+	c.stats.Entries--
+	c.stats.Bytes -= entry.size
+}
+
+/*
+columnsKey encodes a list of columns as a map key, the names are length prefixed as they can hold any byte
+*/
+func columnsKey(columns []string) string {
+	var b []byte
+	for _, column := range columns {
+		b = binary.AppendUvarint(b, uint64(len(column)))
+		b = append(b, column...)
+	}
+	return string(b)
+}
+
+/*
+entrySize estimates the memory used by a cached read from the size of its keys and values
+*/
+func entrySize(key cacheRow, columns string, data []*Hbase.TRowResult) int64 {
+	size := int64(len(key.table) + len(key.row) + len(columns) + 64)
+	for _, r := range data {
+		size += int64(len(r.Row))
+		for name, cell := range r.Columns {
+			size += int64(len(name) + 32)
+			if cell != nil {
+				size += int64(len(cell.Value))
+			}
+		}
+		for _, c := range r.SortedColumns {
+			size += int64(len(c.ColumnName) + 32)
+			if c.Cell != nil {
+				size += int64(len(c.Cell.Value))
+			}
+		}
+	}
+	return size
+}
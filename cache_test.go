@@ -0,0 +1,117 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+)
+
+func cachedValue(t *testing.T, client *goh.HClient, row string, columns ...string) string {
+	r, err := client.RowWithColumns("test", []byte(row), columns, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		return ""
+	}
+	return string(r.Value("cf", "x"))
+}
+
+func TestRowCache(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	client.EnableRowCache(&goh.RowCacheOptions{TTL: time.Hour, NegativeTTL: time.Hour})
+
+	if v := cachedValue(t, client, "a"); v != "value a" {
+		t.Fatalf("a = %q", v)
+	}
+	handler.put("test", "a", "cf:x", "changed", 2)
+	if v := cachedValue(t, client, "a"); v != "value a" {
+		t.Errorf("cached a = %q, want value a", v)
+	}
+	if v := cachedValue(t, client, "a", "cf:x"); v != "changed" {
+		t.Errorf("a with columns = %q, want a read of its own", v)
+	}
+
+	if v := cachedValue(t, client, "b"); v != "" {
+		t.Fatalf("b = %q", v)
+	}
+	handler.put("test", "b", "cf:x", "value b", 1)
+	if v := cachedValue(t, client, "b"); v != "" {
+		t.Errorf("missing b = %q, want a negative hit", v)
+	}
+	if err := client.MutateRow("test", []byte("b"), []*Hbase.Mutation{goh.NewMutation("cf:x", []byte("new b"))}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := cachedValue(t, client, "b"); v != "new b" {
+		t.Errorf("b after MutateRow = %q", v)
+	}
+
+	if _, err := client.AtomicIncrement("test", []byte("a"), "cf:n", 1); err != nil {
+		t.Fatal(err)
+	}
+	if v := cachedValue(t, client, "a"); v != "changed" {
+		t.Errorf("a after AtomicIncrement = %q", v)
+	}
+	if err := client.DeleteAllRow("test", []byte("a"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := cachedValue(t, client, "a"); v != "" {
+		t.Errorf("a after DeleteAllRow = %q", v)
+	}
+
+	if _, err := client.GetRow("test", []byte("c"), map[string][]byte{"k": []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := goh.RowCacheStats{Hits: 2, NegativeHits: 1, Misses: 6, Invalidations: 4, Entries: 2}
+	got := client.RowCacheStats()
+	if got.Bytes <= 0 {
+		t.Errorf("Bytes = %d", got.Bytes)
+	}
+	got.Bytes = 0
+	if got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+
+	client.DisableRowCache()
+	if v := cachedValue(t, client, "b"); v != "new b" || client.RowCacheStats() != (goh.RowCacheStats{}) {
+		t.Errorf("b without cache = %q, stats %+v", v, client.RowCacheStats())
+	}
+}
+
+func TestRowCacheLimits(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	client.EnableRowCache(&goh.RowCacheOptions{TTL: 50 * time.Millisecond})
+
+	cachedValue(t, client, "a")
+	handler.put("test", "a", "cf:x", "changed", 2)
+	time.Sleep(60 * time.Millisecond)
+	if v := cachedValue(t, client, "a"); v != "changed" {
+		t.Errorf("a after the ttl = %q", v)
+	}
+	cachedValue(t, client, "b")
+	cachedValue(t, client, "b")
+	if s := client.RowCacheStats(); s.Hits != 0 || s.Misses != 4 || s.Entries != 1 {
+		t.Errorf("stats without negative caching = %+v", s)
+	}
+
+	client.EnableRowCache(&goh.RowCacheOptions{TTL: time.Hour})
+	cachedValue(t, client, "a")
+	size := client.RowCacheStats().Bytes
+	client.EnableRowCache(&goh.RowCacheOptions{TTL: time.Hour, MaxBytes: 2*size + size/2})
+	for _, row := range []string{"a", "c", "a", "e", "a"} {
+		cachedValue(t, client, row)
+	}
+	if s := client.RowCacheStats(); s.Hits != 2 || s.Misses != 3 || s.Evictions != 1 || s.Entries != 2 || s.Bytes > 2*size+size/2 {
+		t.Errorf("stats of a full cache = %+v", s)
+	}
+	if cachedValue(t, client, "c"); client.RowCacheStats().Misses != 4 {
+		t.Error("c was not the least recently used row")
+	}
+}
@@ -0,0 +1,276 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+optionalMethods are the methods of the Hbase service some versions of the thrift gateway do not have
+*/
+var optionalMethods = []string{
+	"getRowOrBefore", // removed in newer versions
+	"getRegionInfo",
+	"increment", // added in newer versions
+	"incrementRows",
+	"scannerOpenWithScan",
+	"append", // added in newer versions, can not be emulated
+	"checkAndPut",
+}
+
+/*
+Capabilities is the set of optional methods the server supports
+*/
+type Capabilities struct {
+	supported map[string]bool
+}
+
+/*
+Supports report whether the server supports method, methods that are not optional are always supported
+*/
+func (c *Capabilities) Supports(method string) bool {
+	supported, known := c.supported[method]
+	return !known || supported
+}
+
+/*
+Unsupported return the optional methods the server does not support
+*/
+func (c *Capabilities) Unsupported() []string {
+	methods := make([]string, 0)
+	for method, supported := range c.supported {
+		if !supported {
+			methods = append(methods, method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+/*
+Capabilities detect which optional methods the server supports.
+Every optional method not called yet is probed once with harmless arguments, the server answers UNKNOWN_METHOD if it does not have it.
+The result is cached by the client, calls that fail with UNKNOWN_METHOD update it.
+Once a reversed scan made by GetRowOrBefore is found not to work, reversedScan is reported as unsupported too.
+*/
+func (client *HClient) Capabilities() (*Capabilities, error) {
+	for _, method := range optionalMethods {
+		if _, known := client.methodSupport(method); known {
+			continue
+		}
+
+		err := client.probe(method)
+		if isUnknownMethod(err) {
+			client.setMethodSupport(method, false)
+			continue
+		}
+		if herr, ok := err.(*HbaseError); ok && isConnectionError(herr.Err) {
+			return nil, err
+		}
+		client.setMethodSupport(method, true)
+	}
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	c := &Capabilities{supported: make(map[string]bool, len(client.methods))}
+	for method, supported := range client.methods {
+		c.supported[method] = supported
+	}
+	return c, nil
+}
+
+/*
+probe call method with arguments that do not change anything, the call is expected to fail
+*/
+func (client *HClient) probe(method string) error {
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	switch method {
+	case "getRowOrBefore":
+		_, io, e1 := hbase.GetRowOrBefore(Hbase.Text(""), Hbase.Text(""), Hbase.Text(""))
+		return client.checkHbaseError(io, e1)
+	case "getRegionInfo":
+		_, io, e1 := hbase.GetRegionInfo(Hbase.Text(""))
+		return client.checkHbaseError(io, e1)
+	case "increment":
+		return client.checkHbaseError(hbase.Increment(NewTIncrement("", nil, "", 0)))
+	case "incrementRows":
+		return client.checkHbaseError(hbase.IncrementRows([]*Hbase.TIncrement{}))
+	case "scannerOpenWithScan":
+		id, io, e1 := hbase.ScannerOpenWithScan(Hbase.Text(""), &Hbase.TScan{}, nil)
+		if io == nil && e1 == nil {
+			hbase.ScannerClose(id)
+		}
+		return client.checkHbaseError(io, e1)
+	case "append":
+		_, io, e1 := hbase.Append(NewAppend("", nil, nil, nil))
+		return client.checkHbaseError(io, e1)
+	case "checkAndPut":
+		_, io, ia, e1 := hbase.CheckAndPut(Hbase.Text(""), Hbase.Text(""), Hbase.Text(""), nil, NewMutation("", nil), nil)
+		return client.checkHbaseArgError(io, ia, e1)
+	}
+	return nil
+}
+
+func (client *HClient) methodSupport(method string) (supported, known bool) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	supported, known = client.methods[method]
+	return
+}
+
+func (client *HClient) setMethodSupport(method string, supported bool) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.methods == nil {
+		client.methods = make(map[string]bool)
+	}
+	client.methods[method] = supported
+}
+
+/*
+supports report whether method may be called, it is false once the server answered UNKNOWN_METHOD
+*/
+func (client *HClient) supports(method string) bool {
+	supported, known := client.methodSupport(method)
+	return !known || supported
+}
+
+/*
+unsupported report whether a call of method failed with err because the server does not have method,
+and remember the answer
+*/
+func (client *HClient) unsupported(method string, err error) bool {
+	if isUnknownMethod(err) {
+		client.setMethodSupport(method, false)
+		return true
+	}
+	if err == nil {
+		client.setMethodSupport(method, true)
+	}
+	return false
+}
+
+func isUnknownMethod(err error) bool {
+	if herr, ok := err.(*HbaseError); ok {
+		err = herr.Err
+	}
+	x, ok := err.(thrift.TApplicationException)
+	return ok && x.TypeId() == thrift.UNKNOWN_METHOD
+}
+
+/*
+getRowOrBefore emulate getRowOrBefore with GetRowWithColumns, and a reversed scan from row if row does not exist
+*/
+func (client *HClient) getRowOrBefore(tableName string, row []byte, family []byte) (data []*Hbase.TCell, err error) {
+	columns := []string{string(family)}
+	rows, err := client.GetRowWithColumns(tableName, row, columns, nil)
+	if err != nil {
+		return
+	}
+	if len(rows) == 0 {
+		if rows, err = client.rowBefore(tableName, row, columns); err != nil {
+			return
+		}
+	}
+
+	data = make([]*Hbase.TCell, 0)
+	if len(rows) == 0 {
+		return
+	}
+	names := make([]string, 0, len(rows[0].Columns))
+	for name := range rows[0].Columns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		data = append(data, rows[0].Columns[name])
+	}
+	return
+}
+
+/*
+rowBefore return the last row before row with a reversed scan of one row.
+If the server can not scan in reverse it fails with ErrNotSupported unless there is no row before row,
+finding the last one would scan the table from its start.
+*/
+func (client *HClient) rowBefore(tableName string, row []byte, columns []string) ([]*Hbase.TRowResult, error) {
+	if supported, known := client.methodSupport("reversedScan"); !known || supported {
+		rows, err := client.scanOne(tableName, &TScan{StartRow: row, Columns: columns, Caching: 1, Reversed: true})
+		switch herr, _ := err.(*HbaseError); {
+		case herr != nil && herr.Err == ErrNotSupported:
+			client.setMethodSupport("reversedScan", false)
+		case err != nil:
+			return nil, err
+		case len(rows) > 0 && bytes.Compare(rows[0].Row, row) < 0:
+			client.setMethodSupport("reversedScan", true)
+			return rows, nil
+		case len(rows) > 0:
+			// servers older than 0.98 ignore Reversed and scan forward
+			client.setMethodSupport("reversedScan", false)
+		}
+		// no row tells nothing about the direction, a server scanning in reverse would have found the row checked for below
+	}
+
+	id, err := client.ScannerOpenWithStop(tableName, nil, row, columns, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer client.ScannerClose(id)
+
+	rows, err := client.ScannerGetList(id, 1)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	client.setMethodSupport("reversedScan", false)
+	return nil, newHbaseError(nil, nil, ErrNotSupported)
+}
+
+/*
+scanOne return the first row of scan
+*/
+func (client *HClient) scanOne(tableName string, scan *TScan) ([]*Hbase.TRowResult, error) {
+	id, err := client.ScannerOpenWithScan(tableName, scan, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer client.ScannerClose(id)
+	return client.ScannerGetList(id, 1)
+}
+
+/*
+increment emulate increment with atomicIncrement
+*/
+func (client *HClient) increment(increments []*Hbase.TIncrement) error {
+	for _, increment := range increments {
+		if _, err := client.AtomicIncrement(string(increment.Table), increment.Row, string(increment.Column), increment.Ammount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+scannerOpenWithScan emulate scannerOpenWithScan with scannerOpenWithStop(Ts), the caching and batch size of scan are ignored
+*/
+func (client *HClient) scannerOpenWithScan(tableName string, scan *TScan, attributes map[string][]byte) (id int32, err error) {
+	if scan == nil {
+		scan = &TScan{}
+	}
+	if scan.FilterString != "" || scan.SortColumns || scan.Reversed {
+		return 0, newHbaseError(nil, nil, ErrNotSupported)
+	}
+	if scan.Timestamp != 0 {
+		return client.ScannerOpenWithStopTs(tableName, scan.StartRow, scan.StopRow, scan.Columns, scan.Timestamp, attributes)
+	}
+	return client.ScannerOpenWithStop(tableName, scan.StartRow, scan.StopRow, scan.Columns, attributes)
+}
@@ -0,0 +1,197 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+gatewayProcessor serves the Hbase service without some of its methods,
+like the java gateway it keeps the connection after UNKNOWN_METHOD
+*/
+type gatewayProcessor struct {
+	*Hbase.HbaseProcessor
+}
+
+func newGatewayProcessor(handler Hbase.IHbase, missing ...string) *gatewayProcessor {
+	processor := Hbase.NewHbaseProcessor(handler)
+	for _, method := range missing {
+		delete(processor.ProcessorMap(), method)
+	}
+	return &gatewayProcessor{processor}
+}
+
+func (p *gatewayProcessor) Process(in, out thrift.TProtocol) (bool, thrift.TException) {
+	ok, err := p.HbaseProcessor.Process(in, out)
+	if x, isApp := err.(thrift.TApplicationException); isApp && x.TypeId() == thrift.UNKNOWN_METHOD {
+		return true, nil
+	}
+	return ok, err
+}
+
+func newGatewayClient(t *testing.T, missing ...string) (*memHbase, *goh.HClient) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	for _, row := range []string{"a", "c", "e"} {
+		handler.put("test", row, "cf:x", "value "+row, 1)
+	}
+	addr := newTcpTestServer(t, newGatewayProcessor(handler, missing...), thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return handler, client
+}
+
+func checkRowOrBefore(t *testing.T, client *goh.HClient, row string, want string) {
+	cells, err := client.GetRowOrBefore("test", []byte(row), []byte("cf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := ""
+	if len(cells) > 0 {
+		got = string(cells[0].Value)
+	}
+	if len(cells) > 1 || got != want {
+		t.Errorf("GetRowOrBefore(%s) = %v, want %q", row, cells, want)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	cases := []struct {
+		name    string
+		missing []string
+	}{
+		{"current", nil},
+		{"newer", []string{"getRowOrBefore", "getRegionInfo"}},
+		{"older", []string{"increment", "incrementRows", "scannerOpenWithScan", "append", "checkAndPut"}},
+	}
+	for _, c := range cases {
+		_, client := newGatewayClient(t, c.missing...)
+		capabilities, err := client.Capabilities()
+		if err != nil {
+			t.Fatal(c.name, err)
+		}
+
+		want := append([]string{}, c.missing...)
+		sort.Strings(want)
+		for _, method := range want {
+			if capabilities.Supports(method) {
+				t.Errorf("%s: Supports(%s)", c.name, method)
+			}
+		}
+		if got := capabilities.Unsupported(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Unsupported = %v, want %v", c.name, got, want)
+		}
+		if !capabilities.Supports("getRow") {
+			t.Errorf("%s: getRow not supported", c.name)
+		}
+
+		// the connection is still usable after the probes
+		if _, err = client.GetTableNames(); err != nil {
+			t.Fatal(c.name, err)
+		}
+	}
+}
+
+func TestGetRowOrBeforeFallback(t *testing.T) {
+	cases := []struct {
+		missing     []string
+		forward     bool
+		reversed    bool // a row before a missing row can be found
+		unsupported []string
+	}{
+		{nil, false, true, nil},
+		{[]string{"getRowOrBefore"}, false, true, []string{"getRowOrBefore"}},
+		{[]string{"getRowOrBefore"}, true, false, []string{"getRowOrBefore", "reversedScan"}},
+		{[]string{"getRowOrBefore", "scannerOpenWithScan"}, false, false, []string{"getRowOrBefore", "reversedScan", "scannerOpenWithScan"}},
+	}
+	for _, c := range cases {
+		// no Capabilities call, the first UNKNOWN_METHOD switches to the emulation
+		handler, client := newGatewayClient(t, c.missing...)
+		handler.forward = c.forward
+		if c.reversed {
+			checkRowOrBefore(t, client, "z", "value e")
+			checkRowOrBefore(t, client, "d", "value c")
+		} else {
+			// without a reversed scan a row before a missing row would need a scan of the table
+			for _, row := range []string{"z", "d"} {
+				if _, err := client.GetRowOrBefore("test", []byte(row), []byte("cf")); err == nil || err.(*goh.HbaseError).Err != goh.ErrNotSupported {
+					t.Errorf("missing %v: GetRowOrBefore %s = %v, want %v", c.missing, row, err, goh.ErrNotSupported)
+				}
+			}
+		}
+		checkRowOrBefore(t, client, "c", "value c")
+		checkRowOrBefore(t, client, "0", "")
+
+		capabilities, err := client.Capabilities()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := capabilities.Unsupported(); !reflect.DeepEqual(got, append([]string{}, c.unsupported...)) {
+			t.Errorf("Unsupported = %v, want %v", got, c.unsupported)
+		}
+	}
+}
+
+func TestIncrementAndScanFallback(t *testing.T) {
+	handler, client := newGatewayClient(t, "increment", "incrementRows", "scannerOpenWithScan")
+
+	if err := client.Increment(goh.NewTIncrement("test", []byte("a"), "cf:n", 2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.IncrementRows([]*Hbase.TIncrement{goh.NewTIncrement("test", []byte("a"), "cf:n", 3), goh.NewTIncrement("test", []byte("c"), "cf:n", 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if n, _, _, _ := handler.AtomicIncrement(Hbase.Text("test"), Hbase.Text("a"), Hbase.Text("cf:n"), 0); n != 5 {
+		t.Errorf("cf:n of row a = %d, want 5", n)
+	}
+
+	id, err := client.ScannerOpenWithScan("test", &goh.TScan{StartRow: []byte("b"), StopRow: []byte("e"), Columns: []string{"cf:x"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := client.ScannerGetList(id, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.ScannerClose(id)
+	if len(rows) != 1 || string(rows[0].Row) != "c" {
+		t.Errorf("emulated scan returned %v", rows)
+	}
+
+	if _, err = client.ScannerOpenWithScan("test", &goh.TScan{FilterString: "KeyOnlyFilter()"}, nil); err == nil || err.(*goh.HbaseError).Err != goh.ErrNotSupported {
+		t.Errorf("scan with a filter = %v, want %v", err, goh.ErrNotSupported)
+	}
+	if _, err = client.ScannerOpenWithScan("test", &goh.TScan{Reversed: true}, nil); err == nil || err.(*goh.HbaseError).Err != goh.ErrNotSupported {
+		t.Errorf("reversed scan = %v, want %v", err, goh.ErrNotSupported)
+	}
+}
+
+func TestAppendAndCheckAndPutNotSupported(t *testing.T) {
+	_, client := newGatewayClient(t, "append", "checkAndPut")
+
+	// the first call finds out the method is missing, the second one does not call the server
+	for i := 0; i < 2; i++ {
+		if _, err := client.Append(goh.NewAppend("test", []byte("a"), []string{"cf:x"}, [][]byte{[]byte("!")})); err == nil || err.(*goh.HbaseError).Err != goh.ErrNotSupported {
+			t.Errorf("Append = %v, want %v", err, goh.ErrNotSupported)
+		}
+		if _, err := client.CheckAndPut("test", []byte("a"), "cf:x", nil, goh.NewMutation("cf:x", []byte("v")), nil); err == nil || err.(*goh.HbaseError).Err != goh.ErrNotSupported {
+			t.Errorf("CheckAndPut = %v, want %v", err, goh.ErrNotSupported)
+		}
+	}
+}
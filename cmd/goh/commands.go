@@ -0,0 +1,657 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+command is a subcommand of goh
+*/
+type command struct {
+	name string
+	args string
+	desc string
+	run  func(cmd *command, s *session, args []string) error
+}
+
+/*
+usageError is returned for invalid arguments of a command
+*/
+type usageError string
+
+func (e usageError) Error() string {
+	return string(e)
+}
+
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{"list", "", "list tables", runList},
+		{"describe", "table", "show the column families of a table", runDescribe},
+		{"create", "table family[:option=value,...]...", "create a table, options: versions, compression, inmemory, bloom, bloomsize, bloomhashes, blockcache, ttl", runCreate},
+		{"enable", "table", "enable a table", runEnable},
+		{"disable", "table", "disable a table", runDisable},
+		{"drop", "table", "disable and delete a table", runDrop},
+		{"truncate", "table", "delete all rows of a table, keeping its column families", runTruncate},
+		{"clone", "source target", "create a table with the column families of another table", runClone},
+		{"get", "[-ts n] [-versions n] table row [column...]", "get a row or columns of a row", runGet},
+		{"put", "[-ts n] table row column value", "put a value", runPut},
+		{"delete", "[-ts n] table row [column]", "delete a row or a column of a row", runDelete},
+		{"scan", "[-start row] [-stop row] [-prefix row] [-filter string] [-limit n] [-columns c1,c2] [-caching n] [-ts n] [-reversed] table", "scan a table", runScan},
+		{"count", "[-start row] [-stop row] [-prefix row] [-caching n] [-parallel n] [-regions] [-prefix-length n] table", "count the rows of a table, scanning the regions in parallel", runCount},
+		{"incr", "table row column [amount]", "increment a counter, amount defaults to 1", runIncr},
+		{"regions", "table", "list the regions of a table", runRegions},
+		{"inspect", "[-sample n] [-region-ratio f] [-server-ratio f] table", "report the regions of a table per server with size estimates, flag oversized regions and overloaded servers", runInspect},
+		{"export", "[-binary] [-start row] [-stop row] [-prefix row] [-filter string] [-columns c1,c2] [-versions n] [-caching n] [-resume] table file", "export every version of the rows of a table to a file, - for stdout", runExport},
+		{"import", "[-batch n] [-create] [-resume] table file", "import an export into a table keeping the timestamps, - for stdin", runImport},
+		{"copy", "[-to addr] [-to-url url] [-to-protocol p] [-to-framed] [-start row] [-stop row] [-prefix row] [-families f1,f2] [-starttime ts] [-endtime ts] [-versions n] [-parallel n] [-batch n] table [newname]", "copy a table to another server or under a new name, keeping the timestamps", runCopy},
+		{"schema", "[-json] [table...]", "print the schema of tables as a schema file, of all tables if none is given", runSchema},
+		{"plan", "file", "compare a schema file in yaml or json with the tables, - for stdin", runPlan},
+		{"apply", "[-recreate] file", "create the tables of a schema file that are missing, -recreate drops and creates tables whose families differ", runApply},
+		{"shell", "[-history file]", "interactive shell with the syntax of the hbase shell", runShell},
+	}
+}
+
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func (cmd *command) exec(s *session, args []string) error {
+	return cmd.run(cmd, s, args)
+}
+
+func (cmd *command) usage() error {
+	return usageError(fmt.Sprint("usage: goh ", cmd.name, " ", cmd.args))
+}
+
+func (cmd *command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+/*
+parse parses flags of fs that may appear anywhere in args before -- and checks the number of the other arguments
+*/
+func (cmd *command) parse(fs *flag.FlagSet, args []string, min, max int) ([]string, error) {
+	var rest []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, usageError(fmt.Sprint(err, "\n", cmd.usage()))
+		}
+		if n := len(args) - fs.NArg(); n > 0 && args[n-1] == "--" {
+			rest = append(rest, fs.Args()...)
+			break
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		rest = append(rest, args[0])
+		args = args[1:]
+	}
+
+	if len(rest) < min || (max >= 0 && len(rest) > max) {
+		return nil, cmd.usage()
+	}
+	return rest, nil
+}
+
+func unescapeAll(list []string) ([][]byte, error) {
+	data := make([][]byte, len(list))
+	for i, s := range list {
+		b, err := unescape(s)
+		if err != nil {
+			return nil, usageError(err.Error())
+		}
+		data[i] = b
+	}
+	return data, nil
+}
+
+func unescapeColumns(list []string) ([]string, error) {
+	data, err := unescapeAll(list)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, len(data))
+	for i, b := range data {
+		columns[i] = string(b)
+	}
+	return columns, nil
+}
+
+func runList(cmd *command, s *session, args []string) error {
+	if _, err := cmd.parse(cmd.flagSet(), args, 0, 0); err != nil {
+		return err
+	}
+
+	names, err := s.client.GetTableNames()
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+
+	t := newTable("TABLE", "ENABLED")
+	for _, name := range names {
+		enabled, err := s.client.IsTableEnabled(name)
+		if err != nil {
+			return err
+		}
+		t.add(escape([]byte(name)), strconv.FormatBool(enabled))
+	}
+	return t.write(s.out, s.format)
+}
+
+func runDescribe(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	families, err := s.client.GetColumnDescriptors(rest[0])
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	t := newTable("FAMILY", "VERSIONS", "COMPRESSION", "INMEMORY", "BLOOM", "BLOOMSIZE", "BLOOMHASHES", "BLOCKCACHE", "TTL")
+	for _, name := range names {
+		col := families[name]
+		t.add(escape([]byte(strings.TrimSuffix(col.Name, ":"))),
+			strconv.Itoa(int(col.MaxVersions)),
+			col.Compression,
+			strconv.FormatBool(col.InMemory),
+			col.BloomFilterType,
+			strconv.Itoa(int(col.BloomFilterVectorSize)),
+			strconv.Itoa(int(col.BloomFilterNbHashes)),
+			strconv.FormatBool(col.BlockCacheEnabled),
+			strconv.Itoa(int(col.TimeToLive)))
+	}
+	return t.write(s.out, s.format)
+}
+
+/*
+parseFamily parses name[:option=value,...]
+*/
+func parseFamily(spec string) (*goh.ColumnDescriptor, error) {
+	name, options := spec, ""
+	if i := strings.Index(spec, ":"); i >= 0 {
+		name, options = spec[:i], spec[i+1:]
+	}
+	if name == "" {
+		return nil, usageError(fmt.Sprint("invalid family:", spec))
+	}
+
+	col := goh.NewColumnDescriptorDefault(name)
+	if options == "" {
+		return col, nil
+	}
+
+	for _, option := range strings.Split(options, ",") {
+		kv := strings.SplitN(option, "=", 2)
+		if len(kv) != 2 {
+			return nil, usageError(fmt.Sprint("invalid option of ", name, ":", option))
+		}
+
+		key, value := strings.ToLower(kv[0]), kv[1]
+		var err error
+		switch key {
+		case "versions":
+			col.MaxVersions, err = parseInt32(value)
+		case "compression":
+			col.Compression = strings.ToUpper(value)
+		case "inmemory":
+			col.InMemory, err = strconv.ParseBool(value)
+		case "bloom":
+			col.BloomFilterType = strings.ToUpper(value)
+		case "bloomsize":
+			col.BloomFilterVectorSize, err = parseInt32(value)
+		case "bloomhashes":
+			col.BloomFilterNbHashes, err = parseInt32(value)
+		case "blockcache":
+			col.BlockCacheEnabled, err = strconv.ParseBool(value)
+		case "ttl":
+			col.TimeToLive, err = parseInt32(value)
+		default:
+			return nil, usageError(fmt.Sprint("unknown option of ", name, ":", kv[0]))
+		}
+		if err != nil {
+			return nil, usageError(fmt.Sprint("invalid option of ", name, ":", option))
+		}
+	}
+	return col, nil
+}
+
+func parseInt32(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	return int32(v), err
+}
+
+func runCreate(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 2, -1)
+	if err != nil {
+		return err
+	}
+
+	families := make([]*goh.ColumnDescriptor, 0, len(rest)-1)
+	for _, spec := range rest[1:] {
+		col, err := parseFamily(spec)
+		if err != nil {
+			return err
+		}
+		families = append(families, col)
+	}
+
+	if _, err = s.client.CreateTable(rest[0], families); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "created", rest[0])
+	return nil
+}
+
+func runEnable(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	if err = s.client.EnableTable(rest[0]); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "enabled", rest[0])
+	return nil
+}
+
+func runDisable(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	if err = s.client.DisableTable(rest[0]); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "disabled", rest[0])
+	return nil
+}
+
+func runDrop(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	enabled, err := s.client.IsTableEnabled(rest[0])
+	if err != nil {
+		return err
+	}
+	if enabled {
+		if err = s.client.DisableTable(rest[0]); err != nil {
+			return err
+		}
+	}
+
+	if err = s.client.DeleteTable(rest[0]); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "dropped", rest[0])
+	return nil
+}
+
+func runTruncate(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	if err = s.client.TruncateTable(rest[0]); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "truncated", rest[0])
+	return nil
+}
+
+func runClone(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	if err = s.client.CloneSchema(rest[0], rest[1]); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out, "created", rest[1])
+	return nil
+}
+
+func newCellTable() *table {
+	return newTable("ROW", "COLUMN", "TIMESTAMP", "VALUE")
+}
+
+func addCells(t *table, row []byte, column string, cells []*Hbase.TCell) {
+	for _, cell := range cells {
+		t.add(escape(row), escape([]byte(column)), strconv.FormatInt(cell.Timestamp, 10), escape(cell.Value))
+	}
+}
+
+/*
+addRowResults adds a line for every cell of results, in column order
+*/
+func addRowResults(t *table, results []*Hbase.TRowResult) {
+	for _, result := range results {
+		if result.SortedColumns != nil {
+			for _, col := range result.SortedColumns {
+				addCells(t, result.Row, string(col.ColumnName), []*Hbase.TCell{col.Cell})
+			}
+			continue
+		}
+
+		columns := make([]string, 0, len(result.Columns))
+		for column := range result.Columns {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		for _, column := range columns {
+			addCells(t, result.Row, column, []*Hbase.TCell{result.Columns[column]})
+		}
+	}
+}
+
+func runGet(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	ts := fs.Int64("ts", 0, "timestamp")
+	versions := fs.Int("versions", 1, "number of versions")
+	rest, err := cmd.parse(fs, args, 2, -1)
+	if err != nil {
+		return err
+	}
+
+	row, err := unescape(rest[1])
+	if err != nil {
+		return usageError(err.Error())
+	}
+	columns, err := unescapeColumns(rest[2:])
+	if err != nil {
+		return err
+	}
+
+	t := newCellTable()
+	if *versions > 1 {
+		if len(columns) == 0 {
+			return usageError("get: -versions needs a column")
+		}
+		for _, column := range columns {
+			var cells []*Hbase.TCell
+			if *ts > 0 {
+				cells, err = s.client.GetVerTs(rest[0], row, column, *ts, int32(*versions), nil)
+			} else {
+				cells, err = s.client.GetVer(rest[0], row, column, int32(*versions), nil)
+			}
+			if err != nil {
+				return err
+			}
+			addCells(t, row, column, cells)
+		}
+		return t.write(s.out, s.format)
+	}
+
+	var results []*Hbase.TRowResult
+	switch {
+	case len(columns) == 0 && *ts > 0:
+		results, err = s.client.GetRowTs(rest[0], row, *ts, nil)
+	case len(columns) == 0:
+		results, err = s.client.GetRow(rest[0], row, nil)
+	case *ts > 0:
+		results, err = s.client.GetRowWithColumnsTs(rest[0], row, columns, *ts, nil)
+	default:
+		results, err = s.client.GetRowWithColumns(rest[0], row, columns, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	addRowResults(t, results)
+	return t.write(s.out, s.format)
+}
+
+func runPut(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	ts := fs.Int64("ts", 0, "timestamp")
+	rest, err := cmd.parse(fs, args, 4, 4)
+	if err != nil {
+		return err
+	}
+
+	data, err := unescapeAll(rest[1:])
+	if err != nil {
+		return err
+	}
+
+	mutations := []*Hbase.Mutation{goh.NewMutation(string(data[1]), data[2])}
+	if *ts > 0 {
+		err = s.client.MutateRowTs(rest[0], data[0], mutations, *ts, nil)
+	} else {
+		err = s.client.MutateRow(rest[0], data[0], mutations, nil)
+	}
+	return err
+}
+
+func runDelete(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	ts := fs.Int64("ts", 0, "delete versions older than or equal to the timestamp")
+	rest, err := cmd.parse(fs, args, 2, 3)
+	if err != nil {
+		return err
+	}
+
+	data, err := unescapeAll(rest[1:])
+	if err != nil {
+		return err
+	}
+
+	row := data[0]
+	switch {
+	case len(data) == 1 && *ts > 0:
+		err = s.client.DeleteAllRowTs(rest[0], row, *ts, nil)
+	case len(data) == 1:
+		err = s.client.DeleteAllRow(rest[0], row, nil)
+	case *ts > 0:
+		err = s.client.DeleteAllTs(rest[0], row, string(data[1]), *ts, nil)
+	default:
+		err = s.client.DeleteAll(rest[0], row, string(data[1]), nil)
+	}
+	return err
+}
+
+/*
+scanOptions is the flags of scan
+*/
+type scanOptions struct {
+	start    string
+	stop     string
+	prefix   string
+	filter   string
+	columns  string
+	limit    int
+	caching  int
+	ts       int64
+	reversed bool
+}
+
+func (o *scanOptions) flags(fs *flag.FlagSet) {
+	fs.StringVar(&o.start, "start", "", "start row, inclusive")
+	fs.StringVar(&o.stop, "stop", "", "stop row, exclusive")
+	fs.StringVar(&o.prefix, "prefix", "", "only rows starting with prefix")
+	fs.StringVar(&o.filter, "filter", "", "filter string, such as \"ValueFilter(=, 'binary:x')\"")
+	fs.StringVar(&o.columns, "columns", "", "comma separated columns or families")
+	fs.IntVar(&o.limit, "limit", 0, "maximum number of rows, 0 for all")
+	fs.IntVar(&o.caching, "caching", 100, "rows fetched per call")
+	fs.Int64Var(&o.ts, "ts", 0, "only versions older than the timestamp")
+	fs.BoolVar(&o.reversed, "reversed", false, "scan backwards, start has to be greater than stop")
+}
+
+/*
+scan converts o to a goh.TScan, a prefix sets the start row and the stop row
+*/
+func (o *scanOptions) scan() (*goh.TScan, error) {
+	start, err := unescape(o.start)
+	if err != nil {
+		return nil, usageError(err.Error())
+	}
+	stop, err := unescape(o.stop)
+	if err != nil {
+		return nil, usageError(err.Error())
+	}
+	prefix, err := unescape(o.prefix)
+	if err != nil {
+		return nil, usageError(err.Error())
+	}
+
+	if len(prefix) > 0 {
+		if len(start) > 0 || len(stop) > 0 {
+			return nil, usageError("-prefix can not be used with -start or -stop")
+		}
+		if o.reversed {
+			return nil, usageError("-prefix can not be used with -reversed")
+		}
+		start, stop = prefix, prefixEnd(prefix)
+	}
+
+	var columns []string
+	if o.columns != "" {
+		if columns, err = unescapeColumns(strings.Split(o.columns, ",")); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.caching <= 0 {
+		return nil, usageError("-caching has to be greater than 0")
+	}
+	caching := o.caching
+	if o.limit > 0 && o.limit < caching {
+		caching = o.limit
+	}
+
+	return &goh.TScan{
+		StartRow:     start,
+		StopRow:      stop,
+		Timestamp:    o.ts,
+		Columns:      columns,
+		Caching:      int32(caching),
+		FilterString: o.filter,
+		Reversed:     o.reversed,
+	}, nil
+}
+
+func runScan(cmd *command, s *session, args []string) error {
+	var o scanOptions
+	fs := cmd.flagSet()
+	o.flags(fs)
+	rest, err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	scan, err := o.scan()
+	if err != nil {
+		return err
+	}
+
+	id, err := s.client.ScannerOpenWithScan(rest[0], scan, nil)
+	if err != nil {
+		return err
+	}
+	defer s.client.ScannerClose(id)
+
+	t := newCellTable()
+	count := 0
+	for o.limit <= 0 || count < o.limit {
+		n := scan.Caching
+		if o.limit > 0 && o.limit-count < int(n) {
+			n = int32(o.limit - count)
+		}
+
+		results, err := s.client.ScannerGetList(id, n)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			break
+		}
+		addRowResults(t, results)
+		count += len(results)
+	}
+	return t.write(s.out, s.format)
+}
+
+func runIncr(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 3, 4)
+	if err != nil {
+		return err
+	}
+
+	data, err := unescapeAll(rest[1:3])
+	if err != nil {
+		return err
+	}
+
+	amount := int64(1)
+	if len(rest) == 4 {
+		if amount, err = strconv.ParseInt(rest[3], 10, 64); err != nil {
+			return usageError(fmt.Sprint("invalid amount:", rest[3]))
+		}
+	}
+
+	value, err := s.client.AtomicIncrement(rest[0], data[0], string(data[1]), amount)
+	if err != nil {
+		return err
+	}
+
+	t := newTable("ROW", "COLUMN", "VALUE")
+	t.add(escape(data[0]), escape(data[1]), strconv.FormatInt(value, 10))
+	return t.write(s.out, s.format)
+}
+
+func runRegions(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	regions, err := s.client.GetTableRegions(rest[0])
+	if err != nil {
+		return err
+	}
+
+	t := newTable("NAME", "STARTKEY", "ENDKEY", "SERVER", "PORT", "ID", "VERSION")
+	for _, region := range regions {
+		t.add(escape(region.Name),
+			escape(region.StartKey),
+			escape(region.EndKey),
+			region.ServerName,
+			strconv.Itoa(int(region.Port)),
+			strconv.FormatInt(region.Id, 10),
+			strconv.Itoa(int(region.Version)))
+	}
+	return t.write(s.out, s.format)
+}
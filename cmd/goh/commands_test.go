@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	cmd := findCommand("get")
+	fs := cmd.flagSet()
+	ts := fs.Int64("ts", 0, "")
+	rest, err := cmd.parse(fs, []string{"test", "-ts", "5", "row1", "cf:a"}, 2, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rest, []string{"test", "row1", "cf:a"}) || *ts != 5 {
+		t.Errorf("parse = %v, ts = %d", rest, *ts)
+	}
+
+	if _, err = cmd.parse(cmd.flagSet(), []string{"test"}, 2, -1); err == nil {
+		t.Error("parse with too few arguments should fail")
+	} else if _, ok := err.(usageError); !ok {
+		t.Errorf("parse error = %T, want usageError", err)
+	}
+
+	if _, err = cmd.parse(cmd.flagSet(), []string{"-x", "test", "row1"}, 2, -1); err == nil {
+		t.Error("parse with unknown flag should fail")
+	}
+}
+
+func TestParseFamily(t *testing.T) {
+	col, err := parseFamily("cf:versions=5,compression=gz,inmemory=true,bloom=row,ttl=60,blockcache=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if col.Name != "cf" || col.MaxVersions != 5 || col.Compression != "GZ" || !col.InMemory ||
+		col.BloomFilterType != "ROW" || col.TimeToLive != 60 || !col.BlockCacheEnabled {
+		t.Errorf("parseFamily = %+v", col)
+	}
+
+	if col, err = parseFamily("d"); err != nil || col.Name != "d" || col.MaxVersions != 3 {
+		t.Errorf("parseFamily(d) = %+v, %v", col, err)
+	}
+
+	for _, spec := range []string{":versions=1", "cf:versions", "cf:versions=x", "cf:color=red"} {
+		if _, err = parseFamily(spec); err == nil {
+			t.Errorf("parseFamily(%q) should fail", spec)
+		}
+	}
+}
+
+func TestScanOptions(t *testing.T) {
+	o := scanOptions{prefix: `ab\xFF`, columns: `cf:a,cf:\x00`, limit: 10, caching: 100, filter: "KeyOnlyFilter()"}
+	scan, err := o.scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(scan.StartRow, []byte("ab\xFF")) || !bytes.Equal(scan.StopRow, []byte("ac")) {
+		t.Errorf("scan rows = %q, %q", scan.StartRow, scan.StopRow)
+	}
+	if !reflect.DeepEqual(scan.Columns, []string{"cf:a", "cf:\x00"}) || scan.Caching != 10 || scan.FilterString != "KeyOnlyFilter()" {
+		t.Errorf("scan = %+v", scan)
+	}
+
+	for _, o := range []scanOptions{
+		{prefix: "a", start: "b", caching: 1},
+		{prefix: "a", reversed: true, caching: 1},
+		{start: `\x`, caching: 1},
+		{caching: 0},
+	} {
+		if _, err = o.scan(); err == nil {
+			t.Errorf("scan(%+v) should fail", o)
+		}
+	}
+}
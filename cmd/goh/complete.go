@@ -0,0 +1,200 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+/*
+shellCommands are the statements of the shell, the commands of goh plus the commands of the shell itself
+*/
+var shellCommands = []string{
+	"connect", "create", "delete", "deleteall", "describe", "disable", "drop", "enable", "exit", "format",
+	"get", "help", "history", "incr", "list", "protocol", "put", "quit", "regions", "scan", "status",
+	"truncate",
+}
+
+/*
+hashKeys are the keys of the options hash of a statement
+*/
+var hashKeys = map[string][]string{
+	"connect": {"FRAMED", "PROTOCOL", "TIMEOUT", "URL"},
+	"create":  {"BLOCKCACHE", "BLOOMFILTER", "COMPRESSION", "IN_MEMORY", "NAME", "TTL", "VERSIONS"},
+	"get":     {"COLUMN", "COLUMNS", "TIMESTAMP", "VERSIONS"},
+	"scan":    {"CACHE", "COLUMNS", "FILTER", "LIMIT", "REVERSED", "ROWPREFIXFILTER", "STARTROW", "STOPROW", "TIMESTAMP"},
+}
+
+/*
+columnArgs is the index of the first argument of a statement that is a column, the argument before is the row
+*/
+var columnArgs = map[string]int{
+	"get":       2,
+	"put":       2,
+	"delete":    2,
+	"deleteall": 2,
+	"incr":      2,
+}
+
+/*
+completer completes statements of the shell with table names and column families of the server
+*/
+type completer struct {
+	tables   func() []string
+	families func(table string) []string
+}
+
+/*
+complete returns the candidates to replace line[start:] with, line is the text before the cursor
+*/
+func (c *completer) complete(line string) (start int, candidates []string) {
+	tokens, err := tokenize(line)
+	start, partial, quote := len(line), "", ""
+	if n := len(tokens); err == errIncomplete {
+		// inside an open string, a complete name closes it
+		start = tokens[n-1].pos + 1
+		partial = line[start:]
+		quote = line[start-1 : start]
+		tokens = tokens[:n-1]
+	} else if n > 0 && tokens[n-1].kind == tokWord && tokens[n-1].pos+len(tokens[n-1].text) == len(line) {
+		start = tokens[n-1].pos
+		partial = tokens[n-1].text
+		tokens = tokens[:n-1]
+	}
+
+	if len(tokens) == 0 {
+		return start, filter(shellCommands, partial)
+	}
+	name := tokens[0].text
+	ctx := tokens[1:]
+
+	// find the argument, the hash key or the hash value the cursor is in
+	arg, table := 0, ""
+	var stack []int
+	key := ""
+	for i, t := range ctx {
+		switch t.kind {
+		case tokLBrace, tokLBracket:
+			stack = append(stack, t.kind)
+		case tokRBrace, tokRBracket:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case tokComma:
+			if len(stack) == 0 {
+				arg++
+				key = ""
+			} else if stack[len(stack)-1] == tokLBrace {
+				key = ""
+			}
+		case tokArrow:
+			if i > 0 && len(stack) > 0 && stack[len(stack)-1] == tokLBrace {
+				key = strings.ToUpper(ctx[i-1].text)
+			}
+		case tokWord, tokString:
+			if len(stack) == 0 && arg == 0 {
+				table = t.text
+			}
+		}
+	}
+
+	last := -1
+	if len(ctx) > 0 {
+		last = ctx[len(ctx)-1].kind
+	}
+	inHash := false
+	for _, kind := range stack {
+		inHash = inHash || kind == tokLBrace
+	}
+
+	switch {
+	case inHash && key == "" && (last == tokLBrace || last == tokComma):
+		return start, filter(hashKeys[name], strings.ToUpper(partial))
+	case inHash && (key == "COLUMN" || key == "COLUMNS"):
+		return start, c.columns(table, partial)
+	case inHash && key == "PROTOCOL":
+		return start, quoted(filter(protocolNames(), partial), quote)
+	case inHash:
+		return start, nil
+	case len(stack) > 0 && arg >= columnArgs[name] && columnArgs[name] > 0:
+		// a list of columns of get
+		return start, c.columns(table, partial)
+	case len(stack) > 0:
+		return start, nil
+	}
+
+	// a new argument starts after the command or after a comma
+	if len(ctx) > 0 && last != tokComma {
+		return start, nil
+	}
+	switch {
+	case name == "protocol" && arg == 0:
+		return start, quoted(filter(protocolNames(), partial), quote)
+	case name == "format" && arg == 0:
+		return start, quoted(filter([]string{FormatCSV, FormatJSON, FormatTable}, partial), quote)
+	case arg == 0 && (name == "describe" || name == "enable" || name == "disable" || name == "drop" ||
+		name == "truncate" || name == "regions" || name == "scan" || columnArgs[name] > 0):
+		return start, quoted(filter(c.tables(), partial), quote)
+	case columnArgs[name] > 0 && arg == columnArgs[name], name == "get" && arg > columnArgs[name]:
+		return start, c.columns(table, partial)
+	}
+	return start, nil
+}
+
+/*
+columns returns family: for each family of table
+*/
+func (c *completer) columns(table, partial string) []string {
+	if table == "" || strings.Contains(partial, ":") {
+		return nil
+	}
+
+	families := c.families(table)
+	data := make([]string, len(families))
+	for i, f := range families {
+		data[i] = f + ":"
+	}
+	return filter(data, partial)
+}
+
+func protocolNames() []string {
+	names := make([]string, 0, len(protocols))
+	for name := range protocols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func quoted(list []string, quote string) []string {
+	for i, s := range list {
+		list[i] = s + quote
+	}
+	return list
+}
+
+func filter(list []string, prefix string) []string {
+	var data []string
+	for _, s := range list {
+		if strings.HasPrefix(s, prefix) {
+			data = append(data, s)
+		}
+	}
+	return data
+}
+
+/*
+commonPrefix returns the longest prefix shared by list
+*/
+func commonPrefix(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+
+	prefix := list[0]
+	for _, s := range list[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestCompleter() *completer {
+	return &completer{
+		tables: func() []string { return []string{"test", "test2", "users"} },
+		families: func(table string) []string {
+			if table == "test" {
+				return []string{"cf", "meta"}
+			}
+			return nil
+		},
+	}
+}
+
+func TestComplete(t *testing.T) {
+	c := newTestCompleter()
+	cases := []struct {
+		line       string
+		start      int
+		candidates []string
+	}{
+		{"", 0, shellCommands},
+		{"de", 0, []string{"delete", "deleteall", "describe"}},
+		{"scan ", 5, []string{"test", "test2", "users"}},
+		{"scan 'te", 6, []string{"test'", "test2'"}},
+		{"scan u", 5, []string{"users"}},
+		{"create ", 7, nil},
+		{"get 'test', ", 12, nil},
+		{"get 'test', 'r', ", 17, []string{"cf:", "meta:"}},
+		{"get 'test', 'r', 'm", 18, []string{"meta:"}},
+		{"get 'test', 'r', 'cf:", 18, nil},
+		{"get 'test', 'r', 'cf:a', ", 25, []string{"cf:", "meta:"}},
+		{"put 'test', 'r', 'cf:a', ", 25, nil},
+		{"get 'test', 'r', ['cf:a', 'c", 27, []string{"cf:"}},
+		{"scan 'test', {", 14, hashKeys["scan"]},
+		{"scan 'test', {ST", 14, []string{"STARTROW", "STOPROW"}},
+		{"scan 'test', {LIMIT => 1, co", 26, []string{"COLUMNS"}},
+		{"scan 'test', {COLUMNS => ['", 27, []string{"cf:", "meta:"}},
+		{"scan 'test', {LIMIT => ", 23, nil},
+		{"connect 'h:1', {PROTOCOL => 'c", 29, []string{"compact'"}},
+		{"protocol ", 9, []string{"binary", "compact", "header", "json"}},
+		{"format 'j", 8, []string{"json'"}},
+	}
+
+	for _, tc := range cases {
+		start, candidates := c.complete(tc.line)
+		if start != tc.start || !reflect.DeepEqual(candidates, tc.candidates) {
+			t.Errorf("complete(%q) = %d %q, want %d %q", tc.line, start, candidates, tc.start, tc.candidates)
+		}
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	if p := commonPrefix([]string{"delete", "deleteall", "describe"}); p != "de" {
+		t.Errorf("commonPrefix = %q", p)
+	}
+	if p := commonPrefix([]string{"a", "b"}); p != "" {
+		t.Errorf("commonPrefix = %q", p)
+	}
+	if p := commonPrefix(nil); p != "" {
+		t.Errorf("commonPrefix = %q", p)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/sdming/goh"
+)
+
+func runCopy(cmd *command, s *session, args []string) error {
+	var o scanOptions
+	dest := *s.conf
+	fs := cmd.flagSet()
+	toAddr := fs.String("to", "", "address of the destination thrift server")
+	toURL := fs.String("to-url", "", "url of the destination thrift http server, used instead of -to")
+	fs.StringVar(&dest.protocol, "to-protocol", s.conf.protocol, "protocol of the destination server")
+	fs.BoolVar(&dest.framed, "to-framed", s.conf.framed, "use framed transport to the destination server")
+	fs.StringVar(&o.start, "start", "", "start row, inclusive")
+	fs.StringVar(&o.stop, "stop", "", "stop row, exclusive")
+	fs.StringVar(&o.prefix, "prefix", "", "only rows starting with prefix")
+	fs.IntVar(&o.caching, "caching", 100, "rows fetched per call")
+	families := fs.String("families", "", "comma separated families, all if empty")
+	startTime := fs.Int64("starttime", 0, "only versions at or after the timestamp")
+	endTime := fs.Int64("endtime", 0, "only versions before the timestamp, 0 for no limit")
+	versions := fs.Int("versions", 0, "versions of each column, 0 for the max versions of its family")
+	parallel := fs.Int("parallel", 4, "regions copied at once")
+	batch := fs.Int("batch", 100, "rows per batch")
+	rest, err := cmd.parse(fs, args, 1, 2)
+	if err != nil {
+		return err
+	}
+
+	if *toURL != "" {
+		dest.url = *toURL
+	} else if *toAddr != "" {
+		dest.addr, dest.url = *toAddr, ""
+	} else if len(rest) == 1 {
+		return usageError("copy: a copy to the same server needs a new name")
+	}
+	if *parallel <= 0 || *batch <= 0 {
+		return usageError("copy: -parallel and -batch have to be greater than 0")
+	}
+
+	scan, err := o.scan()
+	if err != nil {
+		return err
+	}
+	opts := &goh.CopyOptions{
+		StartRow:  scan.StartRow,
+		StopRow:   scan.StopRow,
+		StartTime: *startTime,
+		EndTime:   *endTime,
+		Versions:  int32(*versions),
+		Parallel:  *parallel,
+		BatchSize: *batch,
+		Caching:   scan.Caching,
+	}
+	if *families != "" {
+		opts.Families = strings.Split(*families, ",")
+	}
+	if len(rest) == 2 {
+		opts.NewName = rest[1]
+	}
+
+	var last time.Time
+	opts.Progress = func(p goh.CopyProgress) {
+		if time.Since(last) < time.Second {
+			return
+		}
+		last = time.Now()
+		fmt.Fprintf(s.errOut, "copied %d/%d region(s), %d row(s), %d cell(s)\n", p.Regions, p.TotalRegions, p.Rows, p.Cells)
+	}
+
+	client, err := dest.connect()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	p, err := s.client.CopyTable(ctx, rest[0], client, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(s.errOut, "copied %d region(s), %d row(s), %d cell(s) to %s\n", p.Regions, p.Rows, p.Cells, dest.target())
+	return nil
+}
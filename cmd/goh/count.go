@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sdming/goh"
+)
+
+func runCount(cmd *command, s *session, args []string) error {
+	var o scanOptions
+	fs := cmd.flagSet()
+	fs.StringVar(&o.start, "start", "", "start row, inclusive")
+	fs.StringVar(&o.stop, "stop", "", "stop row, exclusive")
+	fs.StringVar(&o.prefix, "prefix", "", "only rows starting with prefix")
+	fs.IntVar(&o.caching, "caching", 1000, "rows fetched per call")
+	parallel := fs.Int("parallel", 4, "regions counted at once")
+	byRegion := fs.Bool("regions", false, "print the rows of every region")
+	prefixLength := fs.Int("prefix-length", 0, "print the rows of every key prefix of n bytes")
+	rest, err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *parallel <= 0 || *prefixLength < 0 {
+		return usageError("count: -parallel has to be greater than 0 and -prefix-length can not be negative")
+	}
+
+	scan, err := o.scan()
+	if err != nil {
+		return err
+	}
+	opts := &goh.CountOptions{
+		StartRow:     scan.StartRow,
+		StopRow:      scan.StopRow,
+		Parallel:     *parallel,
+		Caching:      scan.Caching,
+		ByRegion:     *byRegion,
+		PrefixLength: *prefixLength,
+	}
+
+	var last time.Time
+	opts.Progress = func(p goh.CountProgress) {
+		if time.Since(last) < time.Second {
+			return
+		}
+		last = time.Now()
+		fmt.Fprintf(s.errOut, "counted %d/%d region(s), %d row(s)\n", p.Regions, p.TotalRegions, p.Rows)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	count, err := s.client.CountRows(ctx, rest[0], opts)
+	if err != nil {
+		return err
+	}
+
+	if *byRegion {
+		t := newTable("STARTKEY", "ENDKEY", "ROWS")
+		for _, r := range count.Regions {
+			t.add(escape(r.StartKey), escape(r.EndKey), strconv.FormatInt(r.Rows, 10))
+		}
+		if err = t.write(s.out, s.format); err != nil {
+			return err
+		}
+	}
+	if *prefixLength > 0 {
+		prefixes := make([]string, 0, len(count.Prefixes))
+		for prefix := range count.Prefixes {
+			prefixes = append(prefixes, prefix)
+		}
+		sort.Strings(prefixes)
+
+		t := newTable("PREFIX", "ROWS")
+		for _, prefix := range prefixes {
+			t.add(escape([]byte(prefix)), strconv.FormatInt(count.Prefixes[prefix], 10))
+		}
+		if err = t.write(s.out, s.format); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(s.out, count.Rows, "row(s)")
+	return err
+}
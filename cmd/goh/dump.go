@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sdming/goh"
+)
+
+/*
+reporter returns a progress function that prints at most once a second
+*/
+func reporter(w io.Writer, verb string) func(p goh.DumpProgress) {
+	var last time.Time
+	return func(p goh.DumpProgress) {
+		if time.Since(last) < time.Second {
+			return
+		}
+		last = time.Now()
+		fmt.Fprintf(w, "%s %d row(s), %d cell(s), last row %s\n", verb, p.Rows, p.Cells, escape(p.LastRow))
+	}
+}
+
+func runExport(cmd *command, s *session, args []string) error {
+	var o scanOptions
+	fs := cmd.flagSet()
+	fs.StringVar(&o.start, "start", "", "start row, inclusive")
+	fs.StringVar(&o.stop, "stop", "", "stop row, exclusive")
+	fs.StringVar(&o.prefix, "prefix", "", "only rows starting with prefix")
+	fs.StringVar(&o.filter, "filter", "", "filter string")
+	fs.StringVar(&o.columns, "columns", "", "comma separated columns or families")
+	fs.IntVar(&o.caching, "caching", 100, "rows fetched per call")
+	binaryFormat := fs.Bool("binary", false, "write the binary format with checksums instead of json lines")
+	versions := fs.Int("versions", 0, "versions of each column, 0 for the max versions of its family")
+	resume := fs.Bool("resume", false, "continue an interrupted export, the options are read from the file")
+	rest, err := cmd.parse(fs, args, 2, 2)
+	if err != nil {
+		return err
+	}
+	table, name := rest[0], rest[1]
+
+	scan, err := o.scan()
+	if err != nil {
+		return err
+	}
+	opts := &goh.ExportOptions{
+		StartRow:     scan.StartRow,
+		StopRow:      scan.StopRow,
+		Columns:      scan.Columns,
+		FilterString: scan.FilterString,
+		MaxVersions:  int32(*versions),
+		Caching:      scan.Caching,
+		Progress:     reporter(s.errOut, "exported"),
+	}
+
+	format := goh.DumpJSON
+	if *binaryFormat {
+		format = goh.DumpBinary
+	}
+
+	var w *goh.DumpWriter
+	if name == "-" {
+		if *resume {
+			return usageError("export: -resume needs a file")
+		}
+		if w, err = newExportWriter(s.client, table, s.out, format, opts); err != nil {
+			return err
+		}
+	} else {
+		f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if *resume && info.Size() > 0 {
+			if w, err = resumeExport(s, f, table, opts); err != nil || w == nil {
+				return err
+			}
+		} else {
+			if err = f.Truncate(0); err != nil {
+				return err
+			}
+			if w, err = newExportWriter(s.client, table, f, format, opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = s.client.Export(table, w, opts); err != nil {
+		w.Flush()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	p := w.Progress()
+	fmt.Fprintf(s.errOut, "exported %d row(s), %d cell(s)\n", p.Rows, p.Cells)
+	return nil
+}
+
+func newExportWriter(client *goh.HClient, table string, out io.Writer, format goh.DumpFormat, opts *goh.ExportOptions) (*goh.DumpWriter, error) {
+	header, err := client.ExportHeader(table, opts)
+	if err != nil {
+		return nil, err
+	}
+	return goh.NewDumpWriter(out, format, header)
+}
+
+/*
+resumeExport sets opts to the options of the dump in f and to start after its last row,
+the writer is nil if the dump is complete
+*/
+func resumeExport(s *session, f *os.File, table string, opts *goh.ExportOptions) (*goh.DumpWriter, error) {
+	w, header, last, complete, err := goh.ResumeDump(f)
+	if err != nil {
+		return nil, err
+	}
+	if header.Table != table {
+		return nil, usageError(fmt.Sprint("export: the file is an export of ", header.Table))
+	}
+	if complete {
+		fmt.Fprintln(s.errOut, "export is complete")
+		return nil, nil
+	}
+
+	opts.StartRow = header.StartRow
+	if last != nil {
+		opts.StartRow = append(last, 0)
+	}
+	opts.StopRow = header.StopRow
+	opts.Columns = header.Columns
+	opts.FilterString = header.FilterString
+	opts.MaxVersions = header.MaxVersions
+
+	p := w.Progress()
+	fmt.Fprintf(s.errOut, "resuming after %d row(s), last row %s\n", p.Rows, escape(last))
+	return w, nil
+}
+
+func runImport(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	batch := fs.Int("batch", 100, "rows per batch")
+	resume := fs.Bool("resume", false, "skip the rows of an interrupted import, read from file.import")
+	create := fs.Bool("create", false, "create the table with the families of the dump if it does not exist")
+	rest, err := cmd.parse(fs, args, 2, 2)
+	if err != nil {
+		return err
+	}
+	table, name := rest[0], rest[1]
+
+	if *batch <= 0 {
+		return usageError("import: -batch has to be greater than 0")
+	}
+
+	in := s.in
+	checkpoint := ""
+	if name == "-" {
+		if *resume {
+			return usageError("import: -resume needs a file")
+		}
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+		checkpoint = name + ".import"
+	}
+
+	var after []byte
+	if *resume {
+		data, err := os.ReadFile(checkpoint)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil {
+			if after, err = unescape(strings.TrimSpace(string(data))); err != nil {
+				return err
+			}
+			fmt.Fprintln(s.errOut, "resuming after row", escape(after))
+		}
+	}
+
+	r, err := goh.NewDumpReader(in)
+	if err != nil {
+		return err
+	}
+
+	// the checkpoint holds the last row written, a resumed import skips up to it
+	var checkpointErr error
+	report := reporter(s.errOut, "imported")
+	opts := &goh.ImportOptions{
+		BatchSize:   *batch,
+		After:       after,
+		CreateTable: *create,
+		Progress: func(p goh.DumpProgress) {
+			if checkpoint != "" && checkpointErr == nil {
+				checkpointErr = os.WriteFile(checkpoint, []byte(escape(p.LastRow)+"\n"), 0644)
+			}
+			report(p)
+		},
+	}
+
+	p, err := s.client.Import(table, r, opts)
+	if err != nil {
+		return err
+	}
+	if checkpointErr != nil {
+		return checkpointErr
+	}
+	if checkpoint != "" {
+		if err = os.Remove(checkpoint); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	fmt.Fprintf(s.errOut, "imported %d row(s), %d cell(s)\n", p.Rows, p.Cells)
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+const hexDigits = "0123456789ABCDEF"
+
+/*
+escape returns b as printable text, bytes outside of printable ascii and the backslash
+are written as \xNN like Bytes.toStringBinary of hbase, so binary keys and values survive
+a round trip through unescape
+*/
+func escape(b []byte) string {
+	var buf bytes.Buffer
+	for _, c := range b {
+		if c >= ' ' && c <= '~' && c != '\\' {
+			buf.WriteByte(c)
+			continue
+		}
+		buf.WriteString(`\x`)
+		buf.WriteByte(hexDigits[c>>4])
+		buf.WriteByte(hexDigits[c&0x0F])
+	}
+	return buf.String()
+}
+
+/*
+unescape parses text written by escape, \xNN is a byte and \\ is a backslash
+*/
+func unescape(s string) ([]byte, error) {
+	buf := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			buf = append(buf, c)
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == '\\' {
+			buf = append(buf, '\\')
+			i++
+			continue
+		}
+
+		if i+3 >= len(s) || s[i+1] != 'x' {
+			return nil, errors.New(fmt.Sprint("invalid escape at ", i, " in ", s))
+		}
+		hi, ok1 := unhex(s[i+2])
+		lo, ok2 := unhex(s[i+3])
+		if !ok1 || !ok2 {
+			return nil, errors.New(fmt.Sprint("invalid escape at ", i, " in ", s))
+		}
+		buf = append(buf, hi<<4|lo)
+		i += 3
+	}
+	return buf, nil
+}
+
+func unhex(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+/*
+prefixEnd returns the smallest key greater than every key starting with prefix,
+nil if there is none
+*/
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] != 0xFF {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
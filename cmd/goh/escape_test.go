@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEscape(t *testing.T) {
+	cases := []struct {
+		raw     []byte
+		escaped string
+	}{
+		{[]byte("row1"), "row1"},
+		{[]byte("a b:c"), "a b:c"},
+		{[]byte{0, 1, 0xFF}, `\x00\x01\xFF`},
+		{[]byte(`a\b`), `a\x5Cb`},
+		{[]byte("é"), `\xC3\xA9`},
+		{nil, ""},
+	}
+
+	for _, c := range cases {
+		if got := escape(c.raw); got != c.escaped {
+			t.Errorf("escape(%v) = %q, want %q", c.raw, got, c.escaped)
+		}
+		got, err := unescape(c.escaped)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, c.raw) {
+			t.Errorf("unescape(%q) = %v, want %v", c.escaped, got, c.raw)
+		}
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	got, err := unescape(`a\\b\x0a\xfF`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte("a\\b\n\xFF"); !bytes.Equal(got, want) {
+		t.Errorf("unescape = %v, want %v", got, want)
+	}
+
+	for _, s := range []string{`\`, `\x`, `\x1`, `\xZZ`, `\n`} {
+		if _, err := unescape(s); err == nil {
+			t.Errorf("unescape(%q) should fail", s)
+		}
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	cases := []struct {
+		prefix []byte
+		end    []byte
+	}{
+		{[]byte("abc"), []byte("abd")},
+		{[]byte{'a', 0xFF}, []byte("b")},
+		{[]byte{0xFF, 0xFF}, nil},
+		{[]byte{}, nil},
+	}
+
+	for _, c := range cases {
+		if got := prefixEnd(c.prefix); !bytes.Equal(got, c.end) {
+			t.Errorf("prefixEnd(%v) = %v, want %v", c.prefix, got, c.end)
+		}
+	}
+}
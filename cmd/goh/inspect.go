@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sdming/goh"
+)
+
+func runInspect(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	sample := fs.Int("sample", 100, "rows read from the start of every region to estimate its size")
+	regionRatio := fs.Float64("region-ratio", 4, "flag regions larger than ratio times the mean of their neighbours")
+	serverRatio := fs.Float64("server-ratio", 1.5, "flag servers hosting more than ratio times the mean regions per server")
+	rest, err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *sample <= 0 || *regionRatio <= 0 || *serverRatio <= 0 {
+		return usageError("inspect: -sample, -region-ratio and -server-ratio have to be greater than 0")
+	}
+
+	opts := &goh.InspectOptions{SampleRows: int32(*sample), RegionRatio: *regionRatio, ServerRatio: *serverRatio}
+	report, err := s.client.InspectTable(context.Background(), rest[0], opts)
+	if err != nil {
+		return err
+	}
+
+	if s.format == FormatJSON {
+		data, err := report.JSON()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(s.out, string(data))
+		return err
+	}
+
+	regions := newTable("STARTKEY", "ENDKEY", "SERVER", "SAMPLED", "BYTES", "FLAG")
+	for _, r := range report.Regions {
+		size := strconv.FormatInt(r.EstimatedBytes, 10)
+		if !r.Exact {
+			size = "~" + size
+		}
+		regions.add(r.StartKey, r.EndKey, r.Server, strconv.Itoa(r.SampledRows), size, mark(r.Oversized, "oversized"))
+	}
+	if err = regions.write(s.out, s.format); err != nil {
+		return err
+	}
+	fmt.Fprintln(s.out)
+
+	servers := newTable("SERVER", "REGIONS", "BYTES", "FLAG")
+	for _, server := range report.Servers {
+		servers.add(server.Server, strconv.Itoa(server.Regions), strconv.FormatInt(server.EstimatedBytes, 10), mark(server.Overloaded, "overloaded"))
+	}
+	return servers.write(s.out, s.format)
+}
+
+func mark(set bool, name string) string {
+	if set {
+		return name
+	}
+	return ""
+}
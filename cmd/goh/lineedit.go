@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+errInterrupt is returned by readLine when ctrl-c is pressed
+*/
+var errInterrupt = errors.New("interrupt")
+
+/*
+lineEditor reads a line from a terminal in raw mode, with cursor movement, history and tab completion
+*/
+type lineEditor struct {
+	in       *bufio.Reader
+	out      io.Writer
+	complete func(line string) (start int, candidates []string)
+}
+
+func newLineEditor(in io.Reader, out io.Writer, complete func(line string) (int, []string)) *lineEditor {
+	return &lineEditor{in: bufio.NewReader(in), out: out, complete: complete}
+}
+
+/*
+editState is the line being edited
+*/
+type editState struct {
+	prompt  string
+	buf     []rune
+	pos     int
+	history []string
+	index   int    // index in history, len(history) is the new line
+	saved   string // the new line while browsing history
+}
+
+func (s *editState) set(line string) {
+	s.buf = []rune(line)
+	s.pos = len(s.buf)
+}
+
+func (s *editState) insert(r ...rune) {
+	buf := make([]rune, 0, len(s.buf)+len(r))
+	buf = append(buf, s.buf[:s.pos]...)
+	buf = append(buf, r...)
+	s.buf = append(buf, s.buf[s.pos:]...)
+	s.pos += len(r)
+}
+
+func (s *editState) remove(from, to int) {
+	s.buf = append(s.buf[:from], s.buf[to:]...)
+	s.pos = from
+}
+
+func (s *editState) move(i int) {
+	if i >= 0 && i < len(s.history)+1 && i != s.index {
+		if s.index == len(s.history) {
+			s.saved = string(s.buf)
+		}
+		s.index = i
+		if i == len(s.history) {
+			s.set(s.saved)
+		} else {
+			s.set(s.history[i])
+		}
+	}
+}
+
+/*
+readLine prints prompt and reads a line, it returns io.EOF for ctrl-d on an empty line
+and errInterrupt for ctrl-c
+*/
+func (e *lineEditor) readLine(prompt string, history []string) (string, error) {
+	s := &editState{prompt: prompt, history: history, index: len(history)}
+	fmt.Fprint(e.out, prompt)
+
+	tabs := 0
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		if r == '\t' {
+			tabs++
+		} else {
+			tabs = 0
+		}
+
+		switch r {
+		case '\r', '\n':
+			fmt.Fprint(e.out, "\r\n")
+			return string(s.buf), nil
+		case 3: // ctrl-c
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupt
+		case 4: // ctrl-d
+			if len(s.buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			if s.pos < len(s.buf) {
+				s.remove(s.pos, s.pos+1)
+			}
+		case 127, 8: // backspace
+			if s.pos > 0 {
+				s.remove(s.pos-1, s.pos)
+			}
+		case 1: // ctrl-a
+			s.pos = 0
+		case 5: // ctrl-e
+			s.pos = len(s.buf)
+		case 2: // ctrl-b
+			if s.pos > 0 {
+				s.pos--
+			}
+		case 6: // ctrl-f
+			if s.pos < len(s.buf) {
+				s.pos++
+			}
+		case 11: // ctrl-k
+			s.buf = s.buf[:s.pos]
+		case 21: // ctrl-u
+			s.remove(0, s.pos)
+		case 23: // ctrl-w
+			i := s.pos
+			for i > 0 && s.buf[i-1] == ' ' {
+				i--
+			}
+			for i > 0 && s.buf[i-1] != ' ' {
+				i--
+			}
+			s.remove(i, s.pos)
+		case 12: // ctrl-l
+			fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+		case 16: // ctrl-p
+			s.move(s.index - 1)
+		case 14: // ctrl-n
+			s.move(s.index + 1)
+		case '\t':
+			e.completeLine(s, tabs > 1)
+		case 27:
+			if err = e.escape(s); err != nil {
+				return "", err
+			}
+		default:
+			if r >= ' ' {
+				s.insert(r)
+			}
+		}
+		e.refresh(s)
+	}
+}
+
+/*
+escape handles the escape sequences of arrow, home, end and delete keys
+*/
+func (e *lineEditor) escape(s *editState) error {
+	r, _, err := e.in.ReadRune()
+	if err != nil || (r != '[' && r != 'O') {
+		return err
+	}
+
+	var seq []rune
+	for {
+		if r, _, err = e.in.ReadRune(); err != nil {
+			return err
+		}
+		seq = append(seq, r)
+		if r < '0' || r > '9' {
+			break
+		}
+	}
+
+	switch string(seq) {
+	case "A":
+		s.move(s.index - 1)
+	case "B":
+		s.move(s.index + 1)
+	case "C":
+		if s.pos < len(s.buf) {
+			s.pos++
+		}
+	case "D":
+		if s.pos > 0 {
+			s.pos--
+		}
+	case "H", "1~", "7~":
+		s.pos = 0
+	case "F", "4~", "8~":
+		s.pos = len(s.buf)
+	case "3~":
+		if s.pos < len(s.buf) {
+			s.remove(s.pos, s.pos+1)
+		}
+	}
+	return nil
+}
+
+/*
+completeLine completes the word before the cursor, a second tab lists the candidates
+*/
+func (e *lineEditor) completeLine(s *editState, list bool) {
+	if e.complete == nil {
+		return
+	}
+
+	line := string(s.buf[:s.pos])
+	start, candidates := e.complete(line)
+	if len(candidates) == 0 {
+		fmt.Fprint(e.out, "\a")
+		return
+	}
+
+	from := utf8.RuneCountInString(line[:start])
+	partial := line[start:]
+	prefix := commonPrefix(candidates)
+	if len(prefix) > len(partial) {
+		s.remove(from, s.pos)
+		s.insert([]rune(prefix)...)
+		return
+	}
+
+	if len(candidates) > 1 && list {
+		fmt.Fprint(e.out, "\r\n", strings.Join(candidates, "  "), "\r\n")
+	} else if len(candidates) > 1 {
+		fmt.Fprint(e.out, "\a")
+	}
+}
+
+/*
+refresh redraws the prompt and the line and puts the cursor at pos
+*/
+func (e *lineEditor) refresh(s *editState) {
+	fmt.Fprint(e.out, "\r", s.prompt, string(s.buf), "\x1b[K")
+	if n := len(s.buf) - s.pos; n > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", n)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func readTestLine(t *testing.T, input string, history []string) (string, error) {
+	e := newLineEditor(strings.NewReader(input), &bytes.Buffer{}, newTestCompleter().complete)
+	return e.readLine("> ", history)
+}
+
+func TestLineEditor(t *testing.T) {
+	cases := []struct {
+		input string
+		line  string
+	}{
+		{"list\r", "list"},
+		{"lsit\x7f\x7f\x7fist\r", "list"},
+		{"ist\x01l\x05 'x'\r", "list 'x'"},
+		{"lst\x1b[D\x1b[Di\r", "list"},
+		{"scan 'a' 'b'\x17\x17list\r", "scan list"},
+		{"junk\x15list\r", "list"},
+		{"list 'x'\x01\x1b[C\x1b[C\x1b[C\x1b[C\x0b\r", "list"},
+		{"xlist\x1b[H\x1b[3~\r", "list"},
+		{"sc\t 'te\t\t2\t\r", "scan 'test2'"},
+		{"get 'test', 'r', 'm\tx'\r", "get 'test', 'r', 'meta:x'"},
+		{"\x1b[A\r", "scan 'test'"},
+		{"\x1b[A\x1b[A\r", "list"},
+		{"\x1b[A\x1b[A\x1b[B\r", "scan 'test'"},
+		{"new\x1b[A\x1b[B\r", "new"},
+		{"\x10\x10\x10\x0e\r", "scan 'test'"},
+		{"café\x7fé\r", "café"},
+	}
+
+	history := []string{"list", "scan 'test'"}
+	for _, c := range cases {
+		line, err := readTestLine(t, c.input, history)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line != c.line {
+			t.Errorf("readLine(%q) = %q, want %q", c.input, line, c.line)
+		}
+	}
+}
+
+func TestLineEditorInterrupt(t *testing.T) {
+	if _, err := readTestLine(t, "scan\x03", nil); err != errInterrupt {
+		t.Errorf("ctrl-c = %v, want errInterrupt", err)
+	}
+	if _, err := readTestLine(t, "\x04", nil); err != io.EOF {
+		t.Errorf("ctrl-d = %v, want io.EOF", err)
+	}
+	if line, err := readTestLine(t, "lists\x01\x04\r", nil); err != nil || line != "ists" {
+		t.Errorf("ctrl-d on a line = %q, %v", line, err)
+	}
+	if _, err := readTestLine(t, "list", nil); err != io.EOF {
+		t.Errorf("end of input = %v, want io.EOF", err)
+	}
+}
+
+func TestLineEditorListCandidates(t *testing.T) {
+	var out bytes.Buffer
+	e := newLineEditor(strings.NewReader("d\t\t\r"), &out, newTestCompleter().complete)
+	if _, err := e.readLine("> ", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "delete  deleteall  describe  disable  drop") {
+		t.Errorf("output = %q", out.String())
+	}
+}
@@ -0,0 +1,193 @@
+/*
+goh is a command line client of hbase (via thrift)
+
+	goh [flags] command [command flags] args
+
+Connection flags default to the environment variables GOH_ADDR, GOH_URL, GOH_PROTOCOL,
+GOH_FRAMED, GOH_TIMEOUT and GOH_FORMAT. Row keys, columns and values are escaped as \xNN
+when they are not printable, and arguments are parsed the same way.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/sdming/goh"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+config is the connection and output settings
+*/
+type config struct {
+	addr     string
+	url      string
+	protocol string
+	framed   bool
+	timeout  time.Duration
+	format   string
+}
+
+/*
+session is what commands run against
+*/
+type session struct {
+	client *goh.HClient
+	conf   *config
+	in     io.Reader
+	out    io.Writer
+	errOut io.Writer
+	format string
+}
+
+var protocols = map[string]int{
+	"binary":  goh.TBinaryProtocol,
+	"compact": goh.TCompactProtocol,
+	"json":    goh.TJSONProtocol,
+	"header":  goh.THeaderProtocol,
+}
+
+func parseProtocol(name string) (int, error) {
+	if p, ok := protocols[strings.ToLower(name)]; ok {
+		return p, nil
+	}
+	return 0, errors.New(fmt.Sprint("invalid protocol:", name))
+}
+
+func getenv(key, value string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return value
+}
+
+/*
+flags registers the connection flags on fs, defaults come from the environment
+*/
+func (c *config) flags(fs *flag.FlagSet) error {
+	framed, err := strconv.ParseBool(getenv("GOH_FRAMED", "false"))
+	if err != nil {
+		return errors.New(fmt.Sprint("invalid GOH_FRAMED:", err))
+	}
+	timeout, err := time.ParseDuration(getenv("GOH_TIMEOUT", "0s"))
+	if err != nil {
+		return errors.New(fmt.Sprint("invalid GOH_TIMEOUT:", err))
+	}
+
+	fs.StringVar(&c.addr, "addr", getenv("GOH_ADDR", "localhost:9090"), "address of the thrift server (GOH_ADDR)")
+	fs.StringVar(&c.url, "url", getenv("GOH_URL", ""), "url of a thrift http server, used instead of -addr (GOH_URL)")
+	fs.StringVar(&c.protocol, "protocol", getenv("GOH_PROTOCOL", "binary"), "binary, compact, json or header (GOH_PROTOCOL)")
+	fs.BoolVar(&c.framed, "framed", framed, "use framed transport (GOH_FRAMED)")
+	fs.DurationVar(&c.timeout, "timeout", timeout, "read and write timeout, 0 for none (GOH_TIMEOUT)")
+	fs.StringVar(&c.format, "format", getenv("GOH_FORMAT", FormatTable), "output format: table, json or csv (GOH_FORMAT)")
+	return nil
+}
+
+/*
+target is the url or the address of the server
+*/
+func (c *config) target() string {
+	if c.url != "" {
+		return c.url
+	}
+	return c.addr
+}
+
+/*
+connect opens a client for c
+*/
+func (c *config) connect() (*goh.HClient, error) {
+	protocol, err := parseProtocol(c.protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	var client *goh.HClient
+	if c.url != "" {
+		client, err = goh.NewHttpClient(c.url, protocol)
+	} else {
+		client, err = goh.NewTcpClientTimeout(c.addr, protocol, c.framed, c.timeout)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = client.Open(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(w, "usage: goh [flags] command [command flags] args")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintln(w, " ", cmd.name, cmd.args)
+		fmt.Fprintln(w, "     ", cmd.desc)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "flags:")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var c config
+	fs := flag.NewFlagSet("goh", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr, fs) }
+	if err := c.flags(fs); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	if fs.NArg() == 0 {
+		usage(stderr, fs)
+		return 2
+	}
+
+	cmd := findCommand(fs.Arg(0))
+	if cmd == nil {
+		fmt.Fprintln(stderr, "unknown command:", fs.Arg(0))
+		usage(stderr, fs)
+		return 2
+	}
+
+	switch c.format {
+	case FormatTable, FormatJSON, FormatCSV:
+	default:
+		fmt.Fprintln(stderr, "invalid format:", c.format)
+		return 2
+	}
+
+	client, err := c.connect()
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	// the shell may switch to another client
+	s := &session{client: client, conf: &c, in: stdin, out: stdout, errOut: stderr, format: c.format}
+	defer func() { s.client.Close() }()
+	if err = cmd.exec(s, fs.Args()[1:]); err != nil {
+		fmt.Fprintln(stderr, err)
+		if _, ok := err.(usageError); ok {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+/*
+Output format
+*/
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatCSV   = "csv"
+)
+
+/*
+table is the result of a command, every cell is printable text
+*/
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+/*
+write prints t to w as an aligned table, a json array of objects or csv
+*/
+func (t *table) write(w io.Writer, format string) error {
+	switch format {
+	case FormatTable:
+		return t.writeTable(w)
+	case FormatJSON:
+		return t.writeJSON(w)
+	case FormatCSV:
+		return t.writeCSV(w)
+	}
+	return errors.New(fmt.Sprint("invalid format:", format))
+}
+
+func (t *table) writeTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d row(s)\n", len(t.rows))
+	return err
+}
+
+// writeJSON keeps the order of the header in every object, a map would sort the keys,
+// keys are the header in lower case
+func (t *table) writeJSON(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("[")
+	for i, row := range t.rows {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  {")
+		for j, name := range t.header {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			key, _ := json.Marshal(strings.ToLower(name))
+			value, _ := json.Marshal(row[j])
+			b.Write(key)
+			b.WriteString(": ")
+			b.Write(value)
+		}
+		b.WriteString("}")
+	}
+	if len(t.rows) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (t *table) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(t.header)
+	cw.WriteAll(t.rows)
+	return cw.Error()
+}
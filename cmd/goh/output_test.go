@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newTestTable() *table {
+	t := newTable("ROW", "VALUE")
+	t.add("a", "1")
+	t.add(`b\x00`, `say "hi", bye`)
+	return t
+}
+
+func TestWriteTable(t *testing.T) {
+	var b bytes.Buffer
+	if err := newTestTable().write(&b, FormatTable); err != nil {
+		t.Fatal(err)
+	}
+	want := "ROW    VALUE\n" +
+		"a      1\n" +
+		"b\\x00  say \"hi\", bye\n" +
+		"2 row(s)\n"
+	if b.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	if err := newTestTable().write(&b, FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	want := "[\n" +
+		"  {\"row\": \"a\", \"value\": \"1\"},\n" +
+		"  {\"row\": \"b\\\\x00\", \"value\": \"say \\\"hi\\\", bye\"}\n" +
+		"]\n"
+	if b.String() != want {
+		t.Errorf("json =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	if err := newTable("ROW").write(&b, FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "[]\n" {
+		t.Errorf("empty json = %q", b.String())
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var b bytes.Buffer
+	if err := newTestTable().write(&b, FormatCSV); err != nil {
+		t.Fatal(err)
+	}
+	want := "ROW,VALUE\n" +
+		"a,1\n" +
+		"b\\x00,\"say \"\"hi\"\", bye\"\n"
+	if b.String() != want {
+		t.Errorf("csv =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	if err := newTestTable().write(&b, "xml"); err == nil {
+		t.Error("write xml should fail")
+	}
+}
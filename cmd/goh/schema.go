@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sdming/goh"
+)
+
+func runSchema(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	asJSON := fs.Bool("json", false, "write json instead of yaml")
+	rest, err := cmd.parse(fs, args, 0, -1)
+	if err != nil {
+		return err
+	}
+
+	schema, err := s.client.GetSchema(rest...)
+	if err != nil {
+		return err
+	}
+	if !*asJSON {
+		_, err = s.out.Write(schema.YAML())
+		return err
+	}
+
+	data, err := schema.JSON()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(s.out, string(data))
+	return err
+}
+
+/*
+readSchema parses a schema file, - for stdin
+*/
+func readSchema(s *session, name string) (*goh.Schema, error) {
+	var data []byte
+	var err error
+	if name == "-" {
+		data, err = io.ReadAll(s.in)
+	} else {
+		data, err = os.ReadFile(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return goh.ParseSchema(data)
+}
+
+func changeTable(changes []*goh.SchemaChange) *table {
+	t := newTable("CHANGE", "TABLE", "FAMILY", "SETTING", "OLD", "NEW")
+	for _, c := range changes {
+		t.add(c.Kind.String(), escape([]byte(c.Table)), escape([]byte(c.Family)), c.Setting, c.Old, c.New)
+	}
+	return t
+}
+
+func runPlan(cmd *command, s *session, args []string) error {
+	rest, err := cmd.parse(cmd.flagSet(), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	schema, err := readSchema(s, rest[0])
+	if err != nil {
+		return err
+	}
+	plan, err := s.client.PlanSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	if err = changeTable(plan.Changes).write(s.out, s.format); err != nil {
+		return err
+	}
+	if plan.Destructive() {
+		fmt.Fprintln(s.errOut, "the families of some tables differ, thrift can not alter them, apply -recreate drops and creates them again and their data is lost")
+	}
+	return nil
+}
+
+func runApply(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	recreate := fs.Bool("recreate", false, "drop and create again tables whose families differ, their data is lost")
+	rest, err := cmd.parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	schema, err := readSchema(s, rest[0])
+	if err != nil {
+		return err
+	}
+	plan, err := s.client.PlanSchema(schema)
+	if err != nil {
+		return err
+	}
+	if plan.Empty() {
+		fmt.Fprintln(s.errOut, "nothing to change")
+		return nil
+	}
+
+	applied, err := s.client.ApplySchema(plan, &goh.ApplyOptions{Recreate: *recreate})
+	if e, ok := err.(*goh.HbaseError); ok && e.Err == goh.ErrDestructiveChange {
+		changeTable(plan.Changes).write(s.out, s.format)
+		return usageError("apply: the plan drops tables, run apply -recreate to drop and create them again")
+	}
+	if werr := changeTable(applied).write(s.out, s.format); err == nil {
+		err = werr
+	}
+	return err
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxHistory = 1000
+
+const shellHelp = `statements use the syntax of the hbase shell, strings are quoted with ' or " and
+bytes that are not printable are written as \xNN
+
+  list
+  describe 't'
+  create 't', 'cf1', {NAME => 'cf2', VERSIONS => 5, COMPRESSION => 'GZ'}
+  enable 't'
+  disable 't'
+  drop 't'
+  truncate 't'
+  get 't', 'row', 'cf:a', 'cf:b'
+  get 't', 'row', {COLUMNS => ['cf:a'], TIMESTAMP => 1362555589004, VERSIONS => 3}
+  put 't', 'row', 'cf:a', 'value'[, timestamp]
+  delete 't', 'row', 'cf:a'[, timestamp]
+  deleteall 't', 'row'[, 'cf:a'][, timestamp]
+  scan 't', {STARTROW => 'a', STOPROW => 'b', ROWPREFIXFILTER => 'p', COLUMNS => ['cf'],
+    FILTER => "ValueFilter(=, 'binary:x')", LIMIT => 10, CACHE => 100, TIMESTAMP => 1362555589004, REVERSED => true}
+  incr 't', 'row', 'cf:counter'[, amount]
+  regions 't'
+
+  connect 'host:port'[, {PROTOCOL => 'compact', FRAMED => true, TIMEOUT => '5s'}]
+  connect {URL => 'http://host:port/'}
+  protocol 'compact'
+  format 'json'
+  status
+  history
+  exit
+
+a statement continues on the next line while a string, a list or a hash is open,
+after a trailing comma or after a trailing \, tab completes commands, tables and families`
+
+/*
+shell reads statements of the hbase shell syntax and runs them against one client
+*/
+type shell struct {
+	s           *session
+	editor      *lineEditor
+	fd          int
+	plain       *bufio.Reader
+	history     []string
+	historyFile string
+	tables      []string
+	families    map[string][]string
+}
+
+func runShell(cmd *command, s *session, args []string) error {
+	fs := cmd.flagSet()
+	historyFile := fs.String("history", defaultHistoryFile(), "history file, empty for none")
+	if _, err := cmd.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	sh := &shell{s: s, historyFile: *historyFile}
+	sh.loadHistory()
+
+	in, ok := s.in.(*os.File)
+	out, ok2 := s.out.(*os.File)
+	if ok && ok2 && isTerminal(int(in.Fd())) && isTerminal(int(out.Fd())) {
+		sh.fd = int(in.Fd())
+		c := &completer{tables: sh.tableNames, families: sh.familyNames}
+		sh.editor = newLineEditor(in, out, c.complete)
+		fmt.Fprintln(s.out, "goh shell, connected to", s.conf.target()+`, type "help" for help`)
+	} else {
+		sh.plain = bufio.NewReader(s.in)
+	}
+
+	return sh.loop()
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".goh_history")
+}
+
+func (sh *shell) loop() error {
+	for {
+		text, err := sh.readStatement()
+		if err == io.EOF {
+			return nil
+		} else if err == errInterrupt {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		sh.addHistory(text)
+
+		start := time.Now()
+		quit, err := sh.exec(text)
+		if quit {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(sh.s.errOut, "ERROR:", err)
+		}
+		fmt.Fprintf(sh.s.errOut, "took %.4f seconds\n", time.Since(start).Seconds())
+	}
+}
+
+func (sh *shell) prompt(more bool) string {
+	if more {
+		return "goh(" + sh.s.conf.target() + ")* "
+	}
+	return "goh(" + sh.s.conf.target() + ")> "
+}
+
+func (sh *shell) readLine(prompt string) (string, error) {
+	if sh.editor == nil {
+		line, err := sh.plain.ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+		return strings.TrimRight(line, "\r\n"), err
+	}
+
+	restore, err := makeRaw(sh.fd)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+	return sh.editor.readLine(prompt, sh.history)
+}
+
+/*
+readStatement reads lines until the statement is complete
+*/
+func (sh *shell) readStatement() (string, error) {
+	var lines []string
+	for {
+		line, err := sh.readLine(sh.prompt(len(lines) > 0))
+		if err == io.EOF && len(lines) > 0 {
+			return strings.Join(lines, "\n"), nil
+		} else if err != nil {
+			return "", err
+		}
+
+		more := strings.HasSuffix(line, `\`)
+		if more {
+			line = line[:len(line)-1]
+		}
+		lines = append(lines, line)
+		text := strings.Join(lines, "\n")
+		if _, err = parseStatement(text); more || err == errIncomplete {
+			continue
+		}
+		return text, nil
+	}
+}
+
+/*
+exec runs a statement, it returns true if the shell should exit
+*/
+func (sh *shell) exec(text string) (bool, error) {
+	st, err := parseStatement(text)
+	if err != nil || st == nil {
+		return false, err
+	}
+
+	switch st.name {
+	case "exit", "quit":
+		return true, nil
+	case "help":
+		fmt.Fprintln(sh.s.out, shellHelp)
+		return false, nil
+	case "history":
+		for i, h := range sh.history {
+			fmt.Fprintf(sh.s.out, "%5d  %s\n", i+1, h)
+		}
+		return false, nil
+	case "status":
+		c := sh.s.conf
+		fmt.Fprintln(sh.s.out, "server:", c.target(), "protocol:", c.protocol, "framed:", c.framed,
+			"timeout:", c.timeout, "format:", sh.s.format, "state:", sh.s.client.State())
+		return false, nil
+	case "connect":
+		return false, sh.connect(st)
+	case "protocol":
+		if err = checkArgs(st, 1, 1); err != nil {
+			return false, err
+		}
+		c := *sh.s.conf
+		if c.protocol, err = toText(st.args[0]); err != nil {
+			return false, err
+		}
+		return false, sh.reconnect(&c)
+	case "format":
+		if err = checkArgs(st, 1, 1); err != nil {
+			return false, err
+		}
+		format, err := toText(st.args[0])
+		if err != nil {
+			return false, err
+		}
+		if format != FormatTable && format != FormatJSON && format != FormatCSV {
+			return false, errors.New(fmt.Sprint("invalid format:", format))
+		}
+		sh.s.format = format
+		return false, nil
+	}
+
+	name, args, err := translate(st)
+	if err != nil {
+		return false, err
+	}
+
+	if name == "create" || name == "drop" {
+		sh.tables = nil
+		sh.families = nil
+	}
+	return false, findCommand(name).exec(sh.s, args)
+}
+
+/*
+connect handles connect 'host:port' or 'http://url', followed by an optional hash of
+PROTOCOL, FRAMED, TIMEOUT and URL
+*/
+func (sh *shell) connect(st *statement) error {
+	if err := checkArgs(st, 1, 2); err != nil {
+		return err
+	}
+
+	c := *sh.s.conf
+	args := st.args
+	if target, ok := args[0].(string); ok {
+		if strings.Contains(target, "://") {
+			c.url = target
+		} else {
+			c.addr, c.url = target, ""
+		}
+		args = args[1:]
+	}
+
+	if len(args) == 1 {
+		hash, ok := args[0].(shellHash)
+		if !ok {
+			return errors.New("connect: options have to be a hash")
+		}
+		for _, p := range hash {
+			v, err := toText(p.value)
+			if err != nil {
+				return errors.New(fmt.Sprint("connect: ", p.key, " ", err))
+			}
+			switch p.key {
+			case "URL":
+				c.url = v
+			case "PROTOCOL":
+				c.protocol = v
+			case "FRAMED":
+				if c.framed, err = strconv.ParseBool(v); err != nil {
+					return errors.New(fmt.Sprint("connect: FRAMED ", err))
+				}
+			case "TIMEOUT":
+				if c.timeout, err = time.ParseDuration(v); err != nil {
+					return errors.New(fmt.Sprint("connect: TIMEOUT ", err))
+				}
+			default:
+				return errors.New(fmt.Sprint("connect: unknown option ", p.key))
+			}
+		}
+	}
+	return sh.reconnect(&c)
+}
+
+/*
+reconnect opens a client for c and closes the current one, the current one is kept if that fails
+*/
+func (sh *shell) reconnect(c *config) error {
+	client, err := c.connect()
+	if err != nil {
+		return err
+	}
+
+	sh.s.client.Close()
+	sh.s.client = client
+	*sh.s.conf = *c
+	sh.tables = nil
+	sh.families = nil
+	fmt.Fprintln(sh.s.out, "connected to", c.target(), "protocol:", c.protocol)
+	return nil
+}
+
+func (sh *shell) tableNames() []string {
+	if sh.tables == nil {
+		names, err := sh.s.client.GetTableNames()
+		if err != nil {
+			return nil
+		}
+		sort.Strings(names)
+		sh.tables = names
+	}
+	return sh.tables
+}
+
+func (sh *shell) familyNames(table string) []string {
+	if names, ok := sh.families[table]; ok {
+		return names
+	}
+
+	t, err := unescape(table)
+	if err != nil {
+		return nil
+	}
+	families, err := sh.s.client.GetColumnDescriptors(string(t))
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, strings.TrimSuffix(name, ":"))
+	}
+	sort.Strings(names)
+
+	if sh.families == nil {
+		sh.families = make(map[string][]string)
+	}
+	sh.families[table] = names
+	return names
+}
+
+func (sh *shell) loadHistory() {
+	if sh.historyFile == "" {
+		return
+	}
+
+	data, err := os.ReadFile(sh.historyFile)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			sh.history = append(sh.history, line)
+		}
+	}
+	if len(sh.history) > maxHistory {
+		sh.history = sh.history[len(sh.history)-maxHistory:]
+	}
+}
+
+/*
+addHistory adds a statement to the history and appends it to the history file,
+a statement of several lines is kept as one line
+*/
+func (sh *shell) addHistory(text string) {
+	line := strings.Replace(text, "\n", " ", -1)
+	if n := len(sh.history); n > 0 && sh.history[n-1] == line {
+		return
+	}
+
+	sh.history = append(sh.history, line)
+	if len(sh.history) > maxHistory {
+		sh.history = sh.history[1:]
+	}
+
+	if sh.historyFile == "" {
+		return
+	}
+	f, err := os.OpenFile(sh.historyFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(f, line)
+	f.Close()
+}
@@ -0,0 +1,521 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+token kind of the shell syntax
+*/
+const (
+	tokWord     = iota // bare word, number, true or false
+	tokString          // 'single' or "double" quoted string
+	tokComma           // ,
+	tokArrow           // =>
+	tokLBrace          // {
+	tokRBrace          // }
+	tokLBracket        // [
+	tokRBracket        // ]
+)
+
+type token struct {
+	kind int
+	text string
+	pos  int
+}
+
+/*
+errIncomplete is returned for a statement that continues on the next line
+*/
+var errIncomplete = errors.New("incomplete statement")
+
+/*
+tokenize splits a statement of the hbase shell syntax, such as scan 't', {STARTROW => 'a'}.
+Quotes inside a string are escaped with a backslash, other backslashes are kept so \xNN
+reaches the command unchanged. An unterminated string returns the tokens read so far and
+errIncomplete.
+*/
+func tokenize(line string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(line); {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '#':
+			for i < len(line) && line[i] != '\n' {
+				i++
+			}
+		case c == ',':
+			tokens = append(tokens, token{tokComma, ",", i})
+			i++
+		case c == '{':
+			tokens = append(tokens, token{tokLBrace, "{", i})
+			i++
+		case c == '}':
+			tokens = append(tokens, token{tokRBrace, "}", i})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{tokLBracket, "[", i})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{tokRBracket, "]", i})
+			i++
+		case c == '=' && i+1 < len(line) && line[i+1] == '>':
+			tokens = append(tokens, token{tokArrow, "=>", i})
+			i += 2
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(line) && line[j] != c; j++ {
+				if line[j] == '\\' && j+1 < len(line) && line[j+1] == c {
+					j++
+				}
+				b.WriteByte(line[j])
+			}
+			if j >= len(line) {
+				return append(tokens, token{tokString, b.String(), i}), errIncomplete
+			}
+			tokens = append(tokens, token{tokString, b.String(), i})
+			i = j + 1
+		default:
+			j := i
+			for j < len(line) && !strings.ContainsRune(" \t\r\n,{}[]'\"#", rune(line[j])) &&
+				!(line[j] == '=' && j+1 < len(line) && line[j+1] == '>') {
+				j++
+			}
+			tokens = append(tokens, token{tokWord, line[i:j], i})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+/*
+shellValue is a string, a []shellValue list or a shellHash
+*/
+type shellValue interface{}
+
+/*
+shellHash keeps the order of the keys, keys are upper case
+*/
+type shellHash []shellPair
+
+type shellPair struct {
+	key   string
+	value shellValue
+}
+
+/*
+statement is a parsed line of the shell
+*/
+type statement struct {
+	name string
+	args []shellValue
+}
+
+/*
+parseStatement parses name [arg {, arg}], it returns errIncomplete if the statement
+is not finished at the end of line
+*/
+func parseStatement(line string) (*statement, error) {
+	tokens, err := tokenize(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if tokens[0].kind != tokWord {
+		return nil, errors.New(fmt.Sprint("syntax error at ", tokens[0].pos, ": expected a command"))
+	}
+
+	p := &parser{tokens: tokens[1:]}
+	st := &statement{name: tokens[0].text}
+	for !p.done() {
+		if len(st.args) > 0 {
+			if err = p.expect(tokComma); err != nil {
+				return nil, err
+			}
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		st.args = append(st.args, v)
+	}
+	return st, nil
+}
+
+type parser struct {
+	tokens []token
+	i      int
+}
+
+func (p *parser) done() bool {
+	return p.i >= len(p.tokens)
+}
+
+func (p *parser) next() (token, error) {
+	if p.done() {
+		return token{}, errIncomplete
+	}
+	t := p.tokens[p.i]
+	p.i++
+	return t, nil
+}
+
+func (p *parser) expect(kind int) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.kind != kind {
+		return errors.New(fmt.Sprint("syntax error at ", t.pos, ": unexpected ", t.text))
+	}
+	return nil
+}
+
+func (p *parser) value() (shellValue, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.kind {
+	case tokWord, tokString:
+		return t.text, nil
+	case tokLBracket:
+		list := []shellValue{}
+		for {
+			if !p.done() && p.tokens[p.i].kind == tokRBracket {
+				p.i++
+				return list, nil
+			}
+			if len(list) > 0 {
+				if err = p.expect(tokComma); err != nil {
+					return nil, err
+				}
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+	case tokLBrace:
+		hash := shellHash{}
+		for {
+			if !p.done() && p.tokens[p.i].kind == tokRBrace {
+				p.i++
+				return hash, nil
+			}
+			if len(hash) > 0 {
+				if err = p.expect(tokComma); err != nil {
+					return nil, err
+				}
+			}
+			key, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			if key.kind != tokWord && key.kind != tokString {
+				return nil, errors.New(fmt.Sprint("syntax error at ", key.pos, ": unexpected ", key.text))
+			}
+			if err = p.expect(tokArrow); err != nil {
+				return nil, err
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			hash = append(hash, shellPair{strings.ToUpper(key.text), v})
+		}
+	}
+	return nil, errors.New(fmt.Sprint("syntax error at ", t.pos, ": unexpected ", t.text))
+}
+
+func toText(v shellValue) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return "", errors.New(fmt.Sprint("expected a string, got ", v))
+}
+
+/*
+toTextList accepts a string or a list of strings
+*/
+func toTextList(v shellValue) ([]string, error) {
+	if list, ok := v.([]shellValue); ok {
+		data := make([]string, len(list))
+		for i, item := range list {
+			s, err := toText(item)
+			if err != nil {
+				return nil, err
+			}
+			data[i] = s
+		}
+		return data, nil
+	}
+
+	s, err := toText(v)
+	if err != nil {
+		return nil, err
+	}
+	return []string{s}, nil
+}
+
+/*
+isTimestamp tells whether v is a number, which deleteall takes as the timestamp of the row rather than a column
+*/
+func isTimestamp(v shellValue) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil
+}
+
+/*
+joinColumns joins columns for -columns, commas in a column are escaped
+*/
+func joinColumns(columns []string) string {
+	for i, c := range columns {
+		columns[i] = strings.Replace(c, ",", `\x2C`, -1)
+	}
+	return strings.Join(columns, ",")
+}
+
+/*
+argList collects the arguments of a command from a statement
+*/
+type argList struct {
+	name  string
+	flags []string
+	args  []string
+}
+
+func (a *argList) flag(name string, v shellValue) error {
+	s, err := toText(v)
+	if err != nil {
+		return errors.New(fmt.Sprint(a.name, ": ", name, " ", err))
+	}
+	a.flags = append(a.flags, "-"+name+"="+s)
+	return nil
+}
+
+func (a *argList) list() []string {
+	list := append(a.flags, "--")
+	return append(list, a.args...)
+}
+
+func checkArgs(st *statement, min, max int) error {
+	if len(st.args) < min || len(st.args) > max {
+		return errors.New(fmt.Sprint(st.name, ": wrong number of arguments"))
+	}
+	return nil
+}
+
+/*
+translate converts a statement of the hbase shell syntax to the arguments of a command of goh
+*/
+func translate(st *statement) (string, []string, error) {
+	a := &argList{name: st.name}
+	texts := func(from, to int) error {
+		for i := from; i < to && i < len(st.args); i++ {
+			s, err := toText(st.args[i])
+			if err != nil {
+				return errors.New(fmt.Sprint(st.name, ": ", err))
+			}
+			a.args = append(a.args, s)
+		}
+		return nil
+	}
+
+	var err error
+	switch st.name {
+	case "list":
+		err = checkArgs(st, 0, 0)
+	case "describe", "enable", "disable", "drop", "truncate", "regions":
+		if err = checkArgs(st, 1, 1); err == nil {
+			err = texts(0, 1)
+		}
+	case "create":
+		if err = checkArgs(st, 2, 1<<16); err == nil {
+			err = texts(0, 1)
+		}
+		for i := 1; err == nil && i < len(st.args); i++ {
+			var family string
+			if family, err = translateFamily(st.args[i]); err == nil {
+				a.args = append(a.args, family)
+			}
+		}
+	case "get":
+		if err = checkArgs(st, 2, 1<<16); err != nil {
+			break
+		}
+		if err = texts(0, 2); err != nil {
+			break
+		}
+		for i := 2; err == nil && i < len(st.args); i++ {
+			hash, ok := st.args[i].(shellHash)
+			if !ok {
+				var columns []string
+				if columns, err = toTextList(st.args[i]); err == nil {
+					a.args = append(a.args, columns...)
+				}
+				continue
+			}
+			for _, p := range hash {
+				switch p.key {
+				case "COLUMN", "COLUMNS":
+					var columns []string
+					if columns, err = toTextList(p.value); err == nil {
+						a.args = append(a.args, columns...)
+					}
+				case "TIMESTAMP":
+					err = a.flag("ts", p.value)
+				case "VERSIONS":
+					err = a.flag("versions", p.value)
+				default:
+					err = errors.New(fmt.Sprint("get: unknown option ", p.key))
+				}
+			}
+		}
+	case "put":
+		if err = checkArgs(st, 4, 5); err == nil {
+			err = texts(0, 4)
+		}
+		if err == nil && len(st.args) == 5 {
+			err = a.flag("ts", st.args[4])
+		}
+	case "delete", "deleteall":
+		min := 3
+		if st.name == "deleteall" {
+			min = 2
+		}
+		err = checkArgs(st, min, 4)
+		if err == nil && st.name == "deleteall" && len(st.args) == 3 && isTimestamp(st.args[2]) {
+			// deleteall 't', 'row', ts deletes the versions of the whole row
+			if err = texts(0, 2); err == nil {
+				err = a.flag("ts", st.args[2])
+			}
+		} else if err == nil {
+			if err = texts(0, 3); err == nil && len(st.args) == 4 {
+				err = a.flag("ts", st.args[3])
+			}
+		}
+		st = &statement{name: "delete", args: st.args}
+	case "scan":
+		if err = checkArgs(st, 1, 2); err == nil {
+			err = texts(0, 1)
+		}
+		if err == nil && len(st.args) == 2 {
+			err = translateScan(a, st.args[1])
+		}
+	case "incr":
+		if err = checkArgs(st, 3, 4); err == nil {
+			err = texts(0, 4)
+		}
+	default:
+		err = errors.New(fmt.Sprint("unknown command: ", st.name))
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+	return st.name, a.list(), nil
+}
+
+/*
+scanKeys maps options of scan in the hbase shell to flags of goh scan
+*/
+var scanKeys = map[string]string{
+	"STARTROW":        "start",
+	"STOPROW":         "stop",
+	"ROWPREFIXFILTER": "prefix",
+	"FILTER":          "filter",
+	"LIMIT":           "limit",
+	"CACHE":           "caching",
+	"TIMESTAMP":       "ts",
+	"REVERSED":        "reversed",
+}
+
+func translateScan(a *argList, v shellValue) error {
+	hash, ok := v.(shellHash)
+	if !ok {
+		return errors.New("scan: options have to be a hash")
+	}
+
+	for _, p := range hash {
+		if p.key == "COLUMN" || p.key == "COLUMNS" {
+			columns, err := toTextList(p.value)
+			if err != nil {
+				return errors.New(fmt.Sprint("scan: ", p.key, " ", err))
+			}
+			a.flags = append(a.flags, "-columns="+joinColumns(columns))
+			continue
+		}
+
+		name, ok := scanKeys[p.key]
+		if !ok {
+			return errors.New(fmt.Sprint("scan: unknown option ", p.key))
+		}
+		if err := a.flag(name, p.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+familyKeys maps options of a family in the hbase shell to options of goh create
+*/
+var familyKeys = map[string]string{
+	"VERSIONS":    "versions",
+	"COMPRESSION": "compression",
+	"IN_MEMORY":   "inmemory",
+	"BLOOMFILTER": "bloom",
+	"BLOCKCACHE":  "blockcache",
+	"TTL":         "ttl",
+}
+
+func translateFamily(v shellValue) (string, error) {
+	hash, ok := v.(shellHash)
+	if !ok {
+		return toText(v)
+	}
+
+	var name string
+	var options []string
+	for _, p := range hash {
+		s, err := toText(p.value)
+		if err != nil {
+			return "", errors.New(fmt.Sprint("create: ", p.key, " ", err))
+		}
+		if p.key == "NAME" {
+			name = s
+			continue
+		}
+		key, ok := familyKeys[p.key]
+		if !ok {
+			return "", errors.New(fmt.Sprint("create: unknown option ", p.key))
+		}
+		if strings.ContainsAny(s, ",=") {
+			return "", errors.New(fmt.Sprint("create: invalid ", p.key, " ", s))
+		}
+		options = append(options, key+"="+s)
+	}
+
+	if name == "" {
+		return "", errors.New("create: family without NAME")
+	}
+	if len(options) == 0 {
+		return name, nil
+	}
+	return name + ":" + strings.Join(options, ","), nil
+}
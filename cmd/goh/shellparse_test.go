@@ -0,0 +1,112 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStatement(t *testing.T) {
+	st, err := parseStatement(`scan 't1', {STARTROW => "a\x00", filter => "ValueFilter(=, 'binary:x')", COLUMNS => ['cf:a', cf2]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &statement{name: "scan", args: []shellValue{
+		"t1",
+		shellHash{
+			{"STARTROW", `a\x00`},
+			{"FILTER", "ValueFilter(=, 'binary:x')"},
+			{"COLUMNS", []shellValue{"cf:a", "cf2"}},
+		},
+	}}
+	if !reflect.DeepEqual(st, want) {
+		t.Errorf("parseStatement = %#v, want %#v", st, want)
+	}
+
+	if st, err = parseStatement(`put 't', 'it\'s', "say \"hi\"" # comment`); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(st.args, []shellValue{"t", "it's", `say "hi"`}) {
+		t.Errorf("parseStatement args = %#v", st.args)
+	}
+
+	if st, err = parseStatement("  "); st != nil || err != nil {
+		t.Errorf("parseStatement(blank) = %v, %v", st, err)
+	}
+}
+
+func TestParseStatementIncomplete(t *testing.T) {
+	for _, line := range []string{
+		"scan 't',",
+		"scan 't', {STARTROW => 'a',",
+		"scan 't', {STARTROW =>",
+		"get 't', 'r', ['cf:a'",
+		"get 't', 'r",
+		"scan 't', {FILTER => \"x\n",
+	} {
+		if _, err := parseStatement(line); err != errIncomplete {
+			t.Errorf("parseStatement(%q) = %v, want errIncomplete", line, err)
+		}
+	}
+
+	for _, line := range []string{"'scan'", "scan 't' 'x'", "scan 't', {STARTROW 'a'}", "get 't', ]"} {
+		if _, err := parseStatement(line); err == nil || err == errIncomplete {
+			t.Errorf("parseStatement(%q) = %v, want a syntax error", line, err)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	cases := []struct {
+		line string
+		name string
+		args []string
+	}{
+		{"list", "list", []string{"--"}},
+		{"describe 't'", "describe", []string{"--", "t"}},
+		{"create 't', 'cf1', {NAME => 'cf2', VERSIONS => 5, IN_MEMORY => true}", "create", []string{"--", "t", "cf1", "cf2:versions=5,inmemory=true"}},
+		{"get 't', '-r', 'cf:a', ['cf:b']", "get", []string{"--", "t", "-r", "cf:a", "cf:b"}},
+		{"get 't', 'r', {COLUMN => 'cf:a', TIMESTAMP => 5, VERSIONS => 2}", "get", []string{"-ts=5", "-versions=2", "--", "t", "r", "cf:a"}},
+		{"put 't', 'r', 'cf:a', 'v', 7", "put", []string{"-ts=7", "--", "t", "r", "cf:a", "v"}},
+		{"delete 't', 'r', 'cf:a'", "delete", []string{"--", "t", "r", "cf:a"}},
+		{"deleteall 't', 'r'", "delete", []string{"--", "t", "r"}},
+		{"deleteall 't', 'r', 'cf:a', 9", "delete", []string{"-ts=9", "--", "t", "r", "cf:a"}},
+		{"deleteall 't', 'r', 9", "delete", []string{"-ts=9", "--", "t", "r"}},
+		{"scan 't', {ROWPREFIXFILTER => 'p', LIMIT => 3, COLUMNS => ['cf:a', 'cf:b,c'], REVERSED => true}", "scan",
+			[]string{"-prefix=p", "-limit=3", `-columns=cf:a,cf:b\x2Cc`, "-reversed=true", "--", "t"}},
+		{"incr 't', 'r', 'cf:n', -2", "incr", []string{"--", "t", "r", "cf:n", "-2"}},
+	}
+
+	for _, c := range cases {
+		st, err := parseStatement(c.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, args, err := translate(st)
+		if err != nil {
+			t.Errorf("translate(%q): %v", c.line, err)
+			continue
+		}
+		if name != c.name || !reflect.DeepEqual(args, c.args) {
+			t.Errorf("translate(%q) = %s %q, want %s %q", c.line, name, args, c.name, c.args)
+		}
+	}
+
+	for _, line := range []string{
+		"describe",
+		"put 't', 'r', 'cf:a'",
+		"scan 't', 'x'",
+		"scan 't', {SIZE => 1}",
+		"create 't', {VERSIONS => 1}",
+		"get 't', 'r', {COLUMN => {A => 1}}",
+		"shell",
+	} {
+		st, err := parseStatement(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = translate(st); err == nil {
+			t.Errorf("translate(%q) should fail", line)
+		}
+	}
+}
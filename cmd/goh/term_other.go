@@ -0,0 +1,18 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+)
+
+/*
+isTerminal returns false, the shell reads plain lines without editing on this platform
+*/
+func isTerminal(fd int) bool {
+	return false
+}
+
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("raw terminal is not supported")
+}
@@ -0,0 +1,247 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+Column is a column of a table as bytes, so binary families and qualifiers written by other clients round trip exactly.
+A column without a qualifier selects the whole family where columns are asked for.
+*/
+type Column struct {
+	Family    []byte
+	Qualifier []byte
+}
+
+/*
+NewColumn return the column family:qualifier
+*/
+func NewColumn(family, qualifier []byte) Column {
+	return Column{Family: family, Qualifier: qualifier}
+}
+
+/*
+ParseColumn splits a column name at the first colon, families can not contain colons but qualifiers can
+*/
+func ParseColumn(name []byte) Column {
+	if i := bytes.IndexByte(name, ':'); i >= 0 {
+		return Column{Family: name[:i], Qualifier: name[i+1:]}
+	}
+	return Column{Family: name}
+}
+
+/*
+Name return family:qualifier, the column name of the thrift api
+*/
+func (c Column) Name() []byte {
+	name := make([]byte, 0, len(c.Family)+1+len(c.Qualifier))
+	name = append(name, c.Family...)
+	name = append(name, ':')
+	return append(name, c.Qualifier...)
+}
+
+/*
+String return Name as a string for the methods that take column names, a string holds the bytes unchanged.
+The methods with Col or Cols in their name take Column values instead.
+*/
+func (c Column) String() string {
+	return string(c.Name())
+}
+
+/*
+ColumnNames return the names of columns for the methods that take a list of column names
+*/
+func ColumnNames(columns ...Column) []string {
+	if len(columns) == 0 {
+		return nil
+	}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.String()
+	}
+	return names
+}
+
+/*
+NewColumnMutation is NewMutation of a Column
+*/
+func NewColumnMutation(column Column, value []byte) *Hbase.Mutation {
+	return NewMutation(column.String(), value)
+}
+
+/*
+NewColumnDeleteMutation return a mutation deleting column
+*/
+func NewColumnDeleteMutation(column Column) *Hbase.Mutation {
+	return &Hbase.Mutation{IsDelete: true, WriteToWAL: true, Column: column.Name()}
+}
+
+/*
+NewColumnIncrement is NewTIncrement of a Column
+*/
+func NewColumnIncrement(table string, row []byte, column Column, amount int64) *Hbase.TIncrement {
+	return NewTIncrement(table, row, column.String(), amount)
+}
+
+/*
+NewColumnAppend is NewAppend of Column values
+*/
+func NewColumnAppend(table string, row []byte, columns []Column, values [][]byte) *Hbase.TAppend {
+	return NewAppend(table, row, ColumnNames(columns...), values)
+}
+
+/*
+GetCol is Get of a Column
+*/
+func (client *HClient) GetCol(tableName string, row []byte, column Column, attributes map[string][]byte) ([]*Hbase.TCell, error) {
+	return client.Get(tableName, row, column.String(), attributes)
+}
+
+/*
+GetColVer is GetVer of a Column
+*/
+func (client *HClient) GetColVer(tableName string, row []byte, column Column, numVersions int32, attributes map[string][]byte) ([]*Hbase.TCell, error) {
+	return client.GetVer(tableName, row, column.String(), numVersions, attributes)
+}
+
+/*
+GetColVerTs is GetVerTs of a Column
+*/
+func (client *HClient) GetColVerTs(tableName string, row []byte, column Column, timestamp int64, numVersions int32, attributes map[string][]byte) ([]*Hbase.TCell, error) {
+	return client.GetVerTs(tableName, row, column.String(), timestamp, numVersions, attributes)
+}
+
+/*
+GetRowWithCols is GetRowWithColumns of Column values
+*/
+func (client *HClient) GetRowWithCols(tableName string, row []byte, columns []Column, attributes map[string][]byte) ([]*Hbase.TRowResult, error) {
+	return client.GetRowWithColumns(tableName, row, ColumnNames(columns...), attributes)
+}
+
+/*
+GetRowWithColsTs is GetRowWithColumnsTs of Column values
+*/
+func (client *HClient) GetRowWithColsTs(tableName string, row []byte, columns []Column, timestamp int64, attributes map[string][]byte) ([]*Hbase.TRowResult, error) {
+	return client.GetRowWithColumnsTs(tableName, row, ColumnNames(columns...), timestamp, attributes)
+}
+
+/*
+GetRowsWithCols is GetRowsWithColumns of Column values
+*/
+func (client *HClient) GetRowsWithCols(tableName string, rows [][]byte, columns []Column, attributes map[string][]byte) ([]*Hbase.TRowResult, error) {
+	return client.GetRowsWithColumns(tableName, rows, ColumnNames(columns...), attributes)
+}
+
+/*
+GetRowsWithColsTs is GetRowsWithColumnsTs of Column values
+*/
+func (client *HClient) GetRowsWithColsTs(tableName string, rows [][]byte, columns []Column, timestamp int64, attributes map[string][]byte) ([]*Hbase.TRowResult, error) {
+	return client.GetRowsWithColumnsTs(tableName, rows, ColumnNames(columns...), timestamp, attributes)
+}
+
+/*
+GetColAsync is GetAsync of a Column
+*/
+func (client *HClient) GetColAsync(tableName string, row []byte, column Column, attributes map[string][]byte) CellsFuture {
+	return client.GetAsync(tableName, row, column.String(), attributes)
+}
+
+/*
+GetRowWithColsAsync is GetRowWithColumnsAsync of Column values
+*/
+func (client *HClient) GetRowWithColsAsync(tableName string, row []byte, columns []Column, attributes map[string][]byte) RowsFuture {
+	return client.GetRowWithColumnsAsync(tableName, row, ColumnNames(columns...), attributes)
+}
+
+/*
+RowWithCols is RowWithColumns of Column values
+*/
+func (client *HClient) RowWithCols(tableName string, row []byte, columns []Column, attributes map[string][]byte) (*Row, error) {
+	return client.RowWithColumns(tableName, row, ColumnNames(columns...), attributes)
+}
+
+/*
+RowsWithCols is RowsWithColumns of Column values
+*/
+func (client *HClient) RowsWithCols(tableName string, rows [][]byte, columns []Column, attributes map[string][]byte) ([]*Row, error) {
+	return client.RowsWithColumns(tableName, rows, ColumnNames(columns...), attributes)
+}
+
+/*
+AtomicIncrementCol is AtomicIncrement of a Column
+*/
+func (client *HClient) AtomicIncrementCol(tableName string, row []byte, column Column, value int64) (int64, error) {
+	return client.AtomicIncrement(tableName, row, column.String(), value)
+}
+
+/*
+DeleteAllCol is DeleteAll of a Column
+*/
+func (client *HClient) DeleteAllCol(tableName string, row []byte, column Column, attributes map[string][]byte) error {
+	return client.DeleteAll(tableName, row, column.String(), attributes)
+}
+
+/*
+DeleteAllColTs is DeleteAllTs of a Column
+*/
+func (client *HClient) DeleteAllColTs(tableName string, row []byte, column Column, timestamp int64, attributes map[string][]byte) error {
+	return client.DeleteAllTs(tableName, row, column.String(), timestamp, attributes)
+}
+
+/*
+CheckAndPutCol is CheckAndPut of a Column
+*/
+func (client *HClient) CheckAndPutCol(tableName string, row []byte, column Column, value []byte, mput *Hbase.Mutation, attributes map[string][]byte) (bool, error) {
+	return client.CheckAndPut(tableName, row, column.String(), value, mput, attributes)
+}
+
+/*
+ScannerOpenCols is ScannerOpen of Column values
+*/
+func (client *HClient) ScannerOpenCols(tableName string, startRow []byte, columns []Column, attributes map[string][]byte) (int32, error) {
+	return client.ScannerOpen(tableName, startRow, ColumnNames(columns...), attributes)
+}
+
+/*
+ScannerOpenWithStopCols is ScannerOpenWithStop of Column values
+*/
+func (client *HClient) ScannerOpenWithStopCols(tableName string, startRow []byte, stopRow []byte, columns []Column, attributes map[string][]byte) (int32, error) {
+	return client.ScannerOpenWithStop(tableName, startRow, stopRow, ColumnNames(columns...), attributes)
+}
+
+/*
+ScannerOpenWithPrefixCols is ScannerOpenWithPrefix of Column values
+*/
+func (client *HClient) ScannerOpenWithPrefixCols(tableName string, startAndPrefix []byte, columns []Column, attributes map[string][]byte) (int32, error) {
+	return client.ScannerOpenWithPrefix(tableName, startAndPrefix, ColumnNames(columns...), attributes)
+}
+
+/*
+ScannerOpenColsTs is ScannerOpenTs of Column values
+*/
+func (client *HClient) ScannerOpenColsTs(tableName string, startRow []byte, columns []Column, timestamp int64, attributes map[string][]byte) (int32, error) {
+	return client.ScannerOpenTs(tableName, startRow, ColumnNames(columns...), timestamp, attributes)
+}
+
+/*
+ScannerOpenWithStopColsTs is ScannerOpenWithStopTs of Column values
+*/
+func (client *HClient) ScannerOpenWithStopColsTs(tableName string, startRow []byte, stopRow []byte, columns []Column, timestamp int64, attributes map[string][]byte) (int32, error) {
+	return client.ScannerOpenWithStopTs(tableName, startRow, stopRow, ColumnNames(columns...), timestamp, attributes)
+}
+
+/*
+ValueCol is Value taking a Column
+*/
+func (r *Row) ValueCol(column Column) []byte {
+	if c := r.CellCol(column); c != nil {
+		return c.Value
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+)
+
+func TestParseColumn(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		want goh.Column
+	}{
+		{"cf:x", goh.NewColumn([]byte("cf"), []byte("x"))},
+		{"cf:", goh.NewColumn([]byte("cf"), []byte{})},
+		{"cf", goh.NewColumn([]byte("cf"), nil)},
+		{"cf:a:b\x00\xff", goh.NewColumn([]byte("cf"), []byte("a:b\x00\xff"))},
+	} {
+		got := goh.ParseColumn([]byte(c.name))
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ParseColumn(%q) = %q", c.name, got)
+		}
+		if name := got.String(); c.want.Qualifier != nil && name != c.name {
+			t.Errorf("%q.String() = %q", c.name, name)
+		}
+	}
+	if names := goh.ColumnNames(goh.NewColumn([]byte("a"), []byte("\x01")), goh.NewColumn([]byte("b"), nil)); !reflect.DeepEqual(names, []string{"a:\x01", "b:"}) {
+		t.Errorf("ColumnNames = %q", names)
+	}
+}
+
+func TestBinaryKeys(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.split("test", "\x80\x00")
+
+	column := goh.NewColumn([]byte("cf"), []byte("q:\x00\xff"))
+	row := []byte("\xff\x00row")
+	value := []byte{0, 1, 0xfe, 0xff}
+	if err := client.MutateRow("test", row, []*Hbase.Mutation{goh.NewColumnMutation(column, value)}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := client.RowWithCols("test", row, []goh.Column{column}, map[string][]byte{"trace": {0xff, 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil || !bytes.Equal(r.Key, row) || len(r.Cells) != 1 || !bytes.Equal(r.ValueCol(column), value) || !reflect.DeepEqual(r.Cells[0].Column(), column) {
+		t.Errorf("row = %+v", r)
+	}
+
+	cells, err := client.GetRowOrBefore("test", []byte("\xff\x01"), column.Family)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 1 || !bytes.Equal(cells[0].Value, value) {
+		t.Errorf("GetRowOrBefore = %v", cells)
+	}
+
+	id, err := client.ScannerOpenWithPrefixCols("test", row[:2], []goh.Column{{Family: column.Family}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := client.ScannerRowsList(id, 10)
+	client.ScannerClose(id)
+	if err != nil || len(rows) != 1 || !bytes.Equal(rows[0].ValueCol(column), value) {
+		t.Errorf("ScannerOpenWithPrefixCols = %v, %v", rows, err)
+	}
+
+	counter := goh.NewColumn([]byte("cf"), []byte{0xff})
+	if n, err := client.AtomicIncrementCol("test", row, counter, 2); err != nil || n != 2 {
+		t.Errorf("AtomicIncrementCol = %d, %v", n, err)
+	}
+	if err = client.DeleteAllCol("test", row, column, nil); err != nil {
+		t.Fatal(err)
+	}
+	if cells, err = client.GetColVer("test", row, column, 3, nil); err != nil || len(cells) != 0 {
+		t.Errorf("GetColVer after DeleteAllCol = %v, %v", cells, err)
+	}
+	if cells, err = client.GetCol("test", row, counter, nil); err != nil || len(cells) != 1 {
+		t.Errorf("GetCol = %v, %v", cells, err)
+	}
+
+	regions, err := client.GetTableRegions("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != 2 || !bytes.Equal(regions[0].EndKey, []byte("\x80\x00")) || !bytes.Equal(regions[1].StartKey, []byte("\x80\x00")) {
+		t.Errorf("regions = %+v", regions)
+	}
+	region, err := client.GetRegionInfo(append([]byte("test,"), append(row, ",99999999999999"...)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(region.Name, regions[1].Name) {
+		t.Errorf("GetRegionInfo = %q, want %q", region.Name, regions[1].Name)
+	}
+}
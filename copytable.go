@@ -0,0 +1,326 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+CopyOptions select what CopyTable copies and how
+*/
+type CopyOptions struct {
+	StartRow  []byte   // first row, inclusive
+	StopRow   []byte   // last row, exclusive
+	Families  []string // families to copy, all if empty
+	StartTime int64    // only versions at or after StartTime, in milliseconds
+	EndTime   int64    // only versions before EndTime, 0 for no limit
+	NewName   string   // name of the destination table, the name of the source if empty
+	Versions  int32    // versions of each column, 0 for the max versions of its family
+	Parallel  int      // regions copied at once, each by its own pair of connections, 4 if 0
+	BatchSize int      // rows per batch written to the destination, 100 if 0
+	Caching   int32    // rows fetched per call, 100 if 0
+
+	// Progress is called after every batch and every region, calls do not overlap
+	Progress func(p CopyProgress)
+}
+
+/*
+CopyProgress is reported while a table is copied
+*/
+type CopyProgress struct {
+	Regions      int   // regions copied
+	TotalRegions int   // regions to copy
+	Rows         int64 // rows written
+	Cells        int64 // versions written
+}
+
+/*
+keyRange is the part of a table from start, inclusive, to stop, exclusive, an empty stop is the end of the table
+*/
+type keyRange struct {
+	start []byte
+	stop  []byte
+}
+
+/*
+regionRanges cut the key range start to stop at the region boundaries
+*/
+func regionRanges(regions []*TRegionInfo, start, stop []byte) []keyRange {
+	if len(regions) == 0 {
+		return []keyRange{{start, stop}}
+	}
+
+	ranges := make([]keyRange, 0, len(regions))
+	for _, region := range regions {
+		s, e := region.StartKey, region.EndKey
+		if bytes.Compare(s, start) < 0 {
+			s = start
+		}
+		if len(stop) > 0 && (len(e) == 0 || bytes.Compare(e, stop) > 0) {
+			e = stop
+		}
+		if len(e) == 0 || bytes.Compare(s, e) < 0 {
+			ranges = append(ranges, keyRange{s, e})
+		}
+	}
+	return ranges
+}
+
+/*
+runRanges calls work for every range, at most parallel ranges at once. The first worker uses clients,
+each of the others new connections to the same servers, work gets the clients of its worker in the same order.
+The first error cancels the ranges left and is returned, ctx.Err() if ctx is done before every range was handled.
+*/
+func runRanges(ctx context.Context, ranges []keyRange, parallel int, clients []*HClient, work func(ctx context.Context, conns []*HClient, index int, r keyRange) error) error {
+	workers := parallel
+	if workers > len(ranges) {
+		workers = len(ranges)
+	}
+
+	conns := [][]*HClient{clients}
+	defer func() {
+		for _, worker := range conns[1:] {
+			for _, conn := range worker {
+				conn.Close()
+			}
+		}
+	}()
+	for len(conns) < workers {
+		worker := make([]*HClient, 0, len(clients))
+		for _, client := range clients {
+			conn, err := client.NewConnection()
+			if err != nil {
+				for _, c := range worker {
+					c.Close()
+				}
+				return err
+			}
+			worker = append(worker, conn)
+		}
+		conns = append(conns, worker)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	queue := make(chan int)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(conns []*HClient) {
+			defer wg.Done()
+			for index := range queue {
+				if err := work(ctx, conns, index, ranges[index]); err != nil {
+					once.Do(func() { firstErr = err })
+					cancel()
+				}
+			}
+		}(conns[i])
+	}
+
+feed:
+	for i := range ranges {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
+
+/*
+tableCopy is a copy in progress, the regions update progress under mu
+*/
+type tableCopy struct {
+	opts     *CopyOptions
+	table    string
+	dest     string
+	columns  []string
+	versions map[string]int32
+
+	mu       sync.Mutex
+	progress CopyProgress
+}
+
+/*
+CopyTable copies the rows of tableName to dest, cells keep their timestamps.
+The regions of the table are scanned in parallel, each by a new connection to the source and to dest,
+the destination table is created with the families of the source if it does not exist.
+*/
+func (client *HClient) CopyTable(ctx context.Context, tableName string, dest *HClient, opts *CopyOptions) (CopyProgress, error) {
+	o := CopyOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.NewName == "" {
+		o.NewName = tableName
+	}
+	if o.Parallel <= 0 {
+		o.Parallel = 4
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 100
+	}
+	if o.Caching <= 0 {
+		o.Caching = 100
+	}
+
+	families, err := client.GetColumnDescriptors(tableName)
+	if err != nil {
+		return CopyProgress{}, err
+	}
+
+	c := &tableCopy{opts: &o, table: tableName, dest: o.NewName, versions: make(map[string]int32)}
+	var descriptors []*ColumnDescriptor
+	if len(o.Families) == 0 {
+		for _, family := range families {
+			descriptors = append(descriptors, family)
+		}
+	} else {
+		for _, name := range o.Families {
+			name = strings.TrimSuffix(name, ":")
+			family, ok := families[name+":"]
+			if !ok {
+				return CopyProgress{}, newHbaseError(nil, nil, ErrNoSuchFamily)
+			}
+			descriptors = append(descriptors, family)
+			c.columns = append(c.columns, name+":")
+		}
+	}
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Name < descriptors[j].Name })
+	for _, family := range descriptors {
+		c.versions[strings.TrimSuffix(family.Name, ":")] = family.MaxVersions
+	}
+
+	if _, err = dest.EnsureTable(c.dest, descriptors); err != nil {
+		return CopyProgress{}, err
+	}
+
+	regions, err := client.GetTableRegions(tableName)
+	if err != nil {
+		return CopyProgress{}, err
+	}
+	ranges := regionRanges(regions, o.StartRow, o.StopRow)
+	c.progress.TotalRegions = len(ranges)
+
+	err = runRanges(ctx, ranges, o.Parallel, []*HClient{client, dest}, func(ctx context.Context, conns []*HClient, index int, r keyRange) error {
+		return c.copyRange(ctx, conns[0], conns[1], r)
+	})
+	return c.progress, err
+}
+
+/*
+copyRange copies the rows of r with source and dest
+*/
+func (c *tableCopy) copyRange(ctx context.Context, source, dest *HClient, r keyRange) error {
+	scan := &TScan{
+		StartRow:  r.start,
+		StopRow:   r.stop,
+		Timestamp: c.opts.EndTime,
+		Columns:   c.columns,
+		Caching:   c.opts.Caching,
+	}
+	id, err := source.ScannerOpenWithScan(c.table, scan, nil)
+	if err != nil {
+		return err
+	}
+	defer source.ScannerClose(id)
+
+	batch := make([]*DumpRow, 0, c.opts.BatchSize)
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		results, err := source.ScannerGetList(id, c.opts.Caching)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			row, err := source.exportRow(c.table, result, c.opts.EndTime, c.opts.Versions, c.versions)
+			if err != nil {
+				return err
+			}
+			if row = c.filter(row); row != nil {
+				batch = append(batch, row)
+			}
+			if len(batch) == c.opts.BatchSize {
+				if err = c.write(dest, batch); err != nil {
+					return err
+				}
+				batch = batch[:0]
+			}
+		}
+
+		if len(results) == 0 {
+			break
+		}
+	}
+
+	if len(batch) > 0 {
+		if err = c.write(dest, batch); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.progress.Regions++
+	c.report()
+	return nil
+}
+
+/*
+filter drops the versions outside of the time range, it returns nil if none is left
+*/
+func (c *tableCopy) filter(row *DumpRow) *DumpRow {
+	cells := row.Cells[:0]
+	for _, cell := range row.Cells {
+		if cell.Timestamp >= c.opts.StartTime && (c.opts.EndTime == 0 || cell.Timestamp < c.opts.EndTime) {
+			cells = append(cells, cell)
+		}
+	}
+	if len(cells) == 0 {
+		return nil
+	}
+	row.Cells = cells
+	return row
+}
+
+func (c *tableCopy) write(dest *HClient, batch []*DumpRow) error {
+	if err := dest.importBatch(c.dest, batch); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, row := range batch {
+		c.progress.Rows++
+		c.progress.Cells += int64(len(row.Cells))
+	}
+	c.report()
+	return nil
+}
+
+// report calls Progress, mu is held
+func (c *tableCopy) report() {
+	if c.opts.Progress != nil {
+		c.opts.Progress(c.progress)
+	}
+}
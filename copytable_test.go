@@ -0,0 +1,126 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/thrift"
+)
+
+func newMemClient(t *testing.T, handler *memHbase) *goh.HClient {
+	addr := newTcpTestServer(t, newGatewayProcessor(handler), thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func checkCells(t *testing.T, client *goh.HClient, table, row, column string, want ...int64) {
+	cells, err := client.GetVer(table, []byte(row), column, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]int64, len(cells))
+	for i, cell := range cells {
+		got[i] = cell.Timestamp
+	}
+	if len(got) != len(want) {
+		t.Errorf("%s %s %s: timestamps = %v, want %v", table, row, column, got, want)
+		return
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("%s %s %s: timestamps = %v, want %v", table, row, column, got, want)
+			return
+		}
+	}
+}
+
+func TestCopyTable(t *testing.T) {
+	source, client := newGatewayClient(t)
+	source.split("test", "b", "d")
+	source.put("test", "a", "cf:x", "second", 5)
+	source.put("test", "b", "cf:y", "value b", 2)
+
+	dest := newMemHbase()
+	destClient := newMemClient(t, dest)
+
+	var last goh.CopyProgress
+	opts := &goh.CopyOptions{NewName: "copy", Parallel: 2, BatchSize: 1, Progress: func(p goh.CopyProgress) { last = p }}
+	p, err := client.CopyTable(context.Background(), "test", destClient, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := goh.CopyProgress{Regions: 3, TotalRegions: 3, Rows: 4, Cells: 5}
+	if p != want || last != want {
+		t.Errorf("CopyTable = %+v, last progress %+v, want %+v", p, last, want)
+	}
+
+	families, err := destClient.GetColumnDescriptors("copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cf, ok := families["cf:"]; !ok || len(families) != 1 || cf.MaxVersions != 3 {
+		t.Errorf("destination families = %v", families)
+	}
+	checkCells(t, destClient, "copy", "a", "cf:x", 5, 1)
+	checkCells(t, destClient, "copy", "b", "cf:y", 2)
+	checkCells(t, destClient, "copy", "e", "cf:x", 1)
+
+	// the regions run on the runner CountRows shares, a done context copies none of them
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if p, err = client.CopyTable(ctx, "test", destClient, &goh.CopyOptions{NewName: "canceled", Parallel: 2}); err != context.Canceled || p.Regions != 0 {
+		t.Errorf("CopyTable with a canceled context = %+v, %v", p, err)
+	}
+}
+
+func TestCopyTableOptions(t *testing.T) {
+	source, client := newGatewayClient(t)
+	source.createTable("wide", "cf", "meta")
+	source.split("wide", "c")
+	for _, row := range []string{"a", "b", "c", "d", "e"} {
+		source.put("wide", row, "cf:x", "old "+row, 1)
+		source.put("wide", row, "cf:x", "new "+row, 3)
+		source.put("wide", row, "cf:x", "future "+row, 9)
+		source.put("wide", row, "meta:m", "meta "+row, 3)
+	}
+
+	dest := newMemHbase()
+	destClient := newMemClient(t, dest)
+
+	opts := &goh.CopyOptions{StartRow: []byte("b"), StopRow: []byte("e"), Families: []string{"cf"}, StartTime: 2, EndTime: 9}
+	p, err := client.CopyTable(context.Background(), "wide", destClient, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Regions != 2 || p.Rows != 3 || p.Cells != 3 {
+		t.Errorf("CopyTable = %+v", p)
+	}
+
+	families, err := destClient.GetColumnDescriptors("wide")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := families["cf:"]; !ok || len(families) != 1 {
+		t.Errorf("destination families = %v", families)
+	}
+	checkCells(t, destClient, "wide", "a", "cf:x")
+	checkCells(t, destClient, "wide", "b", "cf:x", 3)
+	checkCells(t, destClient, "wide", "d", "cf:x", 3)
+	checkCells(t, destClient, "wide", "e", "cf:x")
+
+	if _, err = client.CopyTable(context.Background(), "wide", destClient, &goh.CopyOptions{Families: []string{"nope"}}); !isHbaseError(err, goh.ErrNoSuchFamily) {
+		t.Errorf("CopyTable with an unknown family = %v, want ErrNoSuchFamily", err)
+	}
+}
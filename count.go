@@ -0,0 +1,185 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"context"
+	"sync"
+)
+
+// countFilter makes the server return only the key of the first cell of every row
+const countFilter = "FirstKeyOnlyFilter() AND KeyOnlyFilter()"
+
+/*
+CountOptions select the rows CountRows counts and how
+*/
+type CountOptions struct {
+	StartRow     []byte // first row, inclusive
+	StopRow      []byte // last row, exclusive
+	Parallel     int    // regions counted at once, each by its own connection, 4 if 0
+	Caching      int32  // rows fetched per call, 1000 if 0
+	ByRegion     bool   // count the rows of every region
+	PrefixLength int    // count the rows of every key prefix of PrefixLength bytes, not at all if 0
+
+	// Progress is called after every call to the server, calls do not overlap
+	Progress func(p CountProgress)
+}
+
+/*
+CountProgress is reported while rows are counted
+*/
+type CountProgress struct {
+	Regions      int   // regions counted
+	TotalRegions int   // regions to count
+	Rows         int64 // rows counted
+}
+
+/*
+RegionCount is the number of rows of a region, cut to the range that was counted
+*/
+type RegionCount struct {
+	StartKey []byte
+	EndKey   []byte
+	Rows     int64
+}
+
+/*
+RowCount is the result of CountRows
+*/
+type RowCount struct {
+	Rows     int64
+	Regions  []*RegionCount   // in key order if ByRegion is set
+	Prefixes map[string]int64 // rows per key prefix if PrefixLength is set, shorter keys are their own prefix
+}
+
+/*
+rowCounter is a count in progress, the regions update count and progress under mu
+*/
+type rowCounter struct {
+	opts   *CountOptions
+	table  string
+	filter string
+
+	mu       sync.Mutex
+	progress CountProgress
+	count    *RowCount
+}
+
+/*
+CountRows counts the rows of tableName between opts.StartRow and opts.StopRow.
+Every region is read by its own scanner with a filter that returns only the first key of each row,
+the regions are counted in parallel by new connections to the server.
+Servers that can not scan with a filter string return whole rows, which is slower but gives the same count.
+*/
+func (client *HClient) CountRows(ctx context.Context, tableName string, opts *CountOptions) (*RowCount, error) {
+	o := CountOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Parallel <= 0 {
+		o.Parallel = 4
+	}
+	if o.Caching <= 0 {
+		o.Caching = 1000
+	}
+
+	regions, err := client.GetTableRegions(tableName)
+	if err != nil {
+		return nil, err
+	}
+	ranges := regionRanges(regions, o.StartRow, o.StopRow)
+
+	c := &rowCounter{opts: &o, table: tableName, filter: countFilter, count: &RowCount{}}
+	c.progress.TotalRegions = len(ranges)
+	if o.ByRegion {
+		c.count.Regions = make([]*RegionCount, len(ranges))
+		for i, r := range ranges {
+			c.count.Regions[i] = &RegionCount{StartKey: r.start, EndKey: r.stop}
+		}
+	}
+	if o.PrefixLength > 0 {
+		c.count.Prefixes = make(map[string]int64)
+	}
+
+	err = runRanges(ctx, ranges, o.Parallel, []*HClient{client}, func(ctx context.Context, conns []*HClient, index int, r keyRange) error {
+		return c.countRange(ctx, conns[0], index, r)
+	})
+	return c.count, err
+}
+
+/*
+countRange counts the rows of r, the index-th range, with conn
+*/
+func (c *rowCounter) countRange(ctx context.Context, conn *HClient, index int, r keyRange) error {
+	id, err := c.open(conn, r)
+	if err != nil {
+		return err
+	}
+	defer conn.ScannerClose(id)
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		results, err := conn.ScannerGetList(id, c.opts.Caching)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			break
+		}
+
+		c.mu.Lock()
+		c.count.Rows += int64(len(results))
+		c.progress.Rows += int64(len(results))
+		if c.count.Regions != nil {
+			c.count.Regions[index].Rows += int64(len(results))
+		}
+		if c.count.Prefixes != nil {
+			for _, result := range results {
+				prefix := result.Row
+				if len(prefix) > c.opts.PrefixLength {
+					prefix = prefix[:c.opts.PrefixLength]
+				}
+				c.count.Prefixes[string(prefix)]++
+			}
+		}
+		c.report()
+		c.mu.Unlock()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.progress.Regions++
+	c.report()
+	return nil
+}
+
+/*
+open opens a scanner of r with the count filter, without it if the server can not scan with a filter string
+*/
+func (c *rowCounter) open(conn *HClient, r keyRange) (int32, error) {
+	c.mu.Lock()
+	filter := c.filter
+	c.mu.Unlock()
+
+	scan := &TScan{StartRow: r.start, StopRow: r.stop, Caching: c.opts.Caching, FilterString: filter}
+	id, err := conn.ScannerOpenWithScan(c.table, scan, nil)
+	if e, ok := err.(*HbaseError); ok && e.Err == ErrNotSupported && filter != "" {
+		c.mu.Lock()
+		c.filter = ""
+		c.mu.Unlock()
+		scan.FilterString = ""
+		id, err = conn.ScannerOpenWithScan(c.table, scan, nil)
+	}
+	return id, err
+}
+
+// report calls Progress, mu is held
+func (c *rowCounter) report() {
+	if c.opts.Progress != nil {
+		c.opts.Progress(c.progress)
+	}
+}
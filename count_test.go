@@ -0,0 +1,73 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/sdming/goh"
+)
+
+func TestCountRows(t *testing.T) {
+	for _, missing := range [][]string{nil, {"scannerOpenWithScan"}} {
+		handler, client := newGatewayClient(t, missing...)
+		handler.split("test", "b", "d")
+		for i := 0; i < 25; i++ {
+			handler.put("test", fmt.Sprintf("b%02d", i), "cf:x", "v", 1)
+			handler.put("test", fmt.Sprintf("b%02d", i), "cf:y", "v", 1)
+		}
+
+		var last goh.CountProgress
+		opts := &goh.CountOptions{Parallel: 2, Caching: 10, ByRegion: true, PrefixLength: 2, Progress: func(p goh.CountProgress) { last = p }}
+		count, err := client.CountRows(context.Background(), "test", opts)
+		if err != nil {
+			t.Fatal(missing, err)
+		}
+		if count.Rows != 28 {
+			t.Errorf("%v: rows = %d, want 28", missing, count.Rows)
+		}
+		if last != (goh.CountProgress{Regions: 3, TotalRegions: 3, Rows: 28}) {
+			t.Errorf("%v: last progress = %+v", missing, last)
+		}
+
+		regions := []*goh.RegionCount{
+			{StartKey: []byte(""), EndKey: []byte("b"), Rows: 1},
+			{StartKey: []byte("b"), EndKey: []byte("d"), Rows: 26},
+			{StartKey: []byte("d"), EndKey: []byte(""), Rows: 1},
+		}
+		if !reflect.DeepEqual(count.Regions, regions) {
+			t.Errorf("%v: regions = %+v", missing, count.Regions)
+		}
+		prefixes := map[string]int64{"a": 1, "b0": 10, "b1": 10, "b2": 5, "c": 1, "e": 1}
+		if !reflect.DeepEqual(count.Prefixes, prefixes) {
+			t.Errorf("%v: prefixes = %v", missing, count.Prefixes)
+		}
+	}
+}
+
+func TestCountRowsRange(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.split("test", "b", "d")
+
+	count, err := client.CountRows(context.Background(), "test", &goh.CountOptions{StartRow: []byte("b"), StopRow: []byte("e")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.Rows != 1 || count.Regions != nil || count.Prefixes != nil {
+		t.Errorf("count = %+v", count)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = client.CountRows(ctx, "test", nil); err != context.Canceled {
+		t.Errorf("CountRows with a canceled context = %v", err)
+	}
+	if _, err = client.CountRows(context.Background(), "missing", nil); err == nil {
+		t.Error("CountRows of a missing table should fail")
+	}
+}
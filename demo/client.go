@@ -27,9 +27,9 @@ func main() {
 
 	table := "test"
 
-	attributes := make(map[string]string)
-	attributes["attr1"] = "attr-val1"
-	attributes["attr2"] = "attr-val2"
+	attributes := make(map[string][]byte)
+	attributes["attr1"] = []byte("attr-val1")
+	attributes["attr2"] = []byte("attr-val2")
 
 	columns := make([]string, 2)
 	columns[0] = "cf:a"
@@ -56,6 +56,9 @@ func main() {
 		Caching:      10,
 		FilterString: "",
 		//FilterString: "substring:value",
+		BatchSize:   0,
+		SortColumns: false,
+		Reversed:    false,
 	}
 
 	var scanId int32
@@ -200,14 +203,14 @@ func main() {
 		}
 
 		fmt.Print("GetRowOrBefore:")
-		if data, err := client.GetRowOrBefore(table, "row1", "cf"); err != nil {
+		if data, err := client.GetRowOrBefore(table, []byte("row1"), []byte("cf")); err != nil {
 			fmt.Println(err)
 		} else {
 			printCells(data)
 		}
 
 		fmt.Print("GetRegionInfo:")
-		if data, err := client.GetRegionInfo(""); err != nil {
+		if data, err := client.GetRegionInfo([]byte("")); err != nil {
 			fmt.Println(err)
 		} else {
 			dump(data)
@@ -247,6 +250,16 @@ func main() {
 		fmt.Print("IncrementRows:")
 		fmt.Println(client.IncrementRows([]*Hbase.TIncrement{goh.NewTIncrement(table, []byte("row4"), "cf:a", 64)}))
 
+		fmt.Print("Append:")
+		if data, err := client.Append(goh.NewAppend(table, []byte("row4"), []string{"cf:b"}, [][]byte{[]byte("-appended")})); err != nil {
+			fmt.Println(err)
+		} else {
+			dump(data)
+		}
+
+		fmt.Print("CheckAndPut:")
+		fmt.Println(client.CheckAndPut(table, []byte("row4"), "cf:c", nil, goh.NewMutation("cf:c", []byte("value4-checked")), nil))
+
 		fmt.Print("DeleteAllRowTs:")
 		fmt.Println(client.DeleteAllRowTs(table, []byte("row4"), timestamp, nil))
 
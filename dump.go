@@ -0,0 +1,799 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/Hbase2"
+)
+
+/*
+DumpFormat is the encoding of a table dump
+*/
+type DumpFormat int
+
+const (
+	DumpJSON   DumpFormat = iota // newline delimited json, a header line, a line per row and a trailer line
+	DumpBinary                   // length prefixed records, each followed by a crc32 of the record
+)
+
+const (
+	dumpVersion   = 1
+	dumpMagic     = "GOHDUMP\x01"
+	dumpMaxRecord = 256 << 20 // largest record written or read, a longer length is a corrupt dump
+)
+
+// record kinds of the binary format
+const (
+	dumpRecordHeader  = 'H'
+	dumpRecordRow     = 'R'
+	dumpRecordTrailer = 'T'
+)
+
+/*
+DumpHeader describes the table and the export a dump was made from, a resumed export reuses its options
+*/
+type DumpHeader struct {
+	Version      int                 `json:"version"`
+	Table        string              `json:"table"`
+	Created      int64               `json:"created"` // milliseconds since epoch
+	Families     []*ColumnDescriptor `json:"families,omitempty"`
+	StartRow     []byte              `json:"startRow,omitempty"`
+	StopRow      []byte              `json:"stopRow,omitempty"`
+	Columns      []string            `json:"columns,omitempty"`
+	FilterString string              `json:"filterString,omitempty"`
+	MaxVersions  int32               `json:"maxVersions,omitempty"`
+}
+
+/*
+DumpCell is a version of a column
+*/
+type DumpCell struct {
+	Column    []byte `json:"column"`
+	Timestamp int64  `json:"timestamp"`
+	Value     []byte `json:"value"`
+}
+
+/*
+DumpRow is a row of a dump with every exported version of its columns
+*/
+type DumpRow struct {
+	Row   []byte     `json:"row"`
+	Cells []DumpCell `json:"cells"`
+}
+
+/*
+DumpProgress is reported while a dump is written or read
+*/
+type DumpProgress struct {
+	Rows    int64  `json:"rows"`
+	Cells   int64  `json:"cells"`
+	LastRow []byte `json:"-"` // last row written, or imported
+}
+
+// dumpLine is a line of the json format, exactly one field is set
+type dumpLine struct {
+	Header  *DumpHeader   `json:"header,omitempty"`
+	Row     []byte        `json:"row,omitempty"`
+	Cells   []DumpCell    `json:"cells,omitempty"`
+	Trailer *DumpProgress `json:"trailer,omitempty"`
+}
+
+/*
+DumpWriter writes rows of a table to a dump, Close writes the trailer that marks the dump complete
+*/
+type DumpWriter struct {
+	w        *bufio.Writer
+	format   DumpFormat
+	progress DumpProgress
+}
+
+/*
+NewDumpWriter writes the header of a new dump to w
+*/
+func NewDumpWriter(w io.Writer, format DumpFormat, header *DumpHeader) (*DumpWriter, error) {
+	if format != DumpJSON && format != DumpBinary {
+		return nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+
+	d := &DumpWriter{w: bufio.NewWriter(w), format: format}
+	h := *header
+	h.Version = dumpVersion
+	if h.Created == 0 {
+		h.Created = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+
+	if format == DumpBinary {
+		if _, err := d.w.WriteString(dumpMagic); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(&h)
+		if err != nil {
+			return nil, err
+		}
+		return d, d.writeRecord(dumpRecordHeader, data)
+	}
+	return d, d.writeLine(&dumpLine{Header: &h})
+}
+
+func (d *DumpWriter) writeLine(line *dumpLine) error {
+	data, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = d.w.Write(data)
+	return err
+}
+
+// writeRecord writes kind, the length of data, data and the crc32 of kind and data
+func (d *DumpWriter) writeRecord(kind byte, data []byte) error {
+	if len(data) > dumpMaxRecord {
+		return newHbaseError(nil, nil, ErrDumpFormat)
+	}
+	var buf [binary.MaxVarintLen64 + 1]byte
+	buf[0] = kind
+	n := binary.PutUvarint(buf[1:], uint64(len(data)))
+	crc := crc32.NewIEEE()
+	crc.Write(buf[:1])
+	crc.Write(data)
+
+	if _, err := d.w.Write(buf[:n+1]); err != nil {
+		return err
+	}
+	if _, err := d.w.Write(data); err != nil {
+		return err
+	}
+	_, err := d.w.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32()))
+	return err
+}
+
+/*
+WriteRow appends a row to the dump
+*/
+func (d *DumpWriter) WriteRow(row *DumpRow) error {
+	var err error
+	if d.format == DumpBinary {
+		err = d.writeRecord(dumpRecordRow, encodeDumpRow(row))
+	} else {
+		err = d.writeLine(&dumpLine{Row: row.Row, Cells: row.Cells})
+	}
+	if err != nil {
+		return err
+	}
+
+	d.progress.Rows++
+	d.progress.Cells += int64(len(row.Cells))
+	d.progress.LastRow = row.Row
+	return nil
+}
+
+/*
+Progress returns the rows and cells written so far, including those of a resumed dump
+*/
+func (d *DumpWriter) Progress() DumpProgress {
+	return d.progress
+}
+
+/*
+Flush writes buffered rows to the underlying writer
+*/
+func (d *DumpWriter) Flush() error {
+	return d.w.Flush()
+}
+
+/*
+Close writes the trailer with the number of rows and cells and flushes, it does not close the underlying writer
+*/
+func (d *DumpWriter) Close() error {
+	trailer := DumpProgress{Rows: d.progress.Rows, Cells: d.progress.Cells}
+	var err error
+	if d.format == DumpBinary {
+		var data []byte
+		data = binary.AppendUvarint(data, uint64(trailer.Rows))
+		data = binary.AppendUvarint(data, uint64(trailer.Cells))
+		err = d.writeRecord(dumpRecordTrailer, data)
+	} else {
+		err = d.writeLine(&dumpLine{Trailer: &trailer})
+	}
+	if err != nil {
+		return err
+	}
+	return d.w.Flush()
+}
+
+func encodeDumpRow(row *DumpRow) []byte {
+	data := binary.AppendUvarint(nil, uint64(len(row.Row)))
+	data = append(data, row.Row...)
+	data = binary.AppendUvarint(data, uint64(len(row.Cells)))
+	for _, cell := range row.Cells {
+		data = binary.AppendUvarint(data, uint64(len(cell.Column)))
+		data = append(data, cell.Column...)
+		data = binary.AppendVarint(data, cell.Timestamp)
+		data = binary.AppendUvarint(data, uint64(len(cell.Value)))
+		data = append(data, cell.Value...)
+	}
+	return data
+}
+
+func decodeDumpRow(data []byte) (*DumpRow, error) {
+	r := bytes.NewReader(data)
+	next := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil || n > uint64(r.Len()) {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+		b := make([]byte, n)
+		r.Read(b)
+		return b, nil
+	}
+
+	key, err := next()
+	if err != nil {
+		return nil, err
+	}
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count > uint64(r.Len()) {
+		return nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+
+	row := &DumpRow{Row: key, Cells: make([]DumpCell, count)}
+	for i := range row.Cells {
+		cell := &row.Cells[i]
+		if cell.Column, err = next(); err != nil {
+			return nil, err
+		}
+		if cell.Timestamp, err = binary.ReadVarint(r); err != nil {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+		if cell.Value, err = next(); err != nil {
+			return nil, err
+		}
+	}
+	if r.Len() != 0 {
+		return nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+	return row, nil
+}
+
+/*
+DumpReader reads a dump written by DumpWriter, the format is detected from the first bytes
+*/
+type DumpReader struct {
+	r        *bufio.Reader
+	format   DumpFormat
+	header   *DumpHeader
+	progress DumpProgress
+	offset   int64 // end of the last complete record
+	complete bool
+}
+
+/*
+NewDumpReader reads the header of a dump from r
+*/
+func NewDumpReader(r io.Reader) (*DumpReader, error) {
+	d := &DumpReader{r: bufio.NewReader(r)}
+
+	magic, err := d.r.Peek(len(dumpMagic))
+	if err == nil && string(magic) == dumpMagic {
+		d.format = DumpBinary
+		d.r.Discard(len(dumpMagic))
+		d.offset = int64(len(dumpMagic))
+
+		kind, data, err := d.readRecord()
+		if err != nil {
+			return nil, err
+		}
+		if kind != dumpRecordHeader {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+		d.header = &DumpHeader{}
+		if err = json.Unmarshal(data, d.header); err != nil {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+	} else {
+		d.format = DumpJSON
+		line, err := d.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if line.Header == nil {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+		d.header = line.Header
+	}
+
+	if d.header.Version != dumpVersion {
+		return nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+	return d, nil
+}
+
+/*
+Header returns the header of the dump
+*/
+func (d *DumpReader) Header() *DumpHeader {
+	return d.header
+}
+
+/*
+Format returns the format of the dump
+*/
+func (d *DumpReader) Format() DumpFormat {
+	return d.format
+}
+
+/*
+Progress returns the rows and cells read so far
+*/
+func (d *DumpReader) Progress() DumpProgress {
+	return d.progress
+}
+
+/*
+Offset returns the end of the last complete record read
+*/
+func (d *DumpReader) Offset() int64 {
+	return d.offset
+}
+
+// readLine reads a json line, a missing newline at the end means the dump was cut off
+func (d *DumpReader) readLine() (*dumpLine, error) {
+	data, err := d.r.ReadBytes('\n')
+	if err == io.EOF && len(data) == 0 {
+		return nil, io.EOF
+	} else if err == io.EOF {
+		return nil, newHbaseError(nil, nil, ErrDumpTruncated)
+	} else if err != nil {
+		return nil, err
+	}
+
+	line := &dumpLine{}
+	if err = json.Unmarshal(data, line); err != nil {
+		return nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+	d.offset += int64(len(data))
+	return line, nil
+}
+
+func (d *DumpReader) readRecord() (byte, []byte, error) {
+	kind, err := d.r.ReadByte()
+	if err == io.EOF {
+		return 0, nil, io.EOF
+	} else if err != nil {
+		return 0, nil, err
+	}
+
+	n, err := binary.ReadUvarint(d.r)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return 0, nil, newHbaseError(nil, nil, ErrDumpTruncated)
+	} else if err != nil || n > dumpMaxRecord {
+		return 0, nil, newHbaseError(nil, nil, ErrDumpFormat)
+	}
+
+	data := make([]byte, n+4)
+	if _, err = io.ReadFull(d.r, data); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return 0, nil, newHbaseError(nil, nil, ErrDumpTruncated)
+	} else if err != nil {
+		return 0, nil, err
+	}
+
+	crc := crc32.NewIEEE()
+	crc.Write([]byte{kind})
+	crc.Write(data[:n])
+	if crc.Sum32() != binary.BigEndian.Uint32(data[n:]) {
+		return 0, nil, newHbaseError(nil, nil, ErrDumpChecksum)
+	}
+
+	d.offset += int64(1+uvarintLen(n)) + int64(len(data))
+	return kind, data[:n], nil
+}
+
+func uvarintLen(n uint64) int {
+	return len(binary.AppendUvarint(nil, n))
+}
+
+/*
+Next returns the next row, io.EOF after the trailer and ErrDumpTruncated if the dump ends without one
+*/
+func (d *DumpReader) Next() (*DumpRow, error) {
+	if d.complete {
+		return nil, io.EOF
+	}
+
+	var row *DumpRow
+	var trailer *DumpProgress
+	if d.format == DumpBinary {
+		kind, data, err := d.readRecord()
+		if err == io.EOF {
+			return nil, newHbaseError(nil, nil, ErrDumpTruncated)
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case dumpRecordRow:
+			if row, err = decodeDumpRow(data); err != nil {
+				return nil, err
+			}
+		case dumpRecordTrailer:
+			r := bytes.NewReader(data)
+			rows, e1 := binary.ReadUvarint(r)
+			cells, e2 := binary.ReadUvarint(r)
+			if e1 != nil || e2 != nil {
+				return nil, newHbaseError(nil, nil, ErrDumpFormat)
+			}
+			trailer = &DumpProgress{Rows: int64(rows), Cells: int64(cells)}
+		default:
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+	} else {
+		line, err := d.readLine()
+		if err == io.EOF {
+			return nil, newHbaseError(nil, nil, ErrDumpTruncated)
+		} else if err != nil {
+			return nil, err
+		}
+
+		if line.Trailer != nil {
+			trailer = line.Trailer
+		} else if line.Row != nil {
+			row = &DumpRow{Row: line.Row, Cells: line.Cells}
+		} else {
+			return nil, newHbaseError(nil, nil, ErrDumpFormat)
+		}
+	}
+
+	if trailer != nil {
+		if trailer.Rows != d.progress.Rows || trailer.Cells != d.progress.Cells {
+			return nil, newHbaseError(nil, nil, ErrDumpChecksum)
+		}
+		d.complete = true
+		return nil, io.EOF
+	}
+
+	d.progress.Rows++
+	d.progress.Cells += int64(len(row.Cells))
+	d.progress.LastRow = row.Row
+	return row, nil
+}
+
+/*
+ResumeDump reads the dump in f up to its last complete row, cuts off what follows and returns
+a writer that appends to f, the header to export the rest with and the last row in the dump.
+complete is true if the dump already has its trailer, the writer is nil then.
+*/
+func ResumeDump(f *os.File) (w *DumpWriter, header *DumpHeader, last []byte, complete bool, err error) {
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return
+	}
+
+	r, err := NewDumpReader(f)
+	if err != nil {
+		return
+	}
+	var end int64
+	for {
+		end = r.offset
+		_, err = r.Next()
+		if err == io.EOF {
+			return nil, r.header, r.progress.LastRow, true, nil
+		}
+		if e, ok := err.(*HbaseError); ok && (e.Err == ErrDumpTruncated || e.Err == ErrDumpChecksum) {
+			// a checksum error at the end is a partly written record, or a trailer that does not match the rows
+			break
+		} else if err != nil {
+			return
+		}
+	}
+
+	if err = f.Truncate(end); err != nil {
+		return
+	}
+	if _, err = f.Seek(end, io.SeekStart); err != nil {
+		return
+	}
+
+	w = &DumpWriter{w: bufio.NewWriter(f), format: r.format, progress: r.progress}
+	return w, r.header, r.progress.LastRow, false, nil
+}
+
+/*
+ExportOptions select the rows and versions to export
+*/
+type ExportOptions struct {
+	StartRow     []byte   // first row, inclusive
+	StopRow      []byte   // last row, exclusive
+	Columns      []string // columns or families, all if empty
+	FilterString string   // filter of the scan, older versions of the columns it selects are read without it
+	MaxVersions  int32    // versions of each column, 0 for the max versions of its family
+	Caching      int32    // rows fetched per call, 100 if 0
+
+	// Progress is called after every batch of rows
+	Progress func(p DumpProgress)
+}
+
+/*
+ExportHeader returns the header of a dump of tableName with opts
+*/
+func (client *HClient) ExportHeader(tableName string, opts *ExportOptions) (*DumpHeader, error) {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+
+	families, err := client.GetColumnDescriptors(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &DumpHeader{
+		Table:        tableName,
+		StartRow:     opts.StartRow,
+		StopRow:      opts.StopRow,
+		Columns:      opts.Columns,
+		FilterString: opts.FilterString,
+		MaxVersions:  opts.MaxVersions,
+	}
+	header.Families = sortedFamilies(families)
+	return header, nil
+}
+
+/*
+Export scans tableName and writes every version of the selected columns to w. It does not close w.
+The scan returns the latest version of a column, the older ones of a family keeping more than one version
+are read with GetVer, which takes no filter: FilterString selects the rows and columns but not their older versions.
+*/
+func (client *HClient) Export(tableName string, w *DumpWriter, opts *ExportOptions) error {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+
+	families, err := client.GetColumnDescriptors(tableName)
+	if err != nil {
+		return err
+	}
+	versions := make(map[string]int32, len(families))
+	for name, family := range families {
+		versions[strings.TrimSuffix(name, ":")] = family.MaxVersions
+	}
+
+	caching := opts.Caching
+	if caching <= 0 {
+		caching = 100
+	}
+	scan := &TScan{
+		StartRow:     opts.StartRow,
+		StopRow:      opts.StopRow,
+		Columns:      opts.Columns,
+		Caching:      caching,
+		FilterString: opts.FilterString,
+	}
+	id, err := client.ScannerOpenWithScan(tableName, scan, nil)
+	if err != nil {
+		return err
+	}
+	defer client.ScannerClose(id)
+
+	for {
+		results, err := client.ScannerGetList(id, caching)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			break
+		}
+
+		for _, result := range results {
+			row, err := client.exportRow(tableName, result, 0, opts.MaxVersions, versions)
+			if err != nil {
+				return err
+			}
+			if err = w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		if err = w.Flush(); err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(w.Progress())
+		}
+	}
+	return nil
+}
+
+// exportRow reads the versions of the columns of result, older than timestamp if it is not 0,
+// GetVer is only called for the columns of the families that keep more than one version
+func (client *HClient) exportRow(tableName string, result *Hbase.TRowResult, timestamp int64, maxVersions int32, versions map[string]int32) (*DumpRow, error) {
+	columns := make([]string, 0, len(result.Columns))
+	for column := range result.Columns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	row := &DumpRow{Row: result.Row}
+	for _, column := range columns {
+		n := versions[strings.SplitN(column, ":", 2)[0]]
+		if maxVersions > 0 && (n <= 0 || maxVersions < n) {
+			n = maxVersions
+		}
+
+		cells := []*Hbase.TCell{result.Columns[column]}
+		if n > 1 {
+			var err error
+			if timestamp != 0 {
+				cells, err = client.GetVerTs(tableName, result.Row, column, timestamp, n, nil)
+			} else {
+				cells, err = client.GetVer(tableName, result.Row, column, n, nil)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, cell := range cells {
+			row.Cells = append(row.Cells, DumpCell{Column: []byte(column), Timestamp: cell.Timestamp, Value: cell.Value})
+		}
+	}
+	return row, nil
+}
+
+/*
+ImportOptions control how a dump is written to a table
+*/
+type ImportOptions struct {
+	BatchSize   int    // rows per batch, 100 if 0
+	After       []byte // rows up to and including After are skipped, to resume an import
+	CreateTable bool   // create the table with the families of the dump if it does not exist
+
+	// Progress is called after every batch, LastRow is the last row written
+	Progress func(p DumpProgress)
+}
+
+/*
+Import writes the rows of r to tableName with MutateRowsTs, cells keep their timestamps.
+A thrift mutation has no timestamp of its own, so a batch of rows is sent with one MutateRowsTs
+for each distinct timestamp in it, THBaseClient.Import sends a batch in one call.
+*/
+func (client *HClient) Import(tableName string, r *DumpReader, opts *ImportOptions) (DumpProgress, error) {
+	if opts != nil && opts.CreateTable {
+		if _, err := client.EnsureTable(tableName, r.Header().Families); err != nil {
+			return DumpProgress{}, err
+		}
+	}
+	return importDump(r, opts, func(rows []*DumpRow) error {
+		return client.importBatch(tableName, rows)
+	})
+}
+
+/*
+Import writes the rows of r to tableName with PutMultiple, a batch of rows is sent in one call
+and every cell keeps its timestamp. CreateTable creates the table with the families of the dump.
+*/
+func (client *THBaseClient) Import(tableName string, r *DumpReader, opts *ImportOptions) (DumpProgress, error) {
+	if opts != nil && opts.CreateTable {
+		if err := client.ensureTable(tableName, r.Header().Families); err != nil {
+			return DumpProgress{}, err
+		}
+	}
+	return importDump(r, opts, func(rows []*DumpRow) error {
+		puts := make([]*Hbase2.TPut, len(rows))
+		for i, row := range rows {
+			puts[i] = NewTPut(row.Row)
+			for _, cell := range row.Cells {
+				value := NewTColumnValue(string(cell.Column), cell.Value)
+				value.Timestamp = cell.Timestamp
+				puts[i].ColumnValues = append(puts[i].ColumnValues, value)
+			}
+		}
+		return client.PutMultiple(tableName, puts)
+	})
+}
+
+// ensureTable creates tableName with the families of a dump if it does not exist
+func (client *THBaseClient) ensureTable(tableName string, families []*ColumnDescriptor) error {
+	exists, err := client.TableExists(tableName)
+	if err != nil || exists {
+		return err
+	}
+
+	desc := &TableDescriptor{Name: tableName}
+	for _, family := range families {
+		f := &ColumnFamilyDescriptor{
+			Name:              strings.TrimSuffix(family.Name, ":"),
+			BloomFilterType:   family.BloomFilterType,
+			Compression:       family.Compression,
+			MaxVersions:       family.MaxVersions,
+			BlockCacheEnabled: family.BlockCacheEnabled,
+			InMemory:          family.InMemory,
+		}
+		if family.TimeToLive > 0 {
+			f.TimeToLive = family.TimeToLive
+		}
+		desc.Families = append(desc.Families, f)
+	}
+	return client.CreateTable(desc, nil)
+}
+
+// importDump reads r in batches of rows and gives each of them to write
+func importDump(r *DumpReader, opts *ImportOptions, write func(rows []*DumpRow) error) (DumpProgress, error) {
+	var progress DumpProgress
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+
+	size := opts.BatchSize
+	if size <= 0 {
+		size = 100
+	}
+
+	batch := make([]*DumpRow, 0, size)
+	for {
+		row, err := r.Next()
+		if err != nil && err != io.EOF {
+			return progress, err
+		}
+		if row != nil && (opts.After == nil || bytes.Compare(row.Row, opts.After) > 0) {
+			batch = append(batch, row)
+		}
+
+		if len(batch) > 0 && (len(batch) == size || err == io.EOF) {
+			if err := write(batch); err != nil {
+				return progress, err
+			}
+			for _, row := range batch {
+				progress.Rows++
+				progress.Cells += int64(len(row.Cells))
+			}
+			progress.LastRow = batch[len(batch)-1].Row
+			batch = batch[:0]
+			if opts.Progress != nil {
+				opts.Progress(progress)
+			}
+		}
+
+		if err == io.EOF {
+			return progress, nil
+		}
+	}
+}
+
+func (client *HClient) importBatch(tableName string, rows []*DumpRow) error {
+	var timestamps []int64
+	batches := make(map[int64][]*Hbase.BatchMutation)
+	for _, row := range rows {
+		mutations := make(map[int64]*Hbase.BatchMutation)
+		for _, cell := range row.Cells {
+			b, ok := mutations[cell.Timestamp]
+			if !ok {
+				b = &Hbase.BatchMutation{Row: Hbase.Text(row.Row)}
+				mutations[cell.Timestamp] = b
+				if _, ok = batches[cell.Timestamp]; !ok {
+					timestamps = append(timestamps, cell.Timestamp)
+				}
+				batches[cell.Timestamp] = append(batches[cell.Timestamp], b)
+			}
+			b.Mutations = append(b.Mutations, NewMutation(string(cell.Column), cell.Value))
+		}
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	for _, ts := range timestamps {
+		if err := client.MutateRowsTs(tableName, batches[ts], ts, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sdming/goh"
+)
+
+var dumpTestRows = []*goh.DumpRow{
+	{Row: []byte("a"), Cells: []goh.DumpCell{
+		{Column: []byte("cf:x"), Timestamp: 3, Value: []byte("new")},
+		{Column: []byte("cf:x"), Timestamp: 1, Value: []byte("old")},
+	}},
+	{Row: []byte("b\x00\xff"), Cells: []goh.DumpCell{{Column: []byte("cf:y"), Timestamp: 2, Value: []byte{0, 1, 2}}}},
+	{Row: []byte("c"), Cells: []goh.DumpCell{{Column: []byte("cf:x"), Timestamp: 1, Value: []byte{}}}},
+}
+
+func writeTestDump(t *testing.T, w io.Writer, format goh.DumpFormat, rows []*goh.DumpRow, close bool) {
+	d, err := goh.NewDumpWriter(w, format, &goh.DumpHeader{Table: "test", StartRow: []byte("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err = d.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if close {
+		err = d.Close()
+	} else {
+		err = d.Flush()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestDump(r io.Reader) ([]*goh.DumpRow, error) {
+	d, err := goh.NewDumpReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*goh.DumpRow
+	for {
+		row, err := d.Next()
+		if err == io.EOF {
+			return rows, nil
+		} else if err != nil {
+			return rows, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+func isHbaseError(err error, want error) bool {
+	e, ok := err.(*goh.HbaseError)
+	return ok && e.Err == want
+}
+
+func TestDumpRoundTrip(t *testing.T) {
+	for _, format := range []goh.DumpFormat{goh.DumpJSON, goh.DumpBinary} {
+		var buf bytes.Buffer
+		writeTestDump(t, &buf, format, dumpTestRows, true)
+
+		d, err := goh.NewDumpReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Format() != format || d.Header().Table != "test" || string(d.Header().StartRow) != "a" || d.Header().Created == 0 {
+			t.Errorf("format %d: header = %+v", format, d.Header())
+		}
+
+		rows, err := readTestDump(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(rows, dumpTestRows) {
+			t.Errorf("format %d: rows = %v, want %v", format, rows, dumpTestRows)
+		}
+	}
+}
+
+func TestDumpCorrupt(t *testing.T) {
+	for _, format := range []goh.DumpFormat{goh.DumpJSON, goh.DumpBinary} {
+		var buf bytes.Buffer
+		writeTestDump(t, &buf, format, dumpTestRows, true)
+		data := buf.Bytes()
+
+		if _, err := readTestDump(bytes.NewReader(data[:len(data)-3])); !isHbaseError(err, goh.ErrDumpTruncated) {
+			t.Errorf("format %d: cut trailer = %v, want ErrDumpTruncated", format, err)
+		}
+
+		var partial bytes.Buffer
+		writeTestDump(t, &partial, format, dumpTestRows, false)
+		if rows, err := readTestDump(&partial); !isHbaseError(err, goh.ErrDumpTruncated) || len(rows) != len(dumpTestRows) {
+			t.Errorf("format %d: no trailer = %d rows, %v, want ErrDumpTruncated", format, len(rows), err)
+		}
+	}
+
+	var buf bytes.Buffer
+	writeTestDump(t, &buf, goh.DumpBinary, dumpTestRows, true)
+	data := buf.Bytes()
+	i := bytes.Index(data, []byte("old"))
+	data[i] = 'x'
+	if _, err := readTestDump(bytes.NewReader(data)); !isHbaseError(err, goh.ErrDumpChecksum) {
+		t.Errorf("changed value = %v, want ErrDumpChecksum", err)
+	}
+
+	huge := append([]byte("GOHDUMP\x01H"), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01)
+	if _, err := goh.NewDumpReader(bytes.NewReader(huge)); !isHbaseError(err, goh.ErrDumpFormat) {
+		t.Errorf("huge record length = %v, want ErrDumpFormat", err)
+	}
+
+	if _, err := goh.NewDumpReader(bytes.NewReader([]byte("not a dump\n"))); !isHbaseError(err, goh.ErrDumpFormat) {
+		t.Errorf("not a dump = %v, want ErrDumpFormat", err)
+	}
+}
+
+func TestResumeDump(t *testing.T) {
+	for _, format := range []goh.DumpFormat{goh.DumpJSON, goh.DumpBinary} {
+		name := filepath.Join(t.TempDir(), "dump")
+		f, err := os.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		var buf bytes.Buffer
+		writeTestDump(t, &buf, format, dumpTestRows[:2], false)
+		complete := buf.Len()
+		writeTestDump(t, &buf, format, dumpTestRows, false)
+		f.Write(buf.Bytes()[:complete])
+		f.Write(buf.Bytes()[complete+30 : complete+40]) // part of a record
+
+		w, header, last, done, err := goh.ResumeDump(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if done || string(last) != string(dumpTestRows[1].Row) || header.Table != "test" {
+			t.Errorf("format %d: ResumeDump = %q, %v, %+v", format, last, done, header)
+		}
+		if p := w.Progress(); p.Rows != 2 || p.Cells != 3 {
+			t.Errorf("format %d: progress = %+v", format, p)
+		}
+		if err = w.WriteRow(dumpTestRows[2]); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		f.Seek(0, io.SeekStart)
+		rows, err := readTestDump(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(rows, dumpTestRows) {
+			t.Errorf("format %d: resumed rows = %v", format, rows)
+		}
+
+		if _, _, _, done, err = goh.ResumeDump(f); err != nil || !done {
+			t.Errorf("format %d: ResumeDump of a complete dump = %v, %v", format, done, err)
+		}
+	}
+}
+
+func TestResumeDumpTrailer(t *testing.T) {
+	for _, format := range []goh.DumpFormat{goh.DumpJSON, goh.DumpBinary} {
+		f, err := os.Create(filepath.Join(t.TempDir(), "dump"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		// the trailer of the dump of three rows after two of them
+		var two, three, closed bytes.Buffer
+		writeTestDump(t, &two, format, dumpTestRows[:2], false)
+		writeTestDump(t, &three, format, dumpTestRows, false)
+		writeTestDump(t, &closed, format, dumpTestRows, true)
+		f.Write(two.Bytes())
+		f.Write(closed.Bytes()[three.Len():])
+
+		w, _, last, done, err := goh.ResumeDump(f)
+		if err != nil || done || string(last) != string(dumpTestRows[1].Row) {
+			t.Fatalf("format %d: ResumeDump = %q, %v, %v", format, last, done, err)
+		}
+		if err = w.WriteRow(dumpTestRows[2]); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		f.Seek(0, io.SeekStart)
+		rows, err := readTestDump(f)
+		if err != nil || !reflect.DeepEqual(rows, dumpTestRows) {
+			t.Errorf("format %d: resumed rows = %v, %v", format, rows, err)
+		}
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "a", "cf:x", "second", 5)
+	handler.put("test", "a", "cf:y", "other", 7)
+	handler.put("test", "c", "cf:x", "third", 9)
+
+	var progress []goh.DumpProgress
+	var buf bytes.Buffer
+	for _, format := range []goh.DumpFormat{goh.DumpJSON, goh.DumpBinary} {
+		buf.Reset()
+		header, err := client.ExportHeader("test", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := goh.NewDumpWriter(&buf, format, header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := &goh.ExportOptions{Caching: 2, Progress: func(p goh.DumpProgress) { progress = append(progress, p) }}
+		if err = client.Export("test", w, opts); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if p := w.Progress(); p.Rows != 3 || p.Cells != 6 {
+			t.Errorf("format %d: export progress = %+v", format, p)
+		}
+
+		r, err := goh.NewDumpReader(bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.Header().Families) != 1 || r.Header().Families[0].Name != "cf:" {
+			t.Errorf("format %d: families = %v", format, r.Header().Families)
+		}
+
+		table := "copy" + string(rune('0'+format))
+		p, err := client.Import(table, r, &goh.ImportOptions{BatchSize: 2, CreateTable: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Rows != 3 || p.Cells != 6 || string(p.LastRow) != "e" {
+			t.Errorf("format %d: import progress = %+v", format, p)
+		}
+
+		cells, err := client.GetVer(table, []byte("a"), "cf:x", 3, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cells) != 2 || string(cells[0].Value) != "second" || cells[0].Timestamp != 5 || cells[1].Timestamp != 1 {
+			t.Errorf("format %d: imported versions = %v", format, cells)
+		}
+		cells, err = client.Get(table, []byte("a"), "cf:y", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cells) != 1 || cells[0].Timestamp != 7 {
+			t.Errorf("format %d: imported cf:y = %v", format, cells)
+		}
+	}
+
+	if len(progress) != 4 || progress[0].Rows != 2 || progress[1].Rows != 3 {
+		t.Errorf("export progress = %+v", progress)
+	}
+}
+
+func TestImportAfter(t *testing.T) {
+	_, client := newGatewayClient(t)
+
+	var buf bytes.Buffer
+	writeTestDump(t, &buf, goh.DumpBinary, dumpTestRows, true)
+	r, err := goh.NewDumpReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := client.Import("test", r, &goh.ImportOptions{After: []byte("a")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Rows != 2 {
+		t.Errorf("import progress = %+v", p)
+	}
+
+	cells, err := client.Get("test", []byte("a"), "cf:x", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 1 || string(cells[0].Value) != "value a" {
+		t.Errorf("skipped row a = %v", cells)
+	}
+	cells, err = client.Get("test", []byte("b\x00\xff"), "cf:y", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 1 || cells[0].Timestamp != 2 {
+		t.Errorf("imported row = %v", cells)
+	}
+}
+
+func TestTHBaseImport(t *testing.T) {
+	_, client := newTHBaseTestClient(t)
+
+	var buf bytes.Buffer
+	header := &goh.DumpHeader{Table: "test", Families: []*goh.ColumnDescriptor{{Name: "cf:", MaxVersions: 3, TimeToLive: -1}}}
+	w, err := goh.NewDumpWriter(&buf, goh.DumpBinary, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range dumpTestRows {
+		if err = w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := goh.NewDumpReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := client.Import("copy", r, &goh.ImportOptions{CreateTable: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Rows != 3 || p.Cells != 4 || string(p.LastRow) != "c" {
+		t.Errorf("import progress = %+v", p)
+	}
+
+	desc, err := client.GetTableDescriptor("copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(desc.Families) != 1 || desc.Families[0].Name != "cf" || desc.Families[0].MaxVersions != 3 {
+		t.Errorf("families = %+v", desc.Families)
+	}
+
+	get := goh.NewTGet([]byte("a"), "cf:x")
+	get.MaxVersions = 3
+	result, err := client.Get("copy", get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ColumnValues) != 2 || result.ColumnValues[0].Timestamp != 3 || result.ColumnValues[1].Timestamp != 1 {
+		t.Errorf("imported versions = %+v", result.ColumnValues)
+	}
+}
@@ -7,7 +7,44 @@ package goh
 
 import (
 	"bytes"
+	"errors"
 	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/Hbase2"
+)
+
+var (
+	// ErrNotHeaderProtocol is returned by header methods of a client that does not use THeaderProtocol
+	ErrNotHeaderProtocol = errors.New("goh: client does not use THeaderProtocol")
+
+	// ErrAsyncNotSupported is returned by asynchronous calls on a transport that cannot pipeline requests, such as http or theader
+	ErrAsyncNotSupported = errors.New("goh: transport does not support asynchronous calls")
+
+	// ErrReconnectBackoff is returned by calls on a broken client before its next reconnect attempt is due
+	ErrReconnectBackoff = errors.New("goh: connection is broken, waiting to reconnect")
+
+	// ErrNotSupported is returned when the server does not have a method and the call can not be emulated
+	ErrNotSupported = errors.New("goh: not supported by the server")
+
+	// ErrNoSuchFamily is returned when a column family that was asked for is not in the table
+	ErrNoSuchFamily = errors.New("goh: no such column family")
+
+	// ErrPoolClosed is returned by Pool.Get after the pool is closed
+	ErrPoolClosed = errors.New("goh: pool is closed")
+
+	// ErrTableExists is returned by CloneSchema when the target table exists, and by ApplySchema when a table to create exists
+	ErrTableExists = errors.New("goh: table exists")
+
+	// ErrDestructiveChange is returned by ApplySchema for a plan that drops tables when recreating them is not allowed
+	ErrDestructiveChange = errors.New("goh: schema change needs tables to be dropped and created again")
+
+	// ErrDumpFormat is returned when a dump is not in a known format or a record can not be decoded
+	ErrDumpFormat = errors.New("goh: invalid dump format")
+
+	// ErrDumpChecksum is returned when a record of a dump does not match its checksum, or the trailer does not match the rows read
+	ErrDumpChecksum = errors.New("goh: dump checksum mismatch")
+
+	// ErrDumpTruncated is returned when a dump ends before its trailer
+	ErrDumpTruncated = errors.New("goh: dump is truncated")
 )
 
 /*
@@ -77,3 +114,21 @@ func checkHbaseArgError(io *Hbase.IOError, arg *Hbase.IllegalArgument, err error
 	}
 	return nil
 }
+
+/*
+checkTHBaseError wrap thrift2 exceptions in HbaseError
+*/
+func checkTHBaseError(io *Hbase2.TIOError, arg *Hbase2.TIllegalArgument, err error) error {
+	if io == nil && arg == nil && err == nil {
+		return nil
+	}
+
+	e := newHbaseError(nil, nil, err)
+	if io != nil {
+		e.IOErr = &Hbase.IOError{Message: io.Message}
+	}
+	if arg != nil {
+		e.ArgErr = &Hbase.IllegalArgument{Message: arg.Message}
+	}
+	return e
+}
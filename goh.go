@@ -12,13 +12,34 @@ import (
 )
 
 /*
-state
+ConnState is the state of the connection of a client
 */
+type ConnState int
+
 const (
-	stateDefault = iota // default
-	stateOpen           // open
+	StateClosed     ConnState = iota // not opened yet, or closed by Close
+	StateConnecting                  // opening the transport
+	StateOpen                        // open
+	StateBroken                      // a transport error occurred, the next call reconnects
 )
 
+/*
+String
+*/
+func (s ConnState) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateConnecting:
+		return "connecting"
+	case StateOpen:
+		return "open"
+	case StateBroken:
+		return "broken"
+	}
+	return fmt.Sprint("ConnState(", int(s), ")")
+}
+
 /*
 Protocol
 */
@@ -29,6 +50,7 @@ const (
 	TDenseProtocol             // "debug"
 	TJSONProtocol              // "json"
 	TSimpleJSONProtocol        // "simplejson"
+	THeaderProtocol            // "header"
 )
 
 /*
@@ -63,6 +85,8 @@ func newProtocolFactory(protocol int) (thrift.TProtocolFactory, error) {
 		return thrift.NewTJSONProtocolFactory(), nil
 	case TSimpleJSONProtocol:
 		return thrift.NewTSimpleJSONProtocolFactory(), nil
+	case THeaderProtocol:
+		return thrift.NewTHeaderProtocolFactory(), nil
 	}
 
 	return nil, errors.New(fmt.Sprint("invalid protocol:", protocol))
@@ -5,6 +5,8 @@ import (
 	"github.com/sdming/goh/thrift" // will replace it later
 	"net"
 	"net/url"
+	"sync"
+	"time"
 	//"thrift"
 )
 
@@ -19,7 +21,23 @@ type HClient struct {
 	Trans           thrift.TTransport
 	ProtocolFactory thrift.TProtocolFactory
 	hbase           *Hbase.HbaseClient
-	state           int //
+	pipe            *pipeline
+
+	dial      func() (thrift.TTransport, *transportMonitor, error) // creates a new transport to the server
+	monitor   *transportMonitor
+	mu        sync.Mutex
+	state     ConnState
+	used      bool // Trans has been opened, reconnecting needs a new one
+	listeners []func(from, to ConnState)
+	changes   []stateChange
+	methods   map[string]bool   // optional methods known to be supported or not
+	cache     *rowCache         // nil unless EnableRowCache was called
+	headers   map[string]string // headers set with SetHeader, set again on the transport of a reconnect
+
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	backoff     time.Duration
+	nextAttempt time.Time
 }
 
 /*
@@ -32,12 +50,9 @@ func NewHttpClient(rawurl string, protocol int) (client *HClient, err error) {
 		return
 	}
 
-	trans, err := thrift.NewTHttpClient(parsedUrl.String())
-	if err != nil {
-		return
-	}
-
-	return newClient(parsedUrl.String(), protocol, trans)
+	return newClient(parsedUrl.String(), protocol, func() (thrift.TTransport, error) {
+		return thrift.NewTHttpPostClient(parsedUrl.String())
+	})
 }
 
 /*
@@ -45,27 +60,37 @@ NewTcpClient return a base tcp client instance
 
 */
 func NewTcpClient(rawaddr string, protocol int, framed bool) (client *HClient, err error) {
-	tcpAddr, err := net.ResolveTCPAddr("tcp", rawaddr)
-	if err != nil {
-		return
-	}
+	return NewTcpClientTimeout(rawaddr, protocol, framed, 0)
+}
+
+/*
+NewTcpClientTimeout return a base tcp client instance whose reads and writes fail after timeout,
+a call that times out leaves the connection broken and the next call reconnects
 
-	var trans thrift.TTransport
-	trans, err = thrift.NewTNonblockingSocketAddr(tcpAddr)
+*/
+func NewTcpClientTimeout(rawaddr string, protocol int, framed bool, timeout time.Duration) (client *HClient, err error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", rawaddr)
 	if err != nil {
 		return
 	}
-	if framed {
-		trans = thrift.NewTFramedTransport(trans)
-	}
 
-	return newClient(tcpAddr.String(), protocol, trans)
+	return newClient(tcpAddr.String(), protocol, func() (thrift.TTransport, error) {
+		trans, err := thrift.NewTNonblockingSocketAddrTimeout(tcpAddr, int64(timeout))
+		if err != nil {
+			return nil, err
+		}
+		if framed && protocol != THeaderProtocol {
+			// THeader frames every message itself
+			return thrift.NewTFramedTransport(trans), nil
+		}
+		return trans, nil
+	})
 }
 
 /*
 newClient create a new hbase client 
 */
-func newClient(addr string, protocol int, trans thrift.TTransport) (*HClient, error) {
+func newClient(addr string, protocol int, dial func() (thrift.TTransport, error)) (*HClient, error) {
 	var client *HClient
 
 	protocolFactory, err := newProtocolFactory(protocol)
@@ -77,9 +102,28 @@ func newClient(addr string, protocol int, trans thrift.TTransport) (*HClient, er
 		addr:            addr,
 		Protocol:        protocol,
 		ProtocolFactory: protocolFactory,
-		Trans:           trans,
-		hbase:           Hbase.NewHbaseClientFactory(trans, protocolFactory),
+		minBackoff:      defaultMinBackoff,
+		maxBackoff:      defaultMaxBackoff,
 	}
+	client.dial = func() (thrift.TTransport, *transportMonitor, error) {
+		trans, err := dial()
+		if err != nil {
+			return nil, nil, err
+		}
+		monitor := newTransportMonitor(trans)
+		trans = monitor
+		if protocol == THeaderProtocol {
+			// share one header transport between all protocols of the client
+			trans = thrift.NewTHeaderTransport(trans)
+		}
+		return trans, monitor, nil
+	}
+
+	trans, monitor, err := client.dial()
+	if err != nil {
+		return nil, err
+	}
+	client.setTransport(trans, monitor)
 
 	// if err = client.Open(); err != nil {
 	// 	return nil, err
@@ -89,31 +133,161 @@ func newClient(addr string, protocol int, trans thrift.TTransport) (*HClient, er
 }
 
 /*
-Open connection
+NewConnection open another connection to the server of client with the same protocol, service name and known capabilities,
+a client must not be used by several goroutines at once, each of them needs its own connection
 */
-func (client *HClient) Open() error {
-	if client.state == stateDefault {
-		if err := client.Trans.Open(); err != nil {
-			return err
+func (client *HClient) NewConnection() (*HClient, error) {
+	client.mu.Lock()
+	c := &HClient{
+		addr:            client.addr,
+		Protocol:        client.Protocol,
+		ProtocolFactory: client.ProtocolFactory,
+		dial:            client.dial,
+		minBackoff:      client.minBackoff,
+		maxBackoff:      client.maxBackoff,
+	}
+	if client.methods != nil {
+		c.methods = make(map[string]bool, len(client.methods))
+		for method, supported := range client.methods {
+			c.methods[method] = supported
 		}
-		client.state = stateOpen
 	}
-	return nil
+	client.mu.Unlock()
+
+	trans, monitor, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	c.setTransport(trans, monitor)
+	if err = c.Open(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+/*
+Open connection, a broken connection is reopened at once
+*/
+func (client *HClient) Open() error {
+	client.mu.Lock()
+	if client.state == StateOpen || client.state == StateConnecting {
+		client.mu.Unlock()
+		return nil
+	}
+	from := client.state
+	err := client.connect()
+	if err != nil && from == StateClosed {
+		client.setState(StateClosed)
+	}
+	client.mu.Unlock()
+	client.notify()
+	return err
 }
 
 /*
 Close connection
 */
 func (client *HClient) Close() error {
-	if client.state == stateOpen {
-		if err := client.Trans.Close(); err != nil {
-			return err
-		}
-		client.state = stateDefault
+	client.mu.Lock()
+	from := client.state
+	if from == StateClosed {
+		client.mu.Unlock()
+		return nil
+	}
+	client.setState(StateClosed)
+	client.mu.Unlock()
+	client.notify()
+
+	if from != StateConnecting {
+		return client.Trans.Close()
+	}
+	return nil
+}
+
+/*
+SetServiceName make the client call the service registered as serviceName on a multiplexed server,
+an empty name switches back to plain calls
+*/
+func (client *HClient) SetServiceName(serviceName string) {
+	protocolFactory := client.ProtocolFactory
+	if multiplexed, ok := protocolFactory.(*thrift.TMultiplexedProtocolFactory); ok {
+		protocolFactory = multiplexed.Factory()
+	}
+	if serviceName != "" {
+		protocolFactory = thrift.NewTMultiplexedProtocolFactory(protocolFactory, serviceName)
+	}
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.ProtocolFactory = protocolFactory
+	client.setTransport(client.Trans, client.monitor)
+}
+
+/*
+HeaderTransport return the THeader transport of the client, or nil if the client does not use THeaderProtocol
+*/
+func (client *HClient) HeaderTransport() *thrift.THeaderTransport {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.headerTransport()
+}
+
+/*
+headerTransport is HeaderTransport with mu held
+*/
+func (client *HClient) headerTransport() *thrift.THeaderTransport {
+	if trans, ok := client.Trans.(*thrift.THeaderTransport); ok {
+		return trans
+	}
+	return nil
+}
+
+/*
+SetHeader set a header sent with every following request, such as a request id, it is kept when the client reconnects
+*/
+func (client *HClient) SetHeader(key, value string) error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	trans := client.headerTransport()
+	if trans == nil {
+		return ErrNotHeaderProtocol
+	}
+	if client.headers == nil {
+		client.headers = make(map[string]string)
+	}
+	client.headers[key] = value
+	trans.SetWriteHeader(key, value)
+	return nil
+}
+
+/*
+DeleteHeader stop sending a header set by SetHeader
+*/
+func (client *HClient) DeleteHeader(key string) error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	trans := client.headerTransport()
+	if trans == nil {
+		return ErrNotHeaderProtocol
 	}
+	delete(client.headers, key)
+	trans.DeleteWriteHeader(key)
 	return nil
 }
 
+/*
+ResponseHeaders return the headers of the last response
+*/
+func (client *HClient) ResponseHeaders() map[string]string {
+	trans := client.HeaderTransport()
+	if trans == nil {
+		return nil
+	}
+	return trans.ReadHeaders()
+}
+
 /**
  * Brings a table on-line (enables it)
  * 
@@ -121,7 +295,11 @@ func (client *HClient) Close() error {
  *  - TableName: name of the table
  */
 func (client *HClient) EnableTable(tableName string) error {
-	return checkHbaseError(client.hbase.EnableTable(Hbase.Bytes(tableName)))
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.EnableTable(Hbase.Bytes(tableName)))
 }
 
 /**
@@ -132,7 +310,11 @@ func (client *HClient) EnableTable(tableName string) error {
  *  - TableName: name of the table
  */
 func (client *HClient) DisableTable(tableName string) (err error) {
-	return checkHbaseError(client.hbase.DisableTable(Hbase.Bytes(tableName)))
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DisableTable(Hbase.Bytes(tableName)))
 }
 
 /**
@@ -142,8 +324,12 @@ func (client *HClient) DisableTable(tableName string) (err error) {
  *  - TableName: name of the table to check
  */
 func (client *HClient) IsTableEnabled(tableName string) (ret bool, err error) {
-	ret, io, e1 := client.hbase.IsTableEnabled(Hbase.Bytes(tableName))
-	err = checkHbaseError(io, e1)
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.IsTableEnabled(Hbase.Bytes(tableName))
+	err = client.checkHbaseError(io, e1)
 	return
 }
 
@@ -152,7 +338,11 @@ func (client *HClient) IsTableEnabled(tableName string) (ret bool, err error) {
  *  - TableNameOrRegionName
  */
 func (client *HClient) Compact(tableNameOrRegionName string) (err error) {
-	return checkHbaseError(client.hbase.Compact(Hbase.Bytes(tableNameOrRegionName)))
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.Compact(Hbase.Bytes(tableNameOrRegionName)))
 }
 
 /**
@@ -160,7 +350,11 @@ func (client *HClient) Compact(tableNameOrRegionName string) (err error) {
  *  - TableNameOrRegionName
  */
 func (client *HClient) MajorCompact(tableNameOrRegionName string) (err error) {
-	return checkHbaseError(client.hbase.MajorCompact(Hbase.Bytes(tableNameOrRegionName)))
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.MajorCompact(Hbase.Bytes(tableNameOrRegionName)))
 }
 
 /**
@@ -172,8 +366,12 @@ func (client *HClient) MajorCompact(tableNameOrRegionName string) (err error) {
  *  - TableName: table name
  */
 func (client *HClient) GetTableNames() (tables []string, err error) {
-	ret, io, e1 := client.hbase.GetTableNames()
-	if err = checkHbaseError(io, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetTableNames()
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -190,8 +388,12 @@ func (client *HClient) GetTableNames() (tables []string, err error) {
  *  - TableName: table name
  */
 func (client *HClient) GetColumnDescriptors(tableName string) (columns map[string]*ColumnDescriptor, err error) {
-	ret, io, e1 := client.hbase.GetColumnDescriptors(Hbase.Text(tableName))
-	if err = checkHbaseError(io, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetColumnDescriptors(Hbase.Text(tableName))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 	columns = toColMap(ret)
@@ -207,8 +409,12 @@ func (client *HClient) GetColumnDescriptors(tableName string) (columns map[strin
  *  - TableName: table name
  */
 func (client *HClient) GetTableRegions(tableName string) (regions []*TRegionInfo, err error) {
-	ret, io, e1 := client.hbase.GetTableRegions(Hbase.Text(tableName))
-	if err = checkHbaseError(io, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetTableRegions(Hbase.Text(tableName))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -232,8 +438,12 @@ func (client *HClient) GetTableRegions(tableName string) (regions []*TRegionInfo
  */
 func (client *HClient) CreateTable(tableName string, columnFamilies []*ColumnDescriptor) (exists bool, err error) {
 	columns := toHbaseColList(columnFamilies)
-	io, ia, ex, e1 := client.hbase.CreateTable(Hbase.Text(tableName), columns)
-	if err = checkHbaseArgError(io, ia, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	io, ia, ex, e1 := hbase.CreateTable(Hbase.Text(tableName), columns)
+	if err = client.checkHbaseArgError(io, ia, e1); err != nil {
 		return
 	}
 	exists = (ex != nil)
@@ -250,7 +460,12 @@ func (client *HClient) CreateTable(tableName string, columnFamilies []*ColumnDes
  *  - TableName: name of table to delete
  */
 func (client *HClient) DeleteTable(tableName string) (err error) {
-	return checkHbaseError(client.hbase.DeleteTable(Hbase.Text(tableName)))
+	defer client.invalidateTable(tableName)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DeleteTable(Hbase.Text(tableName)))
 }
 
 /**
@@ -265,9 +480,13 @@ func (client *HClient) DeleteTable(tableName string) (err error) {
  *  - Column: column name
  *  - Attributes: Get attributes
  */
-func (client *HClient) Get(tableName string, row []byte, column string, attributes map[string]string) (data []*Hbase.TCell, err error) {
-	ret, io, e1 := client.hbase.Get(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) Get(tableName string, row []byte, column string, attributes map[string][]byte) (data []*Hbase.TCell, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.Get(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -288,9 +507,13 @@ func (client *HClient) Get(tableName string, row []byte, column string, attribut
  *  - NumVersions: number of versions to retrieve
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetVer(tableName string, row []byte, column string, numVersions int32, attributes map[string]string) (data []*Hbase.TCell, err error) {
-	ret, io, e1 := client.hbase.GetVer(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), numVersions, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetVer(tableName string, row []byte, column string, numVersions int32, attributes map[string][]byte) (data []*Hbase.TCell, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetVer(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), numVersions, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -313,9 +536,13 @@ func (client *HClient) GetVer(tableName string, row []byte, column string, numVe
  *  - NumVersions: number of versions to retrieve
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetVerTs(tableName string, row []byte, column string, timestamp int64, numVersions int32, attributes map[string]string) (data []*Hbase.TCell, err error) {
-	ret, io, e1 := client.hbase.GetVerTs(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), timestamp, numVersions, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetVerTs(tableName string, row []byte, column string, timestamp int64, numVersions int32, attributes map[string][]byte) (data []*Hbase.TCell, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetVerTs(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), timestamp, numVersions, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -334,14 +561,15 @@ func (client *HClient) GetVerTs(tableName string, row []byte, column string, tim
  *  - Row: row key
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRow(tableName string, row []byte, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRow(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
-		return
-	}
-
-	data = ret
-	return
+func (client *HClient) GetRow(tableName string, row []byte, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	return client.cachedRow(tableName, row, nil, attributes, func() ([]*Hbase.TRowResult, error) {
+		hbase, err := client.conn()
+		if err != nil {
+			return nil, err
+		}
+		ret, io, e1 := hbase.GetRow(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextMap(attributes))
+		return ret, client.checkHbaseError(io, e1)
+	})
 }
 
 /**
@@ -356,14 +584,15 @@ func (client *HClient) GetRow(tableName string, row []byte, attributes map[strin
  *  - Columns: List of columns to return, null for all columns
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowWithColumns(tableName string, row []byte, columns []string, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRowWithColumns(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextList(columns), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
-		return
-	}
-
-	data = ret
-	return
+func (client *HClient) GetRowWithColumns(tableName string, row []byte, columns []string, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	return client.cachedRow(tableName, row, columns, attributes, func() ([]*Hbase.TRowResult, error) {
+		hbase, err := client.conn()
+		if err != nil {
+			return nil, err
+		}
+		ret, io, e1 := hbase.GetRowWithColumns(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return ret, client.checkHbaseError(io, e1)
+	})
 }
 
 /**
@@ -378,9 +607,13 @@ func (client *HClient) GetRowWithColumns(tableName string, row []byte, columns [
  *  - Timestamp: timestamp
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowTs(tableName string, row []byte, timestamp int64, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRowTs(Hbase.Text(tableName), Hbase.Text(row), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRowTs(tableName string, row []byte, timestamp int64, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRowTs(Hbase.Text(tableName), Hbase.Text(row), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -401,9 +634,13 @@ func (client *HClient) GetRowTs(tableName string, row []byte, timestamp int64, a
  *  - Timestamp
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowWithColumnsTs(tableName string, row []byte, columns []string, timestamp int64, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRowWithColumnsTs(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRowWithColumnsTs(tableName string, row []byte, columns []string, timestamp int64, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRowWithColumnsTs(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -422,9 +659,13 @@ func (client *HClient) GetRowWithColumnsTs(tableName string, row []byte, columns
  *  - Rows: row keys
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRows(tableName string, rows [][]byte, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRows(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRows(tableName string, rows [][]byte, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRows(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -444,13 +685,13 @@ func (client *HClient) GetRows(tableName string, rows [][]byte, attributes map[s
  *  - Columns: List of columns to return, null for all columns
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowsWithColumns(tableName string, rows [][]byte, columns []string, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	if err = client.Open(); err != nil {
+func (client *HClient) GetRowsWithColumns(tableName string, rows [][]byte, columns []string, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
 		return
 	}
-
-	ret, io, e1 := client.hbase.GetRowsWithColumns(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextList(columns), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+	ret, io, e1 := hbase.GetRowsWithColumns(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -470,9 +711,13 @@ func (client *HClient) GetRowsWithColumns(tableName string, rows [][]byte, colum
  *  - Timestamp: timestamp
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowsTs(tableName string, rows [][]byte, timestamp int64, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRowsTs(Hbase.Text(tableName), toHbaseTextListFromByte(rows), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRowsTs(tableName string, rows [][]byte, timestamp int64, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRowsTs(Hbase.Text(tableName), toHbaseTextListFromByte(rows), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -493,9 +738,13 @@ func (client *HClient) GetRowsTs(tableName string, rows [][]byte, timestamp int6
  *  - Timestamp
  *  - Attributes: Get attributes
  */
-func (client *HClient) GetRowsWithColumnsTs(tableName string, rows [][]byte, columns []string, timestamp int64, attributes map[string]string) (data []*Hbase.TRowResult, err error) {
-	ret, io, e1 := client.hbase.GetRowsWithColumnsTs(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRowsWithColumnsTs(tableName string, rows [][]byte, columns []string, timestamp int64, attributes map[string][]byte) (data []*Hbase.TRowResult, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRowsWithColumnsTs(Hbase.Text(tableName), toHbaseTextListFromByte(rows), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -515,8 +764,13 @@ func (client *HClient) GetRowsWithColumnsTs(tableName string, rows [][]byte, col
  *  - Mutations: list of mutation commands
  *  - Attributes: Mutation attributes
  */
-func (client *HClient) MutateRow(tableName string, row []byte, mutations []*Hbase.Mutation, attributes map[string]string) error {
-	return checkHbaseArgError(client.hbase.MutateRow(Hbase.Text(tableName), Hbase.Text(row), mutations, toHbaseTextMap(attributes)))
+func (client *HClient) MutateRow(tableName string, row []byte, mutations []*Hbase.Mutation, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseArgError(hbase.MutateRow(Hbase.Text(tableName), Hbase.Text(row), mutations, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -532,8 +786,13 @@ func (client *HClient) MutateRow(tableName string, row []byte, mutations []*Hbas
  *  - Timestamp: timestamp
  *  - Attributes: Mutation attributes
  */
-func (client *HClient) MutateRowTs(tableName string, row []byte, mutations []*Hbase.Mutation, timestamp int64, attributes map[string]string) error {
-	return checkHbaseArgError(client.hbase.MutateRowTs(Hbase.Text(tableName), Hbase.Text(row), mutations, timestamp, toHbaseTextMap(attributes)))
+func (client *HClient) MutateRowTs(tableName string, row []byte, mutations []*Hbase.Mutation, timestamp int64, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseArgError(hbase.MutateRowTs(Hbase.Text(tableName), Hbase.Text(row), mutations, timestamp, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -547,8 +806,13 @@ func (client *HClient) MutateRowTs(tableName string, row []byte, mutations []*Hb
  *  - RowBatches: list of row batches
  *  - Attributes: Mutation attributes
  */
-func (client *HClient) MutateRows(tableName string, rowBatches []*Hbase.BatchMutation, attributes map[string]string) error {
-	return checkHbaseArgError(client.hbase.MutateRows(Hbase.Text(tableName), rowBatches, toHbaseTextMap(attributes)))
+func (client *HClient) MutateRows(tableName string, rowBatches []*Hbase.BatchMutation, attributes map[string][]byte) error {
+	defer client.invalidateBatches(tableName, rowBatches)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseArgError(hbase.MutateRows(Hbase.Text(tableName), rowBatches, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -563,8 +827,13 @@ func (client *HClient) MutateRows(tableName string, rowBatches []*Hbase.BatchMut
  *  - Timestamp: timestamp
  *  - Attributes: Mutation attributes
  */
-func (client *HClient) MutateRowsTs(tableName string, rowBatches []*Hbase.BatchMutation, timestamp int64, attributes map[string]string) error {
-	return checkHbaseArgError(client.hbase.MutateRowsTs(Hbase.Text(tableName), rowBatches, timestamp, toHbaseTextMap(attributes)))
+func (client *HClient) MutateRowsTs(tableName string, rowBatches []*Hbase.BatchMutation, timestamp int64, attributes map[string][]byte) error {
+	defer client.invalidateBatches(tableName, rowBatches)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseArgError(hbase.MutateRowsTs(Hbase.Text(tableName), rowBatches, timestamp, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -577,8 +846,13 @@ func (client *HClient) MutateRowsTs(tableName string, rowBatches []*Hbase.BatchM
  *  - Value: amount to increment by
  */
 func (client *HClient) AtomicIncrement(tableName string, row []byte, column string, value int64) (v int64, err error) {
-	ret, io, ia, e1 := client.hbase.AtomicIncrement(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), value)
-	if err = checkHbaseArgError(io, ia, e1); err != nil {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, ia, e1 := hbase.AtomicIncrement(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), value)
+	if err = client.checkHbaseArgError(io, ia, e1); err != nil {
 		return
 	}
 
@@ -595,8 +869,13 @@ func (client *HClient) AtomicIncrement(tableName string, row []byte, column stri
  *  - Column: name of column whose value is to be deleted
  *  - Attributes: Delete attributes
  */
-func (client *HClient) DeleteAll(tableName string, row []byte, column string, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAll(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), toHbaseTextMap(attributes)))
+func (client *HClient) DeleteAll(tableName string, row []byte, column string, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DeleteAll(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), toHbaseTextMap(attributes)))
 }
 
 /**
@@ -610,8 +889,13 @@ func (client *HClient) DeleteAll(tableName string, row []byte, column string, at
  *  - Timestamp: timestamp
  *  - Attributes: Delete attributes
  */
-func (client *HClient) DeleteAllTs(tableName string, row []byte, column string, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllTs(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), timestamp, toHbaseTextMap(attributes)))
+func (client *HClient) DeleteAllTs(tableName string, row []byte, column string, timestamp int64, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DeleteAllTs(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), timestamp, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -622,8 +906,13 @@ func (client *HClient) DeleteAllTs(tableName string, row []byte, column string,
  *  - Row: key of the row to be completely deleted.
  *  - Attributes: Delete attributes
  */
-func (client *HClient) DeleteAllRow(tableName string, row []byte, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllRow(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextMap(attributes)))
+func (client *HClient) DeleteAllRow(tableName string, row []byte, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DeleteAllRow(Hbase.Text(tableName), Hbase.Text(row), toHbaseTextMap(attributes)))
 }
 
 /**
@@ -636,7 +925,18 @@ func (client *HClient) DeleteAllRow(tableName string, row []byte, attributes map
  *  - Increment: The single increment to apply
  */
 func (client *HClient) Increment(increment *Hbase.TIncrement) error {
-	return checkHbaseError(client.hbase.Increment(increment))
+	defer client.invalidateIncrements([]*Hbase.TIncrement{increment})
+	if client.supports("increment") {
+		hbase, err := client.conn()
+		if err != nil {
+			return err
+		}
+		err = client.checkHbaseError(hbase.Increment(increment))
+		if !client.unsupported("increment", err) {
+			return err
+		}
+	}
+	return client.increment([]*Hbase.TIncrement{increment})
 }
 
 /**
@@ -644,7 +944,18 @@ func (client *HClient) Increment(increment *Hbase.TIncrement) error {
  *  - Increments: The list of increments
  */
 func (client *HClient) IncrementRows(increments []*Hbase.TIncrement) error {
-	return checkHbaseError(client.hbase.IncrementRows(increments))
+	defer client.invalidateIncrements(increments)
+	if client.supports("incrementRows") {
+		hbase, err := client.conn()
+		if err != nil {
+			return err
+		}
+		err = client.checkHbaseError(hbase.IncrementRows(increments))
+		if !client.unsupported("incrementRows", err) {
+			return err
+		}
+	}
+	return client.increment(increments)
 }
 
 /**
@@ -657,8 +968,13 @@ func (client *HClient) IncrementRows(increments []*Hbase.TIncrement) error {
  *  - Timestamp: timestamp
  *  - Attributes: Delete attributes
  */
-func (client *HClient) DeleteAllRowTs(tableName string, row []byte, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllRowTs(Hbase.Text(tableName), Hbase.Text(row), timestamp, toHbaseTextMap(attributes)))
+func (client *HClient) DeleteAllRowTs(tableName string, row []byte, timestamp int64, attributes map[string][]byte) error {
+	defer client.invalidateRow(tableName, row)
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseError(hbase.DeleteAllRowTs(Hbase.Text(tableName), Hbase.Text(row), timestamp, toHbaseTextMap(attributes)))
 }
 
 /**
@@ -670,9 +986,19 @@ func (client *HClient) DeleteAllRowTs(tableName string, row []byte, timestamp in
  *  - Scan: Scan instance
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpenWithScan(tableName string, scan *TScan, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpenWithScan(Hbase.Text(tableName), toHbaseTScan(scan), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpenWithScan(tableName string, scan *TScan, attributes map[string][]byte) (id int32, err error) {
+	if !client.supports("scannerOpenWithScan") {
+		return client.scannerOpenWithScan(tableName, scan, attributes)
+	}
+
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpenWithScan(Hbase.Text(tableName), toHbaseTScan(scan), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); client.unsupported("scannerOpenWithScan", err) {
+		return client.scannerOpenWithScan(tableName, scan, attributes)
+	} else if err != nil {
 		return
 	}
 
@@ -695,9 +1021,13 @@ func (client *HClient) ScannerOpenWithScan(tableName string, scan *TScan, attrib
  * to pass a regex in the column qualifier.
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpen(tableName string, startRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpen(Hbase.Text(tableName), Hbase.Text(startRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpen(tableName string, startRow []byte, columns []string, attributes map[string][]byte) (id int32, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpen(Hbase.Text(tableName), Hbase.Text(startRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -723,9 +1053,13 @@ func (client *HClient) ScannerOpen(tableName string, startRow []byte, columns []
  * to pass a regex in the column qualifier.
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpenWithStop(tableName string, startRow []byte, stopRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpenWithStop(Hbase.Text(tableName), Hbase.Text(startRow), Hbase.Text(stopRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpenWithStop(tableName string, startRow []byte, stopRow []byte, columns []string, attributes map[string][]byte) (id int32, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpenWithStop(Hbase.Text(tableName), Hbase.Text(startRow), Hbase.Text(stopRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -745,9 +1079,13 @@ func (client *HClient) ScannerOpenWithStop(tableName string, startRow []byte, st
  *  - Columns: the columns you want returned
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpenWithPrefix(tableName string, startAndPrefix []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpenWithPrefix(Hbase.Text(tableName), Hbase.Text(startAndPrefix), toHbaseTextList(columns), toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpenWithPrefix(tableName string, startAndPrefix []byte, columns []string, attributes map[string][]byte) (id int32, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpenWithPrefix(Hbase.Text(tableName), Hbase.Text(startAndPrefix), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -772,9 +1110,13 @@ func (client *HClient) ScannerOpenWithPrefix(tableName string, startAndPrefix []
  *  - Timestamp: timestamp
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpenTs(tableName string, startRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpenTs(Hbase.Text(tableName), Hbase.Text(startRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpenTs(tableName string, startRow []byte, columns []string, timestamp int64, attributes map[string][]byte) (id int32, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpenTs(Hbase.Text(tableName), Hbase.Text(startRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -802,9 +1144,13 @@ func (client *HClient) ScannerOpenTs(tableName string, startRow []byte, columns
  *  - Timestamp: timestamp
  *  - Attributes: Scan attributes
  */
-func (client *HClient) ScannerOpenWithStopTs(tableName string, startRow []byte, stopRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
-	ret, io, e1 := client.hbase.ScannerOpenWithStopTs(Hbase.Text(tableName), Hbase.Text(startRow), Hbase.Text(stopRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) ScannerOpenWithStopTs(tableName string, startRow []byte, stopRow []byte, columns []string, timestamp int64, attributes map[string][]byte) (id int32, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.ScannerOpenWithStopTs(Hbase.Text(tableName), Hbase.Text(startRow), Hbase.Text(stopRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
@@ -828,8 +1174,12 @@ func (client *HClient) ScannerOpenWithStopTs(tableName string, startRow []byte,
  *  - Id: id of a scanner returned by scannerOpen
  */
 func (client *HClient) ScannerGet(id int32) (data []*Hbase.TRowResult, err error) {
-	ret, io, ia, e1 := client.hbase.ScannerGet(Hbase.ScannerID(id))
-	if err = checkHbaseArgError(io, ia, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, ia, e1 := hbase.ScannerGet(Hbase.ScannerID(id))
+	if err = client.checkHbaseArgError(io, ia, e1); err != nil {
 		return
 	}
 
@@ -854,8 +1204,12 @@ func (client *HClient) ScannerGet(id int32) (data []*Hbase.TRowResult, err error
  *  - NbRows: number of results to return
  */
 func (client *HClient) ScannerGetList(id int32, nbRows int32) (data []*Hbase.TRowResult, err error) {
-	ret, io, ia, e1 := client.hbase.ScannerGetList(Hbase.ScannerID(id), nbRows)
-	if err = checkHbaseArgError(io, ia, e1); err != nil {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, ia, e1 := hbase.ScannerGetList(Hbase.ScannerID(id), nbRows)
+	if err = client.checkHbaseArgError(io, ia, e1); err != nil {
 		return
 	}
 
@@ -872,22 +1226,38 @@ func (client *HClient) ScannerGetList(id int32, nbRows int32) (data []*Hbase.TRo
  *  - Id: id of a scanner returned by scannerOpen
  */
 func (client *HClient) ScannerClose(id int32) error {
-	return checkHbaseArgError(client.hbase.ScannerClose(Hbase.ScannerID(id)))
+	hbase, err := client.conn()
+	if err != nil {
+		return err
+	}
+	return client.checkHbaseArgError(hbase.ScannerClose(Hbase.ScannerID(id)))
 }
 
 /**
  * Get the row just before the specified one.
+ * It is emulated with a reversed scan on servers that do not have it, it fails with ErrNotSupported
+ * if the server can not scan in reverse either, row does not exist and a row before it does.
  * 
  * @return value for specified row/column
  * 
  * Parameters:
  *  - TableName: name of table
  *  - Row: row key
- *  - Family: column name
+ *  - Family: column family
  */
-func (client *HClient) GetRowOrBefore(tableName string, row string, family string) (data []*Hbase.TCell, err error) {
-	ret, io, e1 := client.hbase.GetRowOrBefore(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(family))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRowOrBefore(tableName string, row []byte, family []byte) (data []*Hbase.TCell, err error) {
+	if !client.supports("getRowOrBefore") {
+		return client.getRowOrBefore(tableName, row, family)
+	}
+
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRowOrBefore(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(family))
+	if err = client.checkHbaseError(io, e1); client.unsupported("getRowOrBefore", err) {
+		return client.getRowOrBefore(tableName, row, family)
+	} else if err != nil {
 		return
 	}
 
@@ -904,12 +1274,82 @@ func (client *HClient) GetRowOrBefore(tableName string, row string, family strin
  * Parameters:
  *  - Row: row key
  */
-func (client *HClient) GetRegionInfo(row string) (region *TRegionInfo, err error) {
-	ret, io, e1 := client.hbase.GetRegionInfo(Hbase.Text(row))
-	if err = checkHbaseError(io, e1); err != nil {
+func (client *HClient) GetRegionInfo(row []byte) (region *TRegionInfo, err error) {
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.GetRegionInfo(Hbase.Text(row))
+	if err = client.checkHbaseError(io, e1); err != nil {
 		return
 	}
 
 	region = toRegion(ret)
 	return
 }
+
+/**
+ * Appends values to one or more columns within a single row.
+ * 
+ * @return values of columns after the append operation.
+ * 
+ * Parameters:
+ *  - Append: The single append operation to apply
+ */
+func (client *HClient) Append(app *Hbase.TAppend) (data []*Hbase.TCell, err error) {
+	if !client.supports("append") {
+		return nil, newHbaseError(nil, nil, ErrNotSupported)
+	}
+	defer client.invalidateRow(string(app.Table), app.Row)
+
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, e1 := hbase.Append(app)
+	if err = client.checkHbaseError(io, e1); client.unsupported("append", err) {
+		return nil, newHbaseError(nil, nil, ErrNotSupported)
+	} else if err != nil {
+		return
+	}
+
+	data = ret
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the corresponding mutation operation for put.
+ * 
+ * @return true if the new put was executed, false otherwise
+ * 
+ * Parameters:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Column: column name
+ *  - Value: the expected value for the column parameter, if not
+ * provided the check is for the non-existence of the
+ * column in question
+ *  - Mput: mutation for the put
+ *  - Attributes: Mutation attributes
+ */
+func (client *HClient) CheckAndPut(tableName string, row []byte, column string, value []byte, mput *Hbase.Mutation, attributes map[string][]byte) (ok bool, err error) {
+	if !client.supports("checkAndPut") {
+		return false, newHbaseError(nil, nil, ErrNotSupported)
+	}
+	defer client.invalidateRow(tableName, row)
+
+	hbase, err := client.conn()
+	if err != nil {
+		return
+	}
+	ret, io, ia, e1 := hbase.CheckAndPut(Hbase.Text(tableName), Hbase.Text(row), Hbase.Text(column), Hbase.Text(value), mput, toHbaseTextMap(attributes))
+	if err = client.checkHbaseArgError(io, ia, e1); client.unsupported("checkAndPut", err) {
+		return false, newHbaseError(nil, nil, ErrNotSupported)
+	} else if err != nil {
+		return
+	}
+
+	ok = ret
+	return
+}
@@ -0,0 +1,205 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+func newHttpTestServer(t *testing.T, protocol int) (*memHbase, *goh.HClient) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	handler.put("test", "row1", "cf:a", "value1", 1)
+
+	var protocolFactory thrift.TProtocolFactory
+	switch protocol {
+	case goh.TCompactProtocol:
+		protocolFactory = thrift.NewTCompactProtocolFactory()
+	default:
+		protocolFactory = thrift.NewTBinaryProtocolFactoryDefault()
+	}
+
+	server := httptest.NewServer(thrift.NewTHttpServer2(Hbase.NewHbaseProcessor(handler), protocolFactory))
+	t.Cleanup(server.Close)
+
+	client, err := goh.NewHttpClient(server.URL, protocol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return handler, client
+}
+
+func newTcpTestServer(t *testing.T, processor thrift.TProcessor, transportFactory thrift.TTransportFactory, protocolFactory thrift.TProtocolFactory) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverTransport, err := thrift.NewTNonblockingServerSocketListener(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTNonblockingServer4(processor, serverTransport, transportFactory, protocolFactory)
+	go server.Serve()
+	t.Cleanup(func() { server.Stop() })
+	return l.Addr().String()
+}
+
+func TestHttpClient(t *testing.T) {
+	for _, protocol := range []int{goh.TBinaryProtocol, goh.TCompactProtocol} {
+		_, client := newHttpTestServer(t, protocol)
+
+		tables, err := client.GetTableNames()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tables) != 1 || tables[0] != "test" {
+			t.Errorf("GetTableNames = %v", tables)
+		}
+
+		if err = client.MutateRow("test", []byte("row2"), []*Hbase.Mutation{goh.NewMutation("cf:b", []byte("value2"))}, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := client.GetRows("test", [][]byte{[]byte("row1"), []byte("row2")}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 2 {
+			t.Fatalf("GetRows returned %d rows", len(rows))
+		}
+		if v := string(rows[1].Columns["cf:b"].Value); v != "value2" {
+			t.Errorf("cf:b = %q", v)
+		}
+
+		if _, err = client.GetRow("missing", []byte("row1"), nil); err == nil {
+			t.Error("GetRow on a missing table should fail")
+		}
+	}
+}
+
+func TestHttpServerMaxBodySize(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	httpServer := thrift.NewTHttpServer1(Hbase.NewHbaseProcessor(handler))
+	if httpServer.MaxBodySize() != thrift.THTTP_SERVER_DEFAULT_MAX_BODY_SIZE {
+		t.Errorf("MaxBodySize = %d", httpServer.MaxBodySize())
+	}
+	httpServer.SetMaxBodySize(1024)
+	server := httptest.NewServer(httpServer)
+	t.Cleanup(server.Close)
+
+	resp, err := http.Post(server.URL, "application/x-thrift", bytes.NewReader(make([]byte, 2048)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("status of a large body = %d, want %d", resp.StatusCode, http.StatusRequestEntityTooLarge)
+	}
+
+	client, err := goh.NewHttpClient(server.URL, goh.TBinaryProtocol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if err = client.MutateRow("test", []byte("small"), []*Hbase.Mutation{goh.NewMutation("cf:a", []byte("value"))}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.MutateRow("test", []byte("large"), []*Hbase.Mutation{goh.NewMutation("cf:a", make([]byte, 2048))}, nil); err == nil {
+		t.Error("MutateRow with a body over the limit succeeded")
+	}
+}
+
+func TestAppendAndCheckAndPut(t *testing.T) {
+	_, client := newGatewayClient(t)
+
+	cells, err := client.Append(goh.NewAppend("test", []byte("a"), []string{"cf:x", "cf:y"}, [][]byte{[]byte("!"), []byte("new")}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 2 || string(cells[0].Value) != "value a!" || string(cells[1].Value) != "new" {
+		t.Errorf("Append = %v", cells)
+	}
+
+	ok, err := client.CheckAndPut("test", []byte("a"), "cf:z", nil, goh.NewMutation("cf:z", []byte("z1")), nil)
+	if err != nil || !ok {
+		t.Fatalf("CheckAndPut absent = %v, %v", ok, err)
+	}
+	ok, err = client.CheckAndPut("test", []byte("a"), "cf:z", []byte("other"), goh.NewMutation("cf:z", []byte("z2")), nil)
+	if err != nil || ok {
+		t.Fatalf("CheckAndPut mismatch = %v, %v", ok, err)
+	}
+	ok, err = client.CheckAndPut("test", []byte("a"), "cf:z", []byte("z1"), goh.NewMutation("cf:z", []byte("z2")), nil)
+	if err != nil || !ok {
+		t.Fatalf("CheckAndPut match = %v, %v", ok, err)
+	}
+
+	cells, err = client.Get("test", []byte("a"), "cf:z", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 1 || string(cells[0].Value) != "z2" {
+		t.Errorf("Get after CheckAndPut = %v", cells)
+	}
+}
+
+func TestScanOptions(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "c", "cf:y", "y c", 1)
+	handler.put("test", "c", "cf:z", "z c", 1)
+
+	scan := func(scan *goh.TScan) []*Hbase.TRowResult {
+		t.Helper()
+		id, err := client.ScannerOpenWithScan("test", scan, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.ScannerClose(id)
+		rows, err := client.ScannerGetList(id, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rows
+	}
+
+	rows := scan(&goh.TScan{StartRow: []byte("d"), StopRow: []byte("a"), Columns: []string{"cf:x"}, Reversed: true})
+	if len(rows) != 1 || string(rows[0].Row) != "c" {
+		t.Errorf("reversed scan = %v", rows)
+	}
+	rows = scan(&goh.TScan{Reversed: true, Columns: []string{"cf:x"}})
+	if len(rows) != 3 || string(rows[0].Row) != "e" || string(rows[2].Row) != "a" {
+		t.Errorf("reversed scan of the whole table = %v", rows)
+	}
+
+	rows = scan(&goh.TScan{StartRow: []byte("c"), StopRow: []byte("d"), SortColumns: true})
+	if len(rows) != 1 || len(rows[0].Columns) != 0 || len(rows[0].SortedColumns) != 3 {
+		t.Fatalf("scan with sorted columns = %v", rows)
+	}
+	for i, column := range []string{"cf:x", "cf:y", "cf:z"} {
+		if string(rows[0].SortedColumns[i].ColumnName) != column {
+			t.Errorf("sorted column %d = %s, want %s", i, rows[0].SortedColumns[i].ColumnName, column)
+		}
+	}
+
+	rows = scan(&goh.TScan{StartRow: []byte("c"), StopRow: []byte("d"), BatchSize: 2})
+	if len(rows) != 2 || len(rows[0].Columns) != 2 || len(rows[1].Columns) != 1 || string(rows[1].Row) != "c" {
+		t.Errorf("scan with batch size = %v", rows)
+	}
+}
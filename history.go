@@ -0,0 +1,184 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+Version is a version of a column of a row
+*/
+type Version struct {
+	Row    []byte
+	Column string // family:qualifier
+	Time   time.Time
+	Value  []byte
+}
+
+/*
+Timestamp return the timestamp of the version in milliseconds, as hbase stores it
+*/
+func (v *Version) Timestamp() int64 {
+	return toMillis(v.Time)
+}
+
+/*
+HistoryOptions select the versions ColumnHistory and RowHistory return
+*/
+type HistoryOptions struct {
+	From        time.Time // only versions at or after From, zero for no limit
+	To          time.Time // only versions before To, zero for no limit
+	MaxVersions int32     // newest versions before To read per column, all if 0
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+/*
+ColumnHistory return the versions of a column of a row in time order, oldest first
+*/
+func (client *HClient) ColumnHistory(tableName string, row []byte, column string, opts *HistoryOptions) ([]*Version, error) {
+	o := HistoryOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	n := o.MaxVersions
+	if n <= 0 {
+		n = math.MaxInt32
+	}
+
+	var cells []*Hbase.TCell
+	var err error
+	if o.To.IsZero() {
+		cells, err = client.GetVer(tableName, row, column, n, nil)
+	} else {
+		cells, err = client.GetVerTs(tableName, row, column, toMillis(o.To), n, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// cells are newest first
+	history := make([]*Version, 0, len(cells))
+	for i := len(cells) - 1; i >= 0; i-- {
+		t := fromMillis(cells[i].Timestamp)
+		if !o.From.IsZero() && t.Before(o.From) {
+			continue
+		}
+		history = append(history, &Version{Row: row, Column: column, Time: t, Value: cells[i].Value})
+	}
+	return history, nil
+}
+
+/*
+RowHistory return the versions of every column of a row, by column and in time order within a column
+*/
+func (client *HClient) RowHistory(tableName string, row []byte, opts *HistoryOptions) ([]*Version, error) {
+	results, err := client.GetRow(tableName, row, nil)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(results[0].Columns))
+	for column := range results[0].Columns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	history := make([]*Version, 0, len(columns))
+	for _, column := range columns {
+		versions, err := client.ColumnHistory(tableName, row, column, opts)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, versions...)
+	}
+	return history, nil
+}
+
+/*
+VersionDiff is a version of a column next to the version before it
+*/
+type VersionDiff struct {
+	Old *Version
+	New *Version
+}
+
+/*
+Changed report whether the value differs from the version before
+*/
+func (d *VersionDiff) Changed() bool {
+	return !bytes.Equal(d.Old.Value, d.New.Value)
+}
+
+/*
+Diff pairs consecutive versions of each column of each row, versions that are not in time order are sorted first.
+Columns are in the order they first appear in versions, a column with a single version has no diff.
+*/
+func Diff(versions []*Version) []*VersionDiff {
+	type key struct{ row, column string }
+	var order []key
+	columns := make(map[key][]*Version)
+	for _, v := range versions {
+		k := key{string(v.Row), v.Column}
+		if _, ok := columns[k]; !ok {
+			order = append(order, k)
+		}
+		columns[k] = append(columns[k], v)
+	}
+
+	diffs := make([]*VersionDiff, 0)
+	for _, k := range order {
+		list := columns[k]
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Time.Before(list[j].Time) })
+		for i := 1; i < len(list); i++ {
+			diffs = append(diffs, &VersionDiff{Old: list[i-1], New: list[i]})
+		}
+	}
+	return diffs
+}
+
+/*
+ValuesAsOf return the version of columns every row had at time at, all columns if columns is empty.
+The result has an entry per row in the order of rows, the versions of a row are sorted by column and
+a row or a column that had no value at that time is left out.
+*/
+func (client *HClient) ValuesAsOf(tableName string, rows [][]byte, columns []string, at time.Time) ([][]*Version, error) {
+	results, err := client.GetRowsWithColumnsTs(tableName, rows, columns, toMillis(at)+1, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byRow := make(map[string]*Hbase.TRowResult, len(results))
+	for _, result := range results {
+		byRow[string(result.Row)] = result
+	}
+
+	values := make([][]*Version, len(rows))
+	for i, row := range rows {
+		values[i] = make([]*Version, 0)
+		result, ok := byRow[string(row)]
+		if !ok {
+			continue
+		}
+		for column, cell := range result.Columns {
+			values[i] = append(values[i], &Version{Row: row, Column: column, Time: fromMillis(cell.Timestamp), Value: cell.Value})
+		}
+		list := values[i]
+		sort.Slice(list, func(a, b int) bool { return list[a].Column < list[b].Column })
+	}
+	return values, nil
+}
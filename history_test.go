@@ -0,0 +1,134 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+)
+
+func ms(ts int64) time.Time {
+	return time.Unix(0, ts*int64(time.Millisecond))
+}
+
+func versionTimes(versions []*goh.Version) []int64 {
+	times := make([]int64, len(versions))
+	for i, v := range versions {
+		times[i] = v.Timestamp()
+	}
+	return times
+}
+
+func TestColumnHistory(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "a", "cf:x", "v2", 2)
+	handler.put("test", "a", "cf:x", "v3", 3)
+
+	history, err := client.ColumnHistory("test", []byte("a"), "cf:x", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versionTimes(history); !reflect.DeepEqual(got, []int64{1, 2, 3}) {
+		t.Errorf("history = %v", got)
+	}
+	if v := history[0]; string(v.Row) != "a" || v.Column != "cf:x" || string(v.Value) != "value a" || !v.Time.Equal(ms(1)) {
+		t.Errorf("first version = %+v", v)
+	}
+
+	for _, c := range []struct {
+		opts goh.HistoryOptions
+		want []int64
+	}{
+		{goh.HistoryOptions{From: ms(2)}, []int64{2, 3}},
+		{goh.HistoryOptions{To: ms(3)}, []int64{1, 2}},
+		{goh.HistoryOptions{From: ms(2), To: ms(3)}, []int64{2}},
+		{goh.HistoryOptions{MaxVersions: 2}, []int64{2, 3}},
+		{goh.HistoryOptions{From: ms(4)}, []int64{}},
+	} {
+		opts := c.opts
+		history, err := client.ColumnHistory("test", []byte("a"), "cf:x", &opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := versionTimes(history); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("history %+v = %v, want %v", c.opts, got, c.want)
+		}
+	}
+
+	if history, err = client.ColumnHistory("test", []byte("b"), "cf:x", nil); err != nil || len(history) != 0 {
+		t.Errorf("history of a missing row = %v, %v", history, err)
+	}
+}
+
+func TestRowHistoryDiff(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "a", "cf:x", "value a", 2)
+	handler.put("test", "a", "cf:x", "new", 3)
+	handler.put("test", "a", "cf:w", "w", 5)
+
+	history, err := client.RowHistory("test", []byte("a"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var columns []string
+	for _, v := range history {
+		columns = append(columns, v.Column)
+	}
+	if !reflect.DeepEqual(columns, []string{"cf:w", "cf:x", "cf:x", "cf:x"}) || !reflect.DeepEqual(versionTimes(history), []int64{5, 1, 2, 3}) {
+		t.Errorf("row history = %v %v", columns, versionTimes(history))
+	}
+
+	diffs := goh.Diff(history)
+	if len(diffs) != 2 {
+		t.Fatalf("diffs = %v", diffs)
+	}
+	if d := diffs[0]; d.Old.Timestamp() != 1 || d.New.Timestamp() != 2 || d.Changed() {
+		t.Errorf("first diff = %+v %+v", d.Old, d.New)
+	}
+	if d := diffs[1]; string(d.Old.Value) != "value a" || string(d.New.Value) != "new" || !d.Changed() {
+		t.Errorf("second diff = %+v %+v", d.Old, d.New)
+	}
+
+	// versions out of order are sorted
+	if diffs = goh.Diff([]*goh.Version{history[3], history[1]}); len(diffs) != 1 || diffs[0].Old != history[1] {
+		t.Errorf("diff of unordered versions = %v", diffs)
+	}
+
+	if history, err = client.RowHistory("test", []byte("b"), nil); err != nil || len(history) != 0 {
+		t.Errorf("history of a missing row = %v, %v", history, err)
+	}
+}
+
+func TestValuesAsOf(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "a", "cf:x", "later", 5)
+	handler.put("test", "c", "cf:y", "y", 3)
+
+	rows := [][]byte{[]byte("c"), []byte("b"), []byte("a")}
+	values, err := client.ValuesAsOf("test", rows, nil, ms(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 || len(values[0]) != 2 || len(values[1]) != 0 || len(values[2]) != 1 {
+		t.Fatalf("values = %v", values)
+	}
+	if v := values[0][1]; v.Column != "cf:y" || string(v.Value) != "y" || v.Timestamp() != 3 {
+		t.Errorf("c cf:y = %+v", v)
+	}
+	if v := values[2][0]; string(v.Row) != "a" || string(v.Value) != "value a" {
+		t.Errorf("a cf:x = %+v", v)
+	}
+
+	values, err = client.ValuesAsOf("test", rows, []string{"cf:x"}, ms(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values[0]) != 1 || string(values[2][0].Value) != "later" {
+		t.Errorf("values at 5 = %v", values)
+	}
+}
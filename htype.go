@@ -52,7 +52,7 @@ func toHbaseTextListFromByte(list [][]byte) []Hbase.Text {
 	return data
 }
 
-func toHbaseTextMap(source map[string]string) map[string]Hbase.Text {
+func toHbaseTextMap(source map[string][]byte) map[string]Hbase.Text {
 	if source == nil {
 		return nil
 	}
@@ -180,10 +180,10 @@ func NewColumnDescriptorDefault(name string) *ColumnDescriptor {
  *  - Port
  */
 type TRegionInfo struct {
-	StartKey   string "startKey"   // 1
-	EndKey     string "endKey"     // 2
+	StartKey   []byte "startKey"   // 1
+	EndKey     []byte "endKey"     // 2
 	Id         int64  "id"         // 3
-	Name       string "name"       // 4
+	Name       []byte "name"       // 4
 	Version    int8   "version"    // 5
 	ServerName string "serverName" // 6
 	Port       int32  "port"       // 7
@@ -191,10 +191,10 @@ type TRegionInfo struct {
 
 func toRegion(region *Hbase.TRegionInfo) *TRegionInfo {
 	return &TRegionInfo{
-		StartKey:   string(region.StartKey),
-		EndKey:     string(region.EndKey),
+		StartKey:   region.StartKey,
+		EndKey:     region.EndKey,
 		Id:         region.Id,
-		Name:       string(region.Name),
+		Name:       region.Name,
 		Version:    region.Version,
 		ServerName: string(region.ServerName),
 		Port:       region.Port,
@@ -289,16 +289,43 @@ func NewTIncrement(table string, row []byte, column string, ammount int64) *Hbas
 	}
 }
 
+// /**
+//  * An Append object is used to specify the parameters for performing the append operation.
+//  * 
+//  * Attributes:
+//  *  - Table
+//  *  - Row
+//  *  - Columns
+//  *  - Values
+//  */
+// type TAppend struct {
+// 	Table   []byte   "table"   // 1
+// 	Row     []byte   "row"     // 2
+// 	Columns [][]byte "columns" // 3
+// 	Values  [][]byte "values"  // 4
+// }
+
+func NewAppend(table string, row []byte, columns []string, values [][]byte) *Hbase.TAppend {
+	return &Hbase.TAppend{
+		Table:   Hbase.Text(table),
+		Row:     Hbase.Text(row),
+		Columns: toHbaseTextList(columns),
+		Values:  toHbaseTextListFromByte(values),
+	}
+}
+
 /**
  * Holds row name and then a map of columns to cells.
  * 
  * Attributes:
  *  - Row
  *  - Columns
+ *  - SortedColumns
  */
 // type TRowResult struct {
-// 	Row     string            "row"     // 1
-// 	Columns map[string]*TCell "columns" // 2
+// 	Row           string            "row"           // 1
+// 	Columns       map[string]*TCell "columns"       // 2
+// 	SortedColumns []*TColumn        "sortedColumns" // 3
 // }
 
 /**
@@ -311,6 +338,9 @@ func NewTIncrement(table string, row []byte, column string, ammount int64) *Hbas
  *  - Columns
  *  - Caching
  *  - FilterString
+ *  - BatchSize: maximum number of columns in a result, a wider row is returned in several results
+ *  - SortColumns: return the columns of a row in order in SortedColumns instead of Columns
+ *  - Reversed: scan from StartRow down to StopRow, StartRow has to be greater than StopRow
  */
 type TScan struct {
 	StartRow     []byte   "startRow"     // 1
@@ -319,6 +349,9 @@ type TScan struct {
 	Columns      []string "columns"      // 4
 	Caching      int32    "caching"      // 5
 	FilterString string   "filterString" // 6
+	BatchSize    int32    "batchSize"    // 7
+	SortColumns  bool     "sortColumns"  // 8
+	Reversed     bool     "reversed"     // 9
 }
 
 func toHbaseTScan(scan *TScan) *Hbase.TScan {
@@ -334,6 +367,9 @@ func toHbaseTScan(scan *TScan) *Hbase.TScan {
 			Columns:      toHbaseTextList(scan.Columns),
 			Caching:      scan.Caching,
 			FilterString: nil,
+			BatchSize:    scan.BatchSize,
+			SortColumns:  scan.SortColumns,
+			Reversed:     scan.Reversed,
 		}
 	}
 
@@ -344,6 +380,9 @@ func toHbaseTScan(scan *TScan) *Hbase.TScan {
 		Columns:      toHbaseTextList(scan.Columns),
 		Caching:      scan.Caching,
 		FilterString: Hbase.Text(scan.FilterString),
+		BatchSize:    scan.BatchSize,
+		SortColumns:  scan.SortColumns,
+		Reversed:     scan.Reversed,
 	}
 
 }
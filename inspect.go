@@ -0,0 +1,284 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+/*
+InspectOptions control InspectTable, zero values are replaced by defaults
+*/
+type InspectOptions struct {
+	// SampleRows is the number of rows read from the start of every region to estimate its size, default 100
+	SampleRows int32
+	// a region is oversized when its estimated size is more than RegionRatio times the mean of its neighbours, default 4
+	RegionRatio float64
+	// a server is overloaded when it hosts more than ServerRatio times the mean number of regions per server, default 1.5
+	ServerRatio float64
+}
+
+/*
+RegionReport describe a region of a table, keys that are not printable are written as \xNN
+*/
+type RegionReport struct {
+	Name           string `json:"name"`
+	Id             int64  `json:"id"`
+	StartKey       string `json:"startKey"`
+	EndKey         string `json:"endKey"`
+	Server         string `json:"server"`
+	SampledRows    int    `json:"sampledRows"`
+	SampledBytes   int64  `json:"sampledBytes"`
+	EstimatedBytes int64  `json:"estimatedBytes"`
+	Exact          bool   `json:"exact"` // the sample read the whole region
+	Oversized      bool   `json:"oversized"`
+}
+
+/*
+ServerReport describe the regions of a table on a region server
+*/
+type ServerReport struct {
+	Server         string `json:"server"`
+	Regions        int    `json:"regions"`
+	EstimatedBytes int64  `json:"estimatedBytes"`
+	Overloaded     bool   `json:"overloaded"`
+}
+
+/*
+TableReport is the layout of a table, regions are in key order and servers by name
+*/
+type TableReport struct {
+	Table   string          `json:"table"`
+	Regions []*RegionReport `json:"regions"`
+	Servers []*ServerReport `json:"servers"`
+}
+
+/*
+JSON encode the report as indented json
+*/
+func (r *TableReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+/*
+Oversized return the regions that are much larger than their neighbours
+*/
+func (r *TableReport) Oversized() []*RegionReport {
+	list := make([]*RegionReport, 0)
+	for _, region := range r.Regions {
+		if region.Oversized {
+			list = append(list, region)
+		}
+	}
+	return list
+}
+
+/*
+Overloaded return the servers that host too many regions of the table
+*/
+func (r *TableReport) Overloaded() []*ServerReport {
+	list := make([]*ServerReport, 0)
+	for _, server := range r.Servers {
+		if server.Overloaded {
+			list = append(list, server)
+		}
+	}
+	return list
+}
+
+// metaSuffix ends the row of a region lookup in the meta table, it sorts after every region id
+const metaSuffix = ",99999999999999"
+
+/*
+InspectTable report the regions of tableName, the servers that host them and their size.
+The location of every region comes from GetRegionInfo when the server has it, GetTableRegions otherwise.
+The size of a region is estimated by reading the first SampleRows rows and assuming the rest of the keys
+are spread as evenly between the region boundaries, it is rough for skewed keys but enough to find hotspots.
+*/
+func (client *HClient) InspectTable(ctx context.Context, tableName string, opts *InspectOptions) (*TableReport, error) {
+	o := InspectOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.SampleRows <= 0 {
+		o.SampleRows = 100
+	}
+	if o.RegionRatio <= 0 {
+		o.RegionRatio = 4
+	}
+	if o.ServerRatio <= 0 {
+		o.ServerRatio = 1.5
+	}
+
+	regions, err := client.GetTableRegions(tableName)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(regions, func(i, j int) bool { return bytes.Compare(regions[i].StartKey, regions[j].StartKey) < 0 })
+
+	report := &TableReport{Table: tableName, Regions: make([]*RegionReport, 0, len(regions)), Servers: make([]*ServerReport, 0)}
+	lookup := true
+	for _, region := range regions {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if lookup {
+			meta := append(append([]byte(tableName+","), region.StartKey...), metaSuffix...)
+			info, err := client.GetRegionInfo(meta)
+			switch {
+			case isUnknownMethod(err):
+				lookup = false
+			case err != nil:
+				return nil, err
+			case bytes.Equal(info.Name, region.Name):
+				region = info
+			}
+		}
+
+		r := &RegionReport{
+			Name:     escapeKey(region.Name),
+			Id:       region.Id,
+			StartKey: escapeKey(region.StartKey),
+			EndKey:   escapeKey(region.EndKey),
+			Server:   region.ServerName + ":" + strconv.Itoa(int(region.Port)),
+		}
+		if err = client.sampleRegion(tableName, region, o.SampleRows, r); err != nil {
+			return nil, err
+		}
+		report.Regions = append(report.Regions, r)
+	}
+
+	for i, r := range report.Regions {
+		var sum int64
+		var n int
+		if i > 0 {
+			sum, n = sum+report.Regions[i-1].EstimatedBytes, n+1
+		}
+		if i+1 < len(report.Regions) {
+			sum, n = sum+report.Regions[i+1].EstimatedBytes, n+1
+		}
+		r.Oversized = n > 0 && float64(r.EstimatedBytes) > o.RegionRatio*float64(sum)/float64(n)
+	}
+
+	servers := make(map[string]*ServerReport)
+	for _, r := range report.Regions {
+		s, ok := servers[r.Server]
+		if !ok {
+			s = &ServerReport{Server: r.Server}
+			servers[r.Server] = s
+			report.Servers = append(report.Servers, s)
+		}
+		s.Regions++
+		s.EstimatedBytes += r.EstimatedBytes
+	}
+	sort.Slice(report.Servers, func(i, j int) bool { return report.Servers[i].Server < report.Servers[j].Server })
+	if len(report.Servers) > 1 {
+		mean := float64(len(report.Regions)) / float64(len(report.Servers))
+		for _, s := range report.Servers {
+			s.Overloaded = float64(s.Regions) > o.ServerRatio*mean
+		}
+	}
+	return report, nil
+}
+
+/*
+sampleRegion read up to rows rows from the start of region and set the sampled and estimated size of r
+*/
+func (client *HClient) sampleRegion(tableName string, region *TRegionInfo, rows int32, r *RegionReport) error {
+	scan := &TScan{StartRow: region.StartKey, StopRow: region.EndKey, Caching: rows}
+	id, err := client.ScannerOpenWithScan(tableName, scan, nil)
+	if err != nil {
+		return err
+	}
+	defer client.ScannerClose(id)
+
+	results, err := client.ScannerGetList(id, rows)
+	if err != nil {
+		return err
+	}
+
+	var last []byte
+	for _, result := range results {
+		// about the size of the key values of the row, each holds the row, the column and a timestamp
+		for column, cell := range result.Columns {
+			r.SampledBytes += int64(len(result.Row) + len(column) + len(cell.Value) + 8)
+		}
+		last = result.Row
+	}
+	r.SampledRows = len(results)
+	r.EstimatedBytes = r.SampledBytes
+
+	if len(results) < int(rows) {
+		r.Exact = true
+		return nil
+	}
+	if more, err := client.ScannerGet(id); err != nil {
+		return err
+	} else if len(more) == 0 {
+		r.Exact = true
+		return nil
+	}
+
+	if f := keyFraction(region.StartKey, region.EndKey, last); f > 0 && f < 1 {
+		r.EstimatedBytes = int64(float64(r.SampledBytes) / f)
+	}
+	return nil
+}
+
+/*
+keyFraction is the position of key between start and end as a number from 0 to 1, an empty end is the end of the table.
+Keys are read as base 256 fractions of their first 8 bytes after the prefix start and end share.
+*/
+func keyFraction(start, end, key []byte) float64 {
+	prefix := 0
+	if len(end) > 0 {
+		for prefix < len(start) && prefix < len(end) && start[prefix] == end[prefix] {
+			prefix++
+		}
+	}
+
+	position := func(b []byte) float64 {
+		if len(b) < prefix {
+			return 0
+		}
+		b = b[prefix:]
+		v, scale := 0.0, 1.0
+		for i := 0; i < len(b) && i < 8; i++ {
+			scale /= 256
+			v += float64(b[i]) * scale
+		}
+		return v
+	}
+
+	s, e := position(start), 1.0
+	if len(end) > 0 {
+		e = position(end)
+	}
+	if e <= s || !bytes.HasPrefix(key, start[:prefix]) {
+		return 0
+	}
+	return (position(key) - s) / (e - s)
+}
+
+/*
+escapeKey writes bytes that are not printable and backslashes as \xNN
+*/
+func escapeKey(b []byte) string {
+	var buf bytes.Buffer
+	for _, c := range b {
+		if c >= ' ' && c <= '~' && c != '\\' {
+			buf.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&buf, `\x%02X`, c)
+	}
+	return buf.String()
+}
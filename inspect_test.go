@@ -0,0 +1,87 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sdming/goh"
+)
+
+func TestInspectTable(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.createTable("hot", "cf")
+	handler.split("hot", "b", "c", "d", "e\x00")
+	handler.move("hot", "rs1", "rs1", "rs1", "rs1", "rs2")
+	for _, row := range []string{"a", "b", "d", "e\x01"} {
+		handler.put("hot", row, "cf:x", "small", 1)
+	}
+	for i := 0; i < 50; i++ {
+		handler.put("hot", fmt.Sprintf("c%03d", i), "cf:x", strings.Repeat("v", 100), 1)
+	}
+
+	report, err := client.InspectTable(context.Background(), "hot", &goh.InspectOptions{SampleRows: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Regions) != 5 {
+		t.Fatalf("regions = %d, want 5", len(report.Regions))
+	}
+
+	c := report.Regions[2]
+	if c.StartKey != "c" || c.EndKey != "d" || c.SampledRows != 10 || c.Exact || c.EstimatedBytes <= c.SampledBytes {
+		t.Errorf("region c = %+v", c)
+	}
+	if d := report.Regions[3]; d.EndKey != `e\x00` || !d.Exact || d.SampledRows != 1 || d.EstimatedBytes != d.SampledBytes {
+		t.Errorf("region d = %+v", d)
+	}
+	if e := report.Regions[4]; e.StartKey != `e\x00` || e.Server != "rs2:60020" {
+		t.Errorf("region e = %+v", e)
+	}
+	if oversized := report.Oversized(); len(oversized) != 1 || oversized[0] != c {
+		t.Errorf("oversized regions = %v", oversized)
+	}
+
+	want := []*goh.ServerReport{
+		{Server: "rs1:60020", Regions: 4, Overloaded: true},
+		{Server: "rs2:60020", Regions: 1},
+	}
+	for _, s := range report.Servers {
+		s.EstimatedBytes = 0
+	}
+	if !reflect.DeepEqual(report.Servers, want) {
+		t.Errorf("servers = %+v", report.Servers)
+	}
+
+	data, err := report.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded goh.TableReport
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, report) {
+		t.Errorf("json = %s", data)
+	}
+
+	// a table of one region has no neighbours to compare with
+	report, err = client.InspectTable(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Regions) != 1 || len(report.Oversized()) != 0 || len(report.Overloaded()) != 0 || report.Regions[0].SampledRows != 3 {
+		t.Errorf("report of test = %+v", report.Regions[0])
+	}
+
+	if _, err = client.InspectTable(context.Background(), "missing", nil); err == nil {
+		t.Error("InspectTable of a missing table should fail")
+	}
+}
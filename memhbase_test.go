@@ -0,0 +1,803 @@
+/*
+
+
+ */
+
+package goh_test
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+memHbase is an in-memory implementation of Hbase.IHbase used by tests,
+methods it does not override panic through the nil embedded interface
+*/
+type memHbase struct {
+	Hbase.IHbase
+
+	mu       sync.Mutex
+	tables   map[string]*memTable
+	scanners map[Hbase.ScannerID]*memScanner
+	nextId   Hbase.ScannerID
+	clock    int64
+	failRows map[string]bool // rows GetRows* fail on
+	forward  bool            // ScannerOpenWithScan ignores Reversed, as gateways older than 0.98
+	raced    bool            // DisableTable finds the table disabled by another client
+	noCreate bool            // CreateTable fails
+}
+
+type memTable struct {
+	enabled  bool
+	families map[string]*Hbase.ColumnDescriptor
+	rows     map[string]map[string][]*Hbase.TCell // row -> column -> versions, newest first
+	splits   []string                             // start keys of the regions after the first
+	servers  []string                             // servers GetRegionInfo reports for the regions
+}
+
+type memScanner struct {
+	rows []*Hbase.TRowResult
+	pos  int
+}
+
+func newMemHbase() *memHbase {
+	return &memHbase{
+		tables:   make(map[string]*memTable),
+		scanners: make(map[Hbase.ScannerID]*memScanner),
+		clock:    1000,
+	}
+}
+
+func (m *memHbase) table(name []byte) (*memTable, *Hbase.IOError) {
+	t, ok := m.tables[string(name)]
+	if !ok {
+		return nil, &Hbase.IOError{Message: "table not found: " + string(name)}
+	}
+	return t, nil
+}
+
+func (m *memHbase) createTable(name string, families ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := &memTable{enabled: true, families: make(map[string]*Hbase.ColumnDescriptor), rows: make(map[string]map[string][]*Hbase.TCell)}
+	for _, f := range families {
+		t.families[f+":"] = &Hbase.ColumnDescriptor{Name: Hbase.Text(f + ":"), MaxVersions: 3, Compression: "NONE", BloomFilterType: "NONE", TimeToLive: -1}
+	}
+	m.tables[name] = t
+}
+
+// split makes the regions of table start at keys, in addition to the first region
+func (m *memHbase) split(table string, keys ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tables[table].splits = keys
+}
+
+// failOn makes the GetRows* calls that ask for one of rows fail
+func (m *memHbase) failOn(rows ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.failRows = make(map[string]bool)
+	for _, row := range rows {
+		m.failRows[row] = true
+	}
+}
+
+// move makes GetRegionInfo report the regions of table on servers, GetTableRegions keeps reporting localhost
+func (m *memHbase) move(table string, servers ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tables[table].servers = servers
+}
+
+func (m *memHbase) put(table string, row string, column string, value string, ts int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := m.tables[table]
+	t.put(row, column, []byte(value), ts)
+}
+
+func (t *memTable) put(row string, column string, value []byte, ts int64) {
+	cols, ok := t.rows[row]
+	if !ok {
+		cols = make(map[string][]*Hbase.TCell)
+		t.rows[row] = cols
+	}
+	cell := &Hbase.TCell{Value: Hbase.Bytes(value), Timestamp: ts}
+	versions := append(cols[column], cell)
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Timestamp > versions[j].Timestamp })
+	cols[column] = versions
+}
+
+func (t *memTable) sortedRows() []string {
+	keys := make([]string, 0, len(t.rows))
+	for k := range t.rows {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func matchColumn(column string, columns []Hbase.Text) bool {
+	if len(columns) == 0 {
+		return true
+	}
+	for _, c := range columns {
+		s := string(c)
+		if s == column || (strings.HasSuffix(s, ":") && strings.HasPrefix(column, s)) || (!strings.Contains(s, ":") && strings.HasPrefix(column, s+":")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *memTable) rowResult(row string, columns []Hbase.Text, timestamp int64) *Hbase.TRowResult {
+	cols, ok := t.rows[row]
+	if !ok {
+		return nil
+	}
+	result := &Hbase.TRowResult{Row: Hbase.Text(row), Columns: make(map[string]*Hbase.TCell)}
+	for column, versions := range cols {
+		if !matchColumn(column, columns) {
+			continue
+		}
+		for _, cell := range versions {
+			if timestamp <= 0 || cell.Timestamp < timestamp {
+				result.Columns[column] = cell
+				break
+			}
+		}
+	}
+	if len(result.Columns) == 0 {
+		return nil
+	}
+	return result
+}
+
+func (m *memHbase) tick() int64 {
+	m.clock++
+	return m.clock
+}
+
+func (m *memHbase) EnableTable(tableName Hbase.Bytes) (*Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil
+	}
+	t.enabled = true
+	return nil, nil
+}
+
+func (m *memHbase) DisableTable(tableName Hbase.Bytes) (*Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil
+	}
+	if m.raced {
+		t.enabled = false
+	}
+	if !t.enabled {
+		return &Hbase.IOError{Message: "TableNotEnabledException: " + string(tableName)}, nil
+	}
+	t.enabled = false
+	return nil, nil
+}
+
+func (m *memHbase) IsTableEnabled(tableName Hbase.Bytes) (bool, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return false, io, nil
+	}
+	return t.enabled, nil, nil
+}
+
+func (m *memHbase) GetTableNames() ([]Hbase.Text, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.tables))
+	for k := range m.tables {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	ret := make([]Hbase.Text, len(names))
+	for i, name := range names {
+		ret[i] = Hbase.Text(name)
+	}
+	return ret, nil, nil
+}
+
+func (m *memHbase) GetColumnDescriptors(tableName Hbase.Text) (map[string]*Hbase.ColumnDescriptor, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return nil, io, nil
+	}
+	ret := make(map[string]*Hbase.ColumnDescriptor, len(t.families))
+	for k, v := range t.families {
+		ret[k] = v
+	}
+	return ret, nil, nil
+}
+
+func (m *memHbase) GetTableRegions(tableName Hbase.Text) ([]*Hbase.TRegionInfo, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return nil, io, nil
+	}
+	keys := append([]string{""}, t.splits...)
+	regions := make([]*Hbase.TRegionInfo, len(keys))
+	for i, key := range keys {
+		end := ""
+		if i+1 < len(keys) {
+			end = keys[i+1]
+		}
+		regions[i] = &Hbase.TRegionInfo{
+			StartKey:   Hbase.Text(key),
+			EndKey:     Hbase.Text(end),
+			Id:         int64(i + 1),
+			Name:       Hbase.Text(string(tableName) + "," + key + "," + strconv.Itoa(i+1)),
+			ServerName: Hbase.Text("localhost"),
+			Port:       60020,
+		}
+	}
+	return regions, nil, nil
+}
+
+func (m *memHbase) CreateTable(tableName Hbase.Text, columnFamilies []*Hbase.ColumnDescriptor) (*Hbase.IOError, *Hbase.IllegalArgument, *Hbase.AlreadyExists, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.noCreate {
+		return &Hbase.IOError{Message: "master is not running"}, nil, nil, nil
+	}
+	if _, ok := m.tables[string(tableName)]; ok {
+		return nil, nil, &Hbase.AlreadyExists{Message: "table exists: " + string(tableName)}, nil
+	}
+	if len(columnFamilies) == 0 {
+		return nil, &Hbase.IllegalArgument{Message: "no column families"}, nil, nil
+	}
+	t := &memTable{enabled: true, families: make(map[string]*Hbase.ColumnDescriptor), rows: make(map[string]map[string][]*Hbase.TCell)}
+	for _, cf := range columnFamilies {
+		name := string(cf.Name)
+		if !strings.HasSuffix(name, ":") {
+			name += ":"
+		}
+		t.families[name] = cf
+	}
+	m.tables[string(tableName)] = t
+	return nil, nil, nil, nil
+}
+
+func (m *memHbase) DeleteTable(tableName Hbase.Text) (*Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil
+	}
+	if t.enabled {
+		return &Hbase.IOError{Message: "table is enabled: " + string(tableName)}, nil
+	}
+	delete(m.tables, string(tableName))
+	return nil, nil
+}
+
+func (m *memHbase) Get(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, attributes map[string]Hbase.Text) ([]*Hbase.TCell, *Hbase.IOError, error) {
+	return m.GetVer(tableName, row, column, 1, attributes)
+}
+
+func (m *memHbase) GetVer(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, numVersions int32, attributes map[string]Hbase.Text) ([]*Hbase.TCell, *Hbase.IOError, error) {
+	return m.GetVerTs(tableName, row, column, 0, numVersions, attributes)
+}
+
+func (m *memHbase) GetVerTs(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, timestamp int64, numVersions int32, attributes map[string]Hbase.Text) ([]*Hbase.TCell, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return nil, io, nil
+	}
+	ret := make([]*Hbase.TCell, 0)
+	for _, cell := range t.rows[string(row)][string(column)] {
+		if int32(len(ret)) >= numVersions {
+			break
+		}
+		if timestamp <= 0 || cell.Timestamp < timestamp {
+			ret = append(ret, cell)
+		}
+	}
+	return ret, nil, nil
+}
+
+func (m *memHbase) GetRow(tableName Hbase.Text, row Hbase.Text, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, []Hbase.Text{row}, nil, 0, attributes)
+}
+
+func (m *memHbase) GetRowWithColumns(tableName Hbase.Text, row Hbase.Text, columns []Hbase.Text, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, []Hbase.Text{row}, columns, 0, attributes)
+}
+
+func (m *memHbase) GetRowTs(tableName Hbase.Text, row Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, []Hbase.Text{row}, nil, timestamp, attributes)
+}
+
+func (m *memHbase) GetRowWithColumnsTs(tableName Hbase.Text, row Hbase.Text, columns []Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, []Hbase.Text{row}, columns, timestamp, attributes)
+}
+
+func (m *memHbase) GetRows(tableName Hbase.Text, rows []Hbase.Text, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, rows, nil, 0, attributes)
+}
+
+func (m *memHbase) GetRowsWithColumns(tableName Hbase.Text, rows []Hbase.Text, columns []Hbase.Text, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, rows, columns, 0, attributes)
+}
+
+func (m *memHbase) GetRowsTs(tableName Hbase.Text, rows []Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	return m.GetRowsWithColumnsTs(tableName, rows, nil, timestamp, attributes)
+}
+
+func (m *memHbase) GetRowsWithColumnsTs(tableName Hbase.Text, rows []Hbase.Text, columns []Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) ([]*Hbase.TRowResult, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return nil, io, nil
+	}
+	ret := make([]*Hbase.TRowResult, 0, len(rows))
+	for _, row := range rows {
+		if m.failRows[string(row)] {
+			return nil, &Hbase.IOError{Message: "failed to read " + string(row)}, nil
+		}
+		if r := t.rowResult(string(row), columns, timestamp); r != nil {
+			ret = append(ret, r)
+		}
+	}
+	return ret, nil, nil
+}
+
+func (m *memHbase) mutate(t *memTable, row string, mutations []*Hbase.Mutation, ts int64) {
+	for _, mutation := range mutations {
+		column := string(mutation.Column)
+		if mutation.IsDelete {
+			if cols, ok := t.rows[row]; ok {
+				delete(cols, column)
+				if len(cols) == 0 {
+					delete(t.rows, row)
+				}
+			}
+			continue
+		}
+		t.put(row, column, mutation.Value, ts)
+	}
+}
+
+func (m *memHbase) MutateRow(tableName Hbase.Text, row Hbase.Text, mutations []*Hbase.Mutation, attributes map[string]Hbase.Text) (*Hbase.IOError, *Hbase.IllegalArgument, error) {
+	return m.MutateRowsTs(tableName, []*Hbase.BatchMutation{{Row: Hbase.Text(row), Mutations: mutations}}, 0, attributes)
+}
+
+func (m *memHbase) MutateRowTs(tableName Hbase.Text, row Hbase.Text, mutations []*Hbase.Mutation, timestamp int64, attributes map[string]Hbase.Text) (*Hbase.IOError, *Hbase.IllegalArgument, error) {
+	return m.MutateRowsTs(tableName, []*Hbase.BatchMutation{{Row: Hbase.Text(row), Mutations: mutations}}, timestamp, attributes)
+}
+
+func (m *memHbase) MutateRows(tableName Hbase.Text, rowBatches []*Hbase.BatchMutation, attributes map[string]Hbase.Text) (*Hbase.IOError, *Hbase.IllegalArgument, error) {
+	return m.MutateRowsTs(tableName, rowBatches, 0, attributes)
+}
+
+func (m *memHbase) MutateRowsTs(tableName Hbase.Text, rowBatches []*Hbase.BatchMutation, timestamp int64, attributes map[string]Hbase.Text) (*Hbase.IOError, *Hbase.IllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil, nil
+	}
+	ts := timestamp
+	if ts <= 0 {
+		ts = m.tick()
+	}
+	for _, batch := range rowBatches {
+		m.mutate(t, string(batch.Row), batch.Mutations, ts)
+	}
+	return nil, nil, nil
+}
+
+func (m *memHbase) CheckAndPut(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, value Hbase.Text, mput *Hbase.Mutation, attributes map[string]Hbase.Text) (bool, *Hbase.IOError, *Hbase.IllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return false, io, nil, nil
+	}
+	if mput == nil || mput.IsDelete {
+		return false, nil, &Hbase.IllegalArgument{Message: "checkAndPut needs a put mutation"}, nil
+	}
+	versions := t.rows[string(row)][string(column)]
+	if value == nil && len(versions) > 0 || value != nil && (len(versions) == 0 || !bytes.Equal(versions[0].Value, value)) {
+		return false, nil, nil, nil
+	}
+	m.mutate(t, string(row), []*Hbase.Mutation{mput}, m.tick())
+	return true, nil, nil, nil
+}
+
+func (m *memHbase) Append(tappend *Hbase.TAppend) ([]*Hbase.TCell, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tappend.Table)
+	if io != nil {
+		return nil, io, nil
+	}
+	if len(tappend.Columns) != len(tappend.Values) {
+		return nil, &Hbase.IOError{Message: "columns and values do not match"}, nil
+	}
+	ts := m.tick()
+	cells := make([]*Hbase.TCell, len(tappend.Columns))
+	for i, column := range tappend.Columns {
+		var value []byte
+		if versions := t.rows[string(tappend.Row)][string(column)]; len(versions) > 0 {
+			value = append(value, versions[0].Value...)
+		}
+		value = append(value, tappend.Values[i]...)
+		t.put(string(tappend.Row), string(column), value, ts)
+		cells[i] = &Hbase.TCell{Value: Hbase.Bytes(value), Timestamp: ts}
+	}
+	return cells, nil, nil
+}
+
+func (m *memHbase) AtomicIncrement(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, value int64) (int64, *Hbase.IOError, *Hbase.IllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return 0, io, nil, nil
+	}
+	var current int64
+	if versions := t.rows[string(row)][string(column)]; len(versions) > 0 {
+		b := versions[0].Value
+		if len(b) != 8 {
+			return 0, nil, &Hbase.IllegalArgument{Message: "field is not a long"}, nil
+		}
+		for _, x := range b {
+			current = current<<8 | int64(x)
+		}
+	}
+	current += value
+	b := make([]byte, 8)
+	for i := 7; i >= 0; i-- {
+		b[i] = byte(current >> uint(8*(7-i)))
+	}
+	t.put(string(row), string(column), b, m.tick())
+	return current, nil, nil, nil
+}
+
+func (m *memHbase) Increment(increment *Hbase.TIncrement) (*Hbase.IOError, error) {
+	_, io, ia, err := m.AtomicIncrement(increment.Table, increment.Row, increment.Column, increment.Ammount)
+	if ia != nil {
+		return &Hbase.IOError{Message: ia.Message}, err
+	}
+	return io, err
+}
+
+func (m *memHbase) IncrementRows(increments []*Hbase.TIncrement) (*Hbase.IOError, error) {
+	for _, increment := range increments {
+		if io, err := m.Increment(increment); io != nil || err != nil {
+			return io, err
+		}
+	}
+	return nil, nil
+}
+
+func (m *memHbase) DeleteAll(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, attributes map[string]Hbase.Text) (*Hbase.IOError, error) {
+	return m.DeleteAllTs(tableName, row, column, 0, attributes)
+}
+
+func (m *memHbase) DeleteAllTs(tableName Hbase.Text, row Hbase.Text, column Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) (*Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil
+	}
+	cols := t.rows[string(row)]
+	kept := make([]*Hbase.TCell, 0)
+	for _, cell := range cols[string(column)] {
+		if timestamp > 0 && cell.Timestamp > timestamp {
+			kept = append(kept, cell)
+		}
+	}
+	if len(kept) > 0 {
+		cols[string(column)] = kept
+	} else if cols != nil {
+		delete(cols, string(column))
+		if len(cols) == 0 {
+			delete(t.rows, string(row))
+		}
+	}
+	return nil, nil
+}
+
+func (m *memHbase) DeleteAllRow(tableName Hbase.Text, row Hbase.Text, attributes map[string]Hbase.Text) (*Hbase.IOError, error) {
+	return m.DeleteAllRowTs(tableName, row, 0, attributes)
+}
+
+func (m *memHbase) DeleteAllRowTs(tableName Hbase.Text, row Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) (*Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return io, nil
+	}
+	if timestamp <= 0 {
+		delete(t.rows, string(row))
+		return nil, nil
+	}
+	cols := t.rows[string(row)]
+	for column, versions := range cols {
+		kept := make([]*Hbase.TCell, 0)
+		for _, cell := range versions {
+			if cell.Timestamp > timestamp {
+				kept = append(kept, cell)
+			}
+		}
+		if len(kept) > 0 {
+			cols[column] = kept
+		} else {
+			delete(cols, column)
+		}
+	}
+	if len(cols) == 0 {
+		delete(t.rows, string(row))
+	}
+	return nil, nil
+}
+
+func (m *memHbase) openScanner(tableName Hbase.Text, startRow, stopRow, prefix []byte, columns []Hbase.Text, timestamp int64) (Hbase.ScannerID, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return 0, io, nil
+	}
+	s := &memScanner{}
+	for _, row := range t.sortedRows() {
+		key := []byte(row)
+		if len(startRow) > 0 && bytes.Compare(key, startRow) < 0 {
+			continue
+		}
+		if len(stopRow) > 0 && bytes.Compare(key, stopRow) >= 0 {
+			break
+		}
+		if len(prefix) > 0 && !bytes.HasPrefix(key, prefix) {
+			continue
+		}
+		if r := t.rowResult(row, columns, timestamp); r != nil {
+			s.rows = append(s.rows, r)
+		}
+	}
+	m.nextId++
+	m.scanners[m.nextId] = s
+	return m.nextId, nil, nil
+}
+
+func (m *memHbase) ScannerOpenWithScan(tableName Hbase.Text, scan *Hbase.TScan, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	if m.forward {
+		scan.Reversed = false
+	}
+	if !scan.Reversed && scan.BatchSize <= 0 && !scan.SortColumns {
+		return m.openScanner(tableName, scan.StartRow, scan.StopRow, nil, scan.Columns, scan.Timestamp)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return 0, io, nil
+	}
+	keys := t.sortedRows()
+	if scan.Reversed {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	}
+	s := &memScanner{}
+	for _, row := range keys {
+		key := []byte(row)
+		if scan.Reversed {
+			if len(scan.StartRow) > 0 && bytes.Compare(key, scan.StartRow) > 0 {
+				continue
+			}
+			if len(scan.StopRow) > 0 && bytes.Compare(key, scan.StopRow) <= 0 {
+				break
+			}
+		} else {
+			if len(scan.StartRow) > 0 && bytes.Compare(key, scan.StartRow) < 0 {
+				continue
+			}
+			if len(scan.StopRow) > 0 && bytes.Compare(key, scan.StopRow) >= 0 {
+				break
+			}
+		}
+		r := t.rowResult(row, scan.Columns, scan.Timestamp)
+		if r == nil {
+			continue
+		}
+
+		// a row with more columns than the batch size is returned in several results, in column order
+		columns := make([]string, 0, len(r.Columns))
+		for column := range r.Columns {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		size := len(columns)
+		if scan.BatchSize > 0 && int(scan.BatchSize) < size {
+			size = int(scan.BatchSize)
+		}
+		for i := 0; i < len(columns); i += size {
+			end := i + size
+			if end > len(columns) {
+				end = len(columns)
+			}
+			part := &Hbase.TRowResult{Row: r.Row}
+			if scan.SortColumns {
+				for _, column := range columns[i:end] {
+					part.SortedColumns = append(part.SortedColumns, &Hbase.TColumn{ColumnName: Hbase.Text(column), Cell: r.Columns[column]})
+				}
+			} else {
+				part.Columns = make(map[string]*Hbase.TCell, end-i)
+				for _, column := range columns[i:end] {
+					part.Columns[column] = r.Columns[column]
+				}
+			}
+			s.rows = append(s.rows, part)
+		}
+	}
+	m.nextId++
+	m.scanners[m.nextId] = s
+	return m.nextId, nil, nil
+}
+
+func (m *memHbase) ScannerOpen(tableName Hbase.Text, startRow Hbase.Text, columns []Hbase.Text, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	return m.openScanner(tableName, startRow, nil, nil, columns, 0)
+}
+
+func (m *memHbase) ScannerOpenWithStop(tableName Hbase.Text, startRow Hbase.Text, stopRow Hbase.Text, columns []Hbase.Text, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	return m.openScanner(tableName, startRow, stopRow, nil, columns, 0)
+}
+
+func (m *memHbase) ScannerOpenWithPrefix(tableName Hbase.Text, startAndPrefix Hbase.Text, columns []Hbase.Text, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	return m.openScanner(tableName, startAndPrefix, nil, startAndPrefix, columns, 0)
+}
+
+func (m *memHbase) ScannerOpenTs(tableName Hbase.Text, startRow Hbase.Text, columns []Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	return m.openScanner(tableName, startRow, nil, nil, columns, timestamp)
+}
+
+func (m *memHbase) ScannerOpenWithStopTs(tableName Hbase.Text, startRow Hbase.Text, stopRow Hbase.Text, columns []Hbase.Text, timestamp int64, attributes map[string]Hbase.Text) (Hbase.ScannerID, *Hbase.IOError, error) {
+	return m.openScanner(tableName, startRow, stopRow, nil, columns, timestamp)
+}
+
+func (m *memHbase) ScannerGet(id Hbase.ScannerID) ([]*Hbase.TRowResult, *Hbase.IOError, *Hbase.IllegalArgument, error) {
+	return m.ScannerGetList(id, 1)
+}
+
+func (m *memHbase) ScannerGetList(id Hbase.ScannerID, nbRows int32) ([]*Hbase.TRowResult, *Hbase.IOError, *Hbase.IllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.scanners[id]
+	if !ok {
+		return nil, nil, &Hbase.IllegalArgument{Message: "invalid scanner id"}, nil
+	}
+	end := s.pos + int(nbRows)
+	if end > len(s.rows) {
+		end = len(s.rows)
+	}
+	ret := s.rows[s.pos:end]
+	s.pos = end
+	return ret, nil, nil, nil
+}
+
+func (m *memHbase) ScannerClose(id Hbase.ScannerID) (*Hbase.IOError, *Hbase.IllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.scanners[id]; !ok {
+		return nil, &Hbase.IllegalArgument{Message: "invalid scanner id"}, nil
+	}
+	delete(m.scanners, id)
+	return nil, nil, nil
+}
+
+func (m *memHbase) GetRowOrBefore(tableName Hbase.Text, row Hbase.Text, family Hbase.Text) ([]*Hbase.TCell, *Hbase.IOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(tableName)
+	if io != nil {
+		return nil, io, nil
+	}
+	keys := t.sortedRows()
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i] > string(row) {
+			continue
+		}
+		if r := t.rowResult(keys[i], []Hbase.Text{family}, 0); r != nil {
+			return sortedCells(r), nil, nil
+		}
+	}
+	return []*Hbase.TCell{}, nil, nil
+}
+
+func (m *memHbase) GetRegionInfo(row Hbase.Text) (*Hbase.TRegionInfo, *Hbase.IOError, error) {
+	// row is table,key,id of the meta table
+	tableName, key := string(row), ""
+	if i := strings.Index(tableName, ","); i >= 0 {
+		tableName, key = tableName[:i], tableName[i+1:]
+		if j := strings.LastIndex(key, ","); j >= 0 {
+			key = key[:j]
+		}
+	}
+	regions, io, err := m.GetTableRegions(Hbase.Text(tableName))
+	if io != nil || err != nil {
+		return nil, io, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := len(regions) - 1
+	for i > 0 && string(regions[i].StartKey) > key {
+		i--
+	}
+	if servers := m.tables[tableName].servers; i < len(servers) {
+		regions[i].ServerName = Hbase.Text(servers[i])
+	}
+	return regions[i], nil, nil
+}
+
+func sortedCells(r *Hbase.TRowResult) []*Hbase.TCell {
+	columns := make([]string, 0, len(r.Columns))
+	for column := range r.Columns {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	cells := make([]*Hbase.TCell, len(columns))
+	for i, column := range columns {
+		cells[i] = r.Columns[column]
+	}
+	return cells
+}
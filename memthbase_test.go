@@ -0,0 +1,783 @@
+/*
+
+
+ */
+
+package goh_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase2"
+)
+
+/*
+memTHBase is an in-memory implementation of Hbase2.ITHBaseService used by tests
+*/
+type memTHBase struct {
+	mu         sync.Mutex
+	tables     map[string]*memTable2
+	namespaces map[string]*Hbase2.TNamespaceDescriptor
+	scanners   map[int32]*memScanner2
+	nextId     int32
+	clock      int64
+}
+
+type memTable2 struct {
+	rows      map[string]map[string][]*Hbase2.TColumnValue // row -> "family:qualifier" -> versions, newest first
+	desc      *Hbase2.TTableDescriptor
+	splitKeys [][]byte
+	disabled  bool
+}
+
+type memScanner2 struct {
+	rows []*Hbase2.TResult
+	pos  int
+}
+
+func newMemTHBase() *memTHBase {
+	return &memTHBase{
+		tables: make(map[string]*memTable2),
+		namespaces: map[string]*Hbase2.TNamespaceDescriptor{
+			"default": {Name: "default"},
+			"hbase":   {Name: "hbase"},
+		},
+		scanners: make(map[int32]*memScanner2),
+		clock:    1000,
+	}
+}
+
+func (m *memTHBase) createTable(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	desc := Hbase2.NewTTableDescriptor()
+	desc.TableName = goh.NewTTableName(name)
+	m.tables[goh.TTableNameString(desc.TableName)] = &memTable2{rows: make(map[string]map[string][]*Hbase2.TColumnValue), desc: desc}
+}
+
+func (m *memTHBase) table(name []byte) (*memTable2, *Hbase2.TIOError) {
+	key := goh.TTableNameString(goh.NewTTableName(string(name)))
+	t, ok := m.tables[key]
+	if !ok {
+		return nil, &Hbase2.TIOError{Message: "table not found: " + string(name)}
+	}
+	if t.disabled {
+		return nil, &Hbase2.TIOError{Message: "table is disabled: " + string(name)}
+	}
+	return t, nil
+}
+
+func (m *memTHBase) tick() int64 {
+	m.clock++
+	return m.clock
+}
+
+func columnName(family, qualifier []byte) string {
+	return string(family) + ":" + string(qualifier)
+}
+
+func (t *memTable2) put(row string, value *Hbase2.TColumnValue) {
+	cols, ok := t.rows[row]
+	if !ok {
+		cols = make(map[string][]*Hbase2.TColumnValue)
+		t.rows[row] = cols
+	}
+	column := columnName(value.Family, value.Qualifier)
+	versions := append(cols[column], value)
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Timestamp > versions[j].Timestamp })
+	cols[column] = versions
+}
+
+func (t *memTable2) latest(row string, column string) *Hbase2.TColumnValue {
+	if versions := t.rows[row][column]; len(versions) > 0 {
+		return versions[0]
+	}
+	return nil
+}
+
+func matchTColumn(column string, columns []*Hbase2.TColumn) bool {
+	if len(columns) == 0 {
+		return true
+	}
+	for _, c := range columns {
+		if c.Qualifier == nil && strings.HasPrefix(column, string(c.Family)+":") {
+			return true
+		}
+		if c.Qualifier != nil && column == columnName(c.Family, c.Qualifier) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+result select versions of row by columns, timestamp or time range and max versions like a region server
+*/
+func (t *memTable2) result(row string, columns []*Hbase2.TColumn, timestamp int64, timeRange *Hbase2.TTimeRange, maxVersions int32) *Hbase2.TResult {
+	result := &Hbase2.TResult{ColumnValues: []*Hbase2.TColumnValue{}}
+	cols, ok := t.rows[row]
+	if !ok {
+		return result
+	}
+	if maxVersions <= 0 {
+		maxVersions = 1
+	}
+
+	names := make([]string, 0, len(cols))
+	for column := range cols {
+		if matchTColumn(column, columns) {
+			names = append(names, column)
+		}
+	}
+	sort.Strings(names)
+
+	for _, column := range names {
+		n := int32(0)
+		for _, v := range cols[column] {
+			if n == maxVersions {
+				break
+			}
+			if timestamp != 0 && v.Timestamp != timestamp {
+				continue
+			}
+			if timestamp == 0 && timeRange != nil && (v.Timestamp < timeRange.MinStamp || v.Timestamp >= timeRange.MaxStamp) {
+				continue
+			}
+			result.ColumnValues = append(result.ColumnValues, v)
+			n++
+		}
+	}
+	if len(result.ColumnValues) > 0 {
+		result.Row = []byte(row)
+	}
+	return result
+}
+
+func (t *memTable2) get(get *Hbase2.TGet) *Hbase2.TResult {
+	maxVersions := int32(1)
+	if get.IsSetMaxVersions() {
+		maxVersions = get.MaxVersions
+	}
+	return t.result(string(get.Row), get.Columns, get.Timestamp, get.TimeRange, maxVersions)
+}
+
+func (m *memTHBase) applyPut(t *memTable2, put *Hbase2.TPut) {
+	ts := put.Timestamp
+	if !put.IsSetTimestamp() {
+		ts = m.tick()
+	}
+	for _, v := range put.ColumnValues {
+		cell := *v
+		if !cell.IsSetTimestamp() {
+			cell.Timestamp = ts
+		}
+		t.put(string(put.Row), &cell)
+	}
+}
+
+func (m *memTHBase) applyDelete(t *memTable2, del *Hbase2.TDelete) {
+	row := string(del.Row)
+	cols, ok := t.rows[row]
+	if !ok {
+		return
+	}
+
+	keep := func(column string, f func(v *Hbase2.TColumnValue, i int) bool) {
+		var versions []*Hbase2.TColumnValue
+		for i, v := range cols[column] {
+			if f(v, i) {
+				versions = append(versions, v)
+			}
+		}
+		if len(versions) == 0 {
+			delete(cols, column)
+		} else {
+			cols[column] = versions
+		}
+	}
+
+	if len(del.Columns) == 0 {
+		for column := range cols {
+			keep(column, func(v *Hbase2.TColumnValue, i int) bool {
+				return del.IsSetTimestamp() && v.Timestamp > del.Timestamp
+			})
+		}
+	}
+	for _, c := range del.Columns {
+		ts := c.Timestamp
+		if !c.IsSetTimestamp() {
+			ts = del.Timestamp
+		}
+		for column := range cols {
+			if !matchTColumn(column, []*Hbase2.TColumn{c}) {
+				continue
+			}
+			if c.Qualifier != nil && del.DeleteType == Hbase2.TDeleteType_DELETE_COLUMN {
+				// one version, the newest or the one at ts
+				keep(column, func(v *Hbase2.TColumnValue, i int) bool {
+					if ts != 0 {
+						return v.Timestamp != ts
+					}
+					return i != 0
+				})
+				continue
+			}
+			keep(column, func(v *Hbase2.TColumnValue, i int) bool {
+				return ts != 0 && v.Timestamp > ts
+			})
+		}
+	}
+	if len(cols) == 0 {
+		delete(t.rows, row)
+	}
+}
+
+func (m *memTHBase) check(t *memTable2, row []byte, family []byte, qualifier []byte, value []byte) bool {
+	latest := t.latest(string(row), columnName(family, qualifier))
+	if value == nil {
+		return latest == nil
+	}
+	return latest != nil && bytes.Equal(latest.Value, value)
+}
+
+func (m *memTHBase) Exists(table []byte, tget *Hbase2.TGet) (bool, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return false, io, nil
+	}
+	return len(t.get(tget).ColumnValues) > 0, nil, nil
+}
+
+func (m *memTHBase) Get(table []byte, tget *Hbase2.TGet) (*Hbase2.TResult, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	return t.get(tget), nil, nil
+}
+
+func (m *memTHBase) GetMultiple(table []byte, tgets []*Hbase2.TGet) ([]*Hbase2.TResult, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	results := make([]*Hbase2.TResult, len(tgets))
+	for i, get := range tgets {
+		results[i] = t.get(get)
+	}
+	return results, nil, nil
+}
+
+func (m *memTHBase) Put(table []byte, tput *Hbase2.TPut) (*Hbase2.TIOError, error) {
+	return m.PutMultiple(table, []*Hbase2.TPut{tput})
+}
+
+func (m *memTHBase) CheckAndPut(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tput *Hbase2.TPut) (bool, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return false, io, nil
+	}
+	if !m.check(t, row, family, qualifier, value) {
+		return false, nil, nil
+	}
+	m.applyPut(t, tput)
+	return true, nil, nil
+}
+
+func (m *memTHBase) PutMultiple(table []byte, tputs []*Hbase2.TPut) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return io, nil
+	}
+	for _, put := range tputs {
+		m.applyPut(t, put)
+	}
+	return nil, nil
+}
+
+func (m *memTHBase) DeleteSingle(table []byte, tdelete *Hbase2.TDelete) (*Hbase2.TIOError, error) {
+	_, io, err := m.DeleteMultiple(table, []*Hbase2.TDelete{tdelete})
+	return io, err
+}
+
+func (m *memTHBase) DeleteMultiple(table []byte, tdeletes []*Hbase2.TDelete) ([]*Hbase2.TDelete, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	failed := []*Hbase2.TDelete{}
+	for _, del := range tdeletes {
+		if !t.hasFamilies(del.Columns) {
+			failed = append(failed, del)
+			continue
+		}
+		m.applyDelete(t, del)
+	}
+	return failed, nil, nil
+}
+
+// hasFamilies report whether the families of columns are in the table, any family is if the table declares none
+func (t *memTable2) hasFamilies(columns []*Hbase2.TColumn) bool {
+	if len(t.desc.Columns) == 0 {
+		return true
+	}
+	for _, c := range columns {
+		if t.family(c.Family) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *memTHBase) CheckAndDelete(table []byte, row []byte, family []byte, qualifier []byte, value []byte, tdelete *Hbase2.TDelete) (bool, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return false, io, nil
+	}
+	if !m.check(t, row, family, qualifier, value) {
+		return false, nil, nil
+	}
+	m.applyDelete(t, tdelete)
+	return true, nil, nil
+}
+
+func (m *memTHBase) Increment(table []byte, tincrement *Hbase2.TIncrement) (*Hbase2.TResult, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	row := string(tincrement.Row)
+	result := &Hbase2.TResult{Row: tincrement.Row}
+	ts := m.tick()
+	for _, inc := range tincrement.Columns {
+		var current int64
+		if latest := t.latest(row, columnName(inc.Family, inc.Qualifier)); latest != nil {
+			if len(latest.Value) != 8 {
+				return nil, &Hbase2.TIOError{Message: "attempted to increment field that isn't 64 bits wide"}, nil
+			}
+			current = int64(binary.BigEndian.Uint64(latest.Value))
+		}
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, uint64(current+inc.Amount))
+		cell := &Hbase2.TColumnValue{Family: inc.Family, Qualifier: inc.Qualifier, Value: value, Timestamp: ts}
+		t.put(row, cell)
+		result.ColumnValues = append(result.ColumnValues, cell)
+	}
+	return result, nil, nil
+}
+
+func (m *memTHBase) Append(table []byte, tappend *Hbase2.TAppend) (*Hbase2.TResult, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	row := string(tappend.Row)
+	result := &Hbase2.TResult{Row: tappend.Row}
+	ts := m.tick()
+	for _, app := range tappend.Columns {
+		var value []byte
+		if latest := t.latest(row, columnName(app.Family, app.Qualifier)); latest != nil {
+			value = append(value, latest.Value...)
+		}
+		value = append(value, app.Value...)
+		cell := &Hbase2.TColumnValue{Family: app.Family, Qualifier: app.Qualifier, Value: value, Timestamp: ts}
+		t.put(row, cell)
+		result.ColumnValues = append(result.ColumnValues, cell)
+	}
+	return result, nil, nil
+}
+
+func (m *memTHBase) scan(t *memTable2, scan *Hbase2.TScan) []*Hbase2.TResult {
+	keys := make([]string, 0, len(t.rows))
+	for k := range t.rows {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if scan.Reversed {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	}
+
+	var rows []*Hbase2.TResult
+	for _, k := range keys {
+		if scan.StartRow != nil && len(scan.StartRow) > 0 {
+			if (!scan.Reversed && k < string(scan.StartRow)) || (scan.Reversed && k > string(scan.StartRow)) {
+				continue
+			}
+		}
+		if scan.StopRow != nil && len(scan.StopRow) > 0 {
+			if (!scan.Reversed && k >= string(scan.StopRow)) || (scan.Reversed && k <= string(scan.StopRow)) {
+				continue
+			}
+		}
+		if r := t.result(k, scan.Columns, 0, scan.TimeRange, scan.MaxVersions); len(r.ColumnValues) > 0 {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+func (m *memTHBase) OpenScanner(table []byte, tscan *Hbase2.TScan) (int32, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return 0, io, nil
+	}
+	m.nextId++
+	m.scanners[m.nextId] = &memScanner2{rows: m.scan(t, tscan)}
+	return m.nextId, nil, nil
+}
+
+func (m *memTHBase) GetScannerRows(scannerId int32, numRows int32) ([]*Hbase2.TResult, *Hbase2.TIOError, *Hbase2.TIllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.scanners[scannerId]
+	if !ok {
+		return nil, nil, &Hbase2.TIllegalArgument{Message: "invalid scanner id"}, nil
+	}
+	end := s.pos + int(numRows)
+	if end > len(s.rows) {
+		end = len(s.rows)
+	}
+	rows := s.rows[s.pos:end]
+	s.pos = end
+	return rows, nil, nil, nil
+}
+
+func (m *memTHBase) CloseScanner(scannerId int32) (*Hbase2.TIOError, *Hbase2.TIllegalArgument, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.scanners[scannerId]; !ok {
+		return nil, &Hbase2.TIllegalArgument{Message: "invalid scanner id"}, nil
+	}
+	delete(m.scanners, scannerId)
+	return nil, nil, nil
+}
+
+func (m *memTHBase) MutateRow(table []byte, trowMutations *Hbase2.TRowMutations) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return io, nil
+	}
+	for _, mutation := range trowMutations.Mutations {
+		if mutation.Put != nil {
+			m.applyPut(t, mutation.Put)
+		}
+		if mutation.DeleteSingle != nil {
+			m.applyDelete(t, mutation.DeleteSingle)
+		}
+	}
+	return nil, nil
+}
+
+func (m *memTHBase) GetScannerResults(table []byte, tscan *Hbase2.TScan, numRows int32) ([]*Hbase2.TResult, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, io := m.table(table)
+	if io != nil {
+		return nil, io, nil
+	}
+	rows := m.scan(t, tscan)
+	if len(rows) > int(numRows) {
+		rows = rows[:numRows]
+	}
+	return rows, nil, nil
+}
+
+func (m *memTHBase) adminTable(tableName *Hbase2.TTableName) (*memTable2, *Hbase2.TIOError) {
+	t, ok := m.tables[goh.TTableNameString(tableName)]
+	if !ok {
+		return nil, &Hbase2.TIOError{Message: "table not found: " + goh.TTableNameString(tableName)}
+	}
+	return t, nil
+}
+
+func (m *memTHBase) disabledTable(tableName *Hbase2.TTableName) (*memTable2, *Hbase2.TIOError) {
+	t, err := m.adminTable(tableName)
+	if err == nil && !t.disabled {
+		return nil, &Hbase2.TIOError{Message: "table is not disabled: " + goh.TTableNameString(tableName)}
+	}
+	return t, err
+}
+
+func (t *memTable2) family(name []byte) int {
+	for i, col := range t.desc.Columns {
+		if bytes.Equal(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *memTHBase) GetTableDescriptor(table *Hbase2.TTableName) (*Hbase2.TTableDescriptor, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(table)
+	if ioErr != nil {
+		return nil, ioErr, nil
+	}
+	return t.desc, nil, nil
+}
+
+func (m *memTHBase) GetTableDescriptors(tables []*Hbase2.TTableName) ([]*Hbase2.TTableDescriptor, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	descs := make([]*Hbase2.TTableDescriptor, 0, len(tables))
+	for _, table := range tables {
+		if t, ok := m.tables[goh.TTableNameString(table)]; ok {
+			descs = append(descs, t.desc)
+		}
+	}
+	return descs, nil, nil
+}
+
+func (m *memTHBase) TableExists(tableName *Hbase2.TTableName) (bool, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.tables[goh.TTableNameString(tableName)]
+	return ok, nil, nil
+}
+
+func (m *memTHBase) CreateTable(desc *Hbase2.TTableDescriptor, splitKeys [][]byte) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	name := goh.TTableNameString(desc.TableName)
+	if _, ok := m.tables[name]; ok {
+		return &Hbase2.TIOError{Message: "table already exists: " + name}, nil
+	}
+	ns := "default"
+	if len(desc.TableName.Ns) > 0 {
+		ns = string(desc.TableName.Ns)
+	}
+	if _, ok := m.namespaces[ns]; !ok {
+		return &Hbase2.TIOError{Message: "namespace not found: " + ns}, nil
+	}
+	for i := 1; i < len(splitKeys); i++ {
+		if bytes.Compare(splitKeys[i-1], splitKeys[i]) >= 0 {
+			return &Hbase2.TIOError{Message: "split keys are not sorted or contain duplicates"}, nil
+		}
+	}
+	m.tables[name] = &memTable2{rows: make(map[string]map[string][]*Hbase2.TColumnValue), desc: desc, splitKeys: splitKeys}
+	return nil, nil
+}
+
+func (m *memTHBase) DeleteTable(tableName *Hbase2.TTableName) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ioErr := m.disabledTable(tableName); ioErr != nil {
+		return ioErr, nil
+	}
+	delete(m.tables, goh.TTableNameString(tableName))
+	return nil, nil
+}
+
+func (m *memTHBase) TruncateTable(tableName *Hbase2.TTableName, preserveSplits bool) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.disabledTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	t.rows = make(map[string]map[string][]*Hbase2.TColumnValue)
+	if !preserveSplits {
+		t.splitKeys = nil
+	}
+	t.disabled = false
+	return nil, nil
+}
+
+func (m *memTHBase) EnableTable(tableName *Hbase2.TTableName) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.disabledTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	t.disabled = false
+	return nil, nil
+}
+
+func (m *memTHBase) DisableTable(tableName *Hbase2.TTableName) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	if t.disabled {
+		return &Hbase2.TIOError{Message: "table is not enabled: " + goh.TTableNameString(tableName)}, nil
+	}
+	t.disabled = true
+	return nil, nil
+}
+
+func (m *memTHBase) IsTableEnabled(tableName *Hbase2.TTableName) (bool, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(tableName)
+	if ioErr != nil {
+		return false, ioErr, nil
+	}
+	return !t.disabled, nil, nil
+}
+
+func (m *memTHBase) AddColumnFamily(tableName *Hbase2.TTableName, column *Hbase2.TColumnFamilyDescriptor) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	if t.family(column.Name) >= 0 {
+		return &Hbase2.TIOError{Message: "column family already exists: " + string(column.Name)}, nil
+	}
+	t.desc.Columns = append(t.desc.Columns, column)
+	return nil, nil
+}
+
+func (m *memTHBase) DeleteColumnFamily(tableName *Hbase2.TTableName, column []byte) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	i := t.family(column)
+	if i < 0 {
+		return &Hbase2.TIOError{Message: "column family does not exist: " + string(column)}, nil
+	}
+	t.desc.Columns = append(t.desc.Columns[:i], t.desc.Columns[i+1:]...)
+	for _, cols := range t.rows {
+		for name := range cols {
+			if strings.HasPrefix(name, string(column)+":") {
+				delete(cols, name)
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (m *memTHBase) ModifyColumnFamily(tableName *Hbase2.TTableName, column *Hbase2.TColumnFamilyDescriptor) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(tableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	i := t.family(column.Name)
+	if i < 0 {
+		return &Hbase2.TIOError{Message: "column family does not exist: " + string(column.Name)}, nil
+	}
+	t.desc.Columns[i] = column
+	return nil, nil
+}
+
+func (m *memTHBase) ModifyTable(desc *Hbase2.TTableDescriptor) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ioErr := m.adminTable(desc.TableName)
+	if ioErr != nil {
+		return ioErr, nil
+	}
+	t.desc = desc
+	return nil, nil
+}
+
+func (m *memTHBase) CreateNamespace(namespaceDesc *Hbase2.TNamespaceDescriptor) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.namespaces[namespaceDesc.Name]; ok {
+		return &Hbase2.TIOError{Message: "namespace already exists: " + namespaceDesc.Name}, nil
+	}
+	m.namespaces[namespaceDesc.Name] = namespaceDesc
+	return nil, nil
+}
+
+func (m *memTHBase) ModifyNamespace(namespaceDesc *Hbase2.TNamespaceDescriptor) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.namespaces[namespaceDesc.Name]; !ok {
+		return &Hbase2.TIOError{Message: "namespace not found: " + namespaceDesc.Name}, nil
+	}
+	m.namespaces[namespaceDesc.Name] = namespaceDesc
+	return nil, nil
+}
+
+func (m *memTHBase) DeleteNamespace(name string) (*Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.namespaces[name]; !ok {
+		return &Hbase2.TIOError{Message: "namespace not found: " + name}, nil
+	}
+	for _, t := range m.tables {
+		if string(t.desc.TableName.Ns) == name {
+			return &Hbase2.TIOError{Message: "namespace is not empty: " + name}, nil
+		}
+	}
+	delete(m.namespaces, name)
+	return nil, nil
+}
+
+func (m *memTHBase) GetNamespaceDescriptor(name string) (*Hbase2.TNamespaceDescriptor, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ns, ok := m.namespaces[name]
+	if !ok {
+		return nil, &Hbase2.TIOError{Message: "namespace not found: " + name}, nil
+	}
+	return ns, nil, nil
+}
+
+func (m *memTHBase) ListNamespaceDescriptors() ([]*Hbase2.TNamespaceDescriptor, *Hbase2.TIOError, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, 0, len(m.namespaces))
+	for name := range m.namespaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	namespaces := make([]*Hbase2.TNamespaceDescriptor, len(names))
+	for i, name := range names {
+		namespaces[i] = m.namespaces[name]
+	}
+	return namespaces, nil, nil
+}
@@ -0,0 +1,145 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+MultiGetOptions control how MultiGet reads rows
+*/
+type MultiGetOptions struct {
+	ChunkSize int      // rows per call to the server, 1000 if 0
+	Columns   []string // columns or families to read, all if empty
+	Timestamp int64    // read the versions older than the timestamp, the latest if 0
+}
+
+/*
+KeyResult is the result of MultiGet for a key
+*/
+type KeyResult struct {
+	Row      *Row  // nil if the row does not exist or its chunk failed
+	NotFound bool  // the server answered and the row does not exist
+	Err      error // the error of the chunk of the key
+}
+
+/*
+ChunkError is a chunk of keys MultiGet could not read, keys[First:Last]
+*/
+type ChunkError struct {
+	First int
+	Last  int
+	Err   error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprint("goh: rows ", e.First, " to ", e.Last, ": ", e.Err)
+}
+
+/*
+MultiGet reads the rows of keys in chunks of ChunkSize keys, the chunks are read concurrently by the connections of the pool.
+The result has an entry for every key in the order of keys. A chunk that fails does not stop the others,
+its keys carry the error and it is reported in the returned chunk errors, chunks not read when ctx is done fail with ctx.Err().
+*/
+func (p *Pool) MultiGet(ctx context.Context, tableName string, keys [][]byte, opts *MultiGetOptions) ([]KeyResult, []*ChunkError) {
+	o := MultiGetOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.ChunkSize <= 0 {
+		o.ChunkSize = 1000
+	}
+
+	results := make([]KeyResult, len(keys))
+	var mu sync.Mutex
+	var failed []*ChunkError
+	var wg sync.WaitGroup
+	for first := 0; first < len(keys); first += o.ChunkSize {
+		last := first + o.ChunkSize
+		if last > len(keys) {
+			last = len(keys)
+		}
+
+		conn, err := p.Get(ctx)
+		if err != nil && ctx.Err() == nil {
+			// a connection that could not be opened fails its chunk only, the next chunk tries again
+			mu.Lock()
+			failed = append(failed, &ChunkError{First: first, Last: last, Err: err})
+			mu.Unlock()
+			continue
+		}
+		if err != nil {
+			// every chunk left fails the same way
+			mu.Lock()
+			for ; first < len(keys); first += o.ChunkSize {
+				last = first + o.ChunkSize
+				if last > len(keys) {
+					last = len(keys)
+				}
+				failed = append(failed, &ChunkError{First: first, Last: last, Err: err})
+			}
+			mu.Unlock()
+			break
+		}
+
+		wg.Add(1)
+		go func(conn *HClient, first, last int) {
+			defer wg.Done()
+			err := getChunk(conn, tableName, keys[first:last], &o, results[first:last])
+			p.Put(conn)
+			if err != nil {
+				mu.Lock()
+				failed = append(failed, &ChunkError{First: first, Last: last, Err: err})
+				mu.Unlock()
+			}
+		}(conn, first, last)
+	}
+	wg.Wait()
+
+	for _, e := range failed {
+		for i := e.First; i < e.Last; i++ {
+			results[i] = KeyResult{Err: e.Err}
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].First < failed[j].First })
+	return results, failed
+}
+
+/*
+getChunk reads the rows of keys with conn into results
+*/
+func getChunk(conn *HClient, tableName string, keys [][]byte, o *MultiGetOptions, results []KeyResult) error {
+	var rows []*Hbase.TRowResult
+	var err error
+	switch {
+	case o.Timestamp != 0 && len(o.Columns) > 0:
+		rows, err = conn.GetRowsWithColumnsTs(tableName, keys, o.Columns, o.Timestamp, nil)
+	case o.Timestamp != 0:
+		rows, err = conn.GetRowsTs(tableName, keys, o.Timestamp, nil)
+	case len(o.Columns) > 0:
+		rows, err = conn.GetRowsWithColumns(tableName, keys, o.Columns, nil)
+	default:
+		rows, err = conn.GetRows(tableName, keys, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]*Row, len(rows))
+	for _, row := range rows {
+		byKey[string(row.Row)] = toRow(row)
+	}
+	for i, key := range keys {
+		row, ok := byKey[string(key)]
+		results[i] = KeyResult{Row: row, NotFound: !ok}
+	}
+	return nil
+}
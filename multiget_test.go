@@ -0,0 +1,182 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/thrift"
+)
+
+func TestMultiGet(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	var keys [][]byte
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("k%03d", i)
+		if i%3 != 0 {
+			handler.put("test", key, "cf:x", "v"+key, 1)
+		}
+		keys = append(keys, []byte(key))
+	}
+	keys = append(keys, []byte("k001"))
+	handler.failOn("k042")
+
+	pool := goh.NewPool(client, 3)
+	defer pool.Close()
+	results, failed := pool.MultiGet(context.Background(), "test", keys, &goh.MultiGetOptions{ChunkSize: 10, Columns: []string{"cf"}})
+	if len(results) != len(keys) {
+		t.Fatalf("results = %d, want %d", len(results), len(keys))
+	}
+	if len(failed) != 1 || failed[0].First != 40 || failed[0].Last != 50 || !isHbaseIOError(failed[0].Err) {
+		t.Fatalf("failed chunks = %v", failed)
+	}
+
+	for i, r := range results {
+		key := string(keys[i])
+		switch {
+		case i >= 40 && i < 50:
+			if r.Err != failed[0].Err || r.Row != nil || r.NotFound {
+				t.Errorf("%s in a failed chunk = %+v", key, r)
+			}
+		case i%3 == 0 && i < 100:
+			if !r.NotFound || r.Row != nil || r.Err != nil {
+				t.Errorf("missing %s = %+v", key, r)
+			}
+		default:
+			if r.NotFound || r.Err != nil || string(r.Row.Key) != key || string(r.Row.Value("cf", "x")) != "v"+key {
+				t.Errorf("%s = %+v", key, r)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, failed = pool.MultiGet(ctx, "test", keys, &goh.MultiGetOptions{ChunkSize: 50}); len(failed) == 0 || failed[len(failed)-1].Last != len(keys) {
+		t.Errorf("failed chunks with a canceled context = %v", failed)
+	}
+}
+
+func TestMultiGetDialError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := goh.NewPool(client, 2)
+	defer pool.Close()
+
+	keys := [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")}
+	results, failed := pool.MultiGet(context.Background(), "test", keys, &goh.MultiGetOptions{ChunkSize: 2})
+	if len(failed) != 3 {
+		t.Fatalf("failed chunks = %v, want 3", failed)
+	}
+	for i, e := range failed {
+		// every chunk dials on its own, none fails with the error of another
+		if e.First != 2*i || e.Err == nil || (i > 0 && e.Err == failed[i-1].Err) {
+			t.Errorf("failed chunk %d = %v", i, e)
+		}
+	}
+	for i, r := range results {
+		if r.Err == nil || r.Row != nil {
+			t.Errorf("%s = %+v", keys[i], r)
+		}
+	}
+}
+
+func isHbaseIOError(err error) bool {
+	e, ok := err.(*goh.HbaseError)
+	return ok && e.IOErr != nil
+}
+
+func TestPool(t *testing.T) {
+	_, client := newGatewayClient(t)
+	pool := goh.NewPool(client, 1)
+
+	conn, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn == client {
+		t.Error("the pool uses the client it was created from")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = pool.Get(ctx); err != context.Canceled {
+		t.Errorf("Get of a full pool = %v, want context.Canceled", err)
+	}
+
+	pool.Put(conn)
+	again, err := pool.Get(context.Background())
+	if err != nil || again != conn {
+		t.Errorf("Get after Put = %v, %v, want the same connection", again, err)
+	}
+	if _, err = again.GetTableNames(); err != nil {
+		t.Error(err)
+	}
+	pool.Put(again)
+
+	if err = pool.Close(); err != nil {
+		t.Error(err)
+	}
+	if _, err = pool.Get(context.Background()); !isHbaseError(err, goh.ErrPoolClosed) {
+		t.Errorf("Get of a closed pool = %v, want ErrPoolClosed", err)
+	}
+}
+
+func TestPoolDropsBrokenConnections(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	server := startThreadPoolServer(t, "127.0.0.1:0", handler)
+	addr := server.ServerTransport().(*thrift.TNonblockingServerSocket).Addr().String()
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := goh.NewPool(client, 1)
+	defer pool.Close()
+
+	conn, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = server.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.GetTableNames(); err == nil || conn.State() != goh.StateBroken {
+		t.Fatalf("call without a server = %v, state %v", err, conn.State())
+	}
+	pool.Put(conn)
+	if conn.State() != goh.StateClosed {
+		t.Errorf("state of a broken connection given back = %v, want closed", conn.State())
+	}
+
+	startThreadPoolServer(t, addr, handler)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	again, err := pool.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == conn {
+		t.Error("Get returned the broken connection")
+	}
+	if _, err = again.GetTableNames(); err != nil {
+		t.Error(err)
+	}
+	pool.Put(again)
+}
@@ -0,0 +1,74 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+func TestMultiplexedClient(t *testing.T) {
+	data := newMemHbase()
+	data.createTable("data", "cf")
+	admin := newMemHbase()
+	admin.createTable("admin", "cf")
+
+	processor := thrift.NewTMultiplexedProcessor()
+	processor.RegisterProcessor("Hbase", Hbase.NewHbaseProcessor(data))
+	processor.RegisterProcessor("Admin", Hbase.NewHbaseProcessor(admin))
+	processor.RegisterDefault(Hbase.NewHbaseProcessor(data))
+
+	addr := newTcpTestServer(t, processor, thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+
+	tables := func(serviceName string) []string {
+		client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = client.Open(); err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+
+		client.SetServiceName(serviceName)
+		names, err := client.GetTableNames()
+		if err != nil {
+			t.Fatalf("service %q: %v", serviceName, err)
+		}
+		return names
+	}
+
+	if names := tables("Hbase"); len(names) != 1 || names[0] != "data" {
+		t.Errorf("Hbase tables = %v", names)
+	}
+	if names := tables("Admin"); len(names) != 1 || names[0] != "admin" {
+		t.Errorf("Admin tables = %v", names)
+	}
+	if names := tables(""); len(names) != 1 || names[0] != "data" {
+		t.Errorf("default tables = %v", names)
+	}
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	client.SetServiceName("Missing")
+	_, err = client.GetTableNames()
+	herr, ok := err.(*goh.HbaseError)
+	if !ok {
+		t.Fatalf("GetTableNames on a missing service: %v", err)
+	}
+	if x, ok := herr.Err.(thrift.TApplicationException); !ok || x.TypeId() != thrift.UNKNOWN_METHOD {
+		t.Errorf("GetTableNames on a missing service: %v", herr.Err)
+	}
+}
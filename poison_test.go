@@ -0,0 +1,213 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+fakeServer answers getRow with the requested row, it answers "slow" rows late,
+"badseq" rows with a wrong seqid and "truncated" rows with a response cut short
+*/
+type fakeServer struct {
+	l           net.Listener
+	delay       time.Duration
+	connections int32
+}
+
+func newFakeServer(t *testing.T, delay time.Duration) *fakeServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{l: l, delay: delay}
+	go s.serve()
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.l.Accept()
+		if err != nil {
+			return
+		}
+		atomic.AddInt32(&s.connections, 1)
+		go s.serveConn(conn)
+	}
+}
+
+func (s *fakeServer) serveConn(conn net.Conn) {
+	defer conn.Close()
+	socket, _ := thrift.NewTSocketConn(conn)
+	trans := thrift.NewTFramedTransport(socket)
+	protocol := thrift.NewTBinaryProtocolFactoryDefault().GetProtocol(trans)
+
+	for {
+		_, _, seqId, err := protocol.ReadMessageBegin()
+		if err != nil {
+			return
+		}
+		args := Hbase.NewGetRowArgs()
+		if err := args.Read(protocol); err != nil {
+			return
+		}
+		protocol.ReadMessageEnd()
+
+		row := string(args.Row)
+		if strings.HasPrefix(row, "slow") {
+			time.Sleep(s.delay)
+		}
+		if strings.HasPrefix(row, "badseq") {
+			seqId += 100
+		}
+		if strings.HasPrefix(row, "truncated") {
+			protocol.WriteMessageBegin("getRow", thrift.REPLY, seqId)
+			protocol.WriteStructBegin("getRow_result")
+			protocol.WriteFieldBegin("success", thrift.LIST, 0)
+			protocol.WriteListBegin(thrift.STRUCT, 1)
+			protocol.WriteStructBegin("TRowResult")
+			protocol.WriteFieldBegin("row", thrift.STRING, 1)
+			protocol.WriteI32(100)
+			trans.Write([]byte("abc"))
+			trans.Flush()
+			return
+		}
+
+		result := Hbase.NewGetRowResult()
+		result.Success = []*Hbase.TRowResult{&Hbase.TRowResult{Row: args.Row}}
+		protocol.WriteMessageBegin("getRow", thrift.REPLY, seqId)
+		result.Write(protocol)
+		protocol.WriteMessageEnd()
+		if err := trans.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func checkRow(t *testing.T, client *goh.HClient, row string) {
+	rows, err := client.GetRow("test", []byte(row), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 || string(rows[0].Row) != row {
+		t.Fatalf("GetRow(%s) = %v", row, rows)
+	}
+}
+
+func TestPoisonAfterTimeout(t *testing.T) {
+	server := newFakeServer(t, 300*time.Millisecond)
+	client, err := goh.NewTcpClientTimeout(server.l.Addr().String(), goh.TBinaryProtocol, true, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	checkRow(t, client, "row1")
+	if _, err = client.GetRow("test", []byte("slow1"), nil); err == nil {
+		t.Fatal("GetRow did not time out")
+	}
+	if client.State() != goh.StateBroken {
+		t.Fatalf("state after a timeout = %v", client.State())
+	}
+
+	// the late answer to slow1 must not be read as the answer to row2
+	time.Sleep(300 * time.Millisecond)
+	checkRow(t, client, "row2")
+	checkRow(t, client, "row3")
+	if n := atomic.LoadInt32(&server.connections); n != 2 {
+		t.Errorf("%d connections, want 2", n)
+	}
+}
+
+func TestPoisonAfterBadSequenceId(t *testing.T) {
+	server := newFakeServer(t, 0)
+	client, err := goh.NewTcpClient(server.l.Addr().String(), goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	_, err = client.GetRow("test", []byte("badseq"), nil)
+	if err == nil {
+		t.Fatal("out of sequence response accepted")
+	}
+	if x, ok := err.(*goh.HbaseError).Err.(thrift.TApplicationException); !ok || x.TypeId() != thrift.BAD_SEQUENCE_ID {
+		t.Errorf("GetRow = %v, want BAD_SEQUENCE_ID", err)
+	}
+	if client.State() != goh.StateBroken {
+		t.Fatalf("state after an out of sequence response = %v", client.State())
+	}
+
+	checkRow(t, client, "row1")
+	if n := atomic.LoadInt32(&server.connections); n != 2 {
+		t.Errorf("%d connections, want 2", n)
+	}
+}
+
+func TestPoisonAsyncTimeout(t *testing.T) {
+	server := newFakeServer(t, 300*time.Millisecond)
+	client, err := goh.NewTcpClientTimeout(server.l.Addr().String(), goh.TBinaryProtocol, true, 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	slow := client.GetRowAsync("test", []byte("slow1"), nil)
+	fast := client.GetRowAsync("test", []byte("row1"), nil)
+	<-slow.Done()
+	<-fast.Done()
+	if _, err = fast.Wait(context.Background()); err == nil {
+		t.Error("call pipelined behind a timed out call succeeded")
+	}
+	if client.State() != goh.StateBroken {
+		t.Fatalf("state after a timeout = %v", client.State())
+	}
+
+	checkRow(t, client, "row2")
+}
+
+func TestPoisonAsyncDecodeError(t *testing.T) {
+	server := newFakeServer(t, 0)
+	client, err := goh.NewTcpClient(server.l.Addr().String(), goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err = client.GetRowAsync("test", []byte("truncated"), nil).Wait(context.Background()); err == nil {
+		t.Fatal("truncated response accepted")
+	}
+	if client.State() != goh.StateBroken {
+		t.Fatalf("state after a response that could not be decoded = %v", client.State())
+	}
+
+	checkRow(t, client, "row1")
+	if n := atomic.LoadInt32(&server.connections); n != 2 {
+		t.Errorf("%d connections, want 2", n)
+	}
+}
@@ -1 +1,112 @@
+/*
+
+ */
+
 package goh
+
+import (
+	"context"
+	"sync"
+)
+
+/*
+Pool is a pool of connections to the server of a client, it is safe for concurrent use.
+Connections are opened on demand with NewConnection, the client the pool was created from is not used by it.
+*/
+type Pool struct {
+	client *HClient
+	tokens chan struct{} // a token for every connection in use
+
+	mu     sync.Mutex
+	idle   []*HClient
+	closed bool
+}
+
+/*
+NewPool creates a pool of at most size connections to the server of client, 4 if size is 0
+*/
+func NewPool(client *HClient, size int) *Pool {
+	if size <= 0 {
+		size = 4
+	}
+	return &Pool{client: client, tokens: make(chan struct{}, size)}
+}
+
+/*
+Size return the maximum number of connections of the pool
+*/
+func (p *Pool) Size() int {
+	return cap(p.tokens)
+}
+
+/*
+Get return a connection of the pool, waiting while all of them are in use, the connection is given back with Put.
+It fails with ctx.Err() once ctx is done.
+*/
+func (p *Pool) Get(ctx context.Context) (*HClient, error) {
+	// select picks at random when a token is free as well, a done ctx must always win
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	select {
+	case p.tokens <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		<-p.tokens
+		return nil, newHbaseError(nil, nil, ErrPoolClosed)
+	}
+	if n := len(p.idle); n > 0 {
+		conn := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return conn, nil
+	}
+	p.mu.Unlock()
+
+	conn, err := p.client.NewConnection()
+	if err != nil {
+		<-p.tokens
+		return nil, err
+	}
+	return conn, nil
+}
+
+/*
+Put gives back a connection returned by Get, a broken connection is closed instead of kept,
+the next Get opens a new one in its place
+*/
+func (p *Pool) Put(conn *HClient) {
+	broken := conn.State() == StateBroken
+	p.mu.Lock()
+	if p.closed || broken {
+		p.mu.Unlock()
+		conn.Close()
+	} else {
+		p.idle = append(p.idle, conn)
+		p.mu.Unlock()
+	}
+	<-p.tokens
+}
+
+/*
+Close closes the idle connections, connections in use are closed when they are given back
+*/
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle, p.closed = nil, true
+	p.mu.Unlock()
+
+	var err error
+	for _, conn := range idle {
+		if e := conn.Close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
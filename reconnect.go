@@ -0,0 +1,271 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+const (
+	defaultMinBackoff = 100 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+/*
+State return the state of the connection
+*/
+func (client *HClient) State() ConnState {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.state
+}
+
+/*
+OnStateChange register fn to be called after every change of the connection state,
+fn is called from the goroutine that caused the change and must not block
+*/
+func (client *HClient) OnStateChange(fn func(from, to ConnState)) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.listeners = append(client.listeners, fn)
+}
+
+/*
+SetReconnectBackoff set how long a broken client waits between reconnect attempts,
+the wait starts at min and doubles after every failed attempt up to max
+*/
+func (client *HClient) SetReconnectBackoff(min, max time.Duration) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if max < min {
+		max = min
+	}
+	client.minBackoff = min
+	client.maxBackoff = max
+}
+
+/*
+setTransport make the client use trans, mu is held or the client is not shared yet
+*/
+func (client *HClient) setTransport(trans thrift.TTransport, monitor *transportMonitor) {
+	client.Trans = trans
+	client.monitor = monitor
+	client.hbase = Hbase.NewHbaseClientFactory(trans, client.ProtocolFactory)
+	client.pipe = newPipeline(client, client.hbase)
+	if header, ok := trans.(*thrift.THeaderTransport); ok {
+		for key, value := range client.headers {
+			header.SetWriteHeader(key, value)
+		}
+	}
+}
+
+/*
+setState record a state change, listeners are called by notify once mu is released
+*/
+func (client *HClient) setState(to ConnState) {
+	if client.state == to {
+		return
+	}
+	client.changes = append(client.changes, stateChange{client.state, to})
+	client.state = to
+}
+
+type stateChange struct {
+	from, to ConnState
+}
+
+func (client *HClient) notify() {
+	client.mu.Lock()
+	changes := client.changes
+	client.changes = nil
+	listeners := client.listeners
+	client.mu.Unlock()
+
+	for _, change := range changes {
+		for _, fn := range listeners {
+			fn(change.from, change.to)
+		}
+	}
+}
+
+/*
+connect open the transport, with a new one if it has been used before, mu is held
+*/
+func (client *HClient) connect() error {
+	from := client.state
+	client.setState(StateConnecting)
+
+	trans, monitor := client.Trans, client.monitor
+	var err error
+	if client.used {
+		if from == StateBroken {
+			trans.Close()
+		}
+		trans, monitor, err = client.dial()
+	}
+	if err == nil {
+		err = trans.Open()
+	}
+
+	if err != nil {
+		client.setState(StateBroken)
+		if client.backoff == 0 {
+			client.backoff = client.minBackoff
+		} else if client.backoff *= 2; client.backoff > client.maxBackoff {
+			client.backoff = client.maxBackoff
+		}
+		client.nextAttempt = time.Now().Add(client.backoff)
+		return err
+	}
+
+	if trans != client.Trans {
+		client.setTransport(trans, monitor)
+	}
+	client.used = true
+	client.backoff = 0
+	client.setState(StateOpen)
+	return nil
+}
+
+/*
+conn return the thrift client to make a call with, reconnecting a broken client.
+It fails with ErrReconnectBackoff before the next reconnect attempt is due or with the error of the reconnect,
+the call is not made then.
+*/
+func (client *HClient) conn() (*Hbase.HbaseClient, error) {
+	client.mu.Lock()
+	var err error
+	if client.state == StateBroken {
+		if time.Now().Before(client.nextAttempt) {
+			err = ErrReconnectBackoff
+		} else {
+			err = client.connect()
+		}
+	}
+	hbase := client.hbase
+	client.mu.Unlock()
+	client.notify()
+
+	if err != nil {
+		return nil, newHbaseError(nil, nil, err)
+	}
+	return hbase, nil
+}
+
+/*
+markBroken move the client to StateBroken after a call made with hbase failed with err,
+or after a read or write on its transport failed.
+The rest of a response that timed out or was read in part would be read by the next call,
+the connection is never used again.
+*/
+func (client *HClient) markBroken(hbase *Hbase.HbaseClient, err error) {
+	client.mu.Lock()
+	if client.hbase != hbase || client.state != StateOpen {
+		client.mu.Unlock()
+		return
+	}
+	if !isConnectionError(err) && client.monitor.Err() == nil {
+		client.mu.Unlock()
+		return
+	}
+	client.setState(StateBroken)
+	client.nextAttempt = time.Time{}
+	client.mu.Unlock()
+	client.notify()
+}
+
+/*
+isConnectionError report whether err leaves the connection unusable,
+exceptions sent by the server do not unless the response did not match the request
+*/
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if x, ok := err.(thrift.TApplicationException); ok {
+		return x.TypeId() == thrift.BAD_SEQUENCE_ID || x.TypeId() == thrift.WRONG_METHOD_NAME
+	}
+	return true
+}
+
+/*
+transportMonitor remember the first error of the reads and writes on a transport,
+the generated client ignores some of them such as the errors of Flush
+*/
+type transportMonitor struct {
+	thrift.TTransport
+
+	mu  sync.Mutex
+	err error
+}
+
+func newTransportMonitor(trans thrift.TTransport) *transportMonitor {
+	return &transportMonitor{TTransport: trans}
+}
+
+func (m *transportMonitor) fail(err error) {
+	if err == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err == nil {
+		m.err = err
+	}
+}
+
+/*
+Err return the first error of the transport
+*/
+func (m *transportMonitor) Err() error {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+func (m *transportMonitor) Read(buf []byte) (int, error) {
+	n, err := m.TTransport.Read(buf)
+	m.fail(err)
+	return n, err
+}
+
+func (m *transportMonitor) ReadAll(buf []byte) (int, error) {
+	return thrift.ReadAllTransport(m, buf)
+}
+
+func (m *transportMonitor) Write(buf []byte) (int, error) {
+	n, err := m.TTransport.Write(buf)
+	m.fail(err)
+	return n, err
+}
+
+func (m *transportMonitor) Flush() error {
+	err := m.TTransport.Flush()
+	m.fail(err)
+	return err
+}
+
+/*
+checkHbaseError check the result of a call made with client.conn()
+*/
+func (client *HClient) checkHbaseError(io *Hbase.IOError, err error) error {
+	client.markBroken(client.hbase, err)
+	return checkHbaseError(io, err)
+}
+
+/*
+checkHbaseArgError check the result of a call made with client.conn()
+*/
+func (client *HClient) checkHbaseArgError(io *Hbase.IOError, arg *Hbase.IllegalArgument, err error) error {
+	client.markBroken(client.hbase, err)
+	return checkHbaseArgError(io, arg, err)
+}
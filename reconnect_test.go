@@ -0,0 +1,126 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+func startThreadPoolServer(t *testing.T, addr string, handler Hbase.IHbase) *thrift.TThreadPoolServer {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverTransport, err := thrift.NewTNonblockingServerSocketListener(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTThreadPoolServer4(Hbase.NewHbaseProcessor(handler), serverTransport, thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+	go server.Serve()
+	t.Cleanup(func() { server.Stop() })
+	return server
+}
+
+type stateRecorder struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (r *stateRecorder) record(from, to goh.ConnState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.changes = append(r.changes, from.String()+"->"+to.String())
+}
+
+func (r *stateRecorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changes := r.changes
+	r.changes = nil
+	return changes
+}
+
+func TestReconnect(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	server := startThreadPoolServer(t, "127.0.0.1:0", handler)
+	addr := server.ServerTransport().(*thrift.TNonblockingServerSocket).Addr().String()
+
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := &stateRecorder{}
+	client.OnStateChange(recorder.record)
+	client.SetReconnectBackoff(50*time.Millisecond, time.Second)
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if _, err = client.GetTableNames(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := recorder.take(), []string{"closed->connecting", "connecting->open"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("opening changed state %v, want %v", got, want)
+	}
+
+	// the gateway goes away
+	if err = server.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetTableNames(); err == nil {
+		t.Fatal("call on a closed connection succeeded")
+	}
+	if client.State() != goh.StateBroken {
+		t.Fatalf("state after a transport error = %v", client.State())
+	}
+
+	// the first reconnect is made at once, the next one waits for the backoff
+	if _, err = client.GetTableNames(); err == nil {
+		t.Fatal("call without a server succeeded")
+	}
+	if _, err = client.GetTableNames(); err == nil || err.(*goh.HbaseError).Err != goh.ErrReconnectBackoff {
+		t.Fatalf("call during the backoff = %v", err)
+	}
+	if got, want := recorder.take(), []string{"open->broken", "broken->connecting", "connecting->broken"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("failing reconnect changed state %v, want %v", got, want)
+	}
+
+	// the gateway is back
+	startThreadPoolServer(t, addr, handler)
+	time.Sleep(60 * time.Millisecond)
+	tables, err := client.GetTableNames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 1 || tables[0] != "test" {
+		t.Errorf("GetTableNames = %v", tables)
+	}
+	if got, want := recorder.take(), []string{"broken->connecting", "connecting->open"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reconnecting changed state %v, want %v", got, want)
+	}
+
+	// exceptions sent by the server keep the connection
+	if _, err = client.GetRow("missing", []byte("row1"), nil); err == nil {
+		t.Fatal("GetRow on a missing table succeeded")
+	}
+	if client.State() != goh.StateOpen {
+		t.Errorf("state after an IOError = %v", client.State())
+	}
+
+	client.Close()
+	if got, want := recorder.take(), []string{"open->closed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("closing changed state %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,316 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/sdming/goh/Hbase"
+)
+
+/*
+Cell is a version of a column
+*/
+type Cell struct {
+	Family    []byte
+	Qualifier []byte
+	Value     []byte
+	Timestamp int64
+}
+
+/*
+Column return the column of the cell
+*/
+func (c *Cell) Column() Column {
+	return Column{Family: c.Family, Qualifier: c.Qualifier}
+}
+
+/*
+Row is a row with its cells sorted by family and qualifier, the versions of a column newest first.
+Every Get* and Scanner* method has a variant returning Row or Cell, except GetRowOrBefore whose cells do not carry their column.
+*/
+type Row struct {
+	Key   []byte
+	Cells []*Cell
+}
+
+/*
+NewRow creates a row of a sorted copy of cells, cells is left unchanged
+*/
+func NewRow(key []byte, cells []*Cell) *Row {
+	return &Row{Key: key, Cells: sortCells(append([]*Cell(nil), cells...))}
+}
+
+// sortCells sort cells in place by family and qualifier, the versions of a column newest first
+func sortCells(cells []*Cell) []*Cell {
+	sort.SliceStable(cells, func(i, j int) bool {
+		a, b := cells[i], cells[j]
+		if c := bytes.Compare(a.Family, b.Family); c != 0 {
+			return c < 0
+		}
+		if c := bytes.Compare(a.Qualifier, b.Qualifier); c != 0 {
+			return c < 0
+		}
+		return a.Timestamp > b.Timestamp
+	})
+	return cells
+}
+
+/*
+Cell return the newest version of a column, nil if the row does not have it
+*/
+func (r *Row) Cell(family, qualifier string) *Cell {
+	return r.CellCol(Column{Family: []byte(family), Qualifier: []byte(qualifier)})
+}
+
+/*
+CellCol is Cell taking a Column
+*/
+func (r *Row) CellCol(column Column) *Cell {
+	i := sort.Search(len(r.Cells), func(i int) bool {
+		c := r.Cells[i]
+		f := bytes.Compare(c.Family, column.Family)
+		return f > 0 || (f == 0 && bytes.Compare(c.Qualifier, column.Qualifier) >= 0)
+	})
+	if i < len(r.Cells) && bytes.Equal(r.Cells[i].Family, column.Family) && bytes.Equal(r.Cells[i].Qualifier, column.Qualifier) {
+		return r.Cells[i]
+	}
+	return nil
+}
+
+/*
+Value return the newest value of a column, nil if the row does not have it
+*/
+func (r *Row) Value(family, qualifier string) []byte {
+	if c := r.Cell(family, qualifier); c != nil {
+		return c.Value
+	}
+	return nil
+}
+
+/*
+Families return the families of the row in order
+*/
+func (r *Row) Families() [][]byte {
+	families := make([][]byte, 0)
+	for _, c := range r.Cells {
+		if len(families) == 0 || !bytes.Equal(families[len(families)-1], c.Family) {
+			families = append(families, c.Family)
+		}
+	}
+	return families
+}
+
+/*
+Latest return the newest version of every column, in column order
+*/
+func (r *Row) Latest() []*Cell {
+	latest := make([]*Cell, 0, len(r.Cells))
+	for _, c := range r.Cells {
+		if n := len(latest); n > 0 && bytes.Equal(latest[n-1].Family, c.Family) && bytes.Equal(latest[n-1].Qualifier, c.Qualifier) {
+			continue
+		}
+		latest = append(latest, c)
+	}
+	return latest
+}
+
+/*
+Map return the newest values of the row by family and qualifier, the keys hold the bytes of the names unchanged
+*/
+func (r *Row) Map() map[string]map[string][]byte {
+	m := make(map[string]map[string][]byte)
+	for _, c := range r.Latest() {
+		family, ok := m[string(c.Family)]
+		if !ok {
+			family = make(map[string][]byte)
+			m[string(c.Family)] = family
+		}
+		family[string(c.Qualifier)] = c.Value
+	}
+	return m
+}
+
+func toCells(column string, cells []*Hbase.TCell) []*Cell {
+	family, qualifier := splitColumn(column)
+	list := make([]*Cell, len(cells))
+	for i, cell := range cells {
+		list[i] = &Cell{Family: family, Qualifier: qualifier, Value: cell.Value, Timestamp: cell.Timestamp}
+	}
+	return list
+}
+
+func toRow(result *Hbase.TRowResult) *Row {
+	cells := make([]*Cell, 0, len(result.Columns)+len(result.SortedColumns))
+	for column, cell := range result.Columns {
+		cells = append(cells, toCells(column, []*Hbase.TCell{cell})...)
+	}
+	for _, column := range result.SortedColumns {
+		cells = append(cells, toCells(string(column.ColumnName), []*Hbase.TCell{column.Cell})...)
+	}
+	return &Row{Key: result.Row, Cells: sortCells(cells)}
+}
+
+func toRows(results []*Hbase.TRowResult) []*Row {
+	rows := make([]*Row, len(results))
+	for i, result := range results {
+		rows[i] = toRow(result)
+	}
+	return rows
+}
+
+// firstRow return the row of results with key row, nil if there is none
+func firstRow(results []*Hbase.TRowResult, row []byte) *Row {
+	for _, result := range results {
+		if bytes.Equal(result.Row, row) {
+			return toRow(result)
+		}
+	}
+	return nil
+}
+
+/*
+Cells is Get returning Cell
+*/
+func (client *HClient) Cells(tableName string, row []byte, column string, attributes map[string][]byte) ([]*Cell, error) {
+	cells, err := client.Get(tableName, row, column, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toCells(column, cells), nil
+}
+
+/*
+CellVersions is GetVer returning Cell
+*/
+func (client *HClient) CellVersions(tableName string, row []byte, column string, numVersions int32, attributes map[string][]byte) ([]*Cell, error) {
+	cells, err := client.GetVer(tableName, row, column, numVersions, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toCells(column, cells), nil
+}
+
+/*
+CellVersionsTs is GetVerTs returning Cell
+*/
+func (client *HClient) CellVersionsTs(tableName string, row []byte, column string, timestamp int64, numVersions int32, attributes map[string][]byte) ([]*Cell, error) {
+	cells, err := client.GetVerTs(tableName, row, column, timestamp, numVersions, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toCells(column, cells), nil
+}
+
+/*
+Row is GetRow returning a Row, nil if the row does not exist
+*/
+func (client *HClient) Row(tableName string, row []byte, attributes map[string][]byte) (*Row, error) {
+	results, err := client.GetRow(tableName, row, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return firstRow(results, row), nil
+}
+
+/*
+RowWithColumns is GetRowWithColumns returning a Row, nil if the row does not exist
+*/
+func (client *HClient) RowWithColumns(tableName string, row []byte, columns []string, attributes map[string][]byte) (*Row, error) {
+	results, err := client.GetRowWithColumns(tableName, row, columns, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return firstRow(results, row), nil
+}
+
+/*
+RowTs is GetRowTs returning a Row, nil if the row does not exist
+*/
+func (client *HClient) RowTs(tableName string, row []byte, timestamp int64, attributes map[string][]byte) (*Row, error) {
+	results, err := client.GetRowTs(tableName, row, timestamp, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return firstRow(results, row), nil
+}
+
+/*
+RowWithColumnsTs is GetRowWithColumnsTs returning a Row, nil if the row does not exist
+*/
+func (client *HClient) RowWithColumnsTs(tableName string, row []byte, columns []string, timestamp int64, attributes map[string][]byte) (*Row, error) {
+	results, err := client.GetRowWithColumnsTs(tableName, row, columns, timestamp, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return firstRow(results, row), nil
+}
+
+/*
+Rows is GetRows returning Row, rows that do not exist are left out
+*/
+func (client *HClient) Rows(tableName string, rows [][]byte, attributes map[string][]byte) ([]*Row, error) {
+	results, err := client.GetRows(tableName, rows, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
+
+/*
+RowsWithColumns is GetRowsWithColumns returning Row, rows that do not exist are left out
+*/
+func (client *HClient) RowsWithColumns(tableName string, rows [][]byte, columns []string, attributes map[string][]byte) ([]*Row, error) {
+	results, err := client.GetRowsWithColumns(tableName, rows, columns, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
+
+/*
+RowsTs is GetRowsTs returning Row, rows that do not exist are left out
+*/
+func (client *HClient) RowsTs(tableName string, rows [][]byte, timestamp int64, attributes map[string][]byte) ([]*Row, error) {
+	results, err := client.GetRowsTs(tableName, rows, timestamp, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
+
+/*
+RowsWithColumnsTs is GetRowsWithColumnsTs returning Row, rows that do not exist are left out
+*/
+func (client *HClient) RowsWithColumnsTs(tableName string, rows [][]byte, columns []string, timestamp int64, attributes map[string][]byte) ([]*Row, error) {
+	results, err := client.GetRowsWithColumnsTs(tableName, rows, columns, timestamp, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
+
+/*
+ScannerRows is ScannerGet returning Row
+*/
+func (client *HClient) ScannerRows(id int32) ([]*Row, error) {
+	results, err := client.ScannerGet(id)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
+
+/*
+ScannerRowsList is ScannerGetList returning Row
+*/
+func (client *HClient) ScannerRowsList(id int32, nbRows int32) ([]*Row, error) {
+	results, err := client.ScannerGetList(id, nbRows)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(results), nil
+}
@@ -0,0 +1,97 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sdming/goh"
+)
+
+func TestRow(t *testing.T) {
+	cells := []*goh.Cell{
+		{Family: []byte("b"), Qualifier: []byte("x"), Value: []byte("bx1"), Timestamp: 1},
+		{Family: []byte("a"), Qualifier: []byte("y"), Value: []byte("ay"), Timestamp: 1},
+		{Family: []byte("b"), Qualifier: []byte("x"), Value: []byte("bx2"), Timestamp: 2},
+		{Family: []byte("a"), Qualifier: []byte(""), Value: []byte("a"), Timestamp: 3},
+	}
+	row := goh.NewRow([]byte("r"), cells)
+	if string(cells[0].Value) != "bx1" || string(cells[3].Value) != "a" {
+		t.Error("NewRow sorted the cells it was given")
+	}
+
+	var columns []string
+	for _, c := range row.Cells {
+		columns = append(columns, c.Column().String())
+	}
+	if want := []string{"a:", "a:y", "b:x", "b:x"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("columns = %v, want %v", columns, want)
+	}
+	if string(row.Value("b", "x")) != "bx2" || string(row.Value("a", "")) != "a" || row.Value("a", "x") != nil || row.Cell("c", "x") != nil {
+		t.Errorf("Value b:x = %q, a: = %q", row.Value("b", "x"), row.Value("a", ""))
+	}
+	if families := row.Families(); !reflect.DeepEqual(families, [][]byte{[]byte("a"), []byte("b")}) {
+		t.Errorf("Families = %v", families)
+	}
+	if latest := row.Latest(); len(latest) != 3 || latest[2].Timestamp != 2 {
+		t.Errorf("Latest = %v", latest)
+	}
+	want := map[string]map[string][]byte{
+		"a": {"": []byte("a"), "y": []byte("ay")},
+		"b": {"x": []byte("bx2")},
+	}
+	if m := row.Map(); !reflect.DeepEqual(m, want) {
+		t.Errorf("Map = %q", m)
+	}
+}
+
+func TestRowMethods(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.put("test", "a", "cf:y", "y", 2)
+	handler.put("test", "a", "cf:x", "new", 3)
+
+	row, err := client.Row("test", []byte("a"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(row.Key) != "a" || len(row.Cells) != 2 || string(row.Value("cf", "x")) != "new" || string(row.Cells[1].Qualifier) != "y" {
+		t.Errorf("Row = %+v", row)
+	}
+	if row, err = client.Row("test", []byte("b"), nil); err != nil || row != nil {
+		t.Errorf("Row of a missing row = %v, %v", row, err)
+	}
+	if row, err = client.RowWithColumnsTs("test", []byte("a"), []string{"cf:x"}, 2, nil); err != nil || len(row.Cells) != 1 || string(row.Value("cf", "x")) != "value a" {
+		t.Errorf("RowWithColumnsTs = %v, %v", row, err)
+	}
+
+	rows, err := client.Rows("test", [][]byte{[]byte("c"), []byte("b"), []byte("a")}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || string(rows[0].Key) != "c" || string(rows[1].Key) != "a" {
+		t.Errorf("Rows = %v", rows)
+	}
+
+	cells, err := client.CellVersions("test", []byte("a"), "cf:x", 5, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cells) != 2 || string(cells[0].Family) != "cf" || string(cells[0].Qualifier) != "x" || cells[0].Timestamp != 3 || string(cells[1].Value) != "value a" {
+		t.Errorf("CellVersions = %v", cells)
+	}
+
+	id, err := client.ScannerOpen("test", nil, []string{"cf"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.ScannerClose(id)
+	if rows, err = client.ScannerRowsList(id, 10); err != nil || len(rows) != 3 || len(rows[0].Families()) != 1 {
+		t.Errorf("ScannerRowsList = %v, %v", rows, err)
+	}
+	if rows, err = client.ScannerRows(id); err != nil || len(rows) != 0 {
+		t.Errorf("ScannerRows at the end = %v, %v", rows, err)
+	}
+}
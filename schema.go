@@ -0,0 +1,499 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+Schema is the declared set of tables and their column families, see ParseSchema
+*/
+type Schema struct {
+	Tables []*TableSchema `json:"tables"`
+}
+
+/*
+TableSchema is a table of a schema, family names end with a colon
+*/
+type TableSchema struct {
+	Name     string
+	Families []*ColumnDescriptor
+
+	settings map[string]map[string]bool // settings of each family given in the schema file, nil if every setting is given
+}
+
+// familySchema is the json form of a ColumnDescriptor, fields that are left out keep their defaults
+type familySchema struct {
+	Name                  string `json:"name"`
+	MaxVersions           int32  `json:"maxVersions"`
+	Compression           string `json:"compression"`
+	InMemory              bool   `json:"inMemory"`
+	BloomFilterType       string `json:"bloomFilterType"`
+	BloomFilterVectorSize int32  `json:"bloomFilterVectorSize"`
+	BloomFilterNbHashes   int32  `json:"bloomFilterNbHashes"`
+	BlockCacheEnabled     bool   `json:"blockCacheEnabled"`
+	TimeToLive            int32  `json:"timeToLive"`
+}
+
+// familySettingNames maps the lower case json names of the settings of a family to the fields of ColumnDescriptor
+var familySettingNames = map[string]string{
+	"maxversions":           "MaxVersions",
+	"compression":           "Compression",
+	"inmemory":              "InMemory",
+	"bloomfiltertype":       "BloomFilterType",
+	"bloomfiltervectorsize": "BloomFilterVectorSize",
+	"bloomfilternbhashes":   "BloomFilterNbHashes",
+	"blockcacheenabled":     "BlockCacheEnabled",
+	"timetolive":            "TimeToLive",
+}
+
+type tableSchemaJSON struct {
+	Name     string            `json:"name"`
+	Families []json.RawMessage `json:"families"`
+}
+
+/*
+MarshalJSON writes the families with the field names of the schema file
+*/
+func (t *TableSchema) MarshalJSON() ([]byte, error) {
+	families := make([]*familySchema, len(t.Families))
+	for i, f := range t.Families {
+		fs := familySchema(*f)
+		fs.Name = strings.TrimSuffix(f.Name, ":")
+		families[i] = &fs
+	}
+	return json.Marshal(&struct {
+		Name     string          `json:"name"`
+		Families []*familySchema `json:"families"`
+	}{t.Name, families})
+}
+
+/*
+UnmarshalJSON reads a table of a schema file, settings of a family that are left out get the values of NewColumnDescriptorDefault
+and are not compared with the server by PlanSchema
+*/
+func (t *TableSchema) UnmarshalJSON(data []byte) error {
+	var v tableSchemaJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	t.Name = v.Name
+	t.Families = make([]*ColumnDescriptor, len(v.Families))
+	t.settings = make(map[string]map[string]bool, len(v.Families))
+	for i, raw := range v.Families {
+		fs := familySchema(*NewColumnDescriptorDefault(""))
+		settings := make(map[string]bool)
+		if len(raw) > 0 && raw[0] == '"' {
+			// a family with the default settings can be given by its name
+			if err := json.Unmarshal(raw, &fs.Name); err != nil {
+				return err
+			}
+		} else {
+			if err := json.Unmarshal(raw, &fs); err != nil {
+				return err
+			}
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &fields); err != nil {
+				return err
+			}
+			for key := range fields {
+				if name, ok := familySettingNames[strings.ToLower(key)]; ok {
+					settings[name] = true
+				}
+			}
+		}
+		f := ColumnDescriptor(fs)
+		if !strings.HasSuffix(f.Name, ":") {
+			f.Name += ":"
+		}
+		t.Families[i] = &f
+		t.settings[f.Name] = settings
+	}
+	return nil
+}
+
+/*
+ParseSchema reads a schema file in json or yaml, such as
+
+	tables:
+	  - name: users
+	    families:
+	      - name: cf
+	        maxVersions: 5
+	        compression: GZ
+	      - name: meta
+	        inMemory: true
+
+the settings of a family are the fields of ColumnDescriptor, settings that are left out get the values of NewColumnDescriptorDefault
+when a table is created and PlanSchema does not compare them, a family with only default settings can be given by its name, as in families: [cf, meta]
+*/
+func ParseSchema(data []byte) (*Schema, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		tree, err := parseYAML(data, "name", "compression", "bloomFilterType")
+		if err != nil {
+			return nil, err
+		}
+		if data, err = json.Marshal(tree); err != nil {
+			return nil, err
+		}
+	}
+
+	schema := &Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, errors.New(fmt.Sprint("goh: invalid schema: ", err))
+	}
+	if err := schema.check(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func (s *Schema) check() error {
+	tables := make(map[string]bool)
+	for _, t := range s.Tables {
+		if t.Name == "" {
+			return errors.New("goh: invalid schema: a table has no name")
+		}
+		if tables[t.Name] {
+			return errors.New(fmt.Sprint("goh: invalid schema: table ", t.Name, " is declared twice"))
+		}
+		tables[t.Name] = true
+
+		if len(t.Families) == 0 {
+			return errors.New(fmt.Sprint("goh: invalid schema: table ", t.Name, " has no families"))
+		}
+		families := make(map[string]bool)
+		for _, f := range t.Families {
+			if f.Name == ":" {
+				return errors.New(fmt.Sprint("goh: invalid schema: a family of table ", t.Name, " has no name"))
+			}
+			if families[f.Name] {
+				return errors.New(fmt.Sprint("goh: invalid schema: family ", f.Name, " of table ", t.Name, " is declared twice"))
+			}
+			families[f.Name] = true
+		}
+	}
+	return nil
+}
+
+/*
+JSON returns the schema as a schema file in json
+*/
+func (s *Schema) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+/*
+YAML returns the schema as a schema file in yaml
+*/
+func (s *Schema) YAML() []byte {
+	var b bytes.Buffer
+	b.WriteString("tables:\n")
+	for _, t := range s.Tables {
+		fmt.Fprintf(&b, "  - name: %s\n", yamlQuote(t.Name))
+		b.WriteString("    families:\n")
+		for _, f := range t.Families {
+			fmt.Fprintf(&b, "      - name: %s\n", yamlQuote(strings.TrimSuffix(f.Name, ":")))
+			fmt.Fprintf(&b, "        maxVersions: %d\n", f.MaxVersions)
+			fmt.Fprintf(&b, "        compression: %s\n", yamlQuote(f.Compression))
+			fmt.Fprintf(&b, "        inMemory: %t\n", f.InMemory)
+			fmt.Fprintf(&b, "        bloomFilterType: %s\n", yamlQuote(f.BloomFilterType))
+			fmt.Fprintf(&b, "        bloomFilterVectorSize: %d\n", f.BloomFilterVectorSize)
+			fmt.Fprintf(&b, "        bloomFilterNbHashes: %d\n", f.BloomFilterNbHashes)
+			fmt.Fprintf(&b, "        blockCacheEnabled: %t\n", f.BlockCacheEnabled)
+			fmt.Fprintf(&b, "        timeToLive: %d\n", f.TimeToLive)
+		}
+	}
+	return b.Bytes()
+}
+
+// yamlQuote quotes s unless it reads back as the same plain string
+func yamlQuote(s string) string {
+	if v, err := parseYAMLScalar(0, s); err == nil && v == s && !strings.ContainsAny(s, ":#,[]{}'\"\\") && strings.TrimSpace(s) == s && !isYAMLItem(s) {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+/*
+GetSchema returns the schema of tableNames, or of all tables if none is given
+*/
+func (client *HClient) GetSchema(tableNames ...string) (*Schema, error) {
+	if len(tableNames) == 0 {
+		var err error
+		if tableNames, err = client.GetTableNames(); err != nil {
+			return nil, err
+		}
+		sort.Strings(tableNames)
+	}
+
+	schema := &Schema{}
+	for _, name := range tableNames {
+		families, err := client.GetColumnDescriptors(name)
+		if err != nil {
+			return nil, err
+		}
+		schema.Tables = append(schema.Tables, &TableSchema{Name: name, Families: sortedFamilies(families)})
+	}
+	return schema, nil
+}
+
+func sortedFamilies(families map[string]*ColumnDescriptor) []*ColumnDescriptor {
+	list := make([]*ColumnDescriptor, 0, len(families))
+	for _, f := range families {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+/*
+SchemaChangeKind is the kind of a difference between a schema and the tables of the server
+*/
+type SchemaChangeKind int
+
+const (
+	ChangeCreateTable  SchemaChangeKind = iota // the table is missing
+	ChangeAddFamily                            // the table does not have a family of the schema
+	ChangeDropFamily                           // the table has a family the schema does not have
+	ChangeModifyFamily                         // a setting of a family differs
+	ChangeExtraTable                           // the table is not in the schema, apply leaves it alone
+)
+
+var changeKindNames = []string{"create table", "add family", "drop family", "modify family", "extra table"}
+
+func (k SchemaChangeKind) String() string {
+	if k < 0 || int(k) >= len(changeKindNames) {
+		return "unknown"
+	}
+	return changeKindNames[k]
+}
+
+/*
+SchemaChange is a difference between a schema and the tables of the server
+*/
+type SchemaChange struct {
+	Kind    SchemaChangeKind
+	Table   string
+	Family  string // family of a family change
+	Setting string // field of ColumnDescriptor of a ChangeModifyFamily
+	Old     string // value of the setting on the server
+	New     string // value of the setting in the schema
+}
+
+/*
+Destructive report whether the change needs the table to be dropped and created again,
+thrift1 can not alter the families of a table
+*/
+func (c *SchemaChange) Destructive() bool {
+	return c.Kind == ChangeAddFamily || c.Kind == ChangeDropFamily || c.Kind == ChangeModifyFamily
+}
+
+func (c *SchemaChange) String() string {
+	switch c.Kind {
+	case ChangeCreateTable, ChangeExtraTable:
+		return fmt.Sprint(c.Kind, " ", c.Table)
+	case ChangeModifyFamily:
+		return fmt.Sprint(c.Kind, " ", c.Table, " ", c.Family, " ", c.Setting, ": ", c.Old, " -> ", c.New)
+	}
+	return fmt.Sprint(c.Kind, " ", c.Table, " ", c.Family)
+}
+
+/*
+SchemaPlan is the difference between a schema and the tables of the server, made by PlanSchema
+*/
+type SchemaPlan struct {
+	Changes []*SchemaChange
+	schema  *Schema
+}
+
+/*
+Empty report whether the server matches the schema, extra tables are ignored
+*/
+func (p *SchemaPlan) Empty() bool {
+	for _, c := range p.Changes {
+		if c.Kind != ChangeExtraTable {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Destructive report whether applying the plan drops tables
+*/
+func (p *SchemaPlan) Destructive() bool {
+	for _, c := range p.Changes {
+		if c.Destructive() {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+recreate returns the tables that have destructive changes
+*/
+func (p *SchemaPlan) recreate() map[string]bool {
+	tables := make(map[string]bool)
+	for _, c := range p.Changes {
+		if c.Destructive() {
+			tables[c.Table] = true
+		}
+	}
+	return tables
+}
+
+/*
+PlanSchema compares schema with the tables of the server
+*/
+func (client *HClient) PlanSchema(schema *Schema) (*SchemaPlan, error) {
+	names, err := client.GetTableNames()
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(names))
+	for _, name := range names {
+		existing[name] = true
+	}
+
+	plan := &SchemaPlan{schema: schema}
+	declared := make(map[string]bool, len(schema.Tables))
+	for _, t := range schema.Tables {
+		declared[t.Name] = true
+		if !existing[t.Name] {
+			plan.Changes = append(plan.Changes, &SchemaChange{Kind: ChangeCreateTable, Table: t.Name})
+			continue
+		}
+
+		families, err := client.GetColumnDescriptors(t.Name)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, diffFamilies(t, families)...)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		if !declared[name] {
+			plan.Changes = append(plan.Changes, &SchemaChange{Kind: ChangeExtraTable, Table: name})
+		}
+	}
+	return plan, nil
+}
+
+func diffFamilies(t *TableSchema, families map[string]*ColumnDescriptor) []*SchemaChange {
+	var changes []*SchemaChange
+	declared := make(map[string]bool, len(t.Families))
+	for _, want := range t.Families {
+		declared[want.Name] = true
+		have, ok := families[want.Name]
+		if !ok {
+			changes = append(changes, &SchemaChange{Kind: ChangeAddFamily, Table: t.Name, Family: want.Name})
+			continue
+		}
+
+		for _, s := range familySettings(have, want, t.settings[want.Name]) {
+			changes = append(changes, &SchemaChange{Kind: ChangeModifyFamily, Table: t.Name, Family: want.Name, Setting: s[0], Old: s[1], New: s[2]})
+		}
+	}
+
+	for _, f := range sortedFamilies(families) {
+		if !declared[f.Name] {
+			changes = append(changes, &SchemaChange{Kind: ChangeDropFamily, Table: t.Name, Family: f.Name})
+		}
+	}
+	return changes
+}
+
+/*
+familySettings returns the settings that differ as name, old and new value, only the settings in given if it is not nil.
+Names of compressions and bloom filters are compared without case and a negative time to live is forever.
+*/
+func familySettings(have, want *ColumnDescriptor, given map[string]bool) [][3]string {
+	var diff [][3]string
+	add := func(name string, old, new interface{}, equal bool) {
+		if !equal && (given == nil || given[name]) {
+			diff = append(diff, [3]string{name, fmt.Sprint(old), fmt.Sprint(new)})
+		}
+	}
+	ttl := func(v int32) int32 {
+		if v < 0 {
+			return math.MaxInt32
+		}
+		return v
+	}
+
+	add("MaxVersions", have.MaxVersions, want.MaxVersions, have.MaxVersions == want.MaxVersions)
+	add("Compression", have.Compression, want.Compression, strings.EqualFold(have.Compression, want.Compression))
+	add("InMemory", have.InMemory, want.InMemory, have.InMemory == want.InMemory)
+	add("BloomFilterType", have.BloomFilterType, want.BloomFilterType, strings.EqualFold(have.BloomFilterType, want.BloomFilterType))
+	add("BloomFilterVectorSize", have.BloomFilterVectorSize, want.BloomFilterVectorSize, have.BloomFilterVectorSize == want.BloomFilterVectorSize)
+	add("BloomFilterNbHashes", have.BloomFilterNbHashes, want.BloomFilterNbHashes, have.BloomFilterNbHashes == want.BloomFilterNbHashes)
+	add("BlockCacheEnabled", have.BlockCacheEnabled, want.BlockCacheEnabled, have.BlockCacheEnabled == want.BlockCacheEnabled)
+	add("TimeToLive", have.TimeToLive, want.TimeToLive, ttl(have.TimeToLive) == ttl(want.TimeToLive))
+	return diff
+}
+
+/*
+ApplyOptions control how ApplySchema handles destructive changes
+*/
+type ApplyOptions struct {
+	// Recreate drops and creates again the tables whose families differ, their data is lost.
+	// Without it ApplySchema refuses a destructive plan before changing anything.
+	Recreate bool
+}
+
+/*
+ApplySchema creates the missing tables of plan, tables with destructive changes are dropped and
+created again if opts.Recreate is set, extra tables are left alone. It returns the changes applied.
+It fails with ErrTableExists if a table the plan creates has been created since the plan was made.
+*/
+func (client *HClient) ApplySchema(plan *SchemaPlan, opts *ApplyOptions) ([]*SchemaChange, error) {
+	if opts == nil {
+		opts = &ApplyOptions{}
+	}
+	if plan.Destructive() && !opts.Recreate {
+		return nil, newHbaseError(nil, nil, ErrDestructiveChange)
+	}
+
+	recreate := plan.recreate()
+	var applied []*SchemaChange
+	for _, t := range plan.schema.Tables {
+		var changes []*SchemaChange
+		for _, c := range plan.Changes {
+			if c.Table == t.Name && c.Kind != ChangeExtraTable {
+				changes = append(changes, c)
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+
+		if recreate[t.Name] {
+			if _, err := client.DropTableIfExists(t.Name); err != nil {
+				return applied, err
+			}
+		}
+		exists, err := client.CreateTable(t.Name, t.Families)
+		if err != nil {
+			return applied, err
+		}
+		if exists {
+			return applied, newHbaseError(nil, nil, ErrTableExists)
+		}
+		applied = append(applied, changes...)
+	}
+	return applied, nil
+}
@@ -0,0 +1,261 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sdming/goh"
+)
+
+const testSchemaYAML = `
+# tables of the test cluster
+tables:
+  - name: test
+    families:
+      - name: cf
+        maxVersions: 5    # keep more history
+        compression: gz
+      - name: "meta"
+        inMemory: true
+  - name: '2024'
+    families: [cf, "x:"]
+  - name: users
+    families:
+    - name: profile
+      timeToLive: 86400
+      bloomFilterType: ROW
+`
+
+const testSchemaJSON = `{"tables": [
+	{"name": "test", "families": [{"name": "cf", "maxVersions": 5, "compression": "gz"}, {"name": "meta", "inMemory": true}]},
+	{"name": "2024", "families": ["cf", "x:"]},
+	{"name": "users", "families": [{"name": "profile", "timeToLive": 86400, "bloomFilterType": "ROW"}]}
+]}`
+
+func TestParseSchema(t *testing.T) {
+	schema, err := goh.ParseSchema([]byte(testSchemaYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cf := goh.NewColumnDescriptorDefault("cf:")
+	cf.MaxVersions = 5
+	cf.Compression = "gz"
+	meta := goh.NewColumnDescriptorDefault("meta:")
+	meta.InMemory = true
+	profile := goh.NewColumnDescriptorDefault("profile:")
+	profile.TimeToLive = 86400
+	profile.BloomFilterType = "ROW"
+	want := &goh.Schema{Tables: []*goh.TableSchema{
+		{Name: "test", Families: []*goh.ColumnDescriptor{cf, meta}},
+		{Name: "2024", Families: []*goh.ColumnDescriptor{goh.NewColumnDescriptorDefault("cf:"), goh.NewColumnDescriptorDefault("x:")}},
+		{Name: "users", Families: []*goh.ColumnDescriptor{profile}},
+	}}
+	if !sameSchema(schema, want) {
+		t.Errorf("ParseSchema(yaml) = %s", schema.YAML())
+	}
+
+	schema, err = goh.ParseSchema([]byte(testSchemaJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameSchema(schema, want) {
+		t.Errorf("ParseSchema(json) = %s", schema.YAML())
+	}
+
+	for _, data := range [][]byte{schema.YAML(), mustJSON(t, schema)} {
+		again, err := goh.ParseSchema(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sameSchema(again, want) {
+			t.Errorf("ParseSchema(%s) = %s", data, again.YAML())
+		}
+	}
+}
+
+func TestParseSchemaFlowSequence(t *testing.T) {
+	schema, err := goh.ParseSchema([]byte("tables:\n  - name: a\n    families: [\"x,y\", 'it''s, here', don't, z]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range schema.Tables[0].Families {
+		names = append(names, f.Name)
+	}
+	if want := []string{"x,y:", "it's, here:", "don't:", "z:"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("families = %q, want %q", names, want)
+	}
+}
+
+// sameSchema compares the tables and families of two schemas, which settings a schema file gave is not compared
+func sameSchema(a, b *goh.Schema) bool {
+	if len(a.Tables) != len(b.Tables) {
+		return false
+	}
+	for i, t := range a.Tables {
+		if t.Name != b.Tables[i].Name || !reflect.DeepEqual(t.Families, b.Tables[i].Families) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustJSON(t *testing.T, schema *goh.Schema) []byte {
+	data, err := schema.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for _, data := range []string{
+		"tables:\n  - name: a\n    families: [cf]\n  - name: a\n    families: [cf]\n",
+		"tables:\n  - name: a\n",
+		"tables:\n  - families: [cf]\n",
+		"tables:\n  - name: a\n    families: [cf, cf]\n",
+		"tables:\n  - name: a\n      families: [cf]\n",
+		"tables:\n\t- name: a\n",
+		"tables:\n  - name: a\n    families: [cf]\n    families: [x]\n",
+		"tables:\n  - name: a\n    families:\n      - name: cf\n        maxVersions: many\n",
+		"tables:\n  - name: a\n    families: {cf: 1}\n",
+		`{"tables": [{"name": "a", "families": [1]}]}`,
+	} {
+		if _, err := goh.ParseSchema([]byte(data)); err == nil {
+			t.Errorf("ParseSchema(%q) should fail", data)
+		}
+	}
+}
+
+func TestPlanApplySchema(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	handler.createTable("old", "cf")
+
+	schema, err := goh.ParseSchema([]byte(testSchemaYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.PlanSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var changes []string
+	for _, c := range plan.Changes {
+		changes = append(changes, c.String())
+	}
+	want := []string{
+		"modify family test cf: MaxVersions: 3 -> 5",
+		"modify family test cf: Compression: NONE -> gz",
+		"add family test meta:",
+		"create table 2024",
+		"create table users",
+		"extra table old",
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("PlanSchema = %q, want %q", changes, want)
+	}
+	if plan.Empty() || !plan.Destructive() {
+		t.Errorf("plan Empty = %v, Destructive = %v", plan.Empty(), plan.Destructive())
+	}
+
+	if _, err = client.ApplySchema(plan, nil); !isHbaseError(err, goh.ErrDestructiveChange) {
+		t.Errorf("ApplySchema = %v, want ErrDestructiveChange", err)
+	}
+	if tables, _ := client.GetTableNames(); len(tables) != 2 {
+		t.Errorf("a refused plan created tables: %v", tables)
+	}
+
+	applied, err := client.ApplySchema(plan, &goh.ApplyOptions{Recreate: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 5 {
+		t.Errorf("ApplySchema applied %v", applied)
+	}
+
+	got, err := client.GetSchema("test", "2024", "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameSchema(got, schema) {
+		t.Errorf("GetSchema = %s", got.YAML())
+	}
+	if cells, _ := client.Get("test", []byte("a"), "cf:x", nil); len(cells) != 0 {
+		t.Errorf("recreated table has rows: %v", cells)
+	}
+
+	if plan, err = client.PlanSchema(schema); err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() || len(plan.Changes) != 1 || plan.Changes[0].Kind != goh.ChangeExtraTable {
+		t.Errorf("PlanSchema after apply = %v", plan.Changes)
+	}
+	if tables, _ := client.GetTableNames(); !strings.Contains(strings.Join(tables, ","), "old") {
+		t.Errorf("extra table was dropped: %v", tables)
+	}
+}
+
+func TestApplySchemaTableCreatedMeanwhile(t *testing.T) {
+	handler, client := newGatewayClient(t)
+	schema, err := goh.ParseSchema([]byte("tables:\n  - name: users\n    families: [profile]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := client.PlanSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler.createTable("users", "cf")
+	applied, err := client.ApplySchema(plan, nil)
+	if !isHbaseError(err, goh.ErrTableExists) || len(applied) != 0 {
+		t.Errorf("ApplySchema = %v, %v, want ErrTableExists", applied, err)
+	}
+}
+
+func TestPlanSchemaGivenSettings(t *testing.T) {
+	_, client := newGatewayClient(t)
+	// the defaults of hbase rather than those of NewColumnDescriptorDefault
+	cf := &goh.ColumnDescriptor{Name: "cf:", MaxVersions: 1, Compression: "NONE", BloomFilterType: "ROW", BlockCacheEnabled: true, TimeToLive: math.MaxInt32}
+	if _, err := client.CreateTable("defaults", []*goh.ColumnDescriptor{cf}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		schema string
+		want   []string
+	}{
+		{"tables:\n  - name: defaults\n    families: [cf]\n", nil},
+		{"tables:\n  - name: defaults\n    families:\n      - name: cf\n        maxVersions: 1\n", nil},
+		{"tables:\n  - name: defaults\n    families:\n      - name: cf\n        inMemory: true\n        blockCacheEnabled: true\n",
+			[]string{"modify family defaults cf: InMemory: false -> true"}},
+		{`{"tables": [{"name": "defaults", "families": [{"name": "cf", "MAXVERSIONS": 2}]}]}`,
+			[]string{"modify family defaults cf: MaxVersions: 1 -> 2"}},
+	} {
+		schema, err := goh.ParseSchema([]byte(c.schema))
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan, err := client.PlanSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var changes []string
+		for _, change := range plan.Changes {
+			if change.Kind != goh.ChangeExtraTable {
+				changes = append(changes, change.String())
+			}
+		}
+		if !reflect.DeepEqual(changes, c.want) {
+			t.Errorf("PlanSchema(%q) = %q, want %q", c.schema, changes, c.want)
+		}
+	}
+}
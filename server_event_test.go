@@ -0,0 +1,211 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+type eventKey struct{}
+
+/*
+recordingEventHandler records the callbacks of a server
+*/
+type recordingEventHandler struct {
+	mu        sync.Mutex
+	preServe  int
+	created   int
+	deleted   chan struct{}
+	processed []string
+}
+
+func (h *recordingEventHandler) PreServe() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.preServe++
+}
+
+func (h *recordingEventHandler) CreateContext(ctx context.Context, input thrift.TProtocol, output thrift.TProtocol) context.Context {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.created++
+	return context.WithValue(ctx, eventKey{}, h.created)
+}
+
+func (h *recordingEventHandler) DeleteContext(ctx context.Context, input thrift.TProtocol, output thrift.TProtocol) {
+	h.deleted <- struct{}{}
+}
+
+func (h *recordingEventHandler) ProcessContext(ctx context.Context, input thrift.TTransport, output thrift.TTransport) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	info, _ := thrift.ConnectionInfoFromContext(ctx)
+	h.processed = append(h.processed, fmt.Sprint(ctx.Value(eventKey{}), " ", info.RemoteAddr))
+}
+
+/*
+contextProcessor records the context requests are processed with
+*/
+type contextProcessor struct {
+	thrift.TProcessor
+	contexts chan context.Context
+}
+
+func (p *contextProcessor) ProcessContext(ctx context.Context, in, out thrift.TProtocol) (bool, thrift.TException) {
+	p.contexts <- ctx
+	return p.Process(in, out)
+}
+
+type bufferLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *bufferLogger) Printf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func (l *bufferLogger) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return strings.Join(l.lines, "\n")
+}
+
+func newTestCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestServerEventHandler(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l = tls.NewListener(l, &tls.Config{Certificates: []tls.Certificate{newTestCertificate(t)}})
+	serverTransport, err := thrift.NewTNonblockingServerSocketListener(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	processor := &contextProcessor{Hbase.NewHbaseProcessor(handler), make(chan context.Context, 4)}
+	events := &recordingEventHandler{deleted: make(chan struct{}, 4)}
+	logger := &bufferLogger{}
+	server := thrift.NewTNonblockingServer4(processor, serverTransport, thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+	server.SetServerEventHandler(events)
+	server.SetLogger(logger)
+	go server.Serve()
+	defer server.Stop()
+
+	conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	socket, err := thrift.NewTSocketConn(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := Hbase.NewHbaseClientFactory(thrift.NewTFramedTransport(socket), thrift.NewTBinaryProtocolFactoryDefault())
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.GetTableNames(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		ctx := <-processor.contexts
+		info, ok := thrift.ConnectionInfoFromContext(ctx)
+		if !ok {
+			t.Fatal("no connection info in the processor context")
+		}
+		if info.RemoteAddr.String() != conn.LocalAddr().String() || info.LocalAddr.String() != l.Addr().String() {
+			t.Errorf("connection info = %v -> %v", info.RemoteAddr, info.LocalAddr)
+		}
+		if info.TLS == nil || !info.TLS.HandshakeComplete {
+			t.Errorf("TLS state = %v", info.TLS)
+		}
+		if v := ctx.Value(eventKey{}); v != 1 {
+			t.Errorf("context value of CreateContext = %v", v)
+		}
+	}
+
+	socket.Close()
+	<-events.deleted
+
+	events.mu.Lock()
+	if events.preServe != 1 || events.created != 1 {
+		t.Errorf("PreServe called %d times, CreateContext %d times", events.preServe, events.created)
+	}
+	want := "1 " + conn.LocalAddr().String()
+	if len(events.processed) != 3 || events.processed[0] != want || events.processed[1] != want {
+		t.Errorf("ProcessContext calls = %q", events.processed)
+	}
+	events.mu.Unlock()
+
+	if s := logger.String(); s != "" {
+		t.Errorf("closing a connection logged %q", s)
+	}
+
+	bad, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad.Write([]byte{0, 0, 0, 4, 0x80, 0x02, 0, 1})
+	<-events.deleted
+	bad.Close()
+	if s := logger.String(); !strings.Contains(s, "error processing request from "+bad.LocalAddr().String()) {
+		t.Errorf("bad request logged %q", s)
+	}
+
+	plain, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain.Write([]byte("not a TLS handshake"))
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(logger.String(), "connection error") && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	plain.Close()
+	if s := logger.String(); !strings.Contains(s, "connection error") {
+		t.Errorf("failed handshake logged %q", s)
+	}
+}
@@ -0,0 +1,361 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase2"
+	"github.com/sdming/goh/thrift"
+)
+
+func newTHBaseTestClient(t *testing.T) (*memTHBase, *goh.THBaseClient) {
+	handler := newMemTHBase()
+	handler.createTable("test")
+
+	addr := newTcpTestServer(t, Hbase2.NewTHBaseServiceProcessor(handler), thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+	client, err := goh.NewTHBaseTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return handler, client
+}
+
+func checkTResult(t *testing.T, name string, result *Hbase2.TResult, want map[string]string) {
+	t.Helper()
+	values := goh.TResultValues(result)
+	if len(values) != len(want) {
+		t.Errorf("%s = %q, want %q", name, values, want)
+		return
+	}
+	for column, value := range want {
+		if string(values[column]) != value {
+			t.Errorf("%s = %q, want %q", name, values, want)
+			return
+		}
+	}
+}
+
+func TestTHBaseGetPutDelete(t *testing.T) {
+	_, client := newTHBaseTestClient(t)
+	row := []byte("row1")
+
+	put := goh.NewTPut(row, goh.NewTColumnValue("cf:a", []byte("a1")), goh.NewTColumnValue("cf:b", []byte("b1")))
+	put.Timestamp = 10
+	if err := client.Put("test", put); err != nil {
+		t.Fatal(err)
+	}
+	put = goh.NewTPut(row, goh.NewTColumnValue("cf:a", []byte("a2")))
+	put.Timestamp = 20
+	if err := client.Put("test", put); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Get("test", goh.NewTGet(row))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result.Row) != "row1" {
+		t.Errorf("Get row = %q", result.Row)
+	}
+	checkTResult(t, "Get", result, map[string]string{"cf:a": "a2", "cf:b": "b1"})
+
+	get := goh.NewTGet(row, "cf:a")
+	get.TimeRange = goh.NewTTimeRange(0, 15)
+	result, err = client.Get("test", get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTResult(t, "Get time range", result, map[string]string{"cf:a": "a1"})
+
+	get = goh.NewTGet(row, "cf:a")
+	get.MaxVersions = 3
+	result, err = client.Get("test", get)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ColumnValues) != 2 || result.ColumnValues[0].Timestamp != 20 || result.ColumnValues[1].Timestamp != 10 {
+		t.Errorf("Get versions = %v", result.ColumnValues)
+	}
+
+	exists, err := client.Exists("test", goh.NewTGet(row, "cf:c"))
+	if err != nil || exists {
+		t.Errorf("Exists cf:c = %v, %v", exists, err)
+	}
+
+	results, err := client.GetMultiple("test", []*Hbase2.TGet{goh.NewTGet(row, "cf:b"), goh.NewTGet([]byte("missing"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[1].Row != nil {
+		t.Fatalf("GetMultiple = %v", results)
+	}
+	checkTResult(t, "GetMultiple", results[0], map[string]string{"cf:b": "b1"})
+
+	del := goh.NewTDelete(row, "cf:a")
+	del.DeleteType = Hbase2.TDeleteType_DELETE_COLUMN
+	if err = client.Delete("test", del); err != nil {
+		t.Fatal(err)
+	}
+	result, err = client.Get("test", goh.NewTGet(row))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTResult(t, "Get after delete of newest version", result, map[string]string{"cf:a": "a1", "cf:b": "b1"})
+
+	if err = client.Delete("test", goh.NewTDelete(row)); err != nil {
+		t.Fatal(err)
+	}
+	if exists, err = client.Exists("test", goh.NewTGet(row)); err != nil || exists {
+		t.Errorf("Exists after delete = %v, %v", exists, err)
+	}
+
+	_, err = client.Get("missing", goh.NewTGet(row))
+	if e, ok := err.(*goh.HbaseError); !ok || e.IOErr == nil {
+		t.Errorf("Get of missing table error = %v", err)
+	}
+}
+
+func TestTHBaseCheckAndMutate(t *testing.T) {
+	_, client := newTHBaseTestClient(t)
+	row := []byte("row1")
+
+	ok, err := client.CheckAndPut("test", row, "cf:a", nil, goh.NewTPut(row, goh.NewTColumnValue("cf:a", []byte("v1"))))
+	if err != nil || !ok {
+		t.Fatalf("CheckAndPut absent = %v, %v", ok, err)
+	}
+	ok, err = client.CheckAndPut("test", row, "cf:a", nil, goh.NewTPut(row, goh.NewTColumnValue("cf:a", []byte("v2"))))
+	if err != nil || ok {
+		t.Fatalf("CheckAndPut present = %v, %v", ok, err)
+	}
+	ok, err = client.CheckAndPut("test", row, "cf:a", []byte("v1"), goh.NewTPut(row, goh.NewTColumnValue("cf:a", []byte("v2"))))
+	if err != nil || !ok {
+		t.Fatalf("CheckAndPut match = %v, %v", ok, err)
+	}
+
+	ok, err = client.CheckAndDelete("test", row, "cf:a", []byte("v1"), goh.NewTDelete(row))
+	if err != nil || ok {
+		t.Fatalf("CheckAndDelete mismatch = %v, %v", ok, err)
+	}
+
+	err = client.MutateRow("test", row, []*Hbase2.TMutation{
+		{Put: goh.NewTPut(row, goh.NewTColumnValue("cf:b", []byte("b")))},
+		{DeleteSingle: goh.NewTDelete(row, "cf:a")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := client.Get("test", goh.NewTGet(row))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTResult(t, "Get after MutateRow", result, map[string]string{"cf:b": "b"})
+
+	ok, err = client.CheckAndDelete("test", row, "cf:b", []byte("b"), goh.NewTDelete(row))
+	if err != nil || !ok {
+		t.Fatalf("CheckAndDelete match = %v, %v", ok, err)
+	}
+
+	inc := &Hbase2.TIncrement{Row: row, Columns: []*Hbase2.TColumnIncrement{goh.NewTColumnIncrement("cf:n", 5)}}
+	client.Increment("test", inc)
+	result, err = client.Increment("test", inc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := binary.BigEndian.Uint64(goh.TResultValues(result)["cf:n"]); n != 10 {
+		t.Errorf("Increment = %d", n)
+	}
+
+	client.Append("test", goh.NewTAppend(row, goh.NewTColumnValue("cf:s", []byte("ab"))))
+	result, err = client.Append("test", goh.NewTAppend(row, goh.NewTColumnValue("cf:s", []byte("cd"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTResult(t, "Append", result, map[string]string{"cf:s": "abcd"})
+}
+
+func TestTHBaseDeleteMultiple(t *testing.T) {
+	_, client := newTHBaseTestClient(t)
+	desc := &goh.TableDescriptor{Name: "t1", Families: []*goh.ColumnFamilyDescriptor{goh.NewColumnFamilyDescriptorDefault("cf")}}
+	if err := client.CreateTable(desc, nil); err != nil {
+		t.Fatal(err)
+	}
+	puts := []*Hbase2.TPut{goh.NewTPut([]byte("a"), goh.NewTColumnValue("cf:x", []byte("a"))), goh.NewTPut([]byte("b"), goh.NewTColumnValue("cf:x", []byte("b")))}
+	if err := client.PutMultiple("t1", puts); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := goh.NewTDelete([]byte("b"), "missing:x")
+	failed, err := client.DeleteMultiple("t1", []*Hbase2.TDelete{goh.NewTDelete([]byte("a"), "cf:x"), bad})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 1 || string(failed[0].Row) != "b" || string(failed[0].Columns[0].Family) != "missing" {
+		t.Errorf("DeleteMultiple failed deletes = %v", failed)
+	}
+	for row, want := range map[string]bool{"a": false, "b": true} {
+		if exists, err := client.Exists("t1", goh.NewTGet([]byte(row))); err != nil || exists != want {
+			t.Errorf("Exists(%s) after DeleteMultiple = %v, %v", row, exists, err)
+		}
+	}
+}
+
+func TestTHBaseScanner(t *testing.T) {
+	_, client := newTHBaseTestClient(t)
+
+	var puts []*Hbase2.TPut
+	for _, row := range []string{"a", "b", "c", "d"} {
+		put := goh.NewTPut([]byte(row), goh.NewTColumnValue("cf:x", []byte(row)), goh.NewTColumnValue("other:y", []byte(row)))
+		puts = append(puts, put)
+	}
+	if err := client.PutMultiple("test", puts); err != nil {
+		t.Fatal(err)
+	}
+
+	scan := Hbase2.NewTScan()
+	scan.StartRow = []byte("b")
+	scan.StopRow = []byte("d")
+	scan.Columns = goh.NewTColumns("cf")
+	id, err := client.OpenScanner("test", scan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []string
+	for {
+		results, err := client.GetScannerRows(id, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) == 0 {
+			break
+		}
+		checkTResult(t, "GetScannerRows", results[0], map[string]string{"cf:x": string(results[0].Row)})
+		rows = append(rows, string(results[0].Row))
+	}
+	if len(rows) != 2 || rows[0] != "b" || rows[1] != "c" {
+		t.Errorf("scanned rows = %v", rows)
+	}
+
+	if err = client.CloseScanner(id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetScannerRows(id, 1)
+	if e, ok := err.(*goh.HbaseError); !ok || e.ArgErr == nil {
+		t.Errorf("GetScannerRows of closed scanner error = %v", err)
+	}
+
+	scan = Hbase2.NewTScan()
+	scan.Reversed = true
+	results, err := client.GetScannerResults("test", scan, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || string(results[0].Row) != "d" || string(results[1].Row) != "c" {
+		t.Errorf("GetScannerResults reversed = %v", results)
+	}
+}
+
+func TestTHBaseAdmin(t *testing.T) {
+	handler, client := newTHBaseTestClient(t)
+
+	if err := client.CreateNamespace(&goh.NamespaceDescriptor{Name: "ns", Configuration: map[string]string{"owner": "goh"}}); err != nil {
+		t.Fatal(err)
+	}
+	namespaces, err := client.ListNamespaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(namespaces) != 3 || namespaces[2].Name != "ns" || namespaces[2].Configuration["owner"] != "goh" {
+		t.Errorf("ListNamespaces = %v", namespaces)
+	}
+
+	family := goh.NewColumnFamilyDescriptorDefault("cf")
+	family.MaxVersions = 5
+	family.MinVersions = 1
+	family.BlockSize = 16384
+	family.Compression = "SNAPPY"
+	family.DataBlockEncoding = "FAST_DIFF"
+	family.KeepDeletedCells = "TTL"
+	desc := &goh.TableDescriptor{Name: "ns:t1", Families: []*goh.ColumnFamilyDescriptor{family}}
+	if err = client.CreateTable(desc, [][]byte{[]byte("g"), []byte("p")}); err != nil {
+		t.Fatal(err)
+	}
+	if splitKeys := handler.tables["ns:t1"].splitKeys; len(splitKeys) != 2 || string(splitKeys[1]) != "p" {
+		t.Errorf("split keys = %q", splitKeys)
+	}
+	if err = client.CreateTable(desc, nil); err == nil {
+		t.Error("CreateTable of existing table returned no error")
+	}
+	if err = client.CreateTable(&goh.TableDescriptor{Name: "missing:t1"}, nil); err == nil {
+		t.Error("CreateTable in missing namespace returned no error")
+	}
+
+	family = goh.NewColumnFamilyDescriptorDefault("cf")
+	family.Compression = "bogus"
+	if err = client.ModifyColumnFamily("ns:t1", family); err == nil {
+		t.Error("ModifyColumnFamily with invalid compression returned no error")
+	}
+	family.Compression = "GZ"
+	family.InMemory = true
+	family.BlockCacheEnabled = false
+	if err = client.ModifyColumnFamily("ns:t1", family); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.AddColumnFamily("ns:t1", &goh.ColumnFamilyDescriptor{Name: "extra", BlockCacheEnabled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	descs, err := client.GetTableDescriptors([]string{"ns:t1", "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(descs) != 2 || descs[0].Name != "ns:t1" || descs[1].Name != "test" || len(descs[0].Families) != 2 {
+		t.Fatalf("GetTableDescriptors = %v", descs)
+	}
+	cf, extra := descs[0].Families[0], descs[0].Families[1]
+	if cf.Compression != "GZ" || !cf.InMemory || cf.BlockCacheEnabled || cf.MaxVersions != 1 || cf.DataBlockEncoding != "NONE" {
+		t.Errorf("modified family = %+v", cf)
+	}
+	if !reflect.DeepEqual(extra, goh.NewColumnFamilyDescriptorDefault("extra")) {
+		t.Errorf("added family = %+v", extra)
+	}
+
+	if err = client.DeleteColumnFamily("ns:t1", "extra"); err != nil {
+		t.Fatal(err)
+	}
+	if err = client.DeleteColumnFamily("ns:t1", "extra"); err == nil {
+		t.Error("DeleteColumnFamily of missing family returned no error")
+	}
+
+	if err = client.DeleteNamespace("ns"); err == nil {
+		t.Error("DeleteNamespace of non-empty namespace returned no error")
+	}
+	if err = client.DeleteTable("ns:t1"); err == nil {
+		t.Error("DeleteTable of enabled table returned no error")
+	}
+	if err = client.DisableTable("ns:t1"); err != nil {
+		t.Fatal(err)
+	}
+	if enabled, err := client.IsTableEnabled("ns:t1"); err != nil || enabled {
+		t.Errorf("IsTableEnabled = %v, %v", enabled, err)
+	}
+	if err = client.DeleteTable("ns:t1"); err != nil {
+		t.Fatal(err)
+	}
+	if exists, err := client.TableExists("ns:t1"); err != nil || exists {
+		t.Errorf("TableExists = %v, %v", exists, err)
+	}
+	if err = client.DeleteNamespace("ns"); err != nil {
+		t.Fatal(err)
+	}
+}
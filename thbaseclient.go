@@ -0,0 +1,483 @@
+package goh
+
+import (
+	"github.com/sdming/goh/Hbase2"
+	"github.com/sdming/goh/thrift"
+	"net"
+	"net/url"
+)
+
+/*
+THBaseClient is wrap of hbase thrift2 client (THBaseService),
+unlike HClient it does not reconnect, a broken client has to be closed and created again
+*/
+type THBaseClient struct {
+	addr            string
+	Protocol        int
+	Trans           thrift.TTransport
+	ProtocolFactory thrift.TProtocolFactory
+	hbase           *Hbase2.THBaseServiceClient
+}
+
+/*
+NewTHBaseHttpClient return a hbase thrift2 http client instance
+*/
+func NewTHBaseHttpClient(rawurl string, protocol int) (client *THBaseClient, err error) {
+	parsedUrl, err := url.Parse(rawurl)
+	if err != nil {
+		return
+	}
+
+	trans, err := thrift.NewTHttpPostClient(parsedUrl.String())
+	if err != nil {
+		return
+	}
+
+	return newTHBaseClient(parsedUrl.String(), protocol, trans)
+}
+
+/*
+NewTHBaseTcpClient return a hbase thrift2 tcp client instance
+*/
+func NewTHBaseTcpClient(rawaddr string, protocol int, framed bool) (client *THBaseClient, err error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", rawaddr)
+	if err != nil {
+		return
+	}
+
+	var trans thrift.TTransport
+	trans, err = thrift.NewTNonblockingSocketAddr(tcpAddr)
+	if err != nil {
+		return
+	}
+	if framed && protocol != THeaderProtocol {
+		trans = thrift.NewTFramedTransport(trans)
+	}
+
+	return newTHBaseClient(tcpAddr.String(), protocol, trans)
+}
+
+func newTHBaseClient(addr string, protocol int, trans thrift.TTransport) (*THBaseClient, error) {
+	protocolFactory, err := newProtocolFactory(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	if protocol == THeaderProtocol {
+		trans = thrift.NewTHeaderTransport(trans)
+	}
+
+	return &THBaseClient{
+		addr:            addr,
+		Protocol:        protocol,
+		Trans:           trans,
+		ProtocolFactory: protocolFactory,
+		hbase:           Hbase2.NewTHBaseServiceClientFactory(trans, protocolFactory),
+	}, nil
+}
+
+/*
+Open connection
+*/
+func (client *THBaseClient) Open() error {
+	return client.Trans.Open()
+}
+
+/*
+Close connection
+*/
+func (client *THBaseClient) Close() error {
+	return client.Trans.Close()
+}
+
+/*
+Exists test for the existence of columns in the table, as specified in the TGet
+*/
+func (client *THBaseClient) Exists(tableName string, get *Hbase2.TGet) (exists bool, err error) {
+	ret, io, e1 := client.hbase.Exists([]byte(tableName), get)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	exists = ret
+	return
+}
+
+/*
+Get return the cells of a row selected by get, the result has no row if the row cannot be found
+*/
+func (client *THBaseClient) Get(tableName string, get *Hbase2.TGet) (result *Hbase2.TResult, err error) {
+	ret, io, e1 := client.hbase.Get([]byte(tableName), get)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	result = ret
+	return
+}
+
+/*
+GetMultiple return the results of gets in the same order
+*/
+func (client *THBaseClient) GetMultiple(tableName string, gets []*Hbase2.TGet) (results []*Hbase2.TResult, err error) {
+	ret, io, e1 := client.hbase.GetMultiple([]byte(tableName), gets)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	results = ret
+	return
+}
+
+/*
+Put commit a TPut to a table
+*/
+func (client *THBaseClient) Put(tableName string, put *Hbase2.TPut) error {
+	io, e1 := client.hbase.Put([]byte(tableName), put)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+PutMultiple commit a list of TPut to a table
+*/
+func (client *THBaseClient) PutMultiple(tableName string, puts []*Hbase2.TPut) error {
+	io, e1 := client.hbase.PutMultiple([]byte(tableName), puts)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+CheckAndPut atomically commit put if the value of column ("family:qualifier") in row equals value,
+a nil value checks that the column does not exist
+*/
+func (client *THBaseClient) CheckAndPut(tableName string, row []byte, column string, value []byte, put *Hbase2.TPut) (ok bool, err error) {
+	family, qualifier := splitColumn(column)
+	ret, io, e1 := client.hbase.CheckAndPut([]byte(tableName), row, family, qualifier, value, put)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	ok = ret
+	return
+}
+
+/*
+Delete delete cells as specified by the TDelete
+*/
+func (client *THBaseClient) Delete(tableName string, del *Hbase2.TDelete) error {
+	io, e1 := client.hbase.DeleteSingle([]byte(tableName), del)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+DeleteMultiple commit a list of TDelete to a table, return the deletes the server could not apply
+*/
+func (client *THBaseClient) DeleteMultiple(tableName string, dels []*Hbase2.TDelete) (failed []*Hbase2.TDelete, err error) {
+	ret, io, e1 := client.hbase.DeleteMultiple([]byte(tableName), dels)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	failed = ret
+	return
+}
+
+/*
+CheckAndDelete atomically commit del if the value of column ("family:qualifier") in row equals value,
+a nil value checks that the column does not exist
+*/
+func (client *THBaseClient) CheckAndDelete(tableName string, row []byte, column string, value []byte, del *Hbase2.TDelete) (ok bool, err error) {
+	family, qualifier := splitColumn(column)
+	ret, io, e1 := client.hbase.CheckAndDelete([]byte(tableName), row, family, qualifier, value, del)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	ok = ret
+	return
+}
+
+/*
+Increment increment columns of a row, return the new values
+*/
+func (client *THBaseClient) Increment(tableName string, increment *Hbase2.TIncrement) (result *Hbase2.TResult, err error) {
+	ret, io, e1 := client.hbase.Increment([]byte(tableName), increment)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	result = ret
+	return
+}
+
+/*
+Append append values to columns of a row, return the new values
+*/
+func (client *THBaseClient) Append(tableName string, app *Hbase2.TAppend) (result *Hbase2.TResult, err error) {
+	ret, io, e1 := client.hbase.Append([]byte(tableName), app)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	result = ret
+	return
+}
+
+/*
+MutateRow apply puts and deletes to a single row atomically
+*/
+func (client *THBaseClient) MutateRow(tableName string, row []byte, mutations []*Hbase2.TMutation) error {
+	io, e1 := client.hbase.MutateRow([]byte(tableName), &Hbase2.TRowMutations{Row: row, Mutations: mutations})
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+OpenScanner get a scanner for the TScan, return scanner id
+*/
+func (client *THBaseClient) OpenScanner(tableName string, scan *Hbase2.TScan) (id int32, err error) {
+	ret, io, e1 := client.hbase.OpenScanner([]byte(tableName), scan)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	id = ret
+	return
+}
+
+/*
+GetScannerRows return up to numRows rows from a scanner, no rows once the scanner is exhausted
+*/
+func (client *THBaseClient) GetScannerRows(id int32, numRows int32) (results []*Hbase2.TResult, err error) {
+	ret, io, ia, e1 := client.hbase.GetScannerRows(id, numRows)
+	if err = checkTHBaseError(io, ia, e1); err != nil {
+		return
+	}
+
+	results = ret
+	return
+}
+
+/*
+CloseScanner close a scanner
+*/
+func (client *THBaseClient) CloseScanner(id int32) error {
+	io, ia, e1 := client.hbase.CloseScanner(id)
+	return checkTHBaseError(io, ia, e1)
+}
+
+/*
+GetScannerResults open a scanner, return up to numRows rows and close the scanner
+*/
+func (client *THBaseClient) GetScannerResults(tableName string, scan *Hbase2.TScan, numRows int32) (results []*Hbase2.TResult, err error) {
+	ret, io, e1 := client.hbase.GetScannerResults([]byte(tableName), scan, numRows)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	results = ret
+	return
+}
+
+/*
+TableExists return true if table ("namespace:table" or "table") exists
+*/
+func (client *THBaseClient) TableExists(tableName string) (exists bool, err error) {
+	ret, io, e1 := client.hbase.TableExists(NewTTableName(tableName))
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	exists = ret
+	return
+}
+
+/*
+GetTableDescriptor return the descriptor of a table
+*/
+func (client *THBaseClient) GetTableDescriptor(tableName string) (desc *TableDescriptor, err error) {
+	ret, io, e1 := client.hbase.GetTableDescriptor(NewTTableName(tableName))
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	desc = toTableDescriptor(ret)
+	return
+}
+
+/*
+GetTableDescriptors return the descriptors of tables in the same order
+*/
+func (client *THBaseClient) GetTableDescriptors(tableNames []string) (descs []*TableDescriptor, err error) {
+	names := make([]*Hbase2.TTableName, len(tableNames))
+	for i, tableName := range tableNames {
+		names[i] = NewTTableName(tableName)
+	}
+
+	ret, io, e1 := client.hbase.GetTableDescriptors(names)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	descs = make([]*TableDescriptor, len(ret))
+	for i, desc := range ret {
+		descs[i] = toTableDescriptor(desc)
+	}
+	return
+}
+
+/*
+CreateTable create a table pre-split at splitKeys, the table has len(splitKeys)+1 regions
+*/
+func (client *THBaseClient) CreateTable(desc *TableDescriptor, splitKeys [][]byte) error {
+	tdesc, err := toTTableDescriptor(desc)
+	if err != nil {
+		return err
+	}
+
+	io, e1 := client.hbase.CreateTable(tdesc, splitKeys)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+ModifyTable replace the descriptor of an existing table
+*/
+func (client *THBaseClient) ModifyTable(desc *TableDescriptor) error {
+	tdesc, err := toTTableDescriptor(desc)
+	if err != nil {
+		return err
+	}
+
+	io, e1 := client.hbase.ModifyTable(tdesc)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+DeleteTable delete a table, the table has to be disabled first
+*/
+func (client *THBaseClient) DeleteTable(tableName string) error {
+	io, e1 := client.hbase.DeleteTable(NewTTableName(tableName))
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+TruncateTable delete all rows of a disabled table, keep the regions if preserveSplits
+*/
+func (client *THBaseClient) TruncateTable(tableName string, preserveSplits bool) error {
+	io, e1 := client.hbase.TruncateTable(NewTTableName(tableName), preserveSplits)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+EnableTable enable a table
+*/
+func (client *THBaseClient) EnableTable(tableName string) error {
+	io, e1 := client.hbase.EnableTable(NewTTableName(tableName))
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+DisableTable disable a table
+*/
+func (client *THBaseClient) DisableTable(tableName string) error {
+	io, e1 := client.hbase.DisableTable(NewTTableName(tableName))
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+IsTableEnabled return true if table is enabled
+*/
+func (client *THBaseClient) IsTableEnabled(tableName string) (enabled bool, err error) {
+	ret, io, e1 := client.hbase.IsTableEnabled(NewTTableName(tableName))
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	enabled = ret
+	return
+}
+
+/*
+AddColumnFamily add a column family to an existing table
+*/
+func (client *THBaseClient) AddColumnFamily(tableName string, family *ColumnFamilyDescriptor) error {
+	col, err := toTColumnFamily(family)
+	if err != nil {
+		return err
+	}
+
+	io, e1 := client.hbase.AddColumnFamily(NewTTableName(tableName), col)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+ModifyColumnFamily replace the descriptor of an existing column family
+*/
+func (client *THBaseClient) ModifyColumnFamily(tableName string, family *ColumnFamilyDescriptor) error {
+	col, err := toTColumnFamily(family)
+	if err != nil {
+		return err
+	}
+
+	io, e1 := client.hbase.ModifyColumnFamily(NewTTableName(tableName), col)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+DeleteColumnFamily delete a column family and all its cells from a table
+*/
+func (client *THBaseClient) DeleteColumnFamily(tableName string, family string) error {
+	io, e1 := client.hbase.DeleteColumnFamily(NewTTableName(tableName), []byte(family))
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+CreateNamespace create a namespace
+*/
+func (client *THBaseClient) CreateNamespace(ns *NamespaceDescriptor) error {
+	io, e1 := client.hbase.CreateNamespace(&Hbase2.TNamespaceDescriptor{Name: ns.Name, Configuration: ns.Configuration})
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+ModifyNamespace replace the configuration of an existing namespace
+*/
+func (client *THBaseClient) ModifyNamespace(ns *NamespaceDescriptor) error {
+	io, e1 := client.hbase.ModifyNamespace(&Hbase2.TNamespaceDescriptor{Name: ns.Name, Configuration: ns.Configuration})
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+DeleteNamespace delete an empty namespace
+*/
+func (client *THBaseClient) DeleteNamespace(name string) error {
+	io, e1 := client.hbase.DeleteNamespace(name)
+	return checkTHBaseError(io, nil, e1)
+}
+
+/*
+GetNamespace return the descriptor of a namespace
+*/
+func (client *THBaseClient) GetNamespace(name string) (ns *NamespaceDescriptor, err error) {
+	ret, io, e1 := client.hbase.GetNamespaceDescriptor(name)
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	ns = &NamespaceDescriptor{Name: ret.Name, Configuration: ret.Configuration}
+	return
+}
+
+/*
+ListNamespaces return the descriptors of all namespaces
+*/
+func (client *THBaseClient) ListNamespaces() (namespaces []*NamespaceDescriptor, err error) {
+	ret, io, e1 := client.hbase.ListNamespaceDescriptors()
+	if err = checkTHBaseError(io, nil, e1); err != nil {
+		return
+	}
+
+	namespaces = make([]*NamespaceDescriptor, len(ret))
+	for i, ns := range ret {
+		namespaces[i] = &NamespaceDescriptor{Name: ns.Name, Configuration: ns.Configuration}
+	}
+	return
+}
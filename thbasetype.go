@@ -0,0 +1,325 @@
+/*
+
+
+*/
+
+package goh
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sdming/goh/Hbase2"
+	"strings"
+)
+
+/*
+splitColumn split "family:qualifier" into family and qualifier, a column without ":" is a family
+*/
+func splitColumn(column string) (family []byte, qualifier []byte) {
+	if i := strings.Index(column, ":"); i >= 0 {
+		return []byte(column[:i]), []byte(column[i+1:])
+	}
+	return []byte(column), []byte{}
+}
+
+/*
+NewTColumn return a thrift2 column of "family:qualifier", "family" or "family:" selects the whole family
+*/
+func NewTColumn(column string) *Hbase2.TColumn {
+	family, qualifier := splitColumn(column)
+	if len(qualifier) == 0 {
+		qualifier = nil
+	}
+	return &Hbase2.TColumn{
+		Family:    family,
+		Qualifier: qualifier,
+	}
+}
+
+/*
+NewTColumns return thrift2 columns of "family:qualifier" or "family"
+*/
+func NewTColumns(columns ...string) []*Hbase2.TColumn {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	data := make([]*Hbase2.TColumn, len(columns))
+	for i, column := range columns {
+		data[i] = NewTColumn(column)
+	}
+	return data
+}
+
+/*
+NewTColumnValue return a thrift2 cell value of "family:qualifier"
+*/
+func NewTColumnValue(column string, value []byte) *Hbase2.TColumnValue {
+	family, qualifier := splitColumn(column)
+	if value == nil {
+		value = []byte{}
+	}
+	return &Hbase2.TColumnValue{
+		Family:    family,
+		Qualifier: qualifier,
+		Value:     value,
+	}
+}
+
+/*
+NewTTimeRange return a time range of [minStamp, maxStamp)
+*/
+func NewTTimeRange(minStamp, maxStamp int64) *Hbase2.TTimeRange {
+	return &Hbase2.TTimeRange{
+		MinStamp: minStamp,
+		MaxStamp: maxStamp,
+	}
+}
+
+/*
+NewTGet return a get of row, restricted to columns if any
+*/
+func NewTGet(row []byte, columns ...string) *Hbase2.TGet {
+	get := Hbase2.NewTGet()
+	get.Row = row
+	get.Columns = NewTColumns(columns...)
+	return get
+}
+
+/*
+NewTPut return a put of values to row
+*/
+func NewTPut(row []byte, values ...*Hbase2.TColumnValue) *Hbase2.TPut {
+	put := Hbase2.NewTPut()
+	put.Row = row
+	put.ColumnValues = values
+	return put
+}
+
+/*
+NewTDelete return a delete of all versions of columns of row, or of the whole row if there are no columns
+*/
+func NewTDelete(row []byte, columns ...string) *Hbase2.TDelete {
+	del := Hbase2.NewTDelete()
+	del.Row = row
+	del.Columns = NewTColumns(columns...)
+	return del
+}
+
+/*
+NewTColumnIncrement return an increment of "family:qualifier" by amount
+*/
+func NewTColumnIncrement(column string, amount int64) *Hbase2.TColumnIncrement {
+	family, qualifier := splitColumn(column)
+	return &Hbase2.TColumnIncrement{
+		Family:    family,
+		Qualifier: qualifier,
+		Amount:    amount,
+	}
+}
+
+/*
+NewTAppend return an append of values to row
+*/
+func NewTAppend(row []byte, values ...*Hbase2.TColumnValue) *Hbase2.TAppend {
+	app := Hbase2.NewTAppend()
+	app.Row = row
+	app.Columns = values
+	return app
+}
+
+/*
+TColumnValueName return "family:qualifier" of a thrift2 cell value
+*/
+func TColumnValueName(value *Hbase2.TColumnValue) string {
+	return string(value.Family) + ":" + string(value.Qualifier)
+}
+
+/*
+TResultValues return the newest value of each column of result, keyed by "family:qualifier"
+*/
+func TResultValues(result *Hbase2.TResult) map[string][]byte {
+	if result == nil {
+		return nil
+	}
+
+	data := make(map[string][]byte, len(result.ColumnValues))
+	for _, value := range result.ColumnValues {
+		name := TColumnValueName(value)
+		if _, ok := data[name]; !ok {
+			data[name] = value.Value
+		}
+	}
+	return data
+}
+
+/*
+NewTTableName return a thrift2 table name of "namespace:table" or "table" in the default namespace
+*/
+func NewTTableName(tableName string) *Hbase2.TTableName {
+	if i := strings.Index(tableName, ":"); i >= 0 {
+		return &Hbase2.TTableName{
+			Ns:        []byte(tableName[:i]),
+			Qualifier: []byte(tableName[i+1:]),
+		}
+	}
+	return &Hbase2.TTableName{Qualifier: []byte(tableName)}
+}
+
+/*
+TTableNameString return "namespace:table" of a thrift2 table name, or "table" if it is in the default namespace
+*/
+func TTableNameString(tableName *Hbase2.TTableName) string {
+	if tableName == nil {
+		return ""
+	}
+	if len(tableName.Ns) == 0 || string(tableName.Ns) == "default" {
+		return string(tableName.Qualifier)
+	}
+	return string(tableName.Ns) + ":" + string(tableName.Qualifier)
+}
+
+/*
+ColumnFamilyDescriptor describe a column family of a thrift2 table,
+empty strings and zero numbers keep the server defaults, the flags are always sent, see NewColumnFamilyDescriptorDefault
+*/
+type ColumnFamilyDescriptor struct {
+	Name              string
+	Configuration     map[string]string
+	BlockSize         int32
+	BloomFilterType   string // NONE, ROW, ROWCOL or ROWPREFIX_FIXED_LENGTH
+	Compression       string // NONE, GZ, LZO, SNAPPY, LZ4, BZIP2 or ZSTD
+	DataBlockEncoding string // NONE, PREFIX, DIFF, FAST_DIFF or ROW_INDEX_V1
+	KeepDeletedCells  string // FALSE, TRUE or TTL
+	MaxVersions       int32
+	MinVersions       int32
+	TimeToLive        int32
+	BlockCacheEnabled bool // false disables the block cache, hbase enables it by default
+	InMemory          bool
+}
+
+/*
+NewColumnFamilyDescriptorDefault return a column family descriptor with the defaults of hbase
+*/
+func NewColumnFamilyDescriptorDefault(name string) *ColumnFamilyDescriptor {
+	return &ColumnFamilyDescriptor{
+		Name:              name,
+		BlockSize:         65536,
+		BloomFilterType:   "ROW",
+		Compression:       "NONE",
+		DataBlockEncoding: "NONE",
+		KeepDeletedCells:  "FALSE",
+		MaxVersions:       1,
+		MinVersions:       0,
+		TimeToLive:        2147483647,
+		BlockCacheEnabled: true,
+		InMemory:          false,
+	}
+}
+
+func toColumnFamily(col *Hbase2.TColumnFamilyDescriptor) *ColumnFamilyDescriptor {
+	return &ColumnFamilyDescriptor{
+		Name:              string(col.Name),
+		Configuration:     col.Configuration,
+		BlockSize:         col.BlockSize,
+		BloomFilterType:   col.BloomnFilterType.String(),
+		Compression:       col.CompressionType.String(),
+		DataBlockEncoding: col.DataBlockEncoding.String(),
+		KeepDeletedCells:  col.KeepDeletedCells.String(),
+		MaxVersions:       col.MaxVersions,
+		MinVersions:       col.MinVersions,
+		TimeToLive:        col.TimeToLive,
+		BlockCacheEnabled: col.BlockCacheEnabled == nil || *col.BlockCacheEnabled,
+		InMemory:          col.InMemory != nil && *col.InMemory,
+	}
+}
+
+func invalidDescriptorValue(name string, value string) error {
+	return errors.New(fmt.Sprint("goh: invalid ", name, ": ", value))
+}
+
+func toTColumnFamily(col *ColumnFamilyDescriptor) (*Hbase2.TColumnFamilyDescriptor, error) {
+	output := Hbase2.NewTColumnFamilyDescriptor()
+	output.Name = []byte(col.Name)
+	output.Configuration = col.Configuration
+	// the flags are sent even when false, hbase only applies the fields that are set
+	blockCacheEnabled, inMemory := col.BlockCacheEnabled, col.InMemory
+	output.BlockCacheEnabled = &blockCacheEnabled
+	output.InMemory = &inMemory
+
+	if col.BlockSize != 0 {
+		output.BlockSize = col.BlockSize
+	}
+	if col.MaxVersions != 0 {
+		output.MaxVersions = col.MaxVersions
+	}
+	if col.MinVersions != 0 {
+		output.MinVersions = col.MinVersions
+	}
+	if col.TimeToLive != 0 {
+		output.TimeToLive = col.TimeToLive
+	}
+
+	if col.BloomFilterType != "" {
+		if output.BloomnFilterType = Hbase2.FromTBloomFilterTypeString(col.BloomFilterType); output.BloomnFilterType < 0 {
+			return nil, invalidDescriptorValue("bloom filter type", col.BloomFilterType)
+		}
+	}
+	if col.Compression != "" {
+		if output.CompressionType = Hbase2.FromTCompressionAlgorithmString(col.Compression); output.CompressionType < 0 {
+			return nil, invalidDescriptorValue("compression", col.Compression)
+		}
+	}
+	if col.DataBlockEncoding != "" {
+		if output.DataBlockEncoding = Hbase2.FromTDataBlockEncodingString(col.DataBlockEncoding); output.DataBlockEncoding < 0 {
+			return nil, invalidDescriptorValue("data block encoding", col.DataBlockEncoding)
+		}
+	}
+	if col.KeepDeletedCells != "" {
+		if output.KeepDeletedCells = Hbase2.FromTKeepDeletedCellsString(col.KeepDeletedCells); output.KeepDeletedCells < 0 {
+			return nil, invalidDescriptorValue("keep deleted cells", col.KeepDeletedCells)
+		}
+	}
+	return output, nil
+}
+
+/*
+TableDescriptor describe a thrift2 table and its column families
+*/
+type TableDescriptor struct {
+	Name     string // "namespace:table" or "table"
+	Families []*ColumnFamilyDescriptor
+}
+
+func toTableDescriptor(desc *Hbase2.TTableDescriptor) *TableDescriptor {
+	output := &TableDescriptor{
+		Name:     TTableNameString(desc.TableName),
+		Families: make([]*ColumnFamilyDescriptor, len(desc.Columns)),
+	}
+	for i, col := range desc.Columns {
+		output.Families[i] = toColumnFamily(col)
+	}
+	return output
+}
+
+func toTTableDescriptor(desc *TableDescriptor) (*Hbase2.TTableDescriptor, error) {
+	output := Hbase2.NewTTableDescriptor()
+	output.TableName = NewTTableName(desc.Name)
+	output.Columns = make([]*Hbase2.TColumnFamilyDescriptor, len(desc.Families))
+	for i, col := range desc.Families {
+		c, err := toTColumnFamily(col)
+		if err != nil {
+			return nil, err
+		}
+		output.Columns[i] = c
+	}
+	return output, nil
+}
+
+/*
+NamespaceDescriptor describe a namespace
+*/
+type NamespaceDescriptor struct {
+	Name          string
+	Configuration map[string]string
+}
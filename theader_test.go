@@ -0,0 +1,142 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"testing"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+// headerRecorder records the request headers seen by the server
+type headerRecorder struct {
+	thrift.TProcessor
+	headers chan map[string]string
+}
+
+func (p *headerRecorder) Process(in, out thrift.TProtocol) (bool, thrift.TException) {
+	ok, err := p.TProcessor.Process(in, out)
+	if h, isHeader := in.(*thrift.THeaderProtocol); isHeader {
+		p.headers <- h.ReadHeaders()
+	}
+	return ok, err
+}
+
+func TestTHeaderTransportRoundTrip(t *testing.T) {
+	buf := thrift.NewTMemoryBuffer()
+	writer := thrift.NewTHeaderProtocol(buf)
+	writer.SetWriteHeader("request_id", "42")
+	writer.SetWriteHeader("user", "goh")
+	if err := writer.AddTransform(thrift.THEADER_TRANSFORM_ZLIB); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.SetProtocolID(thrift.THEADER_PROTOCOL_COMPACT); err != nil {
+		t.Fatal(err)
+	}
+
+	writer.WriteMessageBegin("ping", thrift.CALL, 7)
+	writer.WriteString("payload")
+	writer.WriteMessageEnd()
+	if err := writer.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := thrift.NewTHeaderProtocol(buf)
+	name, typeId, seqid, err := reader.ReadMessageBegin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "ping" || typeId != thrift.CALL || seqid != 7 {
+		t.Errorf("ReadMessageBegin = %q, %v, %d", name, typeId, seqid)
+	}
+	if v, _ := reader.ReadString(); v != "payload" {
+		t.Errorf("ReadString = %q", v)
+	}
+
+	trans := reader.HeaderTransport()
+	if trans.ProtocolID() != thrift.THEADER_PROTOCOL_COMPACT {
+		t.Errorf("ProtocolID = %d", trans.ProtocolID())
+	}
+	if trans.SeqId() != 7 {
+		t.Errorf("SeqId = %d", trans.SeqId())
+	}
+	if v, _ := trans.ReadHeader("request_id"); v != "42" {
+		t.Errorf("request_id = %q", v)
+	}
+	if v, _ := trans.ReadHeader("user"); v != "goh" {
+		t.Errorf("user = %q", v)
+	}
+	if transforms := trans.ReadTransforms(); len(transforms) != 1 || transforms[0] != thrift.THEADER_TRANSFORM_ZLIB {
+		t.Errorf("ReadTransforms = %v", transforms)
+	}
+}
+
+func TestTHeaderServerDetectsClients(t *testing.T) {
+	handler := newMemHbase()
+	handler.createTable("test", "cf")
+	handler.put("test", "row1", "cf:a", "value1", 1)
+
+	recorder := &headerRecorder{TProcessor: Hbase.NewHbaseProcessor(handler), headers: make(chan map[string]string, 16)}
+	addr := newTcpTestServer(t, recorder, thrift.NewTTransportFactory(), thrift.NewTHeaderProtocolFactory())
+
+	cases := []struct {
+		protocol int
+		framed   bool
+	}{
+		{goh.THeaderProtocol, false},
+		{goh.TBinaryProtocol, false},
+		{goh.TBinaryProtocol, true},
+		{goh.TCompactProtocol, false},
+		{goh.TCompactProtocol, true},
+	}
+
+	for _, c := range cases {
+		client, err := goh.NewTcpClient(addr, c.protocol, c.framed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = client.Open(); err != nil {
+			t.Fatal(err)
+		}
+
+		if c.protocol == goh.THeaderProtocol {
+			if err = client.SetHeader("request_id", "r-1"); err != nil {
+				t.Fatal(err)
+			}
+		} else if err = client.SetHeader("request_id", "r-1"); err != goh.ErrNotHeaderProtocol {
+			t.Errorf("SetHeader on protocol %d: %v", c.protocol, err)
+		}
+
+		data, err := client.Get("test", []byte("row1"), "cf:a", nil)
+		if err != nil {
+			t.Fatalf("protocol %d framed %v: %v", c.protocol, c.framed, err)
+		}
+		if len(data) != 1 || string(data[0].Value) != "value1" {
+			t.Errorf("protocol %d framed %v: Get = %v", c.protocol, c.framed, data)
+		}
+
+		headers := <-recorder.headers
+		if c.protocol == goh.THeaderProtocol && headers["request_id"] != "r-1" {
+			t.Errorf("server read headers %v", headers)
+		}
+
+		if c.protocol == goh.THeaderProtocol {
+			// reopening dials a new transport, the headers must be set on it again
+			client.Close()
+			if err = client.Open(); err != nil {
+				t.Fatal(err)
+			}
+			if _, err = client.Get("test", []byte("row1"), "cf:a", nil); err != nil {
+				t.Fatal(err)
+			}
+			if headers = <-recorder.headers; headers["request_id"] != "r-1" {
+				t.Errorf("server read headers %v after reconnecting", headers)
+			}
+		}
+		client.Close()
+	}
+}
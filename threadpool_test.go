@@ -0,0 +1,201 @@
+/*
+
+ */
+
+package goh_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sdming/goh"
+	"github.com/sdming/goh/Hbase"
+	"github.com/sdming/goh/thrift"
+)
+
+/*
+blockingHbase holds GetTableNames calls until release is closed
+*/
+type blockingHbase struct {
+	*memHbase
+
+	started chan struct{}
+	release chan struct{}
+
+	mu      sync.Mutex
+	running int
+	max     int
+}
+
+func newBlockingHbase() *blockingHbase {
+	handler := &blockingHbase{
+		memHbase: newMemHbase(),
+		started:  make(chan struct{}, 16),
+		release:  make(chan struct{}),
+	}
+	handler.createTable("test", "cf")
+	return handler
+}
+
+func (h *blockingHbase) GetTableNames() ([]Hbase.Text, *Hbase.IOError, error) {
+	h.mu.Lock()
+	h.running++
+	if h.running > h.max {
+		h.max = h.running
+	}
+	h.mu.Unlock()
+
+	h.started <- struct{}{}
+	<-h.release
+
+	h.mu.Lock()
+	h.running--
+	h.mu.Unlock()
+	return h.memHbase.GetTableNames()
+}
+
+func newThreadPoolTestServer(t *testing.T, handler Hbase.IHbase, workers int) (*thrift.TThreadPoolServer, string, chan error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverTransport, err := thrift.NewTNonblockingServerSocketListener(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTThreadPoolServer4(Hbase.NewHbaseProcessor(handler), serverTransport, thrift.NewTFramedTransportFactory(thrift.NewTTransportFactory()), thrift.NewTBinaryProtocolFactoryDefault())
+	server.SetWorkers(workers)
+
+	served := make(chan error, 1)
+	go func() { served <- server.Serve() }()
+	t.Cleanup(func() { server.Stop() })
+	return server, l.Addr().String(), served
+}
+
+func newThreadPoolTestClient(t *testing.T, addr string) *goh.HClient {
+	client, err := goh.NewTcpClient(addr, goh.TBinaryProtocol, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.Open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestThreadPoolServerWorkers(t *testing.T) {
+	handler := newBlockingHbase()
+	_, addr, _ := newThreadPoolTestServer(t, handler, 2)
+
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		client := newThreadPoolTestClient(t, addr)
+		go func() {
+			// a worker is busy until its connection is closed
+			_, err := client.GetTableNames()
+			client.Close()
+			errs <- err
+		}()
+	}
+
+	<-handler.started
+	<-handler.started
+	select {
+	case <-handler.started:
+		t.Fatal("third connection served while both workers are busy")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(handler.release)
+	<-handler.started
+	for i := 0; i < 3; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if handler.max != 2 {
+		t.Errorf("max concurrent calls = %d, want 2", handler.max)
+	}
+}
+
+func TestThreadPoolServerShutdown(t *testing.T) {
+	handler := newBlockingHbase()
+	server, addr, served := newThreadPoolTestServer(t, handler, 4)
+
+	idle := newThreadPoolTestClient(t, addr)
+	if _, err := idle.GetColumnDescriptors("test"); err != nil {
+		t.Fatal(err)
+	}
+
+	busy := newThreadPoolTestClient(t, addr)
+	result := make(chan error, 1)
+	go func() {
+		tables, err := busy.GetTableNames()
+		if err == nil && (len(tables) != 1 || tables[0] != "test") {
+			t.Errorf("GetTableNames = %v", tables)
+		}
+		result <- err
+	}()
+	<-handler.started
+
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- server.Shutdown(context.Background()) }()
+
+	for server.Connections() > 1 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := idle.GetColumnDescriptors("test"); err == nil {
+		t.Error("idle connection still served after Shutdown")
+	}
+	if _, err := net.DialTimeout("tcp", addr, time.Second); err == nil {
+		t.Error("server still accepting after Shutdown")
+	}
+
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown returned %v before the request in flight finished", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(handler.release)
+	if err := <-result; err != nil {
+		t.Fatalf("request in flight failed: %v", err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-served; err != nil {
+		t.Fatalf("Serve returned %v", err)
+	}
+}
+
+func TestThreadPoolServerShutdownTimeout(t *testing.T) {
+	handler := newBlockingHbase()
+	server, addr, served := newThreadPoolTestServer(t, handler, 1)
+
+	busy := newThreadPoolTestClient(t, addr)
+	result := make(chan error, 1)
+	go func() {
+		_, err := busy.GetTableNames()
+		result <- err
+	}()
+	<-handler.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Shutdown = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if err := <-result; err == nil {
+		t.Error("request in flight answered after a forced shutdown")
+	}
+
+	close(handler.release)
+	if err := <-served; err != nil {
+		t.Fatalf("Serve returned %v", err)
+	}
+}
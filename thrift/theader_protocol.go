@@ -0,0 +1,302 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+/**
+ * THeaderProtocol reads and writes messages through a THeaderTransport.
+ * The payload is encoded with the binary or the compact protocol,
+ * following the protocol id of the frame that was read last.
+ */
+type THeaderProtocol struct {
+	trans    *THeaderTransport
+	protocol TProtocol
+	// protocol id the current delegate was created for
+	protocolID int
+}
+
+type THeaderProtocolFactory struct {
+	protocolID int
+}
+
+/**
+ * Factory of header protocols writing with the binary protocol.
+ */
+func NewTHeaderProtocolFactory() *THeaderProtocolFactory {
+	return NewTHeaderProtocolFactoryProtocolID(THEADER_PROTOCOL_BINARY)
+}
+
+/**
+ * Factory of header protocols writing with protocolID, one of the
+ * THEADER_PROTOCOL_ constants.
+ */
+func NewTHeaderProtocolFactoryProtocolID(protocolID int) *THeaderProtocolFactory {
+	return &THeaderProtocolFactory{protocolID: protocolID}
+}
+
+func (p *THeaderProtocolFactory) GetProtocol(trans TTransport) TProtocol {
+	_, isHeader := trans.(*THeaderTransport)
+	protocol := NewTHeaderProtocol(trans)
+	if !isHeader {
+		protocol.SetProtocolID(p.protocolID)
+	}
+	return protocol
+}
+
+/**
+ * Creates a header protocol on trans, trans is wrapped in a
+ * THeaderTransport unless it already is one.
+ */
+func NewTHeaderProtocol(trans TTransport) *THeaderProtocol {
+	t := NewTHeaderTransport(trans)
+	p := &THeaderProtocol{trans: t}
+	p.resetProtocol()
+	return p
+}
+
+func (p *THeaderProtocol) resetProtocol() {
+	if p.protocol != nil && p.protocolID == p.trans.ProtocolID() {
+		return
+	}
+	p.protocolID = p.trans.ProtocolID()
+	switch p.protocolID {
+	case THEADER_PROTOCOL_COMPACT:
+		p.protocol = NewTCompactProtocol(p.trans)
+	default:
+		p.protocol = NewTBinaryProtocol(p.trans, true, true)
+	}
+}
+
+/**
+ * Sets the protocol used to encode written messages.
+ */
+func (p *THeaderProtocol) SetProtocolID(protocolID int) error {
+	if err := p.trans.SetProtocolID(protocolID); err != nil {
+		return err
+	}
+	p.resetProtocol()
+	return nil
+}
+
+func (p *THeaderProtocol) HeaderTransport() *THeaderTransport {
+	return p.trans
+}
+
+func (p *THeaderProtocol) ReadHeaders() map[string]string {
+	return p.trans.ReadHeaders()
+}
+
+func (p *THeaderProtocol) SetWriteHeader(key, value string) {
+	p.trans.SetWriteHeader(key, value)
+}
+
+func (p *THeaderProtocol) ClearWriteHeaders() {
+	p.trans.ClearWriteHeaders()
+}
+
+func (p *THeaderProtocol) AddTransform(transform int) error {
+	return p.trans.AddTransform(transform)
+}
+
+func (p *THeaderProtocol) WriteMessageBegin(name string, typeId TMessageType, seqid int32) TProtocolException {
+	p.resetProtocol()
+	p.trans.SetSeqId(seqid)
+	return p.protocol.WriteMessageBegin(name, typeId, seqid)
+}
+
+func (p *THeaderProtocol) WriteMessageEnd() TProtocolException {
+	return p.protocol.WriteMessageEnd()
+}
+
+func (p *THeaderProtocol) WriteStructBegin(name string) TProtocolException {
+	return p.protocol.WriteStructBegin(name)
+}
+
+func (p *THeaderProtocol) WriteStructEnd() TProtocolException {
+	return p.protocol.WriteStructEnd()
+}
+
+func (p *THeaderProtocol) WriteFieldBegin(name string, typeId TType, id int16) TProtocolException {
+	return p.protocol.WriteFieldBegin(name, typeId, id)
+}
+
+func (p *THeaderProtocol) WriteFieldEnd() TProtocolException {
+	return p.protocol.WriteFieldEnd()
+}
+
+func (p *THeaderProtocol) WriteFieldStop() TProtocolException {
+	return p.protocol.WriteFieldStop()
+}
+
+func (p *THeaderProtocol) WriteMapBegin(keyType TType, valueType TType, size int) TProtocolException {
+	return p.protocol.WriteMapBegin(keyType, valueType, size)
+}
+
+func (p *THeaderProtocol) WriteMapEnd() TProtocolException {
+	return p.protocol.WriteMapEnd()
+}
+
+func (p *THeaderProtocol) WriteListBegin(elemType TType, size int) TProtocolException {
+	return p.protocol.WriteListBegin(elemType, size)
+}
+
+func (p *THeaderProtocol) WriteListEnd() TProtocolException {
+	return p.protocol.WriteListEnd()
+}
+
+func (p *THeaderProtocol) WriteSetBegin(elemType TType, size int) TProtocolException {
+	return p.protocol.WriteSetBegin(elemType, size)
+}
+
+func (p *THeaderProtocol) WriteSetEnd() TProtocolException {
+	return p.protocol.WriteSetEnd()
+}
+
+func (p *THeaderProtocol) WriteBool(value bool) TProtocolException {
+	return p.protocol.WriteBool(value)
+}
+
+func (p *THeaderProtocol) WriteByte(value int8) TProtocolException {
+	return p.protocol.WriteByte(value)
+}
+
+func (p *THeaderProtocol) WriteI16(value int16) TProtocolException {
+	return p.protocol.WriteI16(value)
+}
+
+func (p *THeaderProtocol) WriteI32(value int32) TProtocolException {
+	return p.protocol.WriteI32(value)
+}
+
+func (p *THeaderProtocol) WriteI64(value int64) TProtocolException {
+	return p.protocol.WriteI64(value)
+}
+
+func (p *THeaderProtocol) WriteDouble(value float64) TProtocolException {
+	return p.protocol.WriteDouble(value)
+}
+
+func (p *THeaderProtocol) WriteString(value string) TProtocolException {
+	return p.protocol.WriteString(value)
+}
+
+func (p *THeaderProtocol) WriteBinary(value []byte) TProtocolException {
+	return p.protocol.WriteBinary(value)
+}
+
+/**
+ * Reads the next frame before the message header, and switches to the
+ * protocol announced by the frame.
+ */
+func (p *THeaderProtocol) ReadMessageBegin() (name string, typeId TMessageType, seqid int32, err TProtocolException) {
+	if e := p.trans.ReadFrame(); e != nil {
+		err = NewTProtocolExceptionFromOsError(e)
+		return
+	}
+	p.resetProtocol()
+	return p.protocol.ReadMessageBegin()
+}
+
+func (p *THeaderProtocol) ReadMessageEnd() TProtocolException {
+	return p.protocol.ReadMessageEnd()
+}
+
+func (p *THeaderProtocol) ReadStructBegin() (name string, err TProtocolException) {
+	return p.protocol.ReadStructBegin()
+}
+
+func (p *THeaderProtocol) ReadStructEnd() TProtocolException {
+	return p.protocol.ReadStructEnd()
+}
+
+func (p *THeaderProtocol) ReadFieldBegin() (name string, typeId TType, id int16, err TProtocolException) {
+	return p.protocol.ReadFieldBegin()
+}
+
+func (p *THeaderProtocol) ReadFieldEnd() TProtocolException {
+	return p.protocol.ReadFieldEnd()
+}
+
+func (p *THeaderProtocol) ReadMapBegin() (keyType TType, valueType TType, size int, err TProtocolException) {
+	return p.protocol.ReadMapBegin()
+}
+
+func (p *THeaderProtocol) ReadMapEnd() TProtocolException {
+	return p.protocol.ReadMapEnd()
+}
+
+func (p *THeaderProtocol) ReadListBegin() (elemType TType, size int, err TProtocolException) {
+	return p.protocol.ReadListBegin()
+}
+
+func (p *THeaderProtocol) ReadListEnd() TProtocolException {
+	return p.protocol.ReadListEnd()
+}
+
+func (p *THeaderProtocol) ReadSetBegin() (elemType TType, size int, err TProtocolException) {
+	return p.protocol.ReadSetBegin()
+}
+
+func (p *THeaderProtocol) ReadSetEnd() TProtocolException {
+	return p.protocol.ReadSetEnd()
+}
+
+func (p *THeaderProtocol) ReadBool() (value bool, err TProtocolException) {
+	return p.protocol.ReadBool()
+}
+
+func (p *THeaderProtocol) ReadByte() (value int8, err TProtocolException) {
+	return p.protocol.ReadByte()
+}
+
+func (p *THeaderProtocol) ReadI16() (value int16, err TProtocolException) {
+	return p.protocol.ReadI16()
+}
+
+func (p *THeaderProtocol) ReadI32() (value int32, err TProtocolException) {
+	return p.protocol.ReadI32()
+}
+
+func (p *THeaderProtocol) ReadI64() (value int64, err TProtocolException) {
+	return p.protocol.ReadI64()
+}
+
+func (p *THeaderProtocol) ReadDouble() (value float64, err TProtocolException) {
+	return p.protocol.ReadDouble()
+}
+
+func (p *THeaderProtocol) ReadString() (value string, err TProtocolException) {
+	return p.protocol.ReadString()
+}
+
+func (p *THeaderProtocol) ReadBinary() (value []byte, err TProtocolException) {
+	return p.protocol.ReadBinary()
+}
+
+func (p *THeaderProtocol) Skip(fieldType TType) (err TProtocolException) {
+	return SkipDefaultDepth(p, fieldType)
+}
+
+func (p *THeaderProtocol) Flush() (err TProtocolException) {
+	return p.protocol.Flush()
+}
+
+func (p *THeaderProtocol) Transport() TTransport {
+	return p.trans
+}
@@ -0,0 +1,569 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+)
+
+/**
+ * Constants of the THeader wire format.
+ *
+ * A header frame looks like:
+ *
+ *   0 1 2 3 4 5 6 7 8 9 a b c d e f 0 1 2 3 4 5 6 7 8 9 a b c d e f
+ *  +----------------------------------------------------------------+
+ *  | 0|                          LENGTH                             |
+ *  +----------------------------------------------------------------+
+ *  | 0|       HEADER MAGIC          |            FLAGS              |
+ *  +----------------------------------------------------------------+
+ *  |                         SEQUENCE NUMBER                        |
+ *  +----------------------------------------------------------------+
+ *  | 0|     Header Size(/32)        | ...
+ *  +---------------------------------
+ *
+ * followed by the protocol id, the transform ids and the info headers,
+ * all varint encoded and padded to a multiple of 4 bytes, and then the
+ * (possibly transformed) payload.
+ */
+const (
+	THEADER_MAGIC          = 0x0fff0000
+	THEADER_MASK           = 0xffff0000
+	THEADER_FLAGS_MASK     = 0x0000ffff
+	THEADER_MAX_FRAME_SIZE = 0x3fffffff
+)
+
+/**
+ * Protocol ids carried in a header frame.
+ */
+const (
+	THEADER_PROTOCOL_BINARY  = 0x00
+	THEADER_PROTOCOL_COMPACT = 0x02
+)
+
+/**
+ * Transform ids carried in a header frame.
+ */
+const (
+	THEADER_TRANSFORM_NONE = 0x00
+	THEADER_TRANSFORM_ZLIB = 0x01
+)
+
+/**
+ * Info header types carried in a header frame.
+ */
+const (
+	THEADER_INFO_KEYVALUE = 0x01
+)
+
+/**
+ * Wire format used by the peer, detected from the first message read.
+ */
+const (
+	THEADER_CLIENT_HEADERS = iota
+	THEADER_CLIENT_FRAMED_BINARY
+	THEADER_CLIENT_UNFRAMED_BINARY
+	THEADER_CLIENT_FRAMED_COMPACT
+	THEADER_CLIENT_UNFRAMED_COMPACT
+)
+
+/**
+ * THeaderTransport implements the THeader format. It carries a protocol
+ * id, transforms and key/value headers with every message.
+ *
+ * When reading, it also understands framed and unframed binary or
+ * compact messages, and it answers a peer in the format the peer used.
+ */
+type THeaderTransport struct {
+	transport TTransport
+	reader    *bufio.Reader
+
+	frameReader io.Reader
+	frameBuffer *bytes.Buffer
+	writeBuffer *bytes.Buffer
+
+	clientType int
+	protocolID int
+	seqId      int32
+	flags      uint16
+
+	readHeaders     map[string]string
+	writeHeaders    map[string]string
+	readTransforms  []int
+	writeTransforms []int
+}
+
+type tHeaderTransportFactory struct {
+	factory TTransportFactory
+}
+
+func NewTHeaderTransportFactory(factory TTransportFactory) TTransportFactory {
+	return &tHeaderTransportFactory{factory: factory}
+}
+
+func (p *tHeaderTransportFactory) GetTransport(base TTransport) TTransport {
+	if p.factory != nil {
+		base = p.factory.GetTransport(base)
+	}
+	return NewTHeaderTransport(base)
+}
+
+/**
+ * Wraps transport in a THeaderTransport, a transport that already is one
+ * is returned unchanged.
+ */
+func NewTHeaderTransport(transport TTransport) *THeaderTransport {
+	if t, ok := transport.(*THeaderTransport); ok {
+		return t
+	}
+	return &THeaderTransport{
+		transport:    transport,
+		reader:       bufio.NewReader(transport),
+		frameBuffer:  bytes.NewBuffer(make([]byte, 0, 1024)),
+		writeBuffer:  bytes.NewBuffer(make([]byte, 0, 1024)),
+		clientType:   THEADER_CLIENT_HEADERS,
+		protocolID:   THEADER_PROTOCOL_BINARY,
+		readHeaders:  make(map[string]string),
+		writeHeaders: make(map[string]string),
+	}
+}
+
+func (p *THeaderTransport) Open() error {
+	return p.transport.Open()
+}
+
+func (p *THeaderTransport) IsOpen() bool {
+	return p.transport.IsOpen()
+}
+
+func (p *THeaderTransport) Peek() bool {
+	return p.transport.Peek()
+}
+
+func (p *THeaderTransport) Close() error {
+	p.frameReader = nil
+	p.frameBuffer.Reset()
+	p.writeBuffer.Reset()
+	p.reader.Reset(p.transport)
+	return p.transport.Close()
+}
+
+/**
+ * Underlying transport.
+ */
+func (p *THeaderTransport) Transport() TTransport {
+	return p.transport
+}
+
+/**
+ * Protocol id of the last frame read, or the one used for writing.
+ */
+func (p *THeaderTransport) ProtocolID() int {
+	return p.protocolID
+}
+
+/**
+ * Sets the protocol id announced in written header frames.
+ */
+func (p *THeaderTransport) SetProtocolID(protocolID int) error {
+	if protocolID != THEADER_PROTOCOL_BINARY && protocolID != THEADER_PROTOCOL_COMPACT {
+		return NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "unknown THeader protocol id "+strconv.Itoa(protocolID))
+	}
+	p.protocolID = protocolID
+	return nil
+}
+
+/**
+ * Wire format of the peer, one of the THEADER_CLIENT_ constants.
+ */
+func (p *THeaderTransport) ClientType() int {
+	return p.clientType
+}
+
+/**
+ * Sequence id of the last header frame read.
+ */
+func (p *THeaderTransport) SeqId() int32 {
+	return p.seqId
+}
+
+/**
+ * Sets the sequence id written in the next header frame.
+ */
+func (p *THeaderTransport) SetSeqId(seqId int32) {
+	p.seqId = seqId
+}
+
+/**
+ * Headers of the last frame read.
+ */
+func (p *THeaderTransport) ReadHeaders() map[string]string {
+	return p.readHeaders
+}
+
+/**
+ * Returns a header of the last frame read.
+ */
+func (p *THeaderTransport) ReadHeader(key string) (value string, ok bool) {
+	value, ok = p.readHeaders[key]
+	return
+}
+
+/**
+ * Headers sent with every written frame until they are cleared.
+ */
+func (p *THeaderTransport) WriteHeaders() map[string]string {
+	return p.writeHeaders
+}
+
+func (p *THeaderTransport) SetWriteHeader(key, value string) {
+	p.writeHeaders[key] = value
+}
+
+func (p *THeaderTransport) DeleteWriteHeader(key string) {
+	delete(p.writeHeaders, key)
+}
+
+func (p *THeaderTransport) ClearWriteHeaders() {
+	p.writeHeaders = make(map[string]string)
+}
+
+/**
+ * Transforms of the last frame read.
+ */
+func (p *THeaderTransport) ReadTransforms() []int {
+	return p.readTransforms
+}
+
+/**
+ * Adds a transform applied to the payload of written frames.
+ */
+func (p *THeaderTransport) AddTransform(transform int) error {
+	if transform != THEADER_TRANSFORM_ZLIB {
+		return NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "unknown THeader transform id "+strconv.Itoa(transform))
+	}
+	p.writeTransforms = append(p.writeTransforms, transform)
+	return nil
+}
+
+func (p *THeaderTransport) ClearTransforms() {
+	p.writeTransforms = nil
+}
+
+func (p *THeaderTransport) isFramed() bool {
+	return p.clientType == THEADER_CLIENT_HEADERS || p.clientType == THEADER_CLIENT_FRAMED_BINARY || p.clientType == THEADER_CLIENT_FRAMED_COMPACT
+}
+
+func (p *THeaderTransport) needFrame() bool {
+	return p.frameReader == nil || (p.isFramed() && p.frameBuffer.Len() == 0)
+}
+
+func isBinaryVersion(b []byte) bool {
+	return binary.BigEndian.Uint32(b)&VERSION_MASK == VERSION_1
+}
+
+func isCompactVersion(b []byte) bool {
+	return b[0] == COMPACT_PROTOCOL_ID && b[1]&COMPACT_VERSION_MASK == COMPACT_VERSION
+}
+
+/**
+ * Reads the next frame off the wire, unless the current one still has
+ * data. The format of the first message decides the format for the rest
+ * of the connection.
+ */
+func (p *THeaderTransport) ReadFrame() error {
+	if !p.needFrame() {
+		return nil
+	}
+
+	b, err := p.reader.Peek(4)
+	if err != nil {
+		return NewTTransportExceptionFromOsError(err)
+	}
+
+	if isBinaryVersion(b) {
+		p.clientType = THEADER_CLIENT_UNFRAMED_BINARY
+		p.protocolID = THEADER_PROTOCOL_BINARY
+		p.frameReader = p.reader
+		return nil
+	}
+	if isCompactVersion(b) {
+		p.clientType = THEADER_CLIENT_UNFRAMED_COMPACT
+		p.protocolID = THEADER_PROTOCOL_COMPACT
+		p.frameReader = p.reader
+		return nil
+	}
+
+	size := binary.BigEndian.Uint32(b)
+	if size > THEADER_MAX_FRAME_SIZE {
+		return NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader frame size "+strconv.FormatUint(uint64(size), 10)+" exceeds the limit")
+	}
+	if size < 4 {
+		return NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader frame is too short")
+	}
+	p.reader.Discard(4)
+
+	frame := make([]byte, size)
+	if _, err = io.ReadFull(p.reader, frame); err != nil {
+		return NewTTransportExceptionFromOsError(err)
+	}
+
+	switch {
+	case isBinaryVersion(frame):
+		p.clientType = THEADER_CLIENT_FRAMED_BINARY
+		p.protocolID = THEADER_PROTOCOL_BINARY
+	case isCompactVersion(frame):
+		p.clientType = THEADER_CLIENT_FRAMED_COMPACT
+		p.protocolID = THEADER_PROTOCOL_COMPACT
+	case binary.BigEndian.Uint32(frame)&THEADER_MASK == THEADER_MAGIC:
+		p.clientType = THEADER_CLIENT_HEADERS
+		if frame, err = p.parseHeader(frame); err != nil {
+			return err
+		}
+	default:
+		return NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "unknown frame type, neither THeader nor framed binary/compact")
+	}
+
+	p.frameBuffer = bytes.NewBuffer(frame)
+	p.frameReader = p.frameBuffer
+	return nil
+}
+
+/**
+ * Parses a header frame, returns its payload with all transforms undone.
+ */
+func (p *THeaderTransport) parseHeader(frame []byte) ([]byte, error) {
+	if len(frame) < 10 {
+		return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader frame is too short")
+	}
+	p.flags = uint16(binary.BigEndian.Uint32(frame) & THEADER_FLAGS_MASK)
+	p.seqId = int32(binary.BigEndian.Uint32(frame[4:]))
+	headerSize := int(binary.BigEndian.Uint16(frame[8:])) * 4
+	if 10+headerSize > len(frame) {
+		return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader header size exceeds frame size")
+	}
+	header := bytes.NewReader(frame[10 : 10+headerSize])
+	payload := frame[10+headerSize:]
+
+	protocolID, err := binary.ReadUvarint(header)
+	if err != nil {
+		return nil, NewTTransportExceptionFromOsError(err)
+	}
+	if protocolID != THEADER_PROTOCOL_BINARY && protocolID != THEADER_PROTOCOL_COMPACT {
+		return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "unknown THeader protocol id "+strconv.FormatUint(protocolID, 10))
+	}
+	p.protocolID = int(protocolID)
+
+	count, err := binary.ReadUvarint(header)
+	if err != nil {
+		return nil, NewTTransportExceptionFromOsError(err)
+	}
+	p.readTransforms = make([]int, 0, int(count))
+	for i := uint64(0); i < count; i++ {
+		transform, err := binary.ReadUvarint(header)
+		if err != nil {
+			return nil, NewTTransportExceptionFromOsError(err)
+		}
+		p.readTransforms = append(p.readTransforms, int(transform))
+	}
+
+	p.readHeaders = make(map[string]string)
+	for header.Len() > 0 {
+		infoType, err := binary.ReadUvarint(header)
+		if err != nil {
+			return nil, NewTTransportExceptionFromOsError(err)
+		}
+		if infoType != THEADER_INFO_KEYVALUE {
+			// padding, or an info type we do not know about
+			break
+		}
+		count, err := binary.ReadUvarint(header)
+		if err != nil {
+			return nil, NewTTransportExceptionFromOsError(err)
+		}
+		for i := uint64(0); i < count; i++ {
+			key, err := readHeaderString(header)
+			if err != nil {
+				return nil, err
+			}
+			value, err := readHeaderString(header)
+			if err != nil {
+				return nil, err
+			}
+			p.readHeaders[key] = value
+		}
+	}
+
+	for i := len(p.readTransforms) - 1; i >= 0; i-- {
+		switch p.readTransforms[i] {
+		case THEADER_TRANSFORM_NONE:
+		case THEADER_TRANSFORM_ZLIB:
+			r, err := zlib.NewReader(bytes.NewReader(payload))
+			if err != nil {
+				return nil, NewTTransportExceptionFromOsError(err)
+			}
+			if payload, err = ioutil.ReadAll(r); err != nil {
+				return nil, NewTTransportExceptionFromOsError(err)
+			}
+			r.Close()
+		default:
+			return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "unknown THeader transform id "+strconv.Itoa(p.readTransforms[i]))
+		}
+	}
+	return payload, nil
+}
+
+func readHeaderString(r *bytes.Reader) (string, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", NewTTransportExceptionFromOsError(err)
+	}
+	if size > uint64(r.Len()) {
+		return "", NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader string exceeds header size")
+	}
+	b := make([]byte, size)
+	r.Read(b)
+	return string(b), nil
+}
+
+func (p *THeaderTransport) Read(buf []byte) (int, error) {
+	if err := p.ReadFrame(); err != nil {
+		return 0, err
+	}
+	n, err := p.frameReader.Read(buf)
+	if err == io.EOF && p.isFramed() {
+		// end of the current frame, the next read starts a new one
+		err = nil
+	}
+	return n, NewTTransportExceptionFromOsError(err)
+}
+
+func (p *THeaderTransport) ReadAll(buf []byte) (int, error) {
+	return ReadAllTransport(p, buf)
+}
+
+func (p *THeaderTransport) Write(buf []byte) (int, error) {
+	n, err := p.writeBuffer.Write(buf)
+	return n, NewTTransportExceptionFromOsError(err)
+}
+
+/**
+ * Writes the buffered message as one frame, in the format of the peer.
+ */
+func (p *THeaderTransport) Flush() error {
+	payload := p.writeBuffer.Bytes()
+	defer p.writeBuffer.Reset()
+
+	var out []byte
+	switch p.clientType {
+	case THEADER_CLIENT_UNFRAMED_BINARY, THEADER_CLIENT_UNFRAMED_COMPACT:
+		out = payload
+	case THEADER_CLIENT_FRAMED_BINARY, THEADER_CLIENT_FRAMED_COMPACT:
+		out = make([]byte, 4, 4+len(payload))
+		binary.BigEndian.PutUint32(out, uint32(len(payload)))
+		out = append(out, payload...)
+	default:
+		frame, err := p.headerFrame(payload)
+		if err != nil {
+			return err
+		}
+		out = frame
+	}
+
+	if _, err := p.transport.Write(out); err != nil {
+		return NewTTransportExceptionFromOsError(err)
+	}
+	return NewTTransportExceptionFromOsError(p.transport.Flush())
+}
+
+func (p *THeaderTransport) headerFrame(payload []byte) ([]byte, error) {
+	for _, transform := range p.writeTransforms {
+		switch transform {
+		case THEADER_TRANSFORM_ZLIB:
+			var b bytes.Buffer
+			w := zlib.NewWriter(&b)
+			if _, err := w.Write(payload); err != nil {
+				return nil, NewTTransportExceptionFromOsError(err)
+			}
+			if err := w.Close(); err != nil {
+				return nil, NewTTransportExceptionFromOsError(err)
+			}
+			payload = b.Bytes()
+		}
+	}
+
+	var header bytes.Buffer
+	writeUvarint(&header, uint64(p.protocolID))
+	writeUvarint(&header, uint64(len(p.writeTransforms)))
+	for _, transform := range p.writeTransforms {
+		writeUvarint(&header, uint64(transform))
+	}
+	if len(p.writeHeaders) > 0 {
+		keys := make([]string, 0, len(p.writeHeaders))
+		for k := range p.writeHeaders {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		writeUvarint(&header, THEADER_INFO_KEYVALUE)
+		writeUvarint(&header, uint64(len(keys)))
+		for _, k := range keys {
+			writeHeaderString(&header, k)
+			writeHeaderString(&header, p.writeHeaders[k])
+		}
+	}
+	for header.Len()%4 != 0 {
+		header.WriteByte(0)
+	}
+	if header.Len()/4 > 0xffff {
+		return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader headers are too large")
+	}
+
+	size := 10 + header.Len() + len(payload)
+	if size > THEADER_MAX_FRAME_SIZE {
+		return nil, NewTTransportException(UNKNOWN_TRANSPORT_EXCEPTION, "THeader frame size "+strconv.Itoa(size)+" exceeds the limit")
+	}
+	frame := make([]byte, 14, 4+size)
+	binary.BigEndian.PutUint32(frame, uint32(size))
+	binary.BigEndian.PutUint32(frame[4:], THEADER_MAGIC|uint32(p.flags))
+	binary.BigEndian.PutUint32(frame[8:], uint32(p.seqId))
+	binary.BigEndian.PutUint16(frame[12:], uint16(header.Len()/4))
+	frame = append(frame, header.Bytes()...)
+	frame = append(frame, payload...)
+	return frame, nil
+}
+
+func writeUvarint(b *bytes.Buffer, v uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, v)
+	b.Write(buf[:n])
+}
+
+func writeHeaderString(b *bytes.Buffer, s string) {
+	writeUvarint(b, uint64(len(s)))
+	b.WriteString(s)
+}
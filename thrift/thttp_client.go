@@ -21,6 +21,7 @@ package thrift
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -119,6 +120,10 @@ func (p *THttpClient) Read(buf []byte) (int, error) {
 		return 0, NewTTransportException(NOT_OPEN, "Response buffer is empty, no request.")
 	}
 	n, err := p.response.Body.Read(buf)
+	if n > 0 && err == io.EOF {
+		// report EOF on the next read, ReadAll treats it as a failure
+		err = nil
+	}
 	return n, NewTTransportExceptionFromOsError(err)
 }
 
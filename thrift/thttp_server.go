@@ -0,0 +1,155 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+import (
+	"errors"
+	"net/http"
+)
+
+/**
+ * Largest request body a THttpServer reads unless SetMaxBodySize changes it.
+ */
+const THTTP_SERVER_DEFAULT_MAX_BODY_SIZE = 64 << 20
+
+/**
+ * HTTP server implementation. THttpServer is an http.Handler that reads
+ * a thrift request from the POST body, hands it to a processor and writes
+ * the response back as the body of the reply.
+ *
+ * It can be mounted on any http.ServeMux, the client side counterpart is
+ * THttpClient created by NewTHttpPostClient.
+ */
+type THttpServer struct {
+	processorFactory      TProcessorFactory
+	inputProtocolFactory  TProtocolFactory
+	outputProtocolFactory TProtocolFactory
+	maxBodySize           int64
+}
+
+func NewTHttpServer1(processor TProcessor) *THttpServer {
+	return NewTHttpServerFactory1(NewTProcessorFactory(processor))
+}
+
+func NewTHttpServer2(processor TProcessor, protocolFactory TProtocolFactory) *THttpServer {
+	return NewTHttpServerFactory2(NewTProcessorFactory(processor), protocolFactory)
+}
+
+func NewTHttpServer3(processor TProcessor, inputProtocolFactory TProtocolFactory, outputProtocolFactory TProtocolFactory) *THttpServer {
+	return NewTHttpServerFactory3(NewTProcessorFactory(processor),
+		inputProtocolFactory,
+		outputProtocolFactory,
+	)
+}
+
+func NewTHttpServerFactory1(processorFactory TProcessorFactory) *THttpServer {
+	return NewTHttpServerFactory3(processorFactory,
+		NewTBinaryProtocolFactoryDefault(),
+		NewTBinaryProtocolFactoryDefault(),
+	)
+}
+
+func NewTHttpServerFactory2(processorFactory TProcessorFactory, protocolFactory TProtocolFactory) *THttpServer {
+	return NewTHttpServerFactory3(processorFactory,
+		protocolFactory,
+		protocolFactory,
+	)
+}
+
+func NewTHttpServerFactory3(processorFactory TProcessorFactory, inputProtocolFactory TProtocolFactory, outputProtocolFactory TProtocolFactory) *THttpServer {
+	return &THttpServer{processorFactory: processorFactory,
+		inputProtocolFactory:  inputProtocolFactory,
+		outputProtocolFactory: outputProtocolFactory,
+		maxBodySize:           THTTP_SERVER_DEFAULT_MAX_BODY_SIZE,
+	}
+}
+
+func (p *THttpServer) ProcessorFactory() TProcessorFactory {
+	return p.processorFactory
+}
+
+func (p *THttpServer) InputProtocolFactory() TProtocolFactory {
+	return p.inputProtocolFactory
+}
+
+func (p *THttpServer) OutputProtocolFactory() TProtocolFactory {
+	return p.outputProtocolFactory
+}
+
+func (p *THttpServer) MaxBodySize() int64 {
+	return p.maxBodySize
+}
+
+/**
+ * Sets the largest request body read, a larger one is refused with
+ * 413 Request Entity Too Large. Zero or less reads bodies of any size.
+ * It must be set before the server handles requests.
+ */
+func (p *THttpServer) SetMaxBodySize(size int64) {
+	p.maxBodySize = size
+}
+
+/**
+ * Serves one HTTP request. Every thrift message found in the request
+ * body is processed in order, and the replies are concatenated into the
+ * response body.
+ */
+func (p *THttpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "thrift requests must use POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body := r.Body
+	if p.maxBodySize > 0 {
+		body = http.MaxBytesReader(w, r.Body, p.maxBodySize)
+	}
+	inputTransport := NewTMemoryBuffer()
+	if _, err := inputTransport.ReadFrom(body); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+	outputTransport := NewTMemoryBuffer()
+
+	processor := p.processorFactory.GetProcessor(inputTransport)
+	inputProtocol := p.inputProtocolFactory.GetProtocol(inputTransport)
+	outputProtocol := p.outputProtocolFactory.GetProtocol(outputTransport)
+
+	for inputTransport.Len() > 0 {
+		ok, e := processor.Process(inputProtocol, outputProtocol)
+		if e != nil && outputTransport.Len() == 0 {
+			http.Error(w, e.Error(), http.StatusBadRequest)
+			return
+		}
+		if !ok {
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-thrift")
+	w.WriteHeader(http.StatusOK)
+	outputTransport.WriteTo(w)
+}
@@ -0,0 +1,120 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+import (
+	"strings"
+)
+
+/**
+ * TMultiplexedProcessor serves several services on one transport. A call
+ * named "service:method", as written by TMultiplexedProtocol, is handed to
+ * the processor registered for service with the bare method name.
+ *
+ * Calls without a service prefix go to the default processor, so plain
+ * clients of that service keep working.
+ */
+type TMultiplexedProcessor struct {
+	serviceProcessorMap map[string]TProcessor
+	defaultProcessor    TProcessor
+}
+
+func NewTMultiplexedProcessor() *TMultiplexedProcessor {
+	return &TMultiplexedProcessor{serviceProcessorMap: make(map[string]TProcessor)}
+}
+
+/**
+ * Registers processor for calls prefixed by serviceName.
+ */
+func (p *TMultiplexedProcessor) RegisterProcessor(serviceName string, processor TProcessor) {
+	p.serviceProcessorMap[serviceName] = processor
+}
+
+/**
+ * Registers processor for calls without a service prefix.
+ */
+func (p *TMultiplexedProcessor) RegisterDefault(processor TProcessor) {
+	p.defaultProcessor = processor
+}
+
+func (p *TMultiplexedProcessor) Processor(serviceName string) (processor TProcessor, exists bool) {
+	processor, exists = p.serviceProcessorMap[serviceName]
+	return
+}
+
+func (p *TMultiplexedProcessor) Process(in, out TProtocol) (bool, TException) {
+	name, typeId, seqid, err := in.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if typeId != CALL && typeId != ONEWAY {
+		return false, NewTProtocolException(INVALID_DATA, "TMultiplexedProcessor expected a CALL or ONEWAY message")
+	}
+
+	index := strings.Index(name, MULTIPLEXED_SEPARATOR)
+	if index < 0 {
+		if p.defaultProcessor == nil {
+			return p.fail(in, out, name, seqid, "Service name not found in message name: "+name+". Did you forget to use a TMultiplexedProtocol in your client?")
+		}
+		return p.defaultProcessor.Process(newStoredMessageProtocol(in, name, typeId, seqid), out)
+	}
+
+	serviceName := name[:index]
+	processor, ok := p.serviceProcessorMap[serviceName]
+	if !ok {
+		return p.fail(in, out, name, seqid, "Service name not found: "+serviceName+". Did you forget to call RegisterProcessor()?")
+	}
+	return processor.Process(newStoredMessageProtocol(in, name[index+len(MULTIPLEXED_SEPARATOR):], typeId, seqid), out)
+}
+
+func (p *TMultiplexedProcessor) fail(in, out TProtocol, name string, seqid int32, message string) (bool, TException) {
+	in.Skip(STRUCT)
+	in.ReadMessageEnd()
+	x := NewTApplicationException(UNKNOWN_METHOD, message)
+	out.WriteMessageBegin(name, EXCEPTION, seqid)
+	x.Write(out)
+	out.WriteMessageEnd()
+	out.Transport().Flush()
+	return false, x
+}
+
+/**
+ * Protocol decorator returning a message header that has already been
+ * read, so a processor can read the message as if it was never touched.
+ */
+type storedMessageProtocol struct {
+	TProtocol
+	name   string
+	typeId TMessageType
+	seqid  int32
+	read   bool
+}
+
+func newStoredMessageProtocol(protocol TProtocol, name string, typeId TMessageType, seqid int32) *storedMessageProtocol {
+	return &storedMessageProtocol{TProtocol: protocol, name: name, typeId: typeId, seqid: seqid}
+}
+
+func (p *storedMessageProtocol) ReadMessageBegin() (name string, typeId TMessageType, seqid int32, err TProtocolException) {
+	if p.read {
+		return p.TProtocol.ReadMessageBegin()
+	}
+	p.read = true
+	return p.name, p.typeId, p.seqid, nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+/**
+ * Separator between the service name and the method name of a
+ * multiplexed call.
+ */
+const MULTIPLEXED_SEPARATOR = ":"
+
+/**
+ * TMultiplexedProtocol is a protocol decorator for calling a service
+ * registered on a TMultiplexedProcessor. It prefixes the method name of
+ * every call with the service name and MULTIPLEXED_SEPARATOR, as Apache
+ * Thrift's TMultiplexedProtocol does.
+ *
+ * Replies carry the bare method name and are read unchanged.
+ */
+type TMultiplexedProtocol struct {
+	TProtocol
+	serviceName string
+}
+
+type TMultiplexedProtocolFactory struct {
+	factory     TProtocolFactory
+	serviceName string
+}
+
+func NewTMultiplexedProtocol(protocol TProtocol, serviceName string) *TMultiplexedProtocol {
+	return &TMultiplexedProtocol{TProtocol: protocol, serviceName: serviceName}
+}
+
+func NewTMultiplexedProtocolFactory(factory TProtocolFactory, serviceName string) *TMultiplexedProtocolFactory {
+	return &TMultiplexedProtocolFactory{factory: factory, serviceName: serviceName}
+}
+
+func (p *TMultiplexedProtocolFactory) GetProtocol(trans TTransport) TProtocol {
+	return NewTMultiplexedProtocol(p.factory.GetProtocol(trans), p.serviceName)
+}
+
+/**
+ * Factory of the decorated protocols.
+ */
+func (p *TMultiplexedProtocolFactory) Factory() TProtocolFactory {
+	return p.factory
+}
+
+func (p *TMultiplexedProtocolFactory) ServiceName() string {
+	return p.serviceName
+}
+
+func (p *TMultiplexedProtocol) ServiceName() string {
+	return p.serviceName
+}
+
+func (p *TMultiplexedProtocol) WriteMessageBegin(name string, typeId TMessageType, seqid int32) TProtocolException {
+	if typeId == CALL || typeId == ONEWAY {
+		return p.TProtocol.WriteMessageBegin(p.serviceName+MULTIPLEXED_SEPARATOR+name, typeId, seqid)
+	}
+	return p.TProtocol.WriteMessageBegin(name, typeId, seqid)
+}
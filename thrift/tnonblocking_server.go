@@ -19,7 +19,9 @@
 
 package thrift
 
-import ()
+import (
+	"sync/atomic"
+)
 
 /**
  * A nonblocking TServer implementation. This allows for fairness amongst all
@@ -33,8 +35,8 @@ import ()
  * method call has been read off the wire. Clients must also use TFramedTransport.
  */
 type TNonblockingServer struct {
-	/** Flag for stopping the server */
-	stopped bool
+	/** Flag for stopping the server, set with atomic as connections read it */
+	stopped int32
 
 	processorFactory       TProcessorFactory
 	serverTransport        TServerTransport
@@ -42,6 +44,8 @@ type TNonblockingServer struct {
 	outputTransportFactory TTransportFactory
 	inputProtocolFactory   TProtocolFactory
 	outputProtocolFactory  TProtocolFactory
+
+	tServerHooks
 }
 
 func NewTNonblockingServer2(processor TProcessor, serverTransport TServerTransport) *TNonblockingServer {
@@ -121,12 +125,13 @@ func (p *TNonblockingServer) OutputProtocolFactory() TProtocolFactory {
 }
 
 func (p *TNonblockingServer) Serve() error {
-	p.stopped = false
+	atomic.StoreInt32(&p.stopped, 0)
 	err := p.serverTransport.Listen()
 	if err != nil {
 		return err
 	}
-	for !p.stopped {
+	p.preServe()
+	for !p.IsStopped() {
 		client, err := p.serverTransport.Accept()
 		if err != nil {
 			return err
@@ -139,13 +144,13 @@ func (p *TNonblockingServer) Serve() error {
 }
 
 func (p *TNonblockingServer) Stop() error {
-	p.stopped = true
+	atomic.StoreInt32(&p.stopped, 1)
 	p.serverTransport.Interrupt()
 	return nil
 }
 
 func (p *TNonblockingServer) IsStopped() bool {
-	return p.stopped
+	return atomic.LoadInt32(&p.stopped) != 0
 }
 
 func (p *TNonblockingServer) processRequest(client TTransport) {
@@ -154,22 +159,15 @@ func (p *TNonblockingServer) processRequest(client TTransport) {
 	outputTransport := p.outputTransportFactory.GetTransport(client)
 	inputProtocol := p.inputProtocolFactory.GetProtocol(inputTransport)
 	outputProtocol := p.outputProtocolFactory.GetProtocol(outputTransport)
+	if headerProtocol, ok := inputProtocol.(*THeaderProtocol); ok {
+		// answer in the format the request was read in
+		outputProtocol = headerProtocol
+	}
 	if inputTransport != nil {
 		defer inputTransport.Close()
 	}
 	if outputTransport != nil {
 		defer outputTransport.Close()
 	}
-	for {
-		ok, e := processor.Process(inputProtocol, outputProtocol)
-		if e != nil {
-			if !p.stopped {
-				// TODO(pomack) log error
-				break
-			}
-		}
-		if !ok {
-			break
-		}
-	}
+	p.serveConnection(client, processor, inputTransport, outputTransport, inputProtocol, outputProtocol, p.IsStopped, nil)
 }
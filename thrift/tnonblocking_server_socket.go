@@ -71,6 +71,10 @@ func NewTNonblockingServerSocketAddrTimeout(addr net.Addr, nsecTimeout int64) (*
 }
 
 func (p *TNonblockingServerSocket) Listen() error {
+	if p.IsOpen() {
+		// created from a listener
+		return nil
+	}
 	return p.Open()
 }
 
@@ -165,6 +169,6 @@ func (p *TNonblockingServerSocket) Close() (err error) {
 }
 
 func (p *TNonblockingServerSocket) Interrupt() error {
-	// probably not right
+	// closing the listener unblocks a pending Accept
 	return p.Close()
 }
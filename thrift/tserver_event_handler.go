@@ -0,0 +1,215 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log"
+	"net"
+)
+
+/**
+ * Callbacks a server makes around the connections it serves.
+ */
+type TServerEventHandler interface {
+	/**
+	 * Called once the server is listening, before it accepts connections.
+	 */
+	PreServe()
+	/**
+	 * Called when a connection is accepted. ctx carries the
+	 * TConnectionInfo of the connection, the context returned is passed to
+	 * the other callbacks and to processors implementing TContextProcessor.
+	 */
+	CreateContext(ctx context.Context, input TProtocol, output TProtocol) context.Context
+	/**
+	 * Called when the connection is closed.
+	 */
+	DeleteContext(ctx context.Context, input TProtocol, output TProtocol)
+	/**
+	 * Called before each request is processed.
+	 */
+	ProcessContext(ctx context.Context, input TTransport, output TTransport)
+}
+
+/**
+ * A processor that is told about the connection the request came on.
+ */
+type TContextProcessor interface {
+	TProcessor
+	ProcessContext(ctx context.Context, in, out TProtocol) (bool, TException)
+}
+
+/**
+ * Destination of the errors a server cannot report to a client,
+ * *log.Logger implements it.
+ */
+type TLogger interface {
+	Printf(format string, v ...interface{})
+}
+
+/**
+ * The connection a request is served on.
+ */
+type TConnectionInfo struct {
+	LocalAddr  net.Addr
+	RemoteAddr net.Addr
+	// nil unless the connection uses TLS
+	TLS *tls.ConnectionState
+}
+
+type connectionInfoKey struct{}
+
+/**
+ * Returns a copy of ctx carrying info.
+ */
+func NewConnectionContext(ctx context.Context, info *TConnectionInfo) context.Context {
+	return context.WithValue(ctx, connectionInfoKey{}, info)
+}
+
+/**
+ * Returns the connection stored in ctx by the server.
+ */
+func ConnectionInfoFromContext(ctx context.Context) (*TConnectionInfo, bool) {
+	info, ok := ctx.Value(connectionInfoKey{}).(*TConnectionInfo)
+	return info, ok
+}
+
+/**
+ * Event handler and logger shared by the servers.
+ */
+type tServerHooks struct {
+	eventHandler TServerEventHandler
+	logger       TLogger
+}
+
+func (p *tServerHooks) ServerEventHandler() TServerEventHandler {
+	return p.eventHandler
+}
+
+/**
+ * Sets the event handler, it must be called before Serve.
+ */
+func (p *tServerHooks) SetServerEventHandler(eventHandler TServerEventHandler) {
+	p.eventHandler = eventHandler
+}
+
+func (p *tServerHooks) Logger() TLogger {
+	return p.logger
+}
+
+/**
+ * Sets where connection and processing errors are logged, the standard
+ * logger is used when nil. It must be called before Serve.
+ */
+func (p *tServerHooks) SetLogger(logger TLogger) {
+	p.logger = logger
+}
+
+func (p *tServerHooks) logf(format string, v ...interface{}) {
+	if p.logger != nil {
+		p.logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
+
+func (p *tServerHooks) preServe() {
+	if p.eventHandler != nil {
+		p.eventHandler.PreServe()
+	}
+}
+
+/**
+ * Returns the context of a new connection, completing the TLS handshake
+ * to fill in the connection state.
+ */
+func (p *tServerHooks) connectionContext(client TTransport) (context.Context, error) {
+	info := &TConnectionInfo{}
+	if s, ok := client.(*TSocket); ok && s.Conn() != nil {
+		conn := s.Conn()
+		info.LocalAddr = conn.LocalAddr()
+		info.RemoteAddr = conn.RemoteAddr()
+		if tlsConn, ok := conn.(*tls.Conn); ok {
+			if err := tlsConn.Handshake(); err != nil {
+				return nil, err
+			}
+			state := tlsConn.ConnectionState()
+			info.TLS = &state
+		}
+	}
+	return NewConnectionContext(context.Background(), info), nil
+}
+
+/**
+ * Serves requests on a connection until the client goes away, an error
+ * occurs or stopped returns true.
+ */
+func (p *tServerHooks) serveConnection(client TTransport, processor TProcessor, inputTransport, outputTransport TTransport, inputProtocol, outputProtocol TProtocol, stopped func() bool, processed func()) {
+	ctx, err := p.connectionContext(client)
+	if err != nil {
+		p.logf("thrift: connection error: %v", err)
+		return
+	}
+	info, _ := ConnectionInfoFromContext(ctx)
+
+	if p.eventHandler != nil {
+		ctx = p.eventHandler.CreateContext(ctx, inputProtocol, outputProtocol)
+		defer p.eventHandler.DeleteContext(ctx, inputProtocol, outputProtocol)
+	}
+	contextProcessor, _ := processor.(TContextProcessor)
+
+	for {
+		if p.eventHandler != nil {
+			p.eventHandler.ProcessContext(ctx, inputTransport, outputTransport)
+		}
+		var ok bool
+		var e TException
+		if contextProcessor != nil {
+			ok, e = contextProcessor.ProcessContext(ctx, inputProtocol, outputProtocol)
+		} else {
+			ok, e = processor.Process(inputProtocol, outputProtocol)
+		}
+		if processed != nil {
+			processed()
+		}
+		if e != nil {
+			if !stopped() && !isEndOfFile(e) {
+				p.logf("thrift: error processing request from %v: %v", info.RemoteAddr, e)
+			}
+			break
+		}
+		if !ok || stopped() {
+			break
+		}
+	}
+}
+
+/**
+ * Whether e reports a connection closed by the peer.
+ */
+func isEndOfFile(e error) bool {
+	if te, ok := e.(*tTransportException); ok && te.TypeId() == END_OF_FILE {
+		return true
+	}
+	return e.Error() == io.EOF.Error()
+}
@@ -19,12 +19,16 @@
 
 package thrift
 
+import (
+	"sync/atomic"
+)
+
 /**
  * Simple singlethreaded server for testing.
  *
  */
 type TSimpleServer struct {
-	stopped bool
+	stopped int32 // set with atomic, Stop is called from other goroutines
 
 	processorFactory       TProcessorFactory
 	serverTransport        TServerTransport
@@ -32,6 +36,8 @@ type TSimpleServer struct {
 	outputTransportFactory TTransportFactory
 	inputProtocolFactory   TProtocolFactory
 	outputProtocolFactory  TProtocolFactory
+
+	tServerHooks
 }
 
 func NewTSimpleServer2(processor TProcessor, serverTransport TServerTransport) *TSimpleServer {
@@ -111,12 +117,13 @@ func (p *TSimpleServer) OutputProtocolFactory() TProtocolFactory {
 }
 
 func (p *TSimpleServer) Serve() error {
-	p.stopped = false
+	atomic.StoreInt32(&p.stopped, 0)
 	err := p.serverTransport.Listen()
 	if err != nil {
 		return err
 	}
-	for !p.stopped {
+	p.preServe()
+	for !p.IsStopped() {
 		client, err := p.serverTransport.Accept()
 		if err != nil {
 			return err
@@ -129,33 +136,30 @@ func (p *TSimpleServer) Serve() error {
 }
 
 func (p *TSimpleServer) Stop() error {
-	p.stopped = true
+	atomic.StoreInt32(&p.stopped, 1)
 	p.serverTransport.Interrupt()
 	return nil
 }
 
+func (p *TSimpleServer) IsStopped() bool {
+	return atomic.LoadInt32(&p.stopped) != 0
+}
+
 func (p *TSimpleServer) processRequest(client TTransport) {
 	processor := p.processorFactory.GetProcessor(client)
 	inputTransport := p.inputTransportFactory.GetTransport(client)
 	outputTransport := p.outputTransportFactory.GetTransport(client)
 	inputProtocol := p.inputProtocolFactory.GetProtocol(inputTransport)
 	outputProtocol := p.outputProtocolFactory.GetProtocol(outputTransport)
+	if headerProtocol, ok := inputProtocol.(*THeaderProtocol); ok {
+		// answer in the format the request was read in
+		outputProtocol = headerProtocol
+	}
 	if inputTransport != nil {
 		defer inputTransport.Close()
 	}
 	if outputTransport != nil {
 		defer outputTransport.Close()
 	}
-	for {
-		ok, e := processor.Process(inputProtocol, outputProtocol)
-		if e != nil {
-			if !p.stopped {
-				// TODO(pomack) log error
-				break
-			}
-		}
-		if !ok {
-			break
-		}
-	}
+	p.serveConnection(client, processor, inputTransport, outputTransport, inputProtocol, outputProtocol, p.IsStopped, nil)
 }
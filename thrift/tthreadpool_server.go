@@ -0,0 +1,407 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements. See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership. The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License. You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package thrift
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	DEFAULT_WORKERS         = 64
+	DEFAULT_MAX_CONNECTIONS = 1024
+)
+
+/**
+ * A TServer serving connections with a bounded pool of workers. Every
+ * worker serves one connection at a time, accepted connections wait in a
+ * queue for a free worker, and no more than maxConnections connections are
+ * accepted at once.
+ *
+ * Shutdown stops the server gracefully: it stops accepting, lets requests
+ * in flight complete, and closes idle connections.
+ */
+type TThreadPoolServer struct {
+	processorFactory       TProcessorFactory
+	serverTransport        TServerTransport
+	inputTransportFactory  TTransportFactory
+	outputTransportFactory TTransportFactory
+	inputProtocolFactory   TProtocolFactory
+	outputProtocolFactory  TProtocolFactory
+
+	tServerHooks
+
+	workers        int
+	maxConnections int
+
+	mu           sync.Mutex
+	serving      bool
+	shuttingDown bool
+	quit         chan struct{}
+	done         chan struct{}
+	conns        map[*tPoolConn]struct{}
+}
+
+func NewTThreadPoolServer2(processor TProcessor, serverTransport TServerTransport) *TThreadPoolServer {
+	return NewTThreadPoolServerFactory2(NewTProcessorFactory(processor), serverTransport)
+}
+
+func NewTThreadPoolServer4(processor TProcessor, serverTransport TServerTransport, transportFactory TTransportFactory, protocolFactory TProtocolFactory) *TThreadPoolServer {
+	return NewTThreadPoolServerFactory4(NewTProcessorFactory(processor),
+		serverTransport,
+		transportFactory,
+		protocolFactory,
+	)
+}
+
+func NewTThreadPoolServer6(processor TProcessor, serverTransport TServerTransport, inputTransportFactory TTransportFactory, outputTransportFactory TTransportFactory, inputProtocolFactory TProtocolFactory, outputProtocolFactory TProtocolFactory) *TThreadPoolServer {
+	return NewTThreadPoolServerFactory6(NewTProcessorFactory(processor),
+		serverTransport,
+		inputTransportFactory,
+		outputTransportFactory,
+		inputProtocolFactory,
+		outputProtocolFactory,
+	)
+}
+
+func NewTThreadPoolServerFactory2(processorFactory TProcessorFactory, serverTransport TServerTransport) *TThreadPoolServer {
+	return NewTThreadPoolServerFactory6(processorFactory,
+		serverTransport,
+		NewTTransportFactory(),
+		NewTTransportFactory(),
+		NewTBinaryProtocolFactoryDefault(),
+		NewTBinaryProtocolFactoryDefault(),
+	)
+}
+
+func NewTThreadPoolServerFactory4(processorFactory TProcessorFactory, serverTransport TServerTransport, transportFactory TTransportFactory, protocolFactory TProtocolFactory) *TThreadPoolServer {
+	return NewTThreadPoolServerFactory6(processorFactory,
+		serverTransport,
+		transportFactory,
+		transportFactory,
+		protocolFactory,
+		protocolFactory,
+	)
+}
+
+func NewTThreadPoolServerFactory6(processorFactory TProcessorFactory, serverTransport TServerTransport, inputTransportFactory TTransportFactory, outputTransportFactory TTransportFactory, inputProtocolFactory TProtocolFactory, outputProtocolFactory TProtocolFactory) *TThreadPoolServer {
+	return &TThreadPoolServer{processorFactory: processorFactory,
+		serverTransport:        serverTransport,
+		inputTransportFactory:  inputTransportFactory,
+		outputTransportFactory: outputTransportFactory,
+		inputProtocolFactory:   inputProtocolFactory,
+		outputProtocolFactory:  outputProtocolFactory,
+		workers:                DEFAULT_WORKERS,
+		maxConnections:         DEFAULT_MAX_CONNECTIONS,
+	}
+}
+
+func (p *TThreadPoolServer) ProcessorFactory() TProcessorFactory {
+	return p.processorFactory
+}
+
+func (p *TThreadPoolServer) ServerTransport() TServerTransport {
+	return p.serverTransport
+}
+
+func (p *TThreadPoolServer) InputTransportFactory() TTransportFactory {
+	return p.inputTransportFactory
+}
+
+func (p *TThreadPoolServer) OutputTransportFactory() TTransportFactory {
+	return p.outputTransportFactory
+}
+
+func (p *TThreadPoolServer) InputProtocolFactory() TProtocolFactory {
+	return p.inputProtocolFactory
+}
+
+func (p *TThreadPoolServer) OutputProtocolFactory() TProtocolFactory {
+	return p.outputProtocolFactory
+}
+
+func (p *TThreadPoolServer) Workers() int {
+	return p.workers
+}
+
+/**
+ * Sets the number of connections served at the same time, it must be
+ * called before Serve.
+ */
+func (p *TThreadPoolServer) SetWorkers(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	p.workers = workers
+}
+
+func (p *TThreadPoolServer) MaxConnections() int {
+	return p.maxConnections
+}
+
+/**
+ * Sets the number of connections accepted at the same time, served or
+ * waiting for a worker. It must be called before Serve.
+ */
+func (p *TThreadPoolServer) SetMaxConnections(maxConnections int) {
+	p.maxConnections = maxConnections
+}
+
+/**
+ * Number of open connections.
+ */
+func (p *TThreadPoolServer) Connections() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.conns)
+}
+
+func (p *TThreadPoolServer) IsStopped() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.shuttingDown
+}
+
+/**
+ * Accepts and serves connections until the server is shut down. After a
+ * Shutdown or Stop, Serve returns nil once every connection is closed.
+ */
+func (p *TThreadPoolServer) Serve() error {
+	p.mu.Lock()
+	if p.serving || p.shuttingDown {
+		p.mu.Unlock()
+		return NewTTransportException(ALREADY_OPEN, "TThreadPoolServer is already serving or shut down")
+	}
+	maxConnections := p.maxConnections
+	if maxConnections < p.workers {
+		maxConnections = p.workers
+	}
+	p.serving = true
+	p.quit = make(chan struct{})
+	p.done = make(chan struct{})
+	p.conns = make(map[*tPoolConn]struct{})
+	p.mu.Unlock()
+	defer close(p.done)
+
+	err := p.serverTransport.Listen()
+	if err != nil {
+		return err
+	}
+	p.preServe()
+
+	limit := make(chan struct{}, maxConnections)
+	queue := make(chan *tPoolConn, maxConnections)
+	var workers sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for conn := range queue {
+				p.serveConn(conn)
+				<-limit
+			}
+		}()
+	}
+
+	for {
+		select {
+		case limit <- struct{}{}:
+		case <-p.quit:
+			err = nil
+		}
+		if p.IsStopped() {
+			break
+		}
+
+		client, e := p.serverTransport.Accept()
+		if e != nil {
+			<-limit
+			if !p.IsStopped() {
+				err = e
+			}
+			break
+		}
+		if client == nil {
+			<-limit
+			continue
+		}
+		queue <- p.track(client)
+	}
+
+	close(queue)
+	workers.Wait()
+	return err
+}
+
+/**
+ * Stops the server immediately, connections are closed even in the
+ * middle of a request.
+ */
+func (p *TThreadPoolServer) Stop() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p.Shutdown(ctx)
+	return nil
+}
+
+/**
+ * Shuts the server down gracefully: it stops accepting connections,
+ * closes idle connections, and closes the others once the request in
+ * flight has been answered. It returns when Serve has returned, or with
+ * the error of ctx after closing every remaining connection, Serve then
+ * returns once the handlers still running are done.
+ */
+func (p *TThreadPoolServer) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.shuttingDown {
+		p.shuttingDown = true
+		if p.quit != nil {
+			close(p.quit)
+		}
+	}
+	serving := p.serving
+	done := p.done
+	for conn := range p.conns {
+		conn.closeIfIdle()
+	}
+	p.mu.Unlock()
+
+	p.serverTransport.Interrupt()
+	if !serving {
+		return nil
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+
+	p.mu.Lock()
+	for conn := range p.conns {
+		conn.interrupt()
+	}
+	p.mu.Unlock()
+	return ctx.Err()
+}
+
+func (p *TThreadPoolServer) track(client TTransport) *tPoolConn {
+	conn := newTPoolConn(client)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.conns[conn] = struct{}{}
+	return conn
+}
+
+func (p *TThreadPoolServer) untrack(conn *tPoolConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.conns, conn)
+}
+
+func (p *TThreadPoolServer) serveConn(conn *tPoolConn) {
+	defer p.untrack(conn)
+	if p.IsStopped() {
+		conn.interrupt()
+		conn.TTransport.Close()
+		return
+	}
+
+	processor := p.processorFactory.GetProcessor(conn)
+	inputTransport := p.inputTransportFactory.GetTransport(conn)
+	outputTransport := p.outputTransportFactory.GetTransport(conn)
+	inputProtocol := p.inputProtocolFactory.GetProtocol(inputTransport)
+	outputProtocol := p.outputProtocolFactory.GetProtocol(outputTransport)
+	if headerProtocol, ok := inputProtocol.(*THeaderProtocol); ok {
+		// answer in the format the request was read in
+		outputProtocol = headerProtocol
+	}
+	if inputTransport != nil {
+		defer inputTransport.Close()
+	}
+	if outputTransport != nil {
+		defer outputTransport.Close()
+	}
+	p.serveConnection(conn.TTransport, processor, inputTransport, outputTransport, inputProtocol, outputProtocol, p.IsStopped, conn.setIdle)
+}
+
+const (
+	poolConnIdle = iota
+	poolConnActive
+	poolConnClosed
+)
+
+/**
+ * Transport of a connection served by a TThreadPoolServer. It is idle
+ * until the first byte of a request is read, and active until the request
+ * is answered.
+ */
+type tPoolConn struct {
+	TTransport
+	netConn net.Conn
+	state   int32
+}
+
+func newTPoolConn(client TTransport) *tPoolConn {
+	conn := &tPoolConn{TTransport: client, state: poolConnIdle}
+	if s, ok := client.(*TSocket); ok {
+		conn.netConn = s.Conn()
+	}
+	return conn
+}
+
+func (p *tPoolConn) Read(buf []byte) (int, error) {
+	n, err := p.TTransport.Read(buf)
+	if n > 0 && atomic.LoadInt32(&p.state) != poolConnActive {
+		if !atomic.CompareAndSwapInt32(&p.state, poolConnIdle, poolConnActive) {
+			return 0, NewTTransportException(NOT_OPEN, "Connection closed by server shutdown")
+		}
+	}
+	return n, err
+}
+
+func (p *tPoolConn) ReadAll(buf []byte) (int, error) {
+	return ReadAllTransport(p, buf)
+}
+
+func (p *tPoolConn) setIdle() {
+	atomic.CompareAndSwapInt32(&p.state, poolConnActive, poolConnIdle)
+}
+
+func (p *tPoolConn) closeIfIdle() {
+	if atomic.CompareAndSwapInt32(&p.state, poolConnIdle, poolConnClosed) {
+		p.interrupt()
+	}
+}
+
+/**
+ * Closes the connection, unblocking a worker waiting on it.
+ */
+func (p *tPoolConn) interrupt() {
+	atomic.StoreInt32(&p.state, poolConnClosed)
+	if p.netConn != nil {
+		p.netConn.Close()
+		return
+	}
+	p.TTransport.Close()
+}
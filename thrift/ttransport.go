@@ -20,6 +20,7 @@
 package thrift
 
 import (
+	"io"
 	"log"
 	"os"
 	"strconv"
@@ -163,6 +164,10 @@ func ReadAllTransport(p TTransport, buf []byte) (n int, err error) {
 	for n < size {
 		ret, err = p.Read(buf[n:])
 		if ret <= 0 {
+			if err != nil && n == 0 && isEndOfFile(err) {
+				// nothing read, the remote side closed between messages
+				return ret, NewTTransportExceptionFromOsError(io.EOF)
+			}
 			if err != nil {
 				err = NewTTransportExceptionDefaultString("Cannot read. Remote side has closed. Tried to read " + strconv.Itoa(size) + " bytes, but only got " + strconv.Itoa(n) + " bytes.")
 			}
@@ -0,0 +1,305 @@
+/*
+
+ */
+
+package goh
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+yamlLine is a line of a yaml document without its indentation and comment
+*/
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+/*
+yamlParser reads the block style subset of yaml used by schema files: mappings, sequences,
+plain and quoted scalars, flow sequences of scalars and comments. Anchors, tags, multi-line
+scalars and flow mappings are not supported.
+*/
+type yamlParser struct {
+	lines   []yamlLine
+	pos     int
+	strings map[string]bool // keys whose plain scalar values are kept as strings
+}
+
+/*
+parseYAML decodes a yaml document into map[string]interface{}, []interface{}, string, int64, float64, bool and nil values,
+the values of stringKeys are strings even if they look like numbers
+*/
+func parseYAML(data []byte, stringKeys ...string) (interface{}, error) {
+	p := &yamlParser{strings: make(map[string]bool)}
+	for _, key := range stringKeys {
+		p.strings[key] = true
+	}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(stripYAMLComment(line), " \t\r")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || text == "---" {
+			continue
+		}
+		if strings.HasPrefix(text, "\t") {
+			return nil, yamlError(i+1, "tabs can not be used for indentation")
+		}
+		p.lines = append(p.lines, yamlLine{num: i + 1, indent: len(line) - len(text), text: text})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+
+	value, err := p.parseNode(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, yamlError(p.lines[p.pos].num, "unexpected indentation")
+	}
+	return value, nil
+}
+
+func yamlError(line int, message string) error {
+	return errors.New(fmt.Sprint("goh: yaml line ", line, ": ", message))
+}
+
+/*
+stripYAMLComment cuts a # comment that is not inside a quoted string
+*/
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func isYAMLItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *yamlParser) parseNode(indent int) (interface{}, error) {
+	if isYAMLItem(p.lines[p.pos].text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseSequence(indent int) ([]interface{}, error) {
+	list := make([]interface{}, 0)
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isYAMLItem(p.lines[p.pos].text) {
+		line := p.lines[p.pos]
+		rest := strings.TrimLeft(line.text[1:], " ")
+		if rest == "" {
+			p.pos++
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				value, err := p.parseNode(p.lines[p.pos].indent)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			} else {
+				list = append(list, nil)
+			}
+			continue
+		}
+
+		if _, _, ok := splitYAMLKey(rest); ok || isYAMLItem(rest) {
+			// the item is a nested block that starts on the line of the dash
+			p.lines[p.pos] = yamlLine{num: line.num, indent: indent + len(line.text) - len(rest), text: rest}
+			value, err := p.parseNode(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+			continue
+		}
+
+		value, err := parseYAMLScalar(line.num, rest)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+		p.pos++
+	}
+	return list, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		if isYAMLItem(line.text) {
+			return nil, yamlError(line.num, "expected a key")
+		}
+		key, rest, ok := splitYAMLKey(line.text)
+		if !ok {
+			return nil, yamlError(line.num, "expected a key")
+		}
+		k, err := parseYAMLScalar(line.num, key)
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprint(k)
+		if _, dup := m[name]; dup {
+			return nil, yamlError(line.num, "duplicate key "+name)
+		}
+		p.pos++
+
+		if rest != "" {
+			if m[name], err = parseYAMLScalar(line.num, rest); err != nil {
+				return nil, err
+			}
+			if _, isString := m[name].(string); p.strings[name] && !isString && !strings.HasPrefix(rest, "[") {
+				m[name] = rest
+			}
+			continue
+		}
+
+		// a block below the key, a sequence may be indented as much as the key
+		if p.pos < len(p.lines) {
+			next := p.lines[p.pos]
+			if next.indent > indent || (next.indent == indent && isYAMLItem(next.text)) {
+				if m[name], err = p.parseNode(next.indent); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		m[name] = nil
+	}
+
+	if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+		return nil, yamlError(p.lines[p.pos].num, "unexpected indentation")
+	}
+	return m, nil
+}
+
+/*
+splitYAMLKey splits "key: value" at the first colon outside quotes that is followed by a space or ends the line
+*/
+func splitYAMLKey(text string) (string, string, bool) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '\'' || c == '"') && i == 0:
+			quote = c
+		case c == '[' || c == '{':
+			if i == 0 {
+				return "", "", false
+			}
+		case c == ':' && (i == len(text)-1 || text[i+1] == ' '):
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+func parseYAMLScalar(num int, text string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(text, `"`):
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return nil, yamlError(num, "invalid string "+text)
+		}
+		return s, nil
+	case strings.HasPrefix(text, "'"):
+		if len(text) < 2 || !strings.HasSuffix(text, "'") {
+			return nil, yamlError(num, "invalid string "+text)
+		}
+		return strings.Replace(text[1:len(text)-1], "''", "'", -1), nil
+	case strings.HasPrefix(text, "["):
+		if !strings.HasSuffix(text, "]") {
+			return nil, yamlError(num, "flow sequences have to end on the same line")
+		}
+		list := make([]interface{}, 0)
+		inner := strings.TrimSpace(text[1 : len(text)-1])
+		if inner == "" {
+			return list, nil
+		}
+		for _, item := range splitYAMLFlow(inner) {
+			value, err := parseYAMLScalar(num, strings.TrimSpace(item))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	case strings.HasPrefix(text, "{"), strings.HasPrefix(text, "&"), strings.HasPrefix(text, "*"),
+		strings.HasPrefix(text, "!"), strings.HasPrefix(text, "|"), strings.HasPrefix(text, ">"):
+		return nil, yamlError(num, "unsupported yaml "+text)
+	}
+
+	switch text {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return n, nil
+	}
+	if c := text[0]; c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9') {
+		if f, err := strconv.ParseFloat(text, 64); err == nil {
+			return f, nil
+		}
+	}
+	return text, nil
+}
+
+// splitYAMLFlow split the items of a flow sequence at the commas outside quotes and nested sequences
+func splitYAMLFlow(text string) []string {
+	var items []string
+	var quote byte
+	depth, start := 0, 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote == '\'' && c == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '\'' || c == '"') && strings.TrimSpace(text[start:i]) == "":
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(items, text[start:])
+}